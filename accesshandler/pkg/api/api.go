@@ -56,6 +56,12 @@ func New(ctx context.Context, runtime string, dc deploy.DeployConfigReader) (*AP
 		return nil, fmt.Errorf("invalid runtime: %s. valid runtimes are: %s", runtime, validRuntimes())
 	}
 
+	return NewWithRuntime(ctx, rt, dc)
+}
+
+// NewWithRuntime creates a new API using a runtime which was constructed by the caller,
+// such as a local runtime which emits events to an in-process event bus.
+func NewWithRuntime(ctx context.Context, rt Runtime, dc deploy.DeployConfigReader) (*API, error) {
 	err := rt.Init(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "initialising runtime")
@@ -88,6 +88,35 @@ func (a *API) PostGrantsRevoke(w http.ResponseWriter, r *http.Request, grantId s
 	apio.JSON(ctx, w, res, http.StatusOK)
 }
 
+// Extend grant
+// (POST /api/v1/grants/{grantId}/extend)
+func (a *API) PostGrantsExtend(w http.ResponseWriter, r *http.Request, grantId string) {
+	ctx := r.Context()
+	var b types.PostGrantsExtendJSONRequestBody
+
+	err := apio.DecodeJSONBody(w, r, &b)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	if !b.End.After(a.Clock.Now()) {
+		apio.Error(ctx, w, apio.NewRequestError(errors.New("the new end time must be in the future"), http.StatusBadRequest))
+		return
+	}
+
+	g, err := a.runtime.ExtendGrant(ctx, grantId, b.End.Time)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	res := types.GrantResponse{
+		Grant: *g,
+	}
+
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
+
 // run validation on a grant without provisioning any access
 func (a *API) ValidateGrant(w http.ResponseWriter, r *http.Request) {
 
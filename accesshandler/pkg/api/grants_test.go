@@ -118,7 +118,7 @@ func TestRevokeGrant(t *testing.T) {
 			assert.Equal(t, tc.wantErr, apiErr.Error)
 
 			//revoke grant
-			req, err = http.NewRequest("POST", "/api/v1/grants/123/revoke", strings.NewReader(tc.revokeBody))
+			req, err = http.NewRequest("POST", "/api/v1/grants/abcd/revoke", strings.NewReader(tc.revokeBody))
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -137,6 +137,61 @@ func TestRevokeGrant(t *testing.T) {
 	}
 }
 
+func TestExtendGrant(t *testing.T) {
+	type testcase struct {
+		name       string
+		extendBody string
+		wantCode   int
+		wantErr    string
+	}
+
+	TenAM := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+	createBody := fmt.Sprintf(`{"id":"abcd","subject":"chris@commonfate.io","provider":"okta","with":{"group":"Admins"},"start":"%s","end":"%s"}`, iso8601.New(TenAM.Add(time.Minute)), iso8601.New(TenAM.Add(time.Hour)))
+
+	clk := clock.NewMock()
+	clk.Set(TenAM)
+
+	testcases := []testcase{
+		{name: "grant is not active", extendBody: fmt.Sprintf(`{"end":"%s"}`, iso8601.New(TenAM.Add(2*time.Hour))), wantCode: http.StatusBadRequest, wantErr: "grant abcd can't be extended because it is PENDING"},
+		{name: "end in the past", extendBody: fmt.Sprintf(`{"end":"%s"}`, iso8601.New(TenAM.Add(-time.Hour))), wantCode: http.StatusBadRequest, wantErr: "the new end time must be in the future"},
+	}
+	config.ConfigureTestProviders([]config.Provider{
+		{
+			ID:       "okta",
+			Type:     "okta",
+			Provider: &okta.Provider{},
+		},
+	})
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			handler := newTestServer(t, withClock(clk))
+
+			req, err := http.NewRequest("POST", "/api/v1/grants", strings.NewReader(createBody))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+			assert.Equal(t, http.StatusCreated, rr.Code)
+
+			req, err = http.NewRequest("POST", "/api/v1/grants/abcd/extend", strings.NewReader(tc.extendBody))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+			rr = httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+			var apiErr apio.ErrorResponse
+			_ = json.NewDecoder(rr.Body).Decode(&apiErr)
+			assert.Equal(t, tc.wantErr, apiErr.Error)
+		})
+	}
+}
+
 func TestValidateGrant(t *testing.T) {
 	type testcase struct {
 		name     string
@@ -49,6 +49,36 @@ func (a *API) GetProviderArgs(w http.ResponseWriter, r *http.Request, providerId
 	apio.JSON(ctx, w, as.ArgSchema().ToAPI(), http.StatusOK)
 }
 
+// List provider assignments
+// (GET /api/v1/providers/{providerId}/assignments)
+func (a *API) ListProviderAssignments(w http.ResponseWriter, r *http.Request, providerId string) {
+	ctx := r.Context()
+	prov, ok := config.Providers[providerId]
+	if !ok {
+		apio.Error(ctx, w, apio.NewRequestError(&providers.ProviderNotFoundError{Provider: providerId}, http.StatusNotFound))
+		return
+	}
+	inv, ok := prov.Provider.(providers.Inventorier)
+	if !ok {
+		apio.JSON(ctx, w, types.ListAssignmentsResponse{Supported: false, Assignments: []types.ProviderAssignment{}}, http.StatusOK)
+		return
+	}
+	assignments, err := inv.Assignments(ctx)
+	if err != nil {
+		logger.Get(ctx).Errorw("failed to list provider assignments", "provider.id", providerId, "error", err)
+		apio.Error(ctx, w, err)
+		return
+	}
+	res := types.ListAssignmentsResponse{Supported: true, Assignments: []types.ProviderAssignment{}}
+	for _, as := range assignments {
+		res.Assignments = append(res.Assignments, types.ProviderAssignment{
+			Subject: as.Subject,
+			With:    types.ProviderAssignment_With{AdditionalProperties: as.With},
+		})
+	}
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
+
 func (a *API) ListProviderArgOptions(w http.ResponseWriter, r *http.Request, providerId string, argId string) {
 	ctx := r.Context()
 	prov, ok := config.Providers[providerId]
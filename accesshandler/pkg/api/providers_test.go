@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -235,3 +236,69 @@ func TestListProviderArgOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestListProviderAssignments(t *testing.T) {
+	type testcase struct {
+		name           string
+		giveProviderId string
+		wantCode       int
+		wantBody       *types.ListAssignmentsResponse
+		wantErr        string
+	}
+
+	tg := &testgroups.Provider{}
+	tg.SetGroups([]string{"admins", "developers"})
+	err := tg.Grant(context.Background(), "chris@commonfate.io", []byte(`{"group":"admins"}`), "abcd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.ConfigureTestProviders([]config.Provider{
+		{ID: "testgroups", Type: "testgroups", Provider: tg},
+		{ID: "unverifiable", Type: "unverifiable", Provider: unverifiableProvider{}},
+	})
+	notFoundErr := &providers.ProviderNotFoundError{Provider: "badid"}
+
+	testcases := []testcase{
+		{
+			name:           "ok",
+			giveProviderId: "testgroups",
+			wantCode:       http.StatusOK,
+			wantBody: &types.ListAssignmentsResponse{
+				Supported: true,
+				Assignments: []types.ProviderAssignment{
+					{Subject: "chris@commonfate.io", With: types.ProviderAssignment_With{AdditionalProperties: map[string]string{"group": "admins"}}},
+				},
+			},
+		},
+		{name: "not supported", giveProviderId: "unverifiable", wantCode: http.StatusOK, wantBody: &types.ListAssignmentsResponse{Supported: false, Assignments: []types.ProviderAssignment{}}},
+		{name: "not found", giveProviderId: "badid", wantCode: http.StatusNotFound, wantErr: notFoundErr.Error()},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := newTestServer(t)
+
+			req, err := http.NewRequest("GET", "/api/v1/providers/"+tc.giveProviderId+"/assignments", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+			if tc.wantBody != nil {
+				var got types.ListAssignmentsResponse
+				err = json.NewDecoder(rr.Body).Decode(&got)
+				if err != nil {
+					t.Fatal(err)
+				}
+				assert.Equal(t, *tc.wantBody, got)
+			} else {
+				var apiErr apio.ErrorResponse
+				_ = json.NewDecoder(rr.Body).Decode(&apiErr)
+				assert.Equal(t, tc.wantErr, apiErr.Error)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/common-fate/common-fate/accesshandler/pkg/runtime/lambda"
 	"github.com/common-fate/common-fate/accesshandler/pkg/runtime/local"
@@ -12,7 +13,7 @@ import (
 // A runtime is responsible for the actual execution of a grant and are tied to the
 // hosting environment the Access Handler is running in.
 //
-// Example runtimes are local (for testing only), and AWS Lambda with Step Functions.
+// Example runtimes are local (which schedules grants in the Access Handler process), and AWS Lambda with Step Functions.
 type Runtime interface {
 	// Init contains any runtime-specific initialisation logic.
 	Init(ctx context.Context) error
@@ -25,6 +26,9 @@ type Runtime interface {
 	// initiating an AWS Step Functions workflow.
 	// Revokes a grant and terminates the previous create grant workflow
 	RevokeGrant(ctx context.Context, grantID string, revoker string) (*types.Grant, error)
+
+	// ExtendGrant moves the end time of an active grant later, so that access is deactivated at the new end time.
+	ExtendGrant(ctx context.Context, grantID string, end time.Time) (*types.Grant, error)
 }
 
 // runtimes is a map of the supported runtime environments
@@ -23,16 +23,18 @@ func newTestServer(t *testing.T, opts ...func(a *API)) http.Handler {
 	// zaptest outputs logs if a test fails.
 	log := zaptest.NewLogger(t)
 
-	rt := &local.Runtime{}
-	err := rt.Init(context.Background())
-	if err != nil {
-		t.Fatal(err)
-	}
 	clk := clock.NewMock()
 
 	// default test time is 1st Jan 2022, 10:00am UTC
 	clk.Set(time.Date(2022, 01, 01, 10, 0, 0, 0, time.UTC))
 
+	// the runtime uses its own clock so that the scheduler doesn't activate grants during tests.
+	rt := &local.Runtime{Clock: clock.NewMock()}
+	err := rt.Init(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	a := API{
 		runtime: rt,
 		Clock:   clk,
@@ -0,0 +1,79 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/accesshandler/pkg/config"
+	"github.com/common-fate/common-fate/accesshandler/pkg/providers"
+	"github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// Verify Grant
+// (POST /api/v1/grants/verify)
+func (a *API) VerifyGrant(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var b types.GrantAccess
+	err := apio.DecodeJSONBody(w, r, &b)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	prov, ok := config.Providers[b.Provider]
+	if !ok {
+		apio.Error(ctx, w, apio.NewRequestError(errors.New("provider does not exist"), http.StatusBadRequest))
+		return
+	}
+
+	verifier, ok := prov.Provider.(providers.Verifier)
+	if !ok {
+		apio.JSON(ctx, w, types.GrantVerificationResponse{Supported: false}, http.StatusOK)
+		return
+	}
+	args, err := json.Marshal(b.With.AdditionalProperties)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	active, err := verifier.IsActive(ctx, string(b.Subject), args, b.Id)
+	if err != nil {
+		logger.Get(ctx).Errorw("failed to verify grant", "grant.id", b.Id, "provider", b.Provider, "error", err)
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	apio.JSON(ctx, w, types.GrantVerificationResponse{Supported: true, Active: active}, http.StatusOK)
+}
+
+// Deprovision Grant
+// (POST /api/v1/grants/deprovision)
+func (a *API) DeprovisionGrant(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var b types.GrantAccess
+	err := apio.DecodeJSONBody(w, r, &b)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	prov, ok := config.Providers[b.Provider]
+	if !ok {
+		apio.Error(ctx, w, apio.NewRequestError(errors.New("provider does not exist"), http.StatusBadRequest))
+		return
+	}
+	args, err := json.Marshal(b.With.AdditionalProperties)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	logger.Get(ctx).Infow("deprovisioning grant", "grant.id", b.Id, "provider", b.Provider)
+	err = prov.Provider.Revoke(ctx, string(b.Subject), args, b.Id)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	apio.JSON(ctx, w, nil, http.StatusOK)
+}
@@ -0,0 +1,113 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/common-fate/accesshandler/pkg/config"
+	"github.com/common-fate/common-fate/accesshandler/pkg/providers/testgroups"
+	"github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// unverifiableProvider is a provider which doesn't implement providers.Verifier.
+type unverifiableProvider struct{}
+
+func (unverifiableProvider) Grant(ctx context.Context, subject string, args []byte, grantID string) error {
+	return nil
+}
+
+func (unverifiableProvider) Revoke(ctx context.Context, subject string, args []byte, grantID string) error {
+	return nil
+}
+
+func TestVerifyGrant(t *testing.T) {
+	type testcase struct {
+		name     string
+		body     string
+		wantCode int
+		wantBody *types.GrantVerificationResponse
+		wantErr  string
+	}
+
+	tg := &testgroups.Provider{}
+	tg.SetGroups([]string{"admins", "developers"})
+	err := tg.Grant(context.Background(), "chris@commonfate.io", []byte(`{"group":"admins"}`), "abcd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.ConfigureTestProviders([]config.Provider{
+		{ID: "testgroups", Type: "testgroups", Provider: tg},
+		{ID: "unverifiable", Type: "unverifiable", Provider: unverifiableProvider{}},
+	})
+
+	testcases := []testcase{
+		{name: "active", body: `{"id":"abcd","subject":"chris@commonfate.io","provider":"testgroups","with":{"group":"admins"}}`, wantCode: http.StatusOK, wantBody: &types.GrantVerificationResponse{Supported: true, Active: true}},
+		{name: "not active", body: `{"id":"abcd","subject":"chris@commonfate.io","provider":"testgroups","with":{"group":"developers"}}`, wantCode: http.StatusOK, wantBody: &types.GrantVerificationResponse{Supported: true, Active: false}},
+		{name: "not supported", body: `{"id":"abcd","subject":"chris@commonfate.io","provider":"unverifiable","with":{"group":"admins"}}`, wantCode: http.StatusOK, wantBody: &types.GrantVerificationResponse{Supported: false}},
+		{name: "provider not found", body: `{"id":"abcd","subject":"chris@commonfate.io","provider":"other","with":{"group":"admins"}}`, wantCode: http.StatusBadRequest, wantErr: "provider does not exist"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := newTestServer(t)
+
+			req, err := http.NewRequest("POST", "/api/v1/grants/verify", strings.NewReader(tc.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+			if tc.wantBody != nil {
+				var got types.GrantVerificationResponse
+				err = json.NewDecoder(rr.Body).Decode(&got)
+				if err != nil {
+					t.Fatal(err)
+				}
+				assert.Equal(t, *tc.wantBody, got)
+			} else {
+				var apiErr apio.ErrorResponse
+				_ = json.NewDecoder(rr.Body).Decode(&apiErr)
+				assert.Equal(t, tc.wantErr, apiErr.Error)
+			}
+		})
+	}
+}
+
+func TestDeprovisionGrant(t *testing.T) {
+	ctx := context.Background()
+	tg := &testgroups.Provider{}
+	tg.SetGroups([]string{"admins"})
+	args := []byte(`{"group":"admins"}`)
+	err := tg.Grant(ctx, "chris@commonfate.io", args, "abcd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.ConfigureTestProviders([]config.Provider{{ID: "testgroups", Type: "testgroups", Provider: tg}})
+
+	handler := newTestServer(t)
+	req, err := http.NewRequest("POST", "/api/v1/grants/deprovision", strings.NewReader(`{"id":"abcd","subject":"chris@commonfate.io","provider":"testgroups","with":{"group":"admins"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Add("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	handler.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	active, err := tg.IsActive(ctx, "chris@commonfate.io", args, "abcd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, active)
+}
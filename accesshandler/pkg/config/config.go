@@ -16,4 +16,7 @@ type GranterConfig struct {
 	LogLevel       string `env:"LOG_LEVEL,default=info"`
 	EventBusArn    string `env:"COMMONFATE_EVENT_BUS_ARN"`
 	EventBusSource string `env:"COMMONFATE_EVENT_BUS_SOURCE"`
+	// TableName is the Common Fate table, which the granter reads extensions to grants from.
+	// If empty, grants are deactivated at their original end time.
+	TableName string `env:"COMMONFATE_TABLE_NAME"`
 }
@@ -12,6 +12,8 @@ import (
 	eksrolessso "github.com/common-fate/common-fate/accesshandler/pkg/providers/aws/eks-roles-sso"
 	ssov2 "github.com/common-fate/common-fate/accesshandler/pkg/providers/aws/sso-v2"
 	"github.com/common-fate/common-fate/accesshandler/pkg/providers/azure/ad"
+	"github.com/common-fate/common-fate/accesshandler/pkg/providers/database"
+	"github.com/common-fate/common-fate/accesshandler/pkg/providers/github"
 	"github.com/common-fate/common-fate/accesshandler/pkg/providers/okta"
 	"github.com/common-fate/common-fate/accesshandler/pkg/providers/testgroups"
 	"github.com/common-fate/common-fate/accesshandler/pkg/providers/testvault"
@@ -81,6 +83,27 @@ func Registry() ProviderRegistry {
 					Description: "AWS EKS Roles SSO",
 				},
 			},
+			"commonfate/database": {
+				"v1-alpha1": {
+					Provider:    &database.Provider{},
+					DefaultID:   "database",
+					Description: "PostgreSQL and MySQL roles",
+				},
+			},
+			"commonfate/github-teams": {
+				"v1": {
+					Provider:    &github.TeamsProvider{},
+					DefaultID:   "github-teams",
+					Description: "GitHub teams",
+				},
+			},
+			"commonfate/github-repos": {
+				"v1": {
+					Provider:    &github.ReposProvider{},
+					DefaultID:   "github-repos",
+					Description: "GitHub repository roles",
+				},
+			},
 			"commonfate/testvault": {
 				"v1": {
 					Provider:    &testvault.Provider{},
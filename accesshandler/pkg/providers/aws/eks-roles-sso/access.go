@@ -84,6 +84,7 @@ func (p *Provider) Revoke(ctx context.Context, subject string, args []byte, gran
 	return p.removePermissionSet(ctx, permissionSetName, subject)
 }
 
+// IsActive checks whether the permission set created for the grant is assigned to the user by calling the AWS SSO API.
 func (p *Provider) IsActive(ctx context.Context, subject string, args []byte, grantID string) (bool, error) {
 	var a Args
 	err := json.Unmarshal(args, &a)
@@ -91,6 +92,41 @@ func (p *Provider) IsActive(ctx context.Context, subject string, args []byte, gr
 		return false, err
 	}
 
+	arnMatch, err := p.findPermissionSet(ctx, permissionSetNameFromGrantID(grantID))
+	if err != nil {
+		return false, err
+	}
+	// the permission set is deleted when access is revoked.
+	if arnMatch == nil {
+		return false, nil
+	}
+
+	user, err := p.getUser(ctx, subject)
+	if err != nil {
+		return false, err
+	}
+
+	hasMore := true
+	var nextToken *string
+	for hasMore {
+		res, err := p.ssoClient.ListAccountAssignments(ctx, &ssoadmin.ListAccountAssignmentsInput{
+			AccountId:        &p.eksClusterRoleAccountID,
+			InstanceArn:      aws.String(p.instanceARN.Get()),
+			PermissionSetArn: arnMatch,
+			NextToken:        nextToken,
+		})
+		if err != nil {
+			return false, err
+		}
+		for _, aa := range res.AccountAssignments {
+			if aa.PrincipalType == types.PrincipalTypeUser && aws.ToString(aa.PrincipalId) == aws.ToString(user.UserId) {
+				return true, nil
+			}
+		}
+		nextToken = res.NextToken
+		hasMore = nextToken != nil
+	}
+
 	// we didn't find the user, so return false.
 	return false, nil
 }
@@ -216,17 +252,18 @@ func (p *Provider) createKubernetesRoleBinding(ctx context.Context, objectKey st
 	_, err := p.kubeClient.RbacV1().RoleBindings(p.namespace.Get()).Create(ctx, &rb, v1meta.CreateOptions{})
 	return err
 }
-func (p *Provider) removePermissionSet(ctx context.Context, permissionSetName string, subject string) error {
+
+// findPermissionSet returns the ARN of the permission set with the given name, or nil if it doesn't exist.
+func (p *Provider) findPermissionSet(ctx context.Context, permissionSetName string) (*string, error) {
 	hasMore := true
 	var nextToken *string
-	var arnMatch *string
 	for hasMore {
 		o, err := p.ssoClient.ListPermissionSets(ctx, &ssoadmin.ListPermissionSetsInput{
 			InstanceArn: aws.String(p.instanceARN.Get()),
 			NextToken:   nextToken,
 		})
 		if err != nil {
-			return err
+			return nil, err
 		}
 		nextToken = o.NextToken
 		hasMore = nextToken != nil
@@ -236,16 +273,20 @@ func (p *Provider) removePermissionSet(ctx context.Context, permissionSetName st
 				InstanceArn: aws.String(p.instanceARN.Get()), PermissionSetArn: aws.String(arn),
 			})
 			if err != nil {
-				return err
+				return nil, err
 			}
 			if aws.ToString(po.PermissionSet.Name) == permissionSetName {
-				arnMatch = po.PermissionSet.PermissionSetArn
-				break
+				return po.PermissionSet.PermissionSetArn, nil
 			}
 		}
-		if arnMatch != nil {
-			break
-		}
+	}
+	return nil, nil
+}
+
+func (p *Provider) removePermissionSet(ctx context.Context, permissionSetName string, subject string) error {
+	arnMatch, err := p.findPermissionSet(ctx, permissionSetName)
+	if err != nil {
+		return err
 	}
 	// Permission set does not exist, do nothing
 	if arnMatch == nil {
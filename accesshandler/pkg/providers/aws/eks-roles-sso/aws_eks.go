@@ -47,6 +47,8 @@ type Provider struct {
 	ssoRoleARN gconfig.StringValue
 }
 
+var _ providers.Verifier = &Provider{}
+
 func (p *Provider) Config() gconfig.Config {
 	return gconfig.Config{
 		gconfig.StringField("clusterName", &p.clusterName, "The EKS cluster name"),
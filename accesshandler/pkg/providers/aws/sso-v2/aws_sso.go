@@ -32,6 +32,9 @@ type Provider struct {
 	ssoSubdomain gconfig.OptionalStringValue
 }
 
+var _ providers.Verifier = &Provider{}
+var _ providers.Inventorier = &Provider{}
+
 func (p *Provider) Config() gconfig.Config {
 	return gconfig.Config{
 		gconfig.StringField("ssoRoleArn", &p.ssoRoleARN, "The ARN of the AWS IAM Role with permission to administer SSO"),
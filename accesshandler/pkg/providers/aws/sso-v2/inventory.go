@@ -0,0 +1,157 @@
+package ssov2
+
+import (
+	"context"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/common-fate/common-fate/accesshandler/pkg/providers"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// Assignments lists the permission sets assigned directly to users in each account.
+// Assignments made to AWS SSO groups aren't included.
+func (p *Provider) Assignments(ctx context.Context) ([]providers.Assignment, error) {
+	emails, err := p.listUserEmails(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var assignments []providers.Assignment
+	// prevent concurrent writes to `assignments` in goroutines
+	var mu sync.Mutex
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(5) // set a limit here to avoid hitting API rate limits in cases where accounts have many permission sets
+
+	hasMore := true
+	var nextToken *string
+	for hasMore {
+		o, err := p.client.ListPermissionSets(ctx, &ssoadmin.ListPermissionSetsInput{
+			InstanceArn: aws.String(p.instanceARN.Get()),
+			NextToken:   nextToken,
+		})
+		if err != nil {
+			// ensure we don't have stale goroutines hanging around - just send the error into the errgroup
+			// and then call Wait() to wrap up goroutines.
+			g.Go(func() error { return err })
+			_ = g.Wait()
+			return nil, err
+		}
+
+		for _, ARN := range o.PermissionSets {
+			ARNCopy := ARN
+			g.Go(func() error {
+				found, err := p.listPermissionSetAssignments(gctx, ARNCopy, emails)
+				if err != nil {
+					return err
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				assignments = append(assignments, found...)
+				return nil
+			})
+		}
+
+		nextToken = o.NextToken
+		hasMore = nextToken != nil
+	}
+
+	err = g.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return assignments, nil
+}
+
+// listPermissionSetAssignments lists the users assigned to the permission set in each account it is provisioned to.
+// emails maps AWS SSO user IDs to email addresses.
+func (p *Provider) listPermissionSetAssignments(ctx context.Context, permissionSetARN string, emails map[string]string) ([]providers.Assignment, error) {
+	var accounts []string
+	hasMore := true
+	var nextToken *string
+	for hasMore {
+		o, err := p.client.ListAccountsForProvisionedPermissionSet(ctx, &ssoadmin.ListAccountsForProvisionedPermissionSetInput{
+			InstanceArn:      aws.String(p.instanceARN.Get()),
+			PermissionSetArn: aws.String(permissionSetARN),
+			NextToken:        nextToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, o.AccountIds...)
+		nextToken = o.NextToken
+		hasMore = nextToken != nil
+	}
+
+	var assignments []providers.Assignment
+	for _, accountID := range accounts {
+		hasMore = true
+		nextToken = nil
+		for hasMore {
+			o, err := p.client.ListAccountAssignments(ctx, &ssoadmin.ListAccountAssignmentsInput{
+				AccountId:        aws.String(accountID),
+				InstanceArn:      aws.String(p.instanceARN.Get()),
+				PermissionSetArn: aws.String(permissionSetARN),
+				NextToken:        nextToken,
+			})
+			if err != nil {
+				return nil, err
+			}
+			for _, aa := range o.AccountAssignments {
+				if aa.PrincipalType != types.PrincipalTypeUser {
+					continue
+				}
+				email, ok := emails[aws.ToString(aa.PrincipalId)]
+				if !ok {
+					zap.S().Debugw("skipping account assignment for unknown user", "user.id", aws.ToString(aa.PrincipalId))
+					continue
+				}
+				assignments = append(assignments, providers.Assignment{
+					Subject: email,
+					With: map[string]string{
+						"permissionSetArn": permissionSetARN,
+						"accountId":        accountID,
+					},
+				})
+			}
+			nextToken = o.NextToken
+			hasMore = nextToken != nil
+		}
+	}
+	return assignments, nil
+}
+
+// listUserEmails returns the email addresses of the AWS SSO users, keyed by user ID.
+// The user name is used for users without an email address, matching the lookup made by getUser.
+func (p *Provider) listUserEmails(ctx context.Context) (map[string]string, error) {
+	emails := make(map[string]string)
+	hasMore := true
+	var nextToken *string
+	for hasMore {
+		o, err := p.idStoreClient.ListUsers(ctx, &identitystore.ListUsersInput{
+			IdentityStoreId: aws.String(p.identityStoreID.Get()),
+			NextToken:       nextToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range o.Users {
+			email := aws.ToString(u.UserName)
+			for _, e := range u.Emails {
+				if e.Value != nil {
+					email = *e.Value
+					break
+				}
+			}
+			emails[aws.ToString(u.UserId)] = email
+		}
+		nextToken = o.NextToken
+		hasMore = nextToken != nil
+	}
+	return emails, nil
+}
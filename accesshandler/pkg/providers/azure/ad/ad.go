@@ -22,6 +22,9 @@ type Provider struct {
 	clientSecret gconfig.SecretStringValue
 }
 
+var _ providers.Verifier = &Provider{}
+var _ providers.Inventorier = &Provider{}
+
 func (a *Provider) Config() gconfig.Config {
 	return gconfig.Config{
 		gconfig.StringField("tenantId", &a.tenantID, "the azure tenant ID"),
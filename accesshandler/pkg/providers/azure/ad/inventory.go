@@ -0,0 +1,35 @@
+package ad
+
+import (
+	"context"
+
+	"github.com/common-fate/common-fate/accesshandler/pkg/providers"
+	"go.uber.org/zap"
+)
+
+// Assignments lists the members of every Azure AD group.
+func (p *Provider) Assignments(ctx context.Context) ([]providers.Assignment, error) {
+	groups, err := p.ListGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var assignments []providers.Assignment
+	for _, g := range groups {
+		zap.S().Debugw("listing azure group members", "group.id", g.ID)
+		users, err := p.ListGroupUsers(ctx, g.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range users {
+			// members may also be nested groups, which don't have a user principal name
+			if u.Mail == "" {
+				continue
+			}
+			assignments = append(assignments, providers.Assignment{
+				Subject: u.Mail,
+				With:    map[string]string{"groupId": g.ID},
+			})
+		}
+	}
+	return assignments, nil
+}
@@ -0,0 +1,106 @@
+package database
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/common-fate/common-fate/accesshandler/pkg/providers"
+	"go.uber.org/zap"
+)
+
+type Args struct {
+	Role string `json:"role"`
+}
+
+// maxUsernameLength is the maximum length of a MySQL username. PostgreSQL allows up to 63 characters.
+const maxUsernameLength = 32
+
+// credentials returns the username and password of the database user for the grant.
+// The password is derived from the grant ID and the credential key, so that it can be shown to the user
+// in the access instructions without being stored. The credential key is separate from the admin password,
+// so the admin password can't be used to work out the password of a grant, and rotating it doesn't change them.
+func (p *Provider) credentials(grantID string) (username string, password string, err error) {
+	if grantID == "" || len(grantID) > maxUsernameLength {
+		return "", "", fmt.Errorf("grant ID %s can't be used as a database username, it must be between 1 and %d characters", grantID, maxUsernameLength)
+	}
+	mac := hmac.New(sha256.New, []byte(p.credentialKey.Get()))
+	mac.Write([]byte("commonfate-database-grant:" + grantID))
+	return grantID, base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// Grant the access by creating a database user for the grant, which is a member of the role.
+func (p *Provider) Grant(ctx context.Context, subject string, args []byte, grantID string) error {
+	var a Args
+	err := json.Unmarshal(args, &a)
+	if err != nil {
+		return err
+	}
+	username, password, err := p.credentials(grantID)
+	if err != nil {
+		return err
+	}
+	log := zap.S().With("args", a, "username", username)
+
+	var validUntil *time.Time
+	if end, ok := providers.GrantEndFromContext(ctx); ok {
+		validUntil = &end
+	} else {
+		log.Warn("the grant end time wasn't provided, so the database user won't expire until the grant is revoked")
+	}
+
+	log.Infow("creating database user", "validUntil", validUntil)
+	return p.engine.createUser(ctx, p.db, username, password, a.Role, validUntil)
+}
+
+// Extend the access by updating the time that the database user for the grant can log in until.
+// Creating the user again is safe, as the password for a grant is always the same.
+func (p *Provider) Extend(ctx context.Context, subject string, args []byte, grantID string, end time.Time) error {
+	return p.Grant(providers.WithGrantEnd(ctx, end), subject, args, grantID)
+}
+
+// Revoke the access by terminating the sessions of the database user and dropping them.
+func (p *Provider) Revoke(ctx context.Context, subject string, args []byte, grantID string) error {
+	username, _, err := p.credentials(grantID)
+	if err != nil {
+		return err
+	}
+	zap.S().Infow("dropping database user", "username", username)
+	return p.engine.dropUser(ctx, p.db, username)
+}
+
+// IsActive checks whether the database user for the grant exists and can log in.
+func (p *Provider) IsActive(ctx context.Context, subject string, args []byte, grantID string) (bool, error) {
+	username, _, err := p.credentials(grantID)
+	if err != nil {
+		return false, err
+	}
+	return p.engine.userExists(ctx, p.db, username)
+}
+
+// Instructions returns the credentials of the database user for the grant.
+func (p *Provider) Instructions(ctx context.Context, subject string, args []byte, t providers.InstructionsTemplate) (string, error) {
+	var a Args
+	err := json.Unmarshal(args, &a)
+	if err != nil {
+		return "", err
+	}
+	username, password, err := p.credentials(t.GrantID)
+	if err != nil {
+		return "", err
+	}
+	c := p.connection()
+
+	i := fmt.Sprintf("Connect to the **%s** database at `%s:%s` with the following credentials, which are valid until your access ends. Your user is a member of the **%s** role.\n\n", c.Database, c.Host, c.Port, a.Role)
+	i += fmt.Sprintf("Username: `%s`\n\n", username)
+	i += fmt.Sprintf("Password: `%s`\n\n", password)
+	i += "# CLI\n"
+	i += "```\n"
+	i += p.engine.connectCommand(c, username, password) + "\n"
+	i += "```\n"
+	return i, nil
+}
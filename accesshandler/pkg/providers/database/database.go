@@ -0,0 +1,95 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/common-fate/common-fate/accesshandler/pkg/providers"
+	"github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	"go.uber.org/zap"
+)
+
+// Provider grants temporary access to a PostgreSQL or MySQL database by creating a database user
+// for each grant, which is a member of the selected role. The user is dropped when the grant ends.
+type Provider struct {
+	db       *sql.DB
+	engine   engine
+	dbEngine gconfig.StringValue
+	host     gconfig.StringValue
+	port     gconfig.StringValue
+	database gconfig.StringValue
+	username gconfig.StringValue
+	password gconfig.SecretStringValue
+	// credentialKey is the key which the passwords of the users created for grants are derived from.
+	credentialKey gconfig.SecretStringValue
+	sslMode       gconfig.OptionalStringValue
+}
+
+var _ providers.ArgSchemarer = &Provider{}
+var _ providers.ArgOptioner = &Provider{}
+var _ providers.GrantValidator = &Provider{}
+var _ providers.ConfigValidator = &Provider{}
+var _ providers.Instructioner = &Provider{}
+var _ providers.Verifier = &Provider{}
+var _ providers.Extender = &Provider{}
+
+func (p *Provider) Config() gconfig.Config {
+	return gconfig.Config{
+		gconfig.StringField("engine", &p.dbEngine, "the database engine, either postgres or mysql"),
+		gconfig.StringField("host", &p.host, "the hostname of the database server"),
+		gconfig.StringField("port", &p.port, "the port of the database server"),
+		gconfig.StringField("database", &p.database, "the name of the database users are given access to"),
+		gconfig.StringField("username", &p.username, "the username of the admin user which creates and drops users"),
+		gconfig.SecretStringField("password", &p.password, "the password of the admin user", gconfig.WithArgs("/granted/providers/%s/password", 1)),
+		gconfig.SecretStringField("credentialKey", &p.credentialKey, "a random key which the passwords of the database users created for grants are derived from", gconfig.WithArgs("/granted/providers/%s/credentialKey", 1)),
+		gconfig.OptionalStringField("sslMode", &p.sslMode, "the PostgreSQL sslmode, which defaults to require (not used for MySQL)"),
+	}
+}
+
+// Init the database provider.
+func (p *Provider) Init(ctx context.Context) error {
+	switch p.dbEngine.Get() {
+	case "postgres":
+		p.engine = &postgres{}
+	case "mysql":
+		p.engine = &mysql{}
+	default:
+		return fmt.Errorf("unsupported database engine %s, the engine must be postgres or mysql", p.dbEngine.Get())
+	}
+	zap.S().Infow("configuring database client", "engine", p.dbEngine.Get(), "host", p.host.Get(), "database", p.database.Get())
+
+	db, err := sql.Open(p.engine.driver(), p.engine.dsn(p.connection(), p.username.Get(), p.password.Get()))
+	if err != nil {
+		return err
+	}
+	// grants are provisioned one at a time, so there's no need for a large pool of admin connections
+	db.SetMaxOpenConns(2)
+	p.db = db
+	return nil
+}
+
+func (p *Provider) connection() connection {
+	c := connection{
+		Host:     p.host.Get(),
+		Port:     p.port.Get(),
+		Database: p.database.Get(),
+		SSLMode:  "require",
+	}
+	if p.sslMode.IsSet() {
+		c.SSLMode = p.sslMode.Get()
+	}
+	return c
+}
+
+func (p *Provider) ArgSchema() providers.ArgSchema {
+	return providers.ArgSchema{
+		"role": {
+			Id:                 "role",
+			Title:              "Role",
+			RuleFormElement:    types.ArgumentRuleFormElementMULTISELECT,
+			RequestFormElement: providers.ArgumentRequestFormElement(types.ArgumentRequestFormElementSELECT),
+		},
+	}
+}
@@ -0,0 +1,63 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// connection is the address of the database which users connect to.
+type connection struct {
+	Host     string
+	Port     string
+	Database string
+	// SSLMode is only used for PostgreSQL.
+	SSLMode string
+}
+
+// engine contains the SQL statements which differ between database engines.
+type engine interface {
+	driver() string
+	// dsn returns the data source name to connect to the database as the user.
+	dsn(c connection, username, password string) string
+	// createUser creates the user and grants them the role. The user must stop being able to log in after validUntil,
+	// if the engine supports it. If the user already exists, their password is reset, so that provisioning a grant can be retried.
+	createUser(ctx context.Context, db *sql.DB, username, password, role string, validUntil *time.Time) error
+	// dropUser terminates the sessions of the user and drops them. It does nothing if the user doesn't exist.
+	dropUser(ctx context.Context, db *sql.DB, username string) error
+	// userExists returns true if the user exists and can log in.
+	userExists(ctx context.Context, db *sql.DB, username string) (bool, error)
+	// listRoles lists the roles which can be granted to users.
+	listRoles(ctx context.Context, db *sql.DB) ([]string, error)
+	// connectCommand returns a CLI command which connects to the database as the user.
+	connectCommand(c connection, username, password string) string
+}
+
+func queryStrings(ctx context.Context, db *sql.DB, query string, args ...interface{}) ([]string, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var res []string
+	for rows.Next() {
+		var s string
+		err = rows.Scan(&s)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, s)
+	}
+	return res, rows.Err()
+}
+
+// exec runs each statement in turn, stopping at the first error.
+func exec(ctx context.Context, db *sql.DB, statements ...string) error {
+	for _, s := range statements {
+		_, err := db.ExecContext(ctx, s)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
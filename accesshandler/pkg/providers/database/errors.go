@@ -0,0 +1,11 @@
+package database
+
+import "fmt"
+
+type RoleNotFoundError struct {
+	Role string
+}
+
+func (e *RoleNotFoundError) Error() string {
+	return fmt.Sprintf("role %s was not found", e.Role)
+}
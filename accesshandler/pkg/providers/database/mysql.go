@@ -0,0 +1,84 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	mysqldriver "github.com/go-sql-driver/mysql"
+)
+
+// mysql manages users in MySQL 8, which supports roles.
+// MySQL users can't be given an expiry time, so access ends when the user is dropped as the grant is revoked.
+type mysql struct{}
+
+func (mysql) driver() string {
+	return "mysql"
+}
+
+func (mysql) dsn(c connection, username, password string) string {
+	cfg := mysqldriver.NewConfig()
+	cfg.User = username
+	cfg.Passwd = password
+	cfg.Net = "tcp"
+	cfg.Addr = c.Host + ":" + c.Port
+	cfg.DBName = c.Database
+	cfg.TLSConfig = "preferred"
+	return cfg.FormatDSN()
+}
+
+// quote returns s as a MySQL string literal. Account and role names are string literals in MySQL statements.
+func quote(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s) + "'"
+}
+
+// account returns the account name of the user, which can connect from any host.
+func account(username string) string {
+	return quote(username) + "@'%'"
+}
+
+func (mysql) createUser(ctx context.Context, db *sql.DB, username, password, role string, validUntil *time.Time) error {
+	return exec(ctx, db,
+		fmt.Sprintf("CREATE USER IF NOT EXISTS %s IDENTIFIED BY %s", account(username), quote(password)),
+		fmt.Sprintf("ALTER USER %s IDENTIFIED BY %s ACCOUNT UNLOCK", account(username), quote(password)),
+		fmt.Sprintf("GRANT %s TO %s", quote(role), account(username)),
+		fmt.Sprintf("SET DEFAULT ROLE ALL TO %s", account(username)),
+	)
+}
+
+func (mysql) dropUser(ctx context.Context, db *sql.DB, username string) error {
+	// stop the user from logging in again before their sessions are terminated
+	err := exec(ctx, db, fmt.Sprintf("ALTER USER IF EXISTS %s ACCOUNT LOCK", account(username)))
+	if err != nil {
+		return err
+	}
+	ids, err := queryStrings(ctx, db, "SELECT CAST(ID AS CHAR) FROM information_schema.PROCESSLIST WHERE USER = ?", username)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		_, err = db.ExecContext(ctx, "KILL CONNECTION "+id)
+		// the session may have ended since the process list was read
+		if err != nil && !strings.Contains(err.Error(), "Unknown thread id") {
+			return err
+		}
+	}
+	return exec(ctx, db, fmt.Sprintf("DROP USER IF EXISTS %s", account(username)))
+}
+
+func (mysql) userExists(ctx context.Context, db *sql.DB, username string) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM mysql.user WHERE User = ? AND Host = '%' AND account_locked = 'N')", username).Scan(&exists)
+	return exists, err
+}
+
+// listRoles lists the roles in MySQL. Roles are accounts which are locked, have an expired password and no credentials.
+func (mysql) listRoles(ctx context.Context, db *sql.DB) ([]string, error) {
+	return queryStrings(ctx, db, "SELECT User FROM mysql.user WHERE account_locked = 'Y' AND password_expired = 'Y' AND authentication_string = '' ORDER BY User")
+}
+
+func (mysql) connectCommand(c connection, username, password string) string {
+	return fmt.Sprintf("mysql --host=%s --port=%s --user=%s --password=%s %s", c.Host, c.Port, username, password, c.Database)
+}
@@ -0,0 +1,27 @@
+package database
+
+import (
+	"context"
+
+	"github.com/common-fate/common-fate/accesshandler/pkg/providers"
+	"github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"go.uber.org/zap"
+)
+
+// Options lists the roles in the database.
+func (p *Provider) Options(ctx context.Context, arg string) (*types.ArgOptionsResponse, error) {
+	switch arg {
+	case "role":
+		zap.S().Infow("getting database role options", "arg", arg)
+		roles, err := p.engine.listRoles(ctx, p.db)
+		if err != nil {
+			return nil, err
+		}
+		opts := types.ArgOptionsResponse{Options: []types.Option{}}
+		for _, r := range roles {
+			opts.Options = append(opts.Options, types.Option{Label: r, Value: r})
+		}
+		return &opts, nil
+	}
+	return nil, &providers.InvalidArgumentError{Arg: arg}
+}
@@ -0,0 +1,95 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type postgres struct{}
+
+func (postgres) driver() string {
+	return "postgres"
+}
+
+func (postgres) dsn(c connection, username, password string) string {
+	u := url.URL{
+		Scheme:   "postgresql",
+		User:     url.UserPassword(username, password),
+		Host:     c.Host + ":" + c.Port,
+		Path:     "/" + c.Database,
+		RawQuery: url.Values{"sslmode": {c.SSLMode}}.Encode(),
+	}
+	return u.String()
+}
+
+func (e postgres) createUser(ctx context.Context, db *sql.DB, username, password, role string, validUntil *time.Time) error {
+	// PostgreSQL doesn't support parameters in DDL statements, so the values are quoted instead.
+	until := "infinity"
+	if validUntil != nil {
+		until = validUntil.UTC().Format(time.RFC3339)
+	}
+	options := fmt.Sprintf("LOGIN PASSWORD %s VALID UNTIL %s", pq.QuoteLiteral(password), pq.QuoteLiteral(until))
+
+	var exists bool
+	err := db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM pg_roles WHERE rolname = $1)", username).Scan(&exists)
+	if err != nil {
+		return err
+	}
+	create := fmt.Sprintf("CREATE ROLE %s WITH %s", pq.QuoteIdentifier(username), options)
+	if exists {
+		create = fmt.Sprintf("ALTER ROLE %s WITH %s", pq.QuoteIdentifier(username), options)
+	}
+	return exec(ctx, db,
+		create,
+		fmt.Sprintf("GRANT %s TO %s", pq.QuoteIdentifier(role), pq.QuoteIdentifier(username)),
+	)
+}
+
+func (e postgres) dropUser(ctx context.Context, db *sql.DB, username string) error {
+	var exists bool
+	err := db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM pg_roles WHERE rolname = $1)", username).Scan(&exists)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+	user := pq.QuoteIdentifier(username)
+	// stop the user from logging in again before their sessions are terminated
+	err = exec(ctx, db, fmt.Sprintf("ALTER ROLE %s NOLOGIN", user))
+	if err != nil {
+		return err
+	}
+	_, err = db.ExecContext(ctx, "SELECT pg_terminate_backend(pid) FROM pg_stat_activity WHERE usename = $1", username)
+	if err != nil {
+		return err
+	}
+	// objects created by the user are kept, and are owned by the admin user once the user is dropped
+	return exec(ctx, db,
+		fmt.Sprintf("REASSIGN OWNED BY %s TO CURRENT_USER", user),
+		fmt.Sprintf("DROP OWNED BY %s", user),
+		fmt.Sprintf("DROP ROLE %s", user),
+	)
+}
+
+// userExists checks whether the user exists and can log in. A user whose password has expired can't log in,
+// even though they haven't been dropped yet.
+func (postgres) userExists(ctx context.Context, db *sql.DB, username string) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM pg_roles WHERE rolname = $1 AND rolcanlogin AND (rolvaliduntil IS NULL OR rolvaliduntil > now()))", username).Scan(&exists)
+	return exists, err
+}
+
+// listRoles lists the roles which can't log in, excluding the roles built in to PostgreSQL.
+func (postgres) listRoles(ctx context.Context, db *sql.DB) ([]string, error) {
+	return queryStrings(ctx, db, "SELECT rolname FROM pg_roles WHERE NOT rolcanlogin AND rolname NOT LIKE 'pg\\_%' ORDER BY rolname")
+}
+
+func (e postgres) connectCommand(c connection, username, password string) string {
+	return fmt.Sprintf("psql \"%s\"", e.dsn(c, username, password))
+}
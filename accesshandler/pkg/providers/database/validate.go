@@ -0,0 +1,60 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/common-fate/common-fate/accesshandler/pkg/diagnostics"
+	"github.com/common-fate/common-fate/accesshandler/pkg/providers"
+)
+
+func (p *Provider) ValidateGrant() providers.GrantValidationSteps {
+	return map[string]providers.GrantValidationStep{
+		"role-exists-in-database": {
+			UserErrorMessage: "We couldn't find a matching role in the database",
+			Run: func(ctx context.Context, subject string, args []byte) diagnostics.Logs {
+				var a Args
+				err := json.Unmarshal(args, &a)
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				roles, err := p.engine.listRoles(ctx, p.db)
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				for _, r := range roles {
+					if r == a.Role {
+						return diagnostics.Info("Role exists in the database")
+					}
+				}
+				return diagnostics.Error(&RoleNotFoundError{Role: a.Role})
+			},
+		},
+	}
+}
+
+func (p *Provider) ValidateConfig() map[string]providers.ConfigValidationStep {
+	return map[string]providers.ConfigValidationStep{
+		"connect": {
+			Name:            "Connect to the database",
+			FieldsValidated: []string{"engine", "host", "port", "database", "username", "password", "sslMode"},
+			Run: func(ctx context.Context) diagnostics.Logs {
+				err := p.db.PingContext(ctx)
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				return diagnostics.Info("Connected to the %s database as %s", p.database.Get(), p.username.Get())
+			},
+		},
+		"list-roles": {
+			Name: "List database roles",
+			Run: func(ctx context.Context) diagnostics.Logs {
+				roles, err := p.engine.listRoles(ctx, p.db)
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				return diagnostics.Info("The database has %d roles which can be granted", len(roles))
+			},
+		},
+	}
+}
@@ -0,0 +1,182 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lestrrat-go/jwx/jwa"
+	"github.com/lestrrat-go/jwx/jwt"
+)
+
+// client is a minimal client for the GitHub REST API which authenticates as an installation of a GitHub App.
+type client struct {
+	baseURL        string
+	appID          string
+	installationID string
+	key            *rsa.PrivateKey
+	httpClient     *http.Client
+
+	mu sync.Mutex
+	// token is the installation access token, which GitHub issues for an hour.
+	token     string
+	expiresAt time.Time
+}
+
+// APIError is returned when the GitHub API responds with an unsuccessful status code.
+type APIError struct {
+	StatusCode int
+	Message    string `json:"message"`
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("github API returned %d: %s", e.StatusCode, e.Message)
+}
+
+func isNotFound(err error) bool {
+	apiErr, ok := err.(*APIError)
+	return ok && apiErr.StatusCode == http.StatusNotFound
+}
+
+// appJWT returns a JWT which authenticates as the GitHub App.
+// GitHub rejects JWTs which expire more than 10 minutes in the future, and recommends backdating the issued at time
+// to allow for clock drift.
+func (c *client) appJWT() (string, error) {
+	now := time.Now()
+	t := jwt.New()
+	err := t.Set(jwt.IssuerKey, c.appID)
+	if err != nil {
+		return "", err
+	}
+	err = t.Set(jwt.IssuedAtKey, now.Add(-time.Minute))
+	if err != nil {
+		return "", err
+	}
+	err = t.Set(jwt.ExpirationKey, now.Add(9*time.Minute))
+	if err != nil {
+		return "", err
+	}
+	signed, err := jwt.Sign(t, jwa.RS256, c.key)
+	if err != nil {
+		return "", err
+	}
+	return string(signed), nil
+}
+
+// installationToken returns an installation access token, creating a new one if the current token is about to expire.
+func (c *client) installationToken(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.token != "" && time.Until(c.expiresAt) > 5*time.Minute {
+		return c.token, nil
+	}
+
+	appJWT, err := c.appJWT()
+	if err != nil {
+		return "", err
+	}
+	var res struct {
+		Token     string    `json:"token"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}
+	_, err = c.send(ctx, http.MethodPost, c.baseURL+"/app/installations/"+url.PathEscape(c.installationID)+"/access_tokens", "Bearer "+appJWT, nil, &res)
+	if err != nil {
+		return "", err
+	}
+	c.token = res.Token
+	c.expiresAt = res.ExpiresAt
+	return c.token, nil
+}
+
+// do calls the GitHub API as the installation. path is relative to the API URL, and the response is decoded into out if it isn't nil.
+func (c *client) do(ctx context.Context, method, path string, body interface{}, out interface{}) (*http.Response, error) {
+	token, err := c.installationToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.send(ctx, method, c.baseURL+path, "token "+token, body, out)
+}
+
+func (c *client) send(ctx context.Context, method, u string, authorization string, body interface{}, out interface{}) (*http.Response, error) {
+	var r io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		r = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, r)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Authorization", authorization)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode >= 300 {
+		apiErr := APIError{StatusCode: res.StatusCode}
+		// the message is best effort, as not every error response has a JSON body
+		_ = json.Unmarshal(b, &apiErr)
+		return nil, &apiErr
+	}
+	if out != nil && len(b) > 0 {
+		err = json.Unmarshal(b, out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+var nextLinkRegex = regexp.MustCompile(`<([^>]+)>;\s*rel="next"`)
+
+// list calls a paginated endpoint, following the next links in the Link header until every page has been read.
+// fn is called with the body of each page.
+func (c *client) list(ctx context.Context, path string, fn func(page json.RawMessage) error) error {
+	sep := "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
+	}
+	next := c.baseURL + path + sep + "per_page=100"
+	for next != "" {
+		token, err := c.installationToken(ctx)
+		if err != nil {
+			return err
+		}
+		var page json.RawMessage
+		res, err := c.send(ctx, http.MethodGet, next, "token "+token, nil, &page)
+		if err != nil {
+			return err
+		}
+		err = fn(page)
+		if err != nil {
+			return err
+		}
+
+		next = ""
+		if m := nextLinkRegex.FindStringSubmatch(res.Header.Get("Link")); m != nil {
+			next = m[1]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package github
+
+import "fmt"
+
+type UserNotFoundError struct {
+	User string
+}
+
+func (e *UserNotFoundError) Error() string {
+	return fmt.Sprintf("user %s was not found", e.User)
+}
+
+type TeamNotFoundError struct {
+	Team string
+}
+
+func (e *TeamNotFoundError) Error() string {
+	return fmt.Sprintf("team %s was not found", e.Team)
+}
+
+type TeamMemberError struct {
+	User string
+	Team string
+}
+
+func (e *TeamMemberError) Error() string {
+	return fmt.Sprintf("user %s is already a member of team %s", e.User, e.Team)
+}
+
+type RepositoryNotFoundError struct {
+	Repository string
+}
+
+func (e *RepositoryNotFoundError) Error() string {
+	return fmt.Sprintf("repository %s was not found", e.Repository)
+}
+
+type InvalidPermissionError struct {
+	Permission string
+}
+
+func (e *InvalidPermissionError) Error() string {
+	return fmt.Sprintf("%s is not a valid repository permission", e.Permission)
+}
@@ -0,0 +1,133 @@
+package github
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	"go.uber.org/zap"
+)
+
+const defaultAPIURL = "https://api.github.com"
+
+// app holds the configuration shared by the GitHub providers, which call the GitHub API as an installation of a GitHub App.
+type app struct {
+	client         *client
+	appID          gconfig.StringValue
+	installationID gconfig.StringValue
+	organization   gconfig.StringValue
+	privateKey     gconfig.SecretStringValue
+	apiURL         gconfig.OptionalStringValue
+}
+
+func (a *app) Config() gconfig.Config {
+	return gconfig.Config{
+		gconfig.StringField("appId", &a.appID, "the ID of the GitHub App"),
+		gconfig.StringField("installationId", &a.installationID, "the ID of the installation of the GitHub App in the organization"),
+		gconfig.StringField("organization", &a.organization, "the GitHub organization"),
+		gconfig.SecretStringField("privateKey", &a.privateKey, "the PEM encoded private key of the GitHub App", gconfig.WithArgs("/granted/providers/%s/privateKey", 1), gconfig.WithCLIPrompt(gconfig.CLIPromptTypeFile)),
+		gconfig.OptionalStringField("apiUrl", &a.apiURL, "the GitHub API URL, if using GitHub Enterprise Server"),
+	}
+}
+
+// Init the GitHub provider.
+func (a *app) Init(ctx context.Context) error {
+	zap.S().Infow("configuring github client", "organization", a.organization.Get())
+	key, err := parsePrivateKey(a.privateKey.Get())
+	if err != nil {
+		return err
+	}
+	baseURL := defaultAPIURL
+	if a.apiURL.IsSet() {
+		baseURL = strings.TrimSuffix(a.apiURL.Get(), "/")
+	}
+	a.client = &client{
+		baseURL:        baseURL,
+		appID:          a.appID.Get(),
+		installationID: a.installationID.Get(),
+		key:            key,
+		httpClient:     http.DefaultClient,
+	}
+	return nil
+}
+
+// parsePrivateKey parses the private key of a GitHub App. GitHub issues keys in PKCS#1 format,
+// but PKCS#8 is accepted too in case the key has been converted.
+func parsePrivateKey(s string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(s))
+	if block == nil {
+		return nil, errors.New("the GitHub App private key must be PEM encoded")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing GitHub App private key: %w", err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("the GitHub App private key must be an RSA key")
+	}
+	return rsaKey, nil
+}
+
+// orgPath returns the path to a resource in the organization.
+func (a *app) orgPath(format string, args ...string) string {
+	escaped := []interface{}{url.PathEscape(a.organization.Get())}
+	for _, arg := range args {
+		escaped = append(escaped, url.PathEscape(arg))
+	}
+	return fmt.Sprintf(format, escaped...)
+}
+
+// getUsername finds the GitHub username of the user with the email address.
+// GitHub only matches the email addresses which users have made public on their profile.
+func (a *app) getUsername(ctx context.Context, email string) (string, error) {
+	var res struct {
+		Items []struct {
+			Login string `json:"login"`
+		} `json:"items"`
+	}
+	q := url.Values{"q": {email + " in:email type:user"}}
+	_, err := a.client.do(ctx, http.MethodGet, "/search/users?"+q.Encode(), nil, &res)
+	if err != nil {
+		return "", err
+	}
+	if len(res.Items) == 0 {
+		return "", &UserNotFoundError{User: email}
+	}
+	if len(res.Items) > 1 {
+		return "", fmt.Errorf("expected to find 1 GitHub user for email %s but got %d", email, len(res.Items))
+	}
+	return res.Items[0].Login, nil
+}
+
+// checkOrganization checks that the installation can access the organization.
+func (a *app) checkOrganization(ctx context.Context) error {
+	var org struct {
+		Login string `json:"login"`
+	}
+	_, err := a.client.do(ctx, http.MethodGet, a.orgPath("/orgs/%s"), nil, &org)
+	return err
+}
+
+// count returns the number of items in a paginated list, for use in config validation.
+func (a *app) count(ctx context.Context, path string) (int, error) {
+	var n int
+	err := a.client.list(ctx, path, func(page json.RawMessage) error {
+		var items []json.RawMessage
+		err := json.Unmarshal(page, &items)
+		n += len(items)
+		return err
+	})
+	return n, err
+}
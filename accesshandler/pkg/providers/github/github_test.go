@@ -0,0 +1,534 @@
+package github
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/accesshandler/pkg/providers"
+	"github.com/common-fate/common-fate/accesshandler/pkg/psetup"
+	"github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	"github.com/lestrrat-go/jwx/jwa"
+	"github.com/lestrrat-go/jwx/jwt"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeGitHub is a fake of the parts of the GitHub API used by the providers.
+type fakeGitHub struct {
+	t   *testing.T
+	key *rsa.PrivateKey
+	srv *httptest.Server
+
+	mu            sync.Mutex
+	tokenRequests int
+	// users maps public email addresses to usernames
+	users map[string]string
+	// orgMembers can be added to repositories directly, other users are invited
+	orgMembers map[string]bool
+	teams      []string
+	repos      []string
+	// teamMembers maps team/username to the role of the member
+	teamMembers    map[string]string
+	collaborators  map[string]string
+	invitations    map[int64]string
+	orgInvitations map[int64]string
+	nextID         int64
+}
+
+var testKey *rsa.PrivateKey
+
+func getTestKey(t *testing.T) *rsa.PrivateKey {
+	if testKey == nil {
+		k, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testKey = k
+	}
+	return testKey
+}
+
+func newFakeGitHub(t *testing.T) *fakeGitHub {
+	f := &fakeGitHub{
+		t:              t,
+		key:            getTestKey(t),
+		users:          map[string]string{"alice@acme.com": "alice", "bob@acme.com": "bob"},
+		orgMembers:     map[string]bool{"alice": true},
+		teams:          []string{"platform"},
+		repos:          []string{"api"},
+		teamMembers:    map[string]string{},
+		collaborators:  map[string]string{},
+		invitations:    map[int64]string{},
+		orgInvitations: map[int64]string{},
+		nextID:         1,
+	}
+	f.srv = httptest.NewServer(http.HandlerFunc(f.serve))
+	t.Cleanup(f.srv.Close)
+	return f
+}
+
+// app returns the configuration for the providers to call the fake.
+func (f *fakeGitHub) app() app {
+	a := app{
+		client: &client{
+			baseURL:        f.srv.URL,
+			appID:          "1234",
+			installationID: "5678",
+			key:            f.key,
+			httpClient:     f.srv.Client(),
+		},
+	}
+	a.organization.Set("acme")
+	return a
+}
+
+func (f *fakeGitHub) serve(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.Method == http.MethodPost && r.URL.Path == "/app/installations/5678/access_tokens" {
+		tok, err := jwt.Parse([]byte(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")), jwt.WithVerify(jwa.RS256, &f.key.PublicKey), jwt.WithValidate(true))
+		if err != nil || tok.Issuer() != "1234" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		f.tokenRequests++
+		f.json(w, http.StatusCreated, map[string]interface{}{"token": "ghs_test", "expires_at": time.Now().Add(time.Hour)})
+		return
+	}
+	if r.Header.Get("Authorization") != "token ghs_test" {
+		f.json(w, http.StatusUnauthorized, map[string]string{"message": "Bad credentials"})
+		return
+	}
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	// the route is the method, the top level resource and the sub resource, such as "PUT orgs/teams".
+	// Repository sub resources come after the owner and repository name.
+	route := r.Method + " " + parts[0]
+	sub := 2
+	if parts[0] == "repos" {
+		sub = 3
+	}
+	if len(parts) > sub {
+		route += "/" + parts[sub]
+	}
+	switch {
+	case route == "GET search" && parts[1] == "users":
+		q := strings.Fields(r.URL.Query().Get("q"))
+		items := []map[string]string{}
+		if login, ok := f.users[q[0]]; ok {
+			items = append(items, map[string]string{"login": login})
+		}
+		f.json(w, http.StatusOK, map[string]interface{}{"items": items})
+	case route == "GET orgs" && len(parts) == 2:
+		f.json(w, http.StatusOK, map[string]string{"login": parts[1]})
+	case route == "GET orgs/teams" && len(parts) == 3:
+		items := []map[string]string{}
+		for _, t := range f.teams {
+			items = append(items, map[string]string{"name": strings.ToUpper(t), "slug": t})
+		}
+		f.paginate(w, r, items)
+	case route == "GET orgs/teams" && len(parts) == 6:
+		role, ok := f.teamMembers[parts[3]+"/"+parts[5]]
+		if !ok {
+			f.found(w, false)
+			return
+		}
+		f.json(w, http.StatusOK, map[string]string{"role": role, "state": f.membershipState(parts[5])})
+	case route == "GET orgs/teams":
+		f.found(w, contains(f.teams, parts[3]))
+	case route == "PUT orgs/teams":
+		var body struct {
+			Role string `json:"role"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		username := parts[5]
+		if !f.orgMembers[username] {
+			f.orgInvitations[f.nextID] = username
+			f.nextID++
+		}
+		f.teamMembers[parts[3]+"/"+username] = body.Role
+		f.json(w, http.StatusOK, map[string]string{"role": body.Role, "state": f.membershipState(username)})
+	case route == "DELETE orgs/teams":
+		delete(f.teamMembers, parts[3]+"/"+parts[5])
+		w.WriteHeader(http.StatusNoContent)
+	case route == "GET orgs/invitations":
+		items := []map[string]interface{}{}
+		for id, login := range f.orgInvitations {
+			items = append(items, map[string]interface{}{"id": id, "login": login})
+		}
+		f.paginate(w, r, items)
+	case route == "DELETE orgs/invitations":
+		id, _ := strconv.ParseInt(parts[3], 10, 64)
+		delete(f.orgInvitations, id)
+		w.WriteHeader(http.StatusNoContent)
+	case route == "GET orgs/repos":
+		items := []map[string]interface{}{}
+		for _, name := range f.repos {
+			items = append(items, map[string]interface{}{"name": name, "archived": false})
+		}
+		items = append(items, map[string]interface{}{"name": "old", "archived": true})
+		f.paginate(w, r, items)
+	case route == "GET repos" && len(parts) == 3:
+		f.found(w, contains(f.repos, parts[2]))
+	case route == "PUT repos/collaborators":
+		var body struct {
+			Permission string `json:"permission"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		username := parts[4]
+		if !f.orgMembers[username] {
+			f.invitations[f.nextID] = username
+			f.nextID++
+			f.json(w, http.StatusCreated, map[string]interface{}{})
+			return
+		}
+		f.collaborators[parts[2]+"/"+username] = body.Permission
+		w.WriteHeader(http.StatusNoContent)
+	case route == "DELETE repos/collaborators":
+		delete(f.collaborators, parts[2]+"/"+parts[4])
+		w.WriteHeader(http.StatusNoContent)
+	case route == "GET repos/invitations":
+		items := []map[string]interface{}{}
+		for id, login := range f.invitations {
+			items = append(items, map[string]interface{}{"id": id, "invitee": map[string]string{"login": login}})
+		}
+		f.paginate(w, r, items)
+	case route == "DELETE repos/invitations":
+		id, _ := strconv.ParseInt(parts[4], 10, 64)
+		delete(f.invitations, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		f.t.Errorf("unexpected request to fake GitHub API: %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// membershipState is pending for users who haven't accepted their invitation to the organization.
+func (f *fakeGitHub) membershipState(username string) string {
+	if f.orgMembers[username] {
+		return "active"
+	}
+	return "pending"
+}
+
+func (f *fakeGitHub) json(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func (f *fakeGitHub) found(w http.ResponseWriter, ok bool) {
+	if !ok {
+		f.json(w, http.StatusNotFound, map[string]string{"message": "Not Found"})
+		return
+	}
+	f.json(w, http.StatusOK, map[string]string{})
+}
+
+// paginate returns the page of items requested, with a Link header to the next page as GitHub does.
+func (f *fakeGitHub) paginate(w http.ResponseWriter, r *http.Request, items interface{}) {
+	b, _ := json.Marshal(items)
+	var all []json.RawMessage
+	_ = json.Unmarshal(b, &all)
+
+	perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+	if perPage == 0 {
+		perPage = 30
+	}
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page == 0 {
+		page = 1
+	}
+	start := (page - 1) * perPage
+	end := start + perPage
+	if start > len(all) {
+		start = len(all)
+	}
+	if end >= len(all) {
+		end = len(all)
+	} else {
+		w.Header().Set("Link", fmt.Sprintf(`<%s%s?per_page=%d&page=%d>; rel="next", <%s%s?per_page=%d&page=%d>; rel="last"`, f.srv.URL, r.URL.Path, perPage, page+1, f.srv.URL, r.URL.Path, perPage, (len(all)+perPage-1)/perPage))
+	}
+	f.json(w, http.StatusOK, all[start:end])
+}
+
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
+func TestTeamsGrantAndRevoke(t *testing.T) {
+	ctx := context.Background()
+	f := newFakeGitHub(t)
+	p := TeamsProvider{app: f.app()}
+	args := []byte(`{"teamSlug":"platform"}`)
+
+	err := p.Grant(ctx, "alice@acme.com", args, "abcd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "member", f.teamMembers["platform/alice"])
+
+	// granting again leaves the membership unchanged, so that provisioning can be retried
+	err = p.Grant(ctx, "alice@acme.com", args, "abcd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "member", f.teamMembers["platform/alice"])
+
+	err = p.Revoke(ctx, "alice@acme.com", args, "abcd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(t, f.teamMembers)
+
+	// revoking a user who isn't a member succeeds
+	err = p.Revoke(ctx, "alice@acme.com", args, "abcd")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the installation token is reused between calls
+	assert.Equal(t, 1, f.tokenRequests)
+}
+
+func TestTeamsGrantInvitesUser(t *testing.T) {
+	ctx := context.Background()
+	f := newFakeGitHub(t)
+	p := TeamsProvider{app: f.app()}
+	args := []byte(`{"teamSlug":"platform"}`)
+
+	// bob isn't a member of the organization, so he's invited to it
+	err := p.Grant(ctx, "bob@acme.com", args, "abcd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "member", f.teamMembers["platform/bob"])
+	assert.Len(t, f.orgInvitations, 1)
+
+	err = p.Revoke(ctx, "bob@acme.com", args, "abcd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(t, f.teamMembers)
+	assert.Empty(t, f.orgInvitations)
+}
+
+func TestTeamsMaintainerIsUnchanged(t *testing.T) {
+	ctx := context.Background()
+	f := newFakeGitHub(t)
+	f.teamMembers["platform/alice"] = "maintainer"
+	p := TeamsProvider{app: f.app()}
+	args := []byte(`{"teamSlug":"platform"}`)
+
+	err := p.Grant(ctx, "alice@acme.com", args, "abcd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "maintainer", f.teamMembers["platform/alice"])
+
+	err = p.Revoke(ctx, "alice@acme.com", args, "abcd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "maintainer", f.teamMembers["platform/alice"])
+}
+
+func TestReposGrantAndRevoke(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("organization member", func(t *testing.T) {
+		f := newFakeGitHub(t)
+		p := ReposProvider{app: f.app()}
+		args := []byte(`{"repository":"api","permission":"maintain"}`)
+
+		err := p.Grant(ctx, "alice@acme.com", args, "abcd")
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, map[string]string{"api/alice": "maintain"}, f.collaborators)
+
+		err = p.Revoke(ctx, "alice@acme.com", args, "abcd")
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Empty(t, f.collaborators)
+	})
+
+	t.Run("outside collaborator invitation is cancelled", func(t *testing.T) {
+		f := newFakeGitHub(t)
+		p := ReposProvider{app: f.app()}
+		args := []byte(`{"repository":"api","permission":"admin"}`)
+
+		err := p.Grant(ctx, "bob@acme.com", args, "abcd")
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, map[int64]string{1: "bob"}, f.invitations)
+
+		err = p.Revoke(ctx, "bob@acme.com", args, "abcd")
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Empty(t, f.invitations)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		f := newFakeGitHub(t)
+		p := ReposProvider{app: f.app()}
+		err := p.Grant(ctx, "other@acme.com", []byte(`{"repository":"api","permission":"admin"}`), "abcd")
+		assert.Equal(t, &UserNotFoundError{User: "other@acme.com"}, err)
+	})
+}
+
+func TestOptions(t *testing.T) {
+	ctx := context.Background()
+	f := newFakeGitHub(t)
+	f.teams = nil
+	for i := 0; i < 150; i++ {
+		f.teams = append(f.teams, fmt.Sprintf("team-%03d", i))
+	}
+	f.repos = []string{"api", "web"}
+
+	teams := TeamsProvider{app: f.app()}
+	got, err := teams.Options(ctx, "teamSlug")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the teams are returned over two pages
+	assert.Len(t, got.Options, 150)
+	assert.Equal(t, types.Option{Label: "TEAM-149", Value: "team-149"}, got.Options[149])
+
+	repos := ReposProvider{app: f.app()}
+	got, err = repos.Options(ctx, "repository")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// archived repositories aren't included
+	assert.Equal(t, []types.Option{{Label: "api", Value: "api"}, {Label: "web", Value: "web"}}, got.Options)
+
+	got, err = repos.Options(ctx, "permission")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, permissions, got.Options)
+
+	_, err = repos.Options(ctx, "other")
+	assert.Error(t, err)
+}
+
+func TestValidateGrant(t *testing.T) {
+	ctx := context.Background()
+	f := newFakeGitHub(t)
+
+	f.teamMembers["platform/bob"] = "member"
+	teams := TeamsProvider{app: f.app()}
+	repos := ReposProvider{app: f.app()}
+
+	type testcase struct {
+		name       string
+		steps      providers.GrantValidationSteps
+		subject    string
+		args       string
+		wantFailed []string
+	}
+
+	testcases := []testcase{
+		{
+			name:    "team ok",
+			steps:   teams.ValidateGrant(),
+			subject: "alice@acme.com",
+			args:    `{"teamSlug":"platform"}`,
+		},
+		{
+			name:       "user is already a member of the team",
+			steps:      teams.ValidateGrant(),
+			subject:    "bob@acme.com",
+			args:       `{"teamSlug":"platform"}`,
+			wantFailed: []string{"user-not-in-team"},
+		},
+		{
+			name:       "team and user not found",
+			steps:      teams.ValidateGrant(),
+			subject:    "other@acme.com",
+			args:       `{"teamSlug":"other"}`,
+			wantFailed: []string{"team-exists-in-github", "user-exists-in-github"},
+		},
+		{
+			name:    "repository ok",
+			steps:   repos.ValidateGrant(),
+			subject: "alice@acme.com",
+			args:    `{"repository":"api","permission":"push"}`,
+		},
+		{
+			name:       "repository not found and invalid permission",
+			steps:      repos.ValidateGrant(),
+			subject:    "alice@acme.com",
+			args:       `{"repository":"other","permission":"owner"}`,
+			wantFailed: []string{"permission-is-valid", "repository-exists-in-github"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			var failed []string
+			for id, step := range tc.steps {
+				logs := step.Run(ctx, tc.subject, []byte(tc.args))
+				if !logs.HasSucceeded() {
+					failed = append(failed, id)
+				}
+			}
+			sort.Strings(failed)
+			assert.Equal(t, tc.wantFailed, failed)
+		})
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	f := newFakeGitHub(t)
+	p := TeamsProvider{app: f.app()}
+	for id, step := range p.ValidateConfig() {
+		logs := step.Run(ctx)
+		assert.True(t, logs.HasSucceeded(), id)
+	}
+
+	// the config is invalid if the private key isn't the GitHub App's key
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.client.key = other
+	p.client.token = ""
+	logs := p.ValidateConfig()["get-organization"].Run(ctx)
+	assert.False(t, logs.HasSucceeded())
+}
+
+func TestSetup(t *testing.T) {
+	for _, p := range []interface {
+		SetupDocs() embed.FS
+		Config() gconfig.Config
+	}{&TeamsProvider{}, &ReposProvider{}} {
+		_, err := psetup.ParseDocsFS(p.SetupDocs(), p.Config(), psetup.TemplateData{})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
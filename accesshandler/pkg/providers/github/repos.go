@@ -0,0 +1,243 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/common-fate/common-fate/accesshandler/pkg/diagnostics"
+	"github.com/common-fate/common-fate/accesshandler/pkg/providers"
+	"github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"go.uber.org/zap"
+)
+
+// ReposProvider grants temporary collaborator roles on repositories in a GitHub organization.
+type ReposProvider struct {
+	app
+}
+
+var _ providers.ArgSchemarer = &ReposProvider{}
+var _ providers.ArgOptioner = &ReposProvider{}
+var _ providers.GrantValidator = &ReposProvider{}
+var _ providers.ConfigValidator = &ReposProvider{}
+
+// permissions are the repository roles which can be granted, in increasing order of access.
+var permissions = []types.Option{
+	{Label: "Read", Value: "pull"},
+	{Label: "Triage", Value: "triage"},
+	{Label: "Write", Value: "push"},
+	{Label: "Maintain", Value: "maintain"},
+	{Label: "Admin", Value: "admin"},
+}
+
+type RepoArgs struct {
+	Repository string `json:"repository"`
+	Permission string `json:"permission"`
+}
+
+func (p *ReposProvider) ArgSchema() providers.ArgSchema {
+	return providers.ArgSchema{
+		"repository": {
+			Id:              "repository",
+			Title:           "Repository",
+			RuleFormElement: types.ArgumentRuleFormElementMULTISELECT,
+		},
+		"permission": {
+			Id:                 "permission",
+			Title:              "Role",
+			RuleFormElement:    types.ArgumentRuleFormElementMULTISELECT,
+			RequestFormElement: providers.ArgumentRequestFormElement(types.ArgumentRequestFormElementSELECT),
+		},
+	}
+}
+
+// Grant the access by adding the user to the repository as a collaborator.
+// Users who aren't members of the organization are sent an invitation, and have access once they accept it.
+func (p *ReposProvider) Grant(ctx context.Context, subject string, args []byte, grantID string) error {
+	var a RepoArgs
+	err := json.Unmarshal(args, &a)
+	if err != nil {
+		return err
+	}
+	log := zap.S().With("args", a)
+	log.Info("getting github user")
+	username, err := p.getUsername(ctx, subject)
+	if err != nil {
+		return err
+	}
+	log.Infow("adding github user as a repository collaborator", "username", username)
+	res, err := p.client.do(ctx, http.MethodPut, p.orgPath("/repos/%s/%s/collaborators/%s", a.Repository, username), map[string]string{"permission": a.Permission}, nil)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode == http.StatusCreated {
+		log.Infow("github user was invited to the repository", "username", username)
+	}
+	return nil
+}
+
+// Revoke the access by removing the user as a collaborator, and cancelling their invitation if they haven't accepted it yet.
+func (p *ReposProvider) Revoke(ctx context.Context, subject string, args []byte, grantID string) error {
+	var a RepoArgs
+	err := json.Unmarshal(args, &a)
+	if err != nil {
+		return err
+	}
+	log := zap.S().With("args", a)
+	log.Info("getting github user")
+	username, err := p.getUsername(ctx, subject)
+	if err != nil {
+		return err
+	}
+
+	var invitationIDs []int64
+	err = p.client.list(ctx, p.orgPath("/repos/%s/%s/invitations", a.Repository), func(page json.RawMessage) error {
+		var invitations []struct {
+			ID      int64 `json:"id"`
+			Invitee struct {
+				Login string `json:"login"`
+			} `json:"invitee"`
+		}
+		err := json.Unmarshal(page, &invitations)
+		if err != nil {
+			return err
+		}
+		for _, inv := range invitations {
+			if inv.Invitee.Login == username {
+				invitationIDs = append(invitationIDs, inv.ID)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, id := range invitationIDs {
+		log.Infow("cancelling repository invitation", "username", username, "invitation.id", id)
+		_, err = p.client.do(ctx, http.MethodDelete, p.orgPath("/repos/%s/%s/invitations/%s", a.Repository, strconv.FormatInt(id, 10)), nil, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Infow("removing github user as a repository collaborator", "username", username)
+	_, err = p.client.do(ctx, http.MethodDelete, p.orgPath("/repos/%s/%s/collaborators/%s", a.Repository, username), nil, nil)
+	return err
+}
+
+// Options lists the repositories in the organization, and the roles which can be granted.
+func (p *ReposProvider) Options(ctx context.Context, arg string) (*types.ArgOptionsResponse, error) {
+	switch arg {
+	case "repository":
+		zap.S().Infow("getting github repository options", "arg", arg)
+		opts := types.ArgOptionsResponse{Options: []types.Option{}}
+		err := p.client.list(ctx, p.orgPath("/orgs/%s/repos"), func(page json.RawMessage) error {
+			var repos []struct {
+				Name     string `json:"name"`
+				Archived bool   `json:"archived"`
+			}
+			err := json.Unmarshal(page, &repos)
+			if err != nil {
+				return err
+			}
+			for _, r := range repos {
+				// collaborators can't be added to archived repositories
+				if r.Archived {
+					continue
+				}
+				opts.Options = append(opts.Options, types.Option{Label: r.Name, Value: r.Name})
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &opts, nil
+	case "permission":
+		return &types.ArgOptionsResponse{Options: permissions}, nil
+	}
+	return nil, &providers.InvalidArgumentError{Arg: arg}
+}
+
+func isValidPermission(permission string) bool {
+	for _, p := range permissions {
+		if p.Value == permission {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *ReposProvider) ValidateGrant() providers.GrantValidationSteps {
+	return map[string]providers.GrantValidationStep{
+		"user-exists-in-github": {
+			UserErrorMessage: "We couldn't find a GitHub account with your email address. Make sure your email address is public on your GitHub profile",
+			Run: func(ctx context.Context, subject string, args []byte) diagnostics.Logs {
+				_, err := p.getUsername(ctx, subject)
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				return diagnostics.Info("User exists in GitHub")
+			},
+		},
+		"repository-exists-in-github": {
+			UserErrorMessage: "We couldn't find a matching repository in GitHub",
+			Run: func(ctx context.Context, subject string, args []byte) diagnostics.Logs {
+				var a RepoArgs
+				err := json.Unmarshal(args, &a)
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				_, err = p.client.do(ctx, http.MethodGet, p.orgPath("/repos/%s/%s", a.Repository), nil, nil)
+				if isNotFound(err) {
+					return diagnostics.Error(&RepositoryNotFoundError{Repository: a.Repository})
+				}
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				return diagnostics.Info("Repository exists in GitHub")
+			},
+		},
+		"permission-is-valid": {
+			UserErrorMessage: "The repository role isn't valid",
+			Run: func(ctx context.Context, subject string, args []byte) diagnostics.Logs {
+				var a RepoArgs
+				err := json.Unmarshal(args, &a)
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				if !isValidPermission(a.Permission) {
+					return diagnostics.Error(&InvalidPermissionError{Permission: a.Permission})
+				}
+				return diagnostics.Info("Repository role is valid")
+			},
+		},
+	}
+}
+
+func (p *ReposProvider) ValidateConfig() map[string]providers.ConfigValidationStep {
+	return map[string]providers.ConfigValidationStep{
+		"get-organization": {
+			Name:            "Get the GitHub organization",
+			FieldsValidated: []string{"appId", "installationId", "organization", "privateKey"},
+			Run: func(ctx context.Context) diagnostics.Logs {
+				err := p.checkOrganization(ctx)
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				return diagnostics.Info("The GitHub App can access the %s organization", p.organization.Get())
+			},
+		},
+		"list-repositories": {
+			Name: "List GitHub repositories",
+			Run: func(ctx context.Context) diagnostics.Logs {
+				n, err := p.count(ctx, p.orgPath("/orgs/%s/repos"))
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				return diagnostics.Info("GitHub returned %d repositories", n)
+			},
+		},
+	}
+}
@@ -0,0 +1,11 @@
+package github
+
+import "embed"
+
+//go:embed setup
+var setupDocs embed.FS
+
+// SetupDocs returns the embedded filesystem containing setup documentation.
+func (a *app) SetupDocs() embed.FS {
+	return setupDocs
+}
@@ -0,0 +1,287 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/common-fate/common-fate/accesshandler/pkg/diagnostics"
+	"github.com/common-fate/common-fate/accesshandler/pkg/providers"
+	"github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"go.uber.org/zap"
+)
+
+// TeamsProvider grants temporary membership of teams in a GitHub organization.
+type TeamsProvider struct {
+	app
+}
+
+var _ providers.ArgSchemarer = &TeamsProvider{}
+var _ providers.ArgOptioner = &TeamsProvider{}
+var _ providers.GrantValidator = &TeamsProvider{}
+var _ providers.ConfigValidator = &TeamsProvider{}
+
+type TeamArgs struct {
+	TeamSlug string `json:"teamSlug"`
+}
+
+func (p *TeamsProvider) ArgSchema() providers.ArgSchema {
+	return providers.ArgSchema{
+		"teamSlug": {
+			Id:              "teamSlug",
+			Title:           "Team",
+			RuleFormElement: types.ArgumentRuleFormElementMULTISELECT,
+		},
+	}
+}
+
+// teamMembership is a user's membership of a team.
+type teamMembership struct {
+	// Role is either member or maintainer.
+	Role string `json:"role"`
+	// State is pending until a user who isn't a member of the organization accepts their invitation.
+	State string `json:"state"`
+}
+
+// getMembership returns the user's membership of the team, or nil if they aren't a member.
+func (p *TeamsProvider) getMembership(ctx context.Context, teamSlug string, username string) (*teamMembership, error) {
+	var m teamMembership
+	_, err := p.client.do(ctx, http.MethodGet, p.orgPath("/orgs/%s/teams/%s/memberships/%s", teamSlug, username), nil, &m)
+	if isNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// Grant the access by adding the user to the team as a member.
+// Users who aren't members of the organization are invited to it, and have access once they accept the invitation.
+//
+// A user who is already a member of the team is left unchanged, so that a maintainer isn't made a member,
+// and so that granting access can be retried. Requests from users who were members of the team
+// before they requested access are rejected by the user-not-in-team validation.
+func (p *TeamsProvider) Grant(ctx context.Context, subject string, args []byte, grantID string) error {
+	var a TeamArgs
+	err := json.Unmarshal(args, &a)
+	if err != nil {
+		return err
+	}
+	log := zap.S().With("args", a)
+	log.Info("getting github user")
+	username, err := p.getUsername(ctx, subject)
+	if err != nil {
+		return err
+	}
+	log = log.With("username", username)
+	existing, err := p.getMembership(ctx, a.TeamSlug, username)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		log.Infow("github user is already a member of the team, leaving their membership unchanged", "role", existing.Role, "state", existing.State)
+		return nil
+	}
+
+	log.Info("adding github user to team")
+	var m teamMembership
+	_, err = p.client.do(ctx, http.MethodPut, p.orgPath("/orgs/%s/teams/%s/memberships/%s", a.TeamSlug, username), map[string]string{"role": "member"}, &m)
+	if err != nil {
+		return err
+	}
+	if m.State == "pending" {
+		log.Info("github user was invited to the organization, and will be added to the team once they accept the invitation")
+	}
+	return nil
+}
+
+// Revoke the access by removing the user from the team, and cancelling their invitation to the organization
+// if they haven't accepted it yet.
+//
+// Maintainers of the team are left unchanged, as access is only granted as a member.
+func (p *TeamsProvider) Revoke(ctx context.Context, subject string, args []byte, grantID string) error {
+	var a TeamArgs
+	err := json.Unmarshal(args, &a)
+	if err != nil {
+		return err
+	}
+	log := zap.S().With("args", a)
+	log.Info("getting github user")
+	username, err := p.getUsername(ctx, subject)
+	if err != nil {
+		return err
+	}
+	log = log.With("username", username)
+	existing, err := p.getMembership(ctx, a.TeamSlug, username)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		log.Info("github user isn't a member of the team")
+		return nil
+	}
+	if existing.Role == "maintainer" {
+		log.Info("github user is a maintainer of the team, leaving their membership unchanged")
+		return nil
+	}
+
+	log.Info("removing github user from team")
+	_, err = p.client.do(ctx, http.MethodDelete, p.orgPath("/orgs/%s/teams/%s/memberships/%s", a.TeamSlug, username), nil, nil)
+	if err != nil {
+		return err
+	}
+	if existing.State == "pending" {
+		return p.cancelInvitations(ctx, log, username)
+	}
+	return nil
+}
+
+// cancelInvitations cancels the user's pending invitations to the organization.
+func (p *TeamsProvider) cancelInvitations(ctx context.Context, log *zap.SugaredLogger, username string) error {
+	var invitationIDs []int64
+	err := p.client.list(ctx, p.orgPath("/orgs/%s/invitations"), func(page json.RawMessage) error {
+		var invitations []struct {
+			ID    int64  `json:"id"`
+			Login string `json:"login"`
+		}
+		err := json.Unmarshal(page, &invitations)
+		if err != nil {
+			return err
+		}
+		for _, inv := range invitations {
+			if inv.Login == username {
+				invitationIDs = append(invitationIDs, inv.ID)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, id := range invitationIDs {
+		log.Infow("cancelling organization invitation", "invitation.id", id)
+		_, err = p.client.do(ctx, http.MethodDelete, p.orgPath("/orgs/%s/invitations/%s", strconv.FormatInt(id, 10)), nil, nil)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Options lists the teams in the organization.
+func (p *TeamsProvider) Options(ctx context.Context, arg string) (*types.ArgOptionsResponse, error) {
+	switch arg {
+	case "teamSlug":
+		zap.S().Infow("getting github team options", "arg", arg)
+		opts := types.ArgOptionsResponse{Options: []types.Option{}}
+		err := p.client.list(ctx, p.orgPath("/orgs/%s/teams"), func(page json.RawMessage) error {
+			var teams []struct {
+				Name string `json:"name"`
+				Slug string `json:"slug"`
+			}
+			err := json.Unmarshal(page, &teams)
+			if err != nil {
+				return err
+			}
+			for _, t := range teams {
+				opts.Options = append(opts.Options, types.Option{Label: t.Name, Value: t.Slug})
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &opts, nil
+	}
+	return nil, &providers.InvalidArgumentError{Arg: arg}
+}
+
+func (p *TeamsProvider) ValidateGrant() providers.GrantValidationSteps {
+	return map[string]providers.GrantValidationStep{
+		"user-exists-in-github": {
+			UserErrorMessage: "We couldn't find a GitHub account with your email address. Make sure your email address is public on your GitHub profile",
+			Run: func(ctx context.Context, subject string, args []byte) diagnostics.Logs {
+				_, err := p.getUsername(ctx, subject)
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				return diagnostics.Info("User exists in GitHub")
+			},
+		},
+		"team-exists-in-github": {
+			UserErrorMessage: "We couldn't find a matching team in GitHub",
+			Run: func(ctx context.Context, subject string, args []byte) diagnostics.Logs {
+				var a TeamArgs
+				err := json.Unmarshal(args, &a)
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				_, err = p.client.do(ctx, http.MethodGet, p.orgPath("/orgs/%s/teams/%s", a.TeamSlug), nil, nil)
+				if isNotFound(err) {
+					return diagnostics.Error(&TeamNotFoundError{Team: a.TeamSlug})
+				}
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				return diagnostics.Info("Team exists in GitHub")
+			},
+		},
+		"user-not-in-team": {
+			UserErrorMessage: "You're already a member of this GitHub team",
+			Run: func(ctx context.Context, subject string, args []byte) diagnostics.Logs {
+				var a TeamArgs
+				err := json.Unmarshal(args, &a)
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				username, err := p.getUsername(ctx, subject)
+				// a user who can't be found is reported by the user-exists-in-github step.
+				var notFound *UserNotFoundError
+				if errors.As(err, &notFound) {
+					return diagnostics.Info("User isn't a member of the team")
+				}
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				// membership of a team which doesn't exist isn't found either, which is reported by the team-exists-in-github step.
+				m, err := p.getMembership(ctx, a.TeamSlug, username)
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				if m != nil {
+					return diagnostics.Error(&TeamMemberError{User: username, Team: a.TeamSlug})
+				}
+				return diagnostics.Info("User isn't a member of the team")
+			},
+		},
+	}
+}
+
+func (p *TeamsProvider) ValidateConfig() map[string]providers.ConfigValidationStep {
+	return map[string]providers.ConfigValidationStep{
+		"get-organization": {
+			Name:            "Get the GitHub organization",
+			FieldsValidated: []string{"appId", "installationId", "organization", "privateKey"},
+			Run: func(ctx context.Context) diagnostics.Logs {
+				err := p.checkOrganization(ctx)
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				return diagnostics.Info("The GitHub App can access the %s organization", p.organization.Get())
+			},
+		},
+		"list-teams": {
+			Name: "List GitHub teams",
+			Run: func(ctx context.Context) diagnostics.Logs {
+				n, err := p.count(ctx, p.orgPath("/orgs/%s/teams"))
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				return diagnostics.Info("GitHub returned %d teams", n)
+			},
+		},
+	}
+}
@@ -0,0 +1,53 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/common-fate/common-fate/accesshandler/pkg/providers"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"go.uber.org/zap"
+)
+
+// Assignments lists the members of every Okta group.
+func (p *Provider) Assignments(ctx context.Context) ([]providers.Assignment, error) {
+	groups, res, err := p.client.Group.ListGroups(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	for res.HasNextPage() {
+		var page []*okta.Group
+		res, err = res.Next(ctx, &page)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, page...)
+	}
+
+	var assignments []providers.Assignment
+	for _, g := range groups {
+		zap.S().Debugw("listing okta group members", "group.id", g.Id)
+		users, res, err := p.client.Group.ListGroupUsers(ctx, g.Id, nil)
+		if err != nil {
+			return nil, err
+		}
+		for res.HasNextPage() {
+			var page []*okta.User
+			res, err = res.Next(ctx, &page)
+			if err != nil {
+				return nil, err
+			}
+			users = append(users, page...)
+		}
+		for _, u := range users {
+			email, ok := (*u.Profile)["email"].(string)
+			if !ok {
+				continue
+			}
+			assignments = append(assignments, providers.Assignment{
+				Subject: email,
+				With:    map[string]string{"groupId": g.Id},
+			})
+		}
+	}
+	return assignments, nil
+}
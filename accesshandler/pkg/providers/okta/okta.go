@@ -16,6 +16,9 @@ type Provider struct {
 	apiToken gconfig.SecretStringValue
 }
 
+var _ providers.Verifier = &Provider{}
+var _ providers.Inventorier = &Provider{}
+
 func (o *Provider) Config() gconfig.Config {
 	return gconfig.Config{
 		gconfig.StringField("orgUrl", &o.orgURL, "the Okta organization URL"),
@@ -3,6 +3,7 @@ package providers
 import (
 	"context"
 	"embed"
+	"time"
 
 	"github.com/common-fate/common-fate/accesshandler/pkg/diagnostics"
 	"github.com/common-fate/common-fate/accesshandler/pkg/types"
@@ -19,6 +20,53 @@ type Accessor interface {
 	Revoke(ctx context.Context, subject string, args []byte, grantID string) error
 }
 
+type grantEndContext struct{}
+
+// WithGrantEnd returns a context which carries the time that the grant being provisioned ends.
+// Runtimes set it when calling Grant, so that providers which create credentials can make them expire with the grant.
+func WithGrantEnd(ctx context.Context, end time.Time) context.Context {
+	return context.WithValue(ctx, grantEndContext{}, end)
+}
+
+// GrantEndFromContext returns the time that the grant being provisioned ends, or false if the runtime didn't provide it.
+func GrantEndFromContext(ctx context.Context) (time.Time, bool) {
+	end, ok := ctx.Value(grantEndContext{}).(time.Time)
+	return end, ok
+}
+
+// Extenders are called when the end time of an active grant is moved later.
+// Providers which make access expire on its own at the end of a grant, such as by creating
+// credentials which are only valid until then, implement it so that access lasts until the new end time.
+type Extender interface {
+	// Extend the access until end.
+	Extend(ctx context.Context, subject string, args []byte, grantID string, end time.Time) error
+}
+
+// Verifiers can check whether the access for a grant is actually in effect in the provider.
+// They are used to detect drift between the status of a grant in Common Fate and the provider,
+// such as an assignment being removed directly in the provider, or access remaining after
+// a grant has ended because revoking it failed.
+type Verifier interface {
+	// IsActive returns true if the access is currently provisioned for the subject.
+	IsActive(ctx context.Context, subject string, args []byte, grantID string) (bool, error)
+}
+
+// Assignment is access which currently exists in a provider, whether or not it was granted by Common Fate.
+type Assignment struct {
+	// Subject is the email address of the user who has the access.
+	Subject string
+	// With holds the arguments which grant the same access, keyed by argument ID.
+	With map[string]string
+}
+
+// Inventoriers can list the access which currently exists in the provider.
+// They are used to find standing access which wasn't granted by Common Fate.
+type Inventorier interface {
+	// Assignments lists every assignment of a user to a resource which the provider can grant access to.
+	// Access which users hold through membership of another group is not included.
+	Assignments(ctx context.Context) ([]Assignment, error)
+}
+
 // AccessTokeners can indicate whether they need an access token to be generated
 // as part of the access workflow.
 //
@@ -3,6 +3,9 @@ package testgroups
 import (
 	"context"
 	"encoding/json"
+	"sort"
+
+	"github.com/common-fate/common-fate/accesshandler/pkg/providers"
 )
 
 type Args struct {
@@ -20,7 +23,12 @@ func (p *Provider) Grant(ctx context.Context, subject string, args []byte, grant
 
 	// call the validation function. This ensures more realistic behaviour for the provider -
 	// as if validation fails we expect granting access to also fail.
-	return p.Validate(ctx, subject, args)
+	err = p.Validate(ctx, subject, args)
+	if err != nil {
+		return err
+	}
+	p.setActive(membership{subject: subject, group: a.Group}, true)
+	return nil
 }
 
 // Revoke the access. The testgroups provider is a no-op provider for testing, so this doesn't
@@ -34,5 +42,53 @@ func (p *Provider) Revoke(ctx context.Context, subject string, args []byte, gran
 
 	// call the validation function. This ensures more realistic behaviour for the provider -
 	// as if validation fails we expect granting access to also fail.
-	return p.Validate(ctx, subject, args)
+	err = p.Validate(ctx, subject, args)
+	if err != nil {
+		return err
+	}
+	p.setActive(membership{subject: subject, group: a.Group}, false)
+	return nil
+}
+
+// IsActive checks whether the subject has been granted the group and the access hasn't since been revoked.
+// Memberships are only held in memory, so they don't persist across restarts.
+func (p *Provider) IsActive(ctx context.Context, subject string, args []byte, grantID string) (bool, error) {
+	var a Args
+	err := json.Unmarshal(args, &a)
+	if err != nil {
+		return false, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.active[membership{subject: subject, group: a.Group}], nil
+}
+
+// Assignments lists the group memberships which are currently active, sorted by subject and group.
+func (p *Provider) Assignments(ctx context.Context) ([]providers.Assignment, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	assignments := []providers.Assignment{}
+	for m := range p.active {
+		assignments = append(assignments, providers.Assignment{Subject: m.subject, With: map[string]string{"group": m.group}})
+	}
+	sort.Slice(assignments, func(i, j int) bool {
+		if assignments[i].Subject != assignments[j].Subject {
+			return assignments[i].Subject < assignments[j].Subject
+		}
+		return assignments[i].With["group"] < assignments[j].With["group"]
+	})
+	return assignments, nil
+}
+
+func (p *Provider) setActive(m membership, active bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.active == nil {
+		p.active = map[membership]bool{}
+	}
+	if active {
+		p.active[m] = true
+	} else {
+		delete(p.active, m)
+	}
 }
@@ -3,6 +3,7 @@ package testgroups
 import (
 	"context"
 	"strings"
+	"sync"
 
 	"github.com/common-fate/common-fate/accesshandler/pkg/providers"
 	"github.com/common-fate/common-fate/accesshandler/pkg/types"
@@ -13,8 +14,21 @@ import (
 type Provider struct {
 	groups []string
 	g      gconfig.StringValue
+
+	// active records the group memberships which have been granted,
+	// so that the provider can verify access without an external API.
+	mu     sync.Mutex
+	active map[membership]bool
+}
+
+type membership struct {
+	subject string
+	group   string
 }
 
+var _ providers.Verifier = &Provider{}
+var _ providers.Inventorier = &Provider{}
+
 // SetGroups is a convenient method to setup the provider for testing without using gconfig
 func (p *Provider) SetGroups(groups []string) {
 	p.groups = groups
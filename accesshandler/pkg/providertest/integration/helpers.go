@@ -6,14 +6,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/common-fate/common-fate/accesshandler/pkg/providers"
 	"github.com/sethvargo/go-retry"
 	"github.com/stretchr/testify/assert"
 )
 
-type IsActiver interface {
-	IsActive(ctx context.Context, subject string, args []byte, grantID string) (bool, error)
-}
-
 // CheckIsProvisioned calls the underlying integration's API to check that access was
 // provisioned or not. It returns an error if the access status doesn't match what we
 // wanted.
@@ -26,7 +23,7 @@ type IsActiver interface {
 // For some integrations the API is eventually consistent, and access won't be
 // reflected immediately when calling this function. To handle this, CheckIsProvisioned
 // uses go-retry to call the API again with a backoff with a maximum duration of 10 seconds.
-func CheckIsProvisioned(ctx context.Context, access IsActiver, subject string, args []byte, grantID string, want bool) error {
+func CheckIsProvisioned(ctx context.Context, access providers.Verifier, subject string, args []byte, grantID string, want bool) error {
 	b := retry.NewFibonacci(time.Second)
 	b = retry.WithMaxDuration(time.Second*10, b)
 
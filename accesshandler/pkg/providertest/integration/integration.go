@@ -126,9 +126,9 @@ func (it *IntegrationTests) run(t *testing.T, ctx context.Context) {
 
 				if tc.WantValidationErr == nil {
 					t.Run("check provisioned", func(t *testing.T) {
-						checker, ok := it.p.(IsActiver)
+						checker, ok := it.p.(providers.Verifier)
 						if !ok {
-							t.Skip("Provider does not implement IsActiver")
+							t.Skip("Provider does not implement Verifier")
 						} else {
 							err = CheckIsProvisioned(ctx, checker, tc.Subject, []byte(tc.Args), testGrantID, true)
 							if err != nil {
@@ -147,9 +147,9 @@ func (it *IntegrationTests) run(t *testing.T, ctx context.Context) {
 
 				if tc.WantValidationErr == nil {
 					t.Run("check revoked", func(t *testing.T) {
-						checker, ok := it.p.(IsActiver)
+						checker, ok := it.p.(providers.Verifier)
 						if !ok {
-							t.Skip("Provider does not implement IsActiver")
+							t.Skip("Provider does not implement Verifier")
 						} else {
 							err = CheckIsProvisioned(ctx, checker, tc.Subject, []byte(tc.Args), testGrantID, false)
 							if err != nil {
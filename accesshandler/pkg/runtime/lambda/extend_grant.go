@@ -0,0 +1,75 @@
+package lambda
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	aws_config "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/accesshandler/pkg/config"
+	"github.com/common-fate/common-fate/accesshandler/pkg/providers"
+	"github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/iso8601"
+)
+
+// ExtendGrant extends an active grant until end.
+//
+// The input of a running state function can't be changed, so the granter looks up the end time of the grant
+// from the Common Fate request when the grant is due to end, and waits until the new end time if the request was extended.
+// The caller is responsible for saving the new end time on the request.
+func (r *Runtime) ExtendGrant(ctx context.Context, grantID string, end time.Time) (*types.Grant, error) {
+	logger.Get(ctx).Infow("extending grant", "grant", grantID, "end", end)
+
+	c, err := aws_config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sfnClient := sfn.NewFromConfig(c)
+	exeARN := BuildExecutionARN(r.GranterStateMachineARN, grantID)
+
+	out, err := sfnClient.DescribeExecution(ctx, &sfn.DescribeExecutionInput{ExecutionArn: aws.String(exeARN)})
+	if err != nil {
+		return nil, err
+	}
+	var grantInput WorkflowInput
+	err = json.Unmarshal([]byte(*out.Input), &grantInput)
+	if err != nil {
+		return nil, err
+	}
+	grant := grantInput.Grant
+
+	// only grants which are waiting for their end time can be extended
+	statefn, err := sfnClient.GetExecutionHistory(ctx, &sfn.GetExecutionHistoryInput{ExecutionArn: &exeARN})
+	if err != nil {
+		return nil, err
+	}
+	lastState := statefn.Events[len(statefn.Events)-1]
+	if lastState.Type != "WaitStateEntered" || *lastState.StateEnteredEventDetails.Name != "Wait for Window End" {
+		return nil, apio.NewRequestError(errors.New("only active grants can be extended"), http.StatusBadRequest)
+	}
+
+	prov, ok := config.Providers[grant.Provider]
+	if !ok {
+		return nil, &providers.ProviderNotFoundError{Provider: grant.Provider}
+	}
+	if extender, ok := prov.Provider.(providers.Extender); ok {
+		args, err := json.Marshal(grant.With)
+		if err != nil {
+			return nil, err
+		}
+		err = extender.Extend(ctx, string(grant.Subject), args, grant.ID, end)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	grant.Status = types.GrantStatusACTIVE
+	grant.End = iso8601.New(end)
+	return &grant, nil
+}
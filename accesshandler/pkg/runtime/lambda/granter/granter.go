@@ -10,6 +10,9 @@ import (
 	"github.com/common-fate/common-fate/accesshandler/pkg/providers"
 	"github.com/common-fate/common-fate/pkg/deploy"
 	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/iso8601"
 
 	"github.com/common-fate/common-fate/accesshandler/pkg/types"
 	"github.com/pkg/errors"
@@ -19,6 +22,8 @@ import (
 type Granter struct {
 	rawLog *zap.SugaredLogger
 	cfg    config.GranterConfig
+	// db is nil if the granter isn't configured with the Common Fate table.
+	db ddb.Storage
 }
 
 type EventType string
@@ -26,6 +31,8 @@ type EventType string
 const (
 	ACTIVATE   EventType = "ACTIVATE"
 	DEACTIVATE EventType = "DEACTIVATE"
+	// EXTEND checks whether the grant has been extended before it is deactivated.
+	EXTEND EventType = "EXTEND"
 )
 
 type InputEvent struct {
@@ -60,13 +67,23 @@ func NewGranter(ctx context.Context, c config.GranterConfig) (*Granter, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Granter{rawLog: log, cfg: c}, nil
+	g := Granter{rawLog: log, cfg: c}
+	if c.TableName != "" {
+		g.db, err = ddb.New(ctx, c.TableName)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &g, nil
 }
 
 func (g *Granter) HandleRequest(ctx context.Context, in InputEvent) (Output, error) {
 	grant := in.Grant
 	log := g.rawLog.With("grant.id", grant.ID)
 	log.Infow("Handling event", "event", in)
+	if in.Action == EXTEND {
+		return g.checkForExtension(ctx, grant)
+	}
 	prov, ok := config.Providers[grant.Provider]
 	if !ok {
 		return Output{}, &providers.ProviderNotFoundError{Provider: grant.Provider}
@@ -93,7 +110,7 @@ func (g *Granter) HandleRequest(ctx context.Context, in InputEvent) (Output, err
 					err = fmt.Errorf("internal server error with provider: %s  version: %s", prov.Type, prov.Version)
 				}
 			}()
-			return prov.Provider.Grant(ctx, string(grant.Subject), args, grant.ID)
+			return prov.Provider.Grant(providers.WithGrantEnd(ctx, grant.End.Time), string(grant.Subject), args, grant.ID)
 		}()
 	case DEACTIVATE:
 		log.Infow("deactivating grant")
@@ -107,7 +124,7 @@ func (g *Granter) HandleRequest(ctx context.Context, in InputEvent) (Output, err
 			return prov.Provider.Revoke(ctx, string(grant.Subject), args, grant.ID)
 		}()
 	default:
-		err = fmt.Errorf("invocation type: %s not supported, type must be one of [ACTIVATE, DEACTIVATE]", in.Action)
+		err = fmt.Errorf("invocation type: %s not supported, type must be one of [ACTIVATE, DEACTIVATE, EXTEND]", in.Action)
 	}
 
 	// emit an event and return early if we failed (de)provisioning the grant
@@ -144,3 +161,29 @@ func (g *Granter) HandleRequest(ctx context.Context, in InputEvent) (Output, err
 	}
 	return o, nil
 }
+
+// checkForExtension looks up the request for the grant and moves the end time of the grant
+// if the request has been extended. The workflow waits until the new end time before deactivating access.
+func (g *Granter) checkForExtension(ctx context.Context, grant types.Grant) (Output, error) {
+	log := g.rawLog.With("grant.id", grant.ID)
+	out := Output{Grant: grant}
+	if g.db == nil {
+		log.Infow("the Common Fate table isn't configured, deactivating at the original end time")
+		return out, nil
+	}
+
+	q := storage.GetRequest{ID: grant.ID}
+	_, err := g.db.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		log.Infow("request not found for grant, deactivating at the original end time")
+		return out, nil
+	}
+	if err != nil {
+		return Output{}, err
+	}
+	if q.Result.Grant != nil && q.Result.Grant.End.After(grant.End.Time) {
+		log.Infow("grant has been extended", "end", q.Result.Grant.End)
+		out.Grant.End = iso8601.New(q.Result.Grant.End)
+	}
+	return out, nil
+}
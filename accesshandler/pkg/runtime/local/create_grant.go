@@ -2,36 +2,34 @@ package local
 
 import (
 	"context"
-	"time"
+	"fmt"
+	"net/http"
 
+	"github.com/common-fate/apikit/apio"
 	"github.com/common-fate/apikit/logger"
 	"github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/gevent"
 )
 
-// CreateGrant creates a new grant.
+// CreateGrant stores a new grant, to be activated by the scheduler when it starts.
 func (r *Runtime) CreateGrant(ctx context.Context, vcg types.ValidCreateGrant) (types.Grant, error) {
 	grant := types.NewGrant(vcg)
 	logger.Get(ctx).Infow("creating grant", "grant", grant)
 
-	tx := r.db.Txn(true)
-	defer tx.Commit()
-	err := tx.Insert("grants", &grant)
+	unlock := r.lockGrant(grant.ID)
+	defer unlock()
+
+	if _, ok := r.store.get(grant.ID); ok {
+		return types.Grant{}, apio.NewRequestError(fmt.Errorf("grant %s already exists", grant.ID), http.StatusConflict)
+	}
+	err := r.store.put(scheduledGrant{Grant: grant})
 	if err != nil {
 		return types.Grant{}, err
 	}
 
-	go func() {
-		ctx := context.Background()
-		waitFor := time.Until(grant.Start.Time)
-		time.Sleep(waitFor)
-
-		logger.Get(ctx).Infow("activating grant", "grant", grant)
-
-		dur := grant.End.Sub(grant.Start.Time)
-		time.Sleep(dur)
-
-		logger.Get(ctx).Infow("deactivating grant", "grant", grant)
-	}()
-
+	err = r.put(ctx, &gevent.GrantCreated{Grant: grant})
+	if err != nil {
+		return types.Grant{}, err
+	}
 	return grant, nil
 }
@@ -5,13 +5,15 @@ import (
 	"testing"
 	"time"
 
+	"github.com/benbjohnson/clock"
 	"github.com/common-fate/common-fate/accesshandler/pkg/types"
 	"github.com/common-fate/iso8601"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestCreateGrant(t *testing.T) {
 	ctx := context.Background()
-	r := Runtime{}
+	r := Runtime{Clock: clock.NewMock(), StateDir: t.TempDir()}
 
 	err := r.Init(ctx)
 	if err != nil {
@@ -34,8 +36,22 @@ func TestCreateGrant(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	_, err = r.CreateGrant(ctx, *vcg)
+	got, err := r.CreateGrant(ctx, *vcg)
 	if err != nil {
 		t.Fatal(err)
 	}
+	assert.Equal(t, types.GrantStatusPENDING, got.Status)
+
+	// the grant should be loaded by a new runtime using the same state directory.
+	r2 := Runtime{Clock: clock.NewMock(), StateDir: r.StateDir}
+	err = r2.Init(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sg, ok := r2.store.get("abcd")
+	assert.True(t, ok)
+	assert.Equal(t, got, sg.Grant)
+
+	_, err = r.CreateGrant(ctx, *vcg)
+	assert.EqualError(t, err, "grant abcd already exists")
 }
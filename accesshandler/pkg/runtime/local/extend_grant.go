@@ -0,0 +1,75 @@
+package local
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/accesshandler/pkg/config"
+	"github.com/common-fate/common-fate/accesshandler/pkg/providers"
+	"github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/iso8601"
+	"go.uber.org/zap"
+)
+
+// ExtendGrant moves the end time of an active grant later, so that it is deactivated at the new end time.
+func (r *Runtime) ExtendGrant(ctx context.Context, grantID string, end time.Time) (*types.Grant, error) {
+	logger.Get(ctx).Infow("extending grant", "grant", grantID, "end", end)
+
+	unlock := r.lockGrant(grantID)
+	defer unlock()
+
+	sg, ok := r.store.get(grantID)
+	if !ok {
+		return nil, apio.NewRequestError(fmt.Errorf("grant %s not found", grantID), http.StatusNotFound)
+	}
+	if sg.Grant.Status != types.GrantStatusACTIVE {
+		return nil, apio.NewRequestError(fmt.Errorf("grant %s can't be extended because it is %s", grantID, sg.Grant.Status), http.StatusBadRequest)
+	}
+	if !end.After(sg.Grant.End.Time) {
+		return nil, apio.NewRequestError(fmt.Errorf("grant %s already ends at %s", grantID, sg.Grant.End), http.StatusBadRequest)
+	}
+
+	sg.Grant.End = iso8601.New(end)
+	err := callExtender(ctx, sg.Grant)
+	if err != nil {
+		return nil, err
+	}
+
+	// any failed attempts to deactivate the grant at its previous end time no longer need to be retried.
+	sg.Attempts = 0
+	sg.LastError = ""
+	sg.RetryAt = nil
+	err = r.store.put(sg)
+	if err != nil {
+		return nil, err
+	}
+	return &sg.Grant, nil
+}
+
+// callExtender calls the Extend method of the grant's provider, if the provider implements it.
+func callExtender(ctx context.Context, grant types.Grant) (err error) {
+	prov, ok := config.Providers[grant.Provider]
+	if !ok {
+		return &providers.ProviderNotFoundError{Provider: grant.Provider}
+	}
+	extender, ok := prov.Provider.(providers.Extender)
+	if !ok {
+		return nil
+	}
+	args, err := json.Marshal(grant.With)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if rec := recover(); rec != nil {
+			zap.S().Errorw("recovered panic while calling provider", "error", rec, "provider", prov)
+			err = fmt.Errorf("internal server error with provider: %s  version: %s", prov.Type, prov.Version)
+		}
+	}()
+	return extender.Extend(ctx, string(grant.Subject), args, grant.ID, grant.End.Time)
+}
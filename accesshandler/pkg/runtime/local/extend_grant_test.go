@@ -0,0 +1,84 @@
+package local
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/accesshandler/pkg/config"
+	"github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// testExtender is a testAccessor which records the new end time of extended grants.
+type testExtender struct {
+	testAccessor
+	extendedTo []time.Time
+}
+
+func (e *testExtender) Extend(ctx context.Context, subject string, args []byte, grantID string, end time.Time) error {
+	e.extendedTo = append(e.extendedTo, end)
+	return nil
+}
+
+func TestExtendGrantReschedulesDeactivation(t *testing.T) {
+	ctx := context.Background()
+	acc := &testAccessor{}
+	r, clk, _ := newTestRuntime(t, t.TempDir(), acc)
+	now := clk.Now()
+	createTestGrant(t, r, now, now.Add(time.Hour))
+
+	// a grant can't be extended until it is active
+	_, err := r.ExtendGrant(ctx, "abcd", now.Add(2*time.Hour))
+	assert.Error(t, err)
+
+	err = r.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	grant, err := r.ExtendGrant(ctx, "abcd", now.Add(2*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, now.Add(2*time.Hour), grant.End.Time)
+
+	// the grant is still active at its original end time
+	clk.Add(time.Hour)
+	err = r.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, types.GrantStatusACTIVE, grantStatus(t, r))
+
+	clk.Add(time.Hour)
+	err = r.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, types.GrantStatusEXPIRED, grantStatus(t, r))
+	assert.Equal(t, []string{"grant abcd", "revoke abcd"}, acc.calls)
+}
+
+func TestExtendGrantCallsExtender(t *testing.T) {
+	ctx := context.Background()
+	ext := &testExtender{}
+	r, clk, _ := newTestRuntime(t, t.TempDir(), &ext.testAccessor)
+	// replace the provider so that it implements Extender.
+	config.ConfigureTestProviders([]config.Provider{{ID: "test", Type: "test", Provider: ext}})
+	now := clk.Now()
+	createTestGrant(t, r, now, now.Add(time.Hour))
+	err := r.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = r.ExtendGrant(ctx, "abcd", now.Add(2*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []time.Time{now.Add(2 * time.Hour)}, ext.extendedTo)
+
+	// the end time can only be moved later
+	_, err = r.ExtendGrant(ctx, "abcd", now.Add(time.Hour))
+	assert.Error(t, err)
+}
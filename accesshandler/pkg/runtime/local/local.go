@@ -2,37 +2,118 @@ package local
 
 import (
 	"context"
+	"sync"
+	"time"
 
-	"github.com/hashicorp/go-memdb"
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/sethvargo/go-envconfig"
+	"go.uber.org/zap"
 )
 
-// Runtime is a local runtime for testing use only which executes
-// grants using goroutines. It stores grants in memory.
+// Runtime is a runtime which executes grants in the Access Handler process,
+// allowing the self-hosted server to be used without AWS Step Functions.
+//
+// Grants are persisted to StateDir so that scheduled activations and deactivations
+// survive a restart, and are executed by a scheduler which calls the provider's
+// Grant and Revoke methods when the grant starts and ends.
 type Runtime struct {
-	db *memdb.MemDB
+	// StateDir is the directory which grants are stored in.
+	// If empty, grants are only stored in memory and are lost when the process exits.
+	StateDir    string `env:"COMMONFATE_LOCAL_RUNTIME_STATE_DIR"`
+	EventBusArn string `env:"COMMONFATE_EVENT_BUS_ARN"`
+	// PollInterval is how often the scheduler checks for grants to activate or deactivate.
+	PollInterval time.Duration `env:"COMMONFATE_LOCAL_RUNTIME_POLL_INTERVAL,default=1s"`
+	// MaxAttempts is the number of attempts made to activate or deactivate a grant before it is marked as failed.
+	MaxAttempts int `env:"COMMONFATE_LOCAL_RUNTIME_MAX_ATTEMPTS,default=5"`
+	// InitialBackoff is the delay before the first retry, which doubles after each attempt.
+	InitialBackoff time.Duration `env:"COMMONFATE_LOCAL_RUNTIME_INITIAL_BACKOFF,default=5s"`
+	// Retention is how long a finished grant is kept after it ends, before it is deleted from StateDir.
+	// If zero, finished grants are never deleted.
+	Retention time.Duration `env:"COMMONFATE_LOCAL_RUNTIME_RETENTION,default=720h"`
+
+	// EventPutter is used to emit grant events.
+	// If nil, events are sent to the EventBusArn bus, or are only logged if no bus is configured.
+	EventPutter gevent.EventPutter
+	// Clock can be overriden for testing purposes.
+	Clock clock.Clock
+
+	// mu guards locks.
+	mu sync.Mutex
+	// locks ensure that the scheduler and API calls don't act on a grant at the same time.
+	// There is a lock for each grant, so that a slow provider call doesn't hold up other grants.
+	locks map[string]*grantLock
+	store *store
+}
+
+// grantLock is a lock on a single grant, which is deleted once nothing is waiting for it.
+type grantLock struct {
+	sync.Mutex
+	// refs is the number of callers holding or waiting for the lock.
+	refs int
+}
+
+// lockGrant locks the grant with the given ID and returns a function which unlocks it.
+func (r *Runtime) lockGrant(grantID string) (unlock func()) {
+	r.mu.Lock()
+	if r.locks == nil {
+		r.locks = map[string]*grantLock{}
+	}
+	l, ok := r.locks[grantID]
+	if !ok {
+		l = &grantLock{}
+		r.locks[grantID] = l
+	}
+	l.refs++
+	r.mu.Unlock()
+
+	l.Lock()
+	return func() {
+		l.Unlock()
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		l.refs--
+		if l.refs == 0 {
+			delete(r.locks, grantID)
+		}
+	}
 }
 
-// Init initialises the runtime and sets up the in-memory storage.
+// Init initialises the runtime, loading any grants stored by a previous run
+// and starting the scheduler in the background.
 func (r *Runtime) Init(ctx context.Context) error {
-	schema := &memdb.DBSchema{
-		Tables: map[string]*memdb.TableSchema{
-			"grants": {
-				Name: "grants",
-				Indexes: map[string]*memdb.IndexSchema{
-					"id": {
-						Name:    "id",
-						Unique:  true,
-						Indexer: &memdb.StringFieldIndex{Field: "ID"},
-					},
-				},
-			},
-		},
-	}
-	db, err := memdb.NewMemDB(schema)
+	err := envconfig.Process(ctx, r)
 	if err != nil {
 		return err
 	}
+	if r.Clock == nil {
+		r.Clock = clock.New()
+	}
+	if r.EventPutter == nil && r.EventBusArn != "" {
+		sender, err := gevent.NewSender(ctx, gevent.SenderOpts{EventBusARN: r.EventBusArn})
+		if err != nil {
+			return err
+		}
+		r.EventPutter = sender
+	}
+
+	s, err := openStore(r.StateDir)
+	if err != nil {
+		return err
+	}
+	r.store = s
+
+	if r.StateDir == "" {
+		zap.S().Warnw("COMMONFATE_LOCAL_RUNTIME_STATE_DIR is not set, grants will be lost when the access handler restarts")
+	}
+	var scheduled int
+	for _, sg := range s.list() {
+		if _, ok := sg.dueAt(); ok {
+			scheduled++
+		}
+	}
+	zap.S().Infow("loaded grants", "dir", r.StateDir, "scheduled", scheduled)
 
-	r.db = db
+	go r.RunEvery(ctx, r.PollInterval)
 	return nil
 }
@@ -3,11 +3,13 @@ package local
 import (
 	"context"
 	"testing"
+
+	"github.com/benbjohnson/clock"
 )
 
 func TestInit(t *testing.T) {
 	ctx := context.Background()
-	r := Runtime{}
+	r := Runtime{Clock: clock.NewMock()}
 
 	err := r.Init(ctx)
 	if err != nil {
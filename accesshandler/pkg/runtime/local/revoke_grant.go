@@ -2,14 +2,46 @@ package local
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 
+	"github.com/common-fate/apikit/apio"
 	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/accesshandler/pkg/providers"
 	"github.com/common-fate/common-fate/accesshandler/pkg/types"
 )
 
-func (r *Runtime) RevokeGrant(ctx context.Context, grant string, revoker string) (*types.Grant, error) {
+// RevokeGrant revokes access if the grant is active and cancels any scheduled activation or deactivation.
+// The GrantRevoked event is emitted by the caller rather than the runtime, as it includes details of the revoker.
+func (r *Runtime) RevokeGrant(ctx context.Context, grantID string, revoker string) (*types.Grant, error) {
+	logger.Get(ctx).Infow("revoking grant", "grant", grantID, "revoker", revoker)
 
-	logger.Get(ctx).Infow("revoking grant", "grant", grant, "revoker", revoker)
+	unlock := r.lockGrant(grantID)
+	defer unlock()
 
-	return &types.Grant{}, nil
+	sg, ok := r.store.get(grantID)
+	if !ok {
+		return nil, apio.NewRequestError(fmt.Errorf("grant %s not found", grantID), http.StatusNotFound)
+	}
+
+	switch sg.Grant.Status {
+	case types.GrantStatusPENDING:
+	case types.GrantStatusACTIVE:
+		err := callProvider(ctx, sg.Grant, providers.Accessor.Revoke)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, apio.NewRequestError(fmt.Errorf("grant %s can't be revoked because it is %s", grantID, sg.Grant.Status), http.StatusBadRequest)
+	}
+
+	sg.Grant.Status = types.GrantStatusREVOKED
+	sg.Attempts = 0
+	sg.LastError = ""
+	sg.RetryAt = nil
+	err := r.store.put(sg)
+	if err != nil {
+		return nil, err
+	}
+	return &sg.Grant, nil
 }
@@ -0,0 +1,62 @@
+package local
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRevokeGrant(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("pending grant", func(t *testing.T) {
+		acc := &testAccessor{}
+		r, clk, _ := newTestRuntime(t, t.TempDir(), acc)
+		now := clk.Now()
+		createTestGrant(t, r, now.Add(time.Minute), now.Add(time.Hour))
+
+		got, err := r.RevokeGrant(ctx, "abcd", "usr_123")
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, types.GrantStatusREVOKED, got.Status)
+
+		// the grant should no longer be activated
+		clk.Add(time.Minute)
+		err = r.Run(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Empty(t, acc.calls)
+	})
+
+	t.Run("active grant", func(t *testing.T) {
+		acc := &testAccessor{}
+		r, clk, _ := newTestRuntime(t, t.TempDir(), acc)
+		now := clk.Now()
+		createTestGrant(t, r, now, now.Add(time.Hour))
+		err := r.Run(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := r.RevokeGrant(ctx, "abcd", "usr_123")
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, types.GrantStatusREVOKED, got.Status)
+		assert.Equal(t, []string{"grant abcd", "revoke abcd"}, acc.calls)
+
+		_, err = r.RevokeGrant(ctx, "abcd", "usr_123")
+		assert.EqualError(t, err, "grant abcd can't be revoked because it is REVOKED")
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		r, _, _ := newTestRuntime(t, t.TempDir(), &testAccessor{})
+		_, err := r.RevokeGrant(ctx, "other", "usr_123")
+		assert.EqualError(t, err, "grant other not found")
+	})
+}
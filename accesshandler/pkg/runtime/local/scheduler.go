@@ -0,0 +1,193 @@
+package local
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/common-fate/common-fate/accesshandler/pkg/config"
+	"github.com/common-fate/common-fate/accesshandler/pkg/providers"
+	"github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/schedule"
+	"go.uber.org/zap"
+)
+
+// Run activates the stored grants which have started and deactivates those which have ended,
+// and then deletes the grants which finished more than Retention ago.
+// If the provider returns an error, the action is retried with exponential backoff on a
+// later run, and the grant is marked as failed once MaxAttempts have been made.
+//
+// A grant which can't be saved is logged and skipped, so that it doesn't stop the remaining grants
+// from being processed. Its action is taken again on the next run.
+func (r *Runtime) Run(ctx context.Context) error {
+	now := r.Clock.Now()
+	for _, sg := range r.store.list() {
+		due, ok := sg.dueAt()
+		if !ok || now.Before(due) {
+			continue
+		}
+		err := r.process(ctx, sg.Grant.ID, now)
+		if err != nil {
+			zap.S().Errorw("failed to process grant", "grant.id", sg.Grant.ID, zap.Error(err))
+		}
+	}
+	if r.Retention > 0 {
+		r.prune(now.Add(-r.Retention))
+	}
+	return nil
+}
+
+// RunEvery calls Run on the given interval until the context is cancelled.
+// Grants which were due while the access handler wasn't running are processed on the first run.
+func (r *Runtime) RunEvery(ctx context.Context, interval time.Duration) {
+	schedule.RunEvery(ctx, r.Clock, interval, "failed to run grant scheduler", r.Run)
+}
+
+// prune deletes the finished grants which ended before the given time, so that StateDir doesn't grow without bound.
+func (r *Runtime) prune(endedBefore time.Time) {
+	for _, sg := range r.store.list() {
+		if _, ok := sg.dueAt(); ok || !sg.Grant.End.Time.Before(endedBefore) {
+			continue
+		}
+		err := r.deleteGrant(sg.Grant.ID)
+		if err != nil {
+			zap.S().Errorw("failed to delete finished grant", "grant.id", sg.Grant.ID, zap.Error(err))
+		}
+	}
+}
+
+// deleteGrant deletes a finished grant.
+func (r *Runtime) deleteGrant(grantID string) error {
+	unlock := r.lockGrant(grantID)
+	defer unlock()
+
+	// the grant may have been changed since it was listed.
+	sg, ok := r.store.get(grantID)
+	if !ok {
+		return nil
+	}
+	if _, ok := sg.dueAt(); ok {
+		return nil
+	}
+	return r.store.delete(grantID)
+}
+
+// process takes the next action on a grant.
+// Errors from the provider are recorded against the grant rather than being returned.
+func (r *Runtime) process(ctx context.Context, grantID string, now time.Time) error {
+	unlock := r.lockGrant(grantID)
+	defer unlock()
+
+	// the grant may have been revoked since the scheduler listed it.
+	sg, ok := r.store.get(grantID)
+	if !ok {
+		return nil
+	}
+	due, ok := sg.dueAt()
+	if !ok || now.Before(due) {
+		return nil
+	}
+
+	log := zap.S().With("grant.id", grantID)
+	var evt gevent.EventTyper
+	switch sg.Grant.Status {
+	case types.GrantStatusPENDING:
+		if !now.Before(sg.Grant.End.Time) {
+			// the access handler wasn't running for the whole of the grant, so there's nothing to provision.
+			log.Infow("grant ended before it could be activated")
+			sg.Grant.Status = types.GrantStatusEXPIRED
+			evt = &gevent.GrantExpired{Grant: sg.Grant}
+			break
+		}
+		log.Infow("activating grant", "attempt", sg.Attempts+1)
+		err := callProvider(ctx, sg.Grant, providers.Accessor.Grant)
+		if err != nil {
+			return r.retry(ctx, sg, now, err)
+		}
+		sg.Grant.Status = types.GrantStatusACTIVE
+		evt = &gevent.GrantActivated{Grant: sg.Grant}
+	case types.GrantStatusACTIVE:
+		log.Infow("deactivating grant", "attempt", sg.Attempts+1)
+		err := callProvider(ctx, sg.Grant, providers.Accessor.Revoke)
+		if err != nil {
+			return r.retry(ctx, sg, now, err)
+		}
+		sg.Grant.Status = types.GrantStatusEXPIRED
+		evt = &gevent.GrantExpired{Grant: sg.Grant}
+	}
+
+	sg.Attempts = 0
+	sg.LastError = ""
+	sg.RetryAt = nil
+	err := r.store.put(sg)
+	if err != nil {
+		return err
+	}
+	r.emit(ctx, evt)
+	return nil
+}
+
+// retry schedules the failed action to be retried, or marks the grant as failed if
+// there are no attempts remaining.
+func (r *Runtime) retry(ctx context.Context, sg scheduledGrant, now time.Time, providerErr error) error {
+	sg.Attempts++
+	sg.LastError = providerErr.Error()
+	log := zap.S().With("grant.id", sg.Grant.ID, "attempts", sg.Attempts)
+
+	if sg.Attempts >= r.MaxAttempts {
+		log.Errorw("grant failed", zap.Error(providerErr))
+		sg.Grant.Status = types.GrantStatusERROR
+		sg.RetryAt = nil
+		err := r.store.put(sg)
+		if err != nil {
+			return err
+		}
+		r.emit(ctx, &gevent.GrantFailed{Grant: sg.Grant, Reason: providerErr.Error()})
+		return nil
+	}
+
+	backoff := r.InitialBackoff << (sg.Attempts - 1)
+	retryAt := now.Add(backoff)
+	sg.RetryAt = &retryAt
+	log.Infow("failed to provision grant, retrying", "backoff", backoff, zap.Error(providerErr))
+	return r.store.put(sg)
+}
+
+// callProvider calls the Grant or Revoke method of the grant's provider.
+// Panics are recovered so that a faulty provider doesn't stop the scheduler.
+func callProvider(ctx context.Context, grant types.Grant, fn func(p providers.Accessor, ctx context.Context, subject string, args []byte, grantID string) error) (err error) {
+	prov, ok := config.Providers[grant.Provider]
+	if !ok {
+		return &providers.ProviderNotFoundError{Provider: grant.Provider}
+	}
+	args, err := json.Marshal(grant.With)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if rec := recover(); rec != nil {
+			zap.S().Errorw("recovered panic while calling provider", "error", rec, "provider", prov)
+			err = fmt.Errorf("internal server error with provider: %s  version: %s", prov.Type, prov.Version)
+		}
+	}()
+	return fn(prov.Provider, providers.WithGrantEnd(ctx, grant.End.Time), string(grant.Subject), args, grant.ID)
+}
+
+// emit emits a grant event. Errors are logged rather than returned, as the grant
+// has already been updated by the time the event is emitted.
+func (r *Runtime) emit(ctx context.Context, evt gevent.EventTyper) {
+	err := r.put(ctx, evt)
+	if err != nil {
+		zap.S().Errorw("failed to emit grant event", "event", evt, zap.Error(err))
+	}
+}
+
+func (r *Runtime) put(ctx context.Context, evt gevent.EventTyper) error {
+	if r.EventPutter == nil {
+		zap.S().Infow("no event bus is configured, skipping grant event", "event", evt)
+		return nil
+	}
+	return r.EventPutter.Put(ctx, evt)
+}
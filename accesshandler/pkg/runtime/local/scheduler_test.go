@@ -0,0 +1,367 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/accesshandler/pkg/config"
+	"github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/iso8601"
+	"github.com/stretchr/testify/assert"
+)
+
+// testAccessor records the calls made to it, and returns errors for the first failures calls.
+type testAccessor struct {
+	failures int
+	calls    []string
+}
+
+func (a *testAccessor) Grant(ctx context.Context, subject string, args []byte, grantID string) error {
+	return a.call("grant " + grantID)
+}
+
+func (a *testAccessor) Revoke(ctx context.Context, subject string, args []byte, grantID string) error {
+	return a.call("revoke " + grantID)
+}
+
+func (a *testAccessor) call(c string) error {
+	a.calls = append(a.calls, c)
+	if a.failures > 0 {
+		a.failures--
+		return errors.New("provider unavailable")
+	}
+	return nil
+}
+
+type eventRecorder struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (e *eventRecorder) Put(ctx context.Context, evt gevent.EventTyper) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.events = append(e.events, evt.EventType())
+	return nil
+}
+
+// newTestRuntime returns a runtime which isn't running the scheduler in the background,
+// so that tests can call Run directly. The clock is set to 1st Jan 2022, 10:00am UTC.
+func newTestRuntime(t *testing.T, dir string, acc *testAccessor) (*Runtime, *clock.Mock, *eventRecorder) {
+	config.ConfigureTestProviders([]config.Provider{{ID: "test", Type: "test", Provider: acc}})
+	s, err := openStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clk := clock.NewMock()
+	clk.Set(time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC))
+	events := &eventRecorder{}
+	r := &Runtime{
+		StateDir:       dir,
+		MaxAttempts:    3,
+		InitialBackoff: time.Second,
+		EventPutter:    events,
+		Clock:          clk,
+		store:          s,
+	}
+	return r, clk, events
+}
+
+func createTestGrant(t *testing.T, r *Runtime, start, end time.Time) types.Grant {
+	return createTestGrantWithID(t, r, "abcd", start, end)
+}
+
+func createTestGrantWithID(t *testing.T, r *Runtime, id string, start, end time.Time) types.Grant {
+	ctx := context.Background()
+	g := types.CreateGrant{
+		Id:       id,
+		Provider: "test",
+		Subject:  "test@acme.com",
+		Start:    iso8601.New(start),
+		End:      iso8601.New(end),
+	}
+	vcg, err := g.Validate(ctx, r.Clock.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	grant, err := r.CreateGrant(ctx, *vcg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return grant
+}
+
+func grantStatus(t *testing.T, r *Runtime) types.GrantStatus {
+	sg, ok := r.store.get("abcd")
+	if !ok {
+		t.Fatal("grant not found")
+	}
+	return sg.Grant.Status
+}
+
+func TestRunActivatesAndDeactivates(t *testing.T) {
+	ctx := context.Background()
+	acc := &testAccessor{}
+	r, clk, events := newTestRuntime(t, t.TempDir(), acc)
+	now := clk.Now()
+	createTestGrant(t, r, now.Add(time.Minute), now.Add(time.Hour))
+
+	// the grant hasn't started yet
+	err := r.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(t, acc.calls)
+
+	clk.Add(time.Minute)
+	err = r.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, types.GrantStatusACTIVE, grantStatus(t, r))
+
+	clk.Add(time.Hour)
+	err = r.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, types.GrantStatusEXPIRED, grantStatus(t, r))
+
+	// there is nothing left to do once the grant has expired
+	err = r.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"grant abcd", "revoke abcd"}, acc.calls)
+	assert.Equal(t, []string{gevent.GrantCreatedType, gevent.GrantActivatedType, gevent.GrantExpiredType}, events.events)
+}
+
+func TestRunRetriesWithBackoff(t *testing.T) {
+	ctx := context.Background()
+	acc := &testAccessor{failures: 2}
+	r, clk, events := newTestRuntime(t, t.TempDir(), acc)
+	now := clk.Now()
+	createTestGrant(t, r, now, now.Add(time.Hour))
+
+	err := r.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sg, _ := r.store.get("abcd")
+	assert.Equal(t, 1, sg.Attempts)
+	assert.Equal(t, "provider unavailable", sg.LastError)
+	assert.Equal(t, now.Add(time.Second), *sg.RetryAt)
+
+	// the retry isn't due yet
+	err = r.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, acc.calls, 1)
+
+	clk.Add(time.Second)
+	err = r.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sg, _ = r.store.get("abcd")
+	assert.Equal(t, 2, sg.Attempts)
+	assert.Equal(t, clk.Now().Add(2*time.Second), *sg.RetryAt)
+
+	clk.Add(2 * time.Second)
+	err = r.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sg, _ = r.store.get("abcd")
+	assert.Equal(t, types.GrantStatusACTIVE, sg.Grant.Status)
+	assert.Equal(t, 0, sg.Attempts)
+	assert.Nil(t, sg.RetryAt)
+	assert.Equal(t, []string{gevent.GrantCreatedType, gevent.GrantActivatedType}, events.events)
+}
+
+func TestRunMarksGrantFailed(t *testing.T) {
+	ctx := context.Background()
+	acc := &testAccessor{failures: 10}
+	r, clk, events := newTestRuntime(t, t.TempDir(), acc)
+	now := clk.Now()
+	createTestGrant(t, r, now, now.Add(time.Hour))
+
+	for i := 0; i < 5; i++ {
+		err := r.Run(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		clk.Add(time.Minute)
+	}
+	assert.Len(t, acc.calls, 3)
+	assert.Equal(t, types.GrantStatusERROR, grantStatus(t, r))
+	assert.Equal(t, []string{gevent.GrantCreatedType, gevent.GrantFailedType}, events.events)
+}
+
+func TestRunRecoversGrantsFromDisk(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	acc := &testAccessor{}
+	r, clk, _ := newTestRuntime(t, dir, acc)
+	now := clk.Now()
+	createTestGrant(t, r, now, now.Add(time.Hour))
+	err := r.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// simulate a restart after the grant has ended
+	restarted, clk, events := newTestRuntime(t, dir, acc)
+	clk.Add(2 * time.Hour)
+	err = restarted.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, types.GrantStatusEXPIRED, grantStatus(t, restarted))
+	assert.Equal(t, []string{"grant abcd", "revoke abcd"}, acc.calls)
+	assert.Equal(t, []string{gevent.GrantExpiredType}, events.events)
+}
+
+func TestRunExpiresGrantsWhichEndedBeforeActivation(t *testing.T) {
+	ctx := context.Background()
+	acc := &testAccessor{}
+	r, clk, events := newTestRuntime(t, t.TempDir(), acc)
+	now := clk.Now()
+	createTestGrant(t, r, now.Add(time.Minute), now.Add(time.Hour))
+
+	clk.Add(2 * time.Hour)
+	err := r.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, types.GrantStatusEXPIRED, grantStatus(t, r))
+	assert.Empty(t, acc.calls)
+	assert.Equal(t, []string{gevent.GrantCreatedType, gevent.GrantExpiredType}, events.events)
+}
+
+func TestRunContinuesAfterSaveError(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	acc := &testAccessor{}
+	r, clk, _ := newTestRuntime(t, dir, acc)
+	now := clk.Now()
+	createTestGrantWithID(t, r, "a", now, now.Add(time.Hour))
+	createTestGrantWithID(t, r, "b", now, now.Add(time.Hour))
+
+	// replace the first grant's file with a directory, so that it can't be saved.
+	path := r.store.path("a")
+	err := os.Remove(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.MkdirAll(filepath.Join(path, "child"), 0700)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = r.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, _ := r.store.get("a")
+	assert.Equal(t, types.GrantStatusPENDING, a.Grant.Status)
+	b, _ := r.store.get("b")
+	assert.Equal(t, types.GrantStatusACTIVE, b.Grant.Status)
+	assert.Equal(t, []string{"grant a", "grant b"}, acc.calls)
+}
+
+func TestRunPrunesFinishedGrants(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	acc := &testAccessor{}
+	r, clk, _ := newTestRuntime(t, dir, acc)
+	r.Retention = 24 * time.Hour
+	now := clk.Now()
+	createTestGrant(t, r, now, now.Add(time.Hour))
+
+	clk.Add(2 * time.Hour)
+	err := r.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, types.GrantStatusEXPIRED, grantStatus(t, r))
+
+	// the grant is kept until the retention period has passed since it ended
+	clk.Add(22 * time.Hour)
+	err = r.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, ok := r.store.get("abcd")
+	assert.True(t, ok)
+
+	clk.Add(time.Hour + time.Minute)
+	err = r.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, ok = r.store.get("abcd")
+	assert.False(t, ok)
+	assert.NoFileExists(t, r.store.path("abcd"))
+	assert.Empty(t, r.locks)
+}
+
+// blockingAccessor blocks calls for the blocked grant until release is closed.
+type blockingAccessor struct {
+	blocked string
+	called  chan struct{}
+	release chan struct{}
+}
+
+func (a *blockingAccessor) Grant(ctx context.Context, subject string, args []byte, grantID string) error {
+	if grantID == a.blocked {
+		close(a.called)
+		<-a.release
+	}
+	return nil
+}
+
+func (a *blockingAccessor) Revoke(ctx context.Context, subject string, args []byte, grantID string) error {
+	return nil
+}
+
+func TestProviderCallDoesNotBlockOtherGrants(t *testing.T) {
+	ctx := context.Background()
+	r, clk, _ := newTestRuntime(t, t.TempDir(), &testAccessor{})
+	acc := &blockingAccessor{blocked: "a", called: make(chan struct{}), release: make(chan struct{})}
+	config.ConfigureTestProviders([]config.Provider{{ID: "test", Type: "test", Provider: acc}})
+	now := clk.Now()
+	createTestGrantWithID(t, r, "a", now, now.Add(time.Hour))
+	createTestGrantWithID(t, r, "b", now.Add(time.Minute), now.Add(time.Hour))
+
+	done := make(chan struct{})
+	go func() {
+		err := r.process(ctx, "a", now)
+		if err != nil {
+			t.Error(err)
+		}
+		close(done)
+	}()
+	<-acc.called
+
+	// grant b can be revoked while the provider is still granting access for grant a
+	_, err := r.RevokeGrant(ctx, "b", "admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	close(acc.release)
+	<-done
+
+	a, _ := r.store.get("a")
+	assert.Equal(t, types.GrantStatusACTIVE, a.Grant.Status)
+	b, _ := r.store.get("b")
+	assert.Equal(t, types.GrantStatusREVOKED, b.Grant.Status)
+}
@@ -0,0 +1,146 @@
+package local
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/common-fate/common-fate/accesshandler/pkg/types"
+)
+
+// scheduledGrant is a grant stored by the runtime, along with the state of the
+// next action to be taken on it.
+type scheduledGrant struct {
+	Grant types.Grant `json:"grant"`
+	// Attempts is the number of failed attempts made to take the next action on the grant.
+	Attempts  int    `json:"attempts,omitempty"`
+	LastError string `json:"lastError,omitempty"`
+	// RetryAt is when the next action will be retried after a failed attempt.
+	RetryAt *time.Time `json:"retryAt,omitempty"`
+}
+
+// dueAt returns when the next action should be taken on the grant.
+// It returns false if the grant has finished and there are no further actions.
+func (sg scheduledGrant) dueAt() (time.Time, bool) {
+	var due time.Time
+	switch sg.Grant.Status {
+	case types.GrantStatusPENDING:
+		due = sg.Grant.Start.Time
+	case types.GrantStatusACTIVE:
+		due = sg.Grant.End.Time
+	default:
+		return time.Time{}, false
+	}
+	if sg.RetryAt != nil {
+		due = *sg.RetryAt
+	}
+	return due, true
+}
+
+// store holds grants in memory, writing each grant to a JSON file in dir when it changes.
+// If dir is empty, grants are only held in memory.
+type store struct {
+	dir    string
+	mu     sync.Mutex
+	grants map[string]scheduledGrant
+}
+
+// openStore loads the grants which were previously written to dir.
+func openStore(dir string) (*store, error) {
+	s := store{dir: dir, grants: map[string]scheduledGrant{}}
+	if dir == "" {
+		return &s, nil
+	}
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, err
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		b, err := os.ReadFile(f)
+		if err != nil {
+			return nil, err
+		}
+		var sg scheduledGrant
+		err = json.Unmarshal(b, &sg)
+		if err != nil {
+			return nil, err
+		}
+		s.grants[sg.Grant.ID] = sg
+	}
+	return &s, nil
+}
+
+func (s *store) get(grantID string) (scheduledGrant, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sg, ok := s.grants[grantID]
+	return sg, ok
+}
+
+// list returns the stored grants, sorted by ID.
+func (s *store) list() []scheduledGrant {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := make([]scheduledGrant, 0, len(s.grants))
+	for _, sg := range s.grants {
+		res = append(res, sg)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Grant.ID < res[j].Grant.ID })
+	return res
+}
+
+// put stores the grant. The file is written to a temporary path and then renamed,
+// so that a crash while writing doesn't leave a partially written grant behind.
+func (s *store) put(sg scheduledGrant) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.dir != "" {
+		b, err := json.MarshalIndent(sg, "", "  ")
+		if err != nil {
+			return err
+		}
+		path := s.path(sg.Grant.ID)
+		tmp := path + ".tmp"
+		err = os.WriteFile(tmp, b, 0600)
+		if err != nil {
+			return err
+		}
+		err = os.Rename(tmp, path)
+		if err != nil {
+			return err
+		}
+	}
+	s.grants[sg.Grant.ID] = sg
+	return nil
+}
+
+// delete removes the grant, along with its file.
+func (s *store) delete(grantID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.dir != "" {
+		err := os.Remove(s.path(grantID))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+	delete(s.grants, grantID)
+	return nil
+}
+
+func (s *store) path(grantID string) string {
+	// grant IDs are provided by the caller, so they are escaped to prevent them from referring to other paths.
+	name := strings.ReplaceAll(url.PathEscape(grantID), ".", "%2E")
+	return filepath.Join(s.dir, name+".json")
+}
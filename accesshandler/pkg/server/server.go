@@ -22,6 +22,19 @@ type Server struct {
 }
 
 func New(ctx context.Context, c config.Config) (*Server, error) {
+	return newServer(ctx, c, func(dc deploy.DeployConfigReader) (*api.API, error) {
+		return api.New(ctx, c.Runtime, dc)
+	})
+}
+
+// NewWithRuntime creates a server which uses the provided runtime, rather than the runtime named in the config.
+func NewWithRuntime(ctx context.Context, c config.Config, rt api.Runtime) (*Server, error) {
+	return newServer(ctx, c, func(dc deploy.DeployConfigReader) (*api.API, error) {
+		return api.NewWithRuntime(ctx, rt, dc)
+	})
+}
+
+func newServer(ctx context.Context, c config.Config, newAPI func(dc deploy.DeployConfigReader) (*api.API, error)) (*Server, error) {
 	log, err := logger.Build(c.LogLevel)
 	if err != nil {
 		return nil, err
@@ -47,7 +60,7 @@ func New(ctx context.Context, c config.Config) (*Server, error) {
 	if err != nil {
 		return nil, err
 	}
-	api, err := api.New(ctx, c.Runtime, dc)
+	api, err := newAPI(dc)
 	if err != nil {
 		return nil, err
 	}
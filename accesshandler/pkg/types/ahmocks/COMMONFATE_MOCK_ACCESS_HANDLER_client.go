@@ -36,6 +36,46 @@ func (m *MockClientWithResponsesInterface) EXPECT() *MockClientWithResponsesInte
 	return m.recorder
 }
 
+// DeprovisionGrantWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) DeprovisionGrantWithBodyWithResponse(arg0 context.Context, arg1 string, arg2 io.Reader, arg3 ...types.RequestEditorFn) (*types.DeprovisionGrantResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeprovisionGrantWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*types.DeprovisionGrantResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeprovisionGrantWithBodyWithResponse indicates an expected call of DeprovisionGrantWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) DeprovisionGrantWithBodyWithResponse(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeprovisionGrantWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).DeprovisionGrantWithBodyWithResponse), varargs...)
+}
+
+// DeprovisionGrantWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) DeprovisionGrantWithResponse(arg0 context.Context, arg1 types.GrantAccess, arg2 ...types.RequestEditorFn) (*types.DeprovisionGrantResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeprovisionGrantWithResponse", varargs...)
+	ret0, _ := ret[0].(*types.DeprovisionGrantResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeprovisionGrantWithResponse indicates an expected call of DeprovisionGrantWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) DeprovisionGrantWithResponse(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeprovisionGrantWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).DeprovisionGrantWithResponse), varargs...)
+}
+
 // GetAccessInstructionsWithResponse mocks base method.
 func (m *MockClientWithResponsesInterface) GetAccessInstructionsWithResponse(arg0 context.Context, arg1 string, arg2 *types.GetAccessInstructionsParams, arg3 ...types.RequestEditorFn) (*types.GetAccessInstructionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -156,6 +196,26 @@ func (mr *MockClientWithResponsesInterfaceMockRecorder) ListProviderArgOptionsWi
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProviderArgOptionsWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ListProviderArgOptionsWithResponse), varargs...)
 }
 
+// ListProviderAssignmentsWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ListProviderAssignmentsWithResponse(arg0 context.Context, arg1 string, arg2 ...types.RequestEditorFn) (*types.ListProviderAssignmentsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProviderAssignmentsWithResponse", varargs...)
+	ret0, _ := ret[0].(*types.ListProviderAssignmentsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProviderAssignmentsWithResponse indicates an expected call of ListProviderAssignmentsWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ListProviderAssignmentsWithResponse(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProviderAssignmentsWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ListProviderAssignmentsWithResponse), varargs...)
+}
+
 // ListProvidersWithResponse mocks base method.
 func (m *MockClientWithResponsesInterface) ListProvidersWithResponse(arg0 context.Context, arg1 ...types.RequestEditorFn) (*types.ListProvidersResponse, error) {
 	m.ctrl.T.Helper()
@@ -176,6 +236,46 @@ func (mr *MockClientWithResponsesInterfaceMockRecorder) ListProvidersWithRespons
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProvidersWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ListProvidersWithResponse), varargs...)
 }
 
+// PostGrantsExtendWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) PostGrantsExtendWithBodyWithResponse(arg0 context.Context, arg1, arg2 string, arg3 io.Reader, arg4 ...types.RequestEditorFn) (*types.PostGrantsExtendResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PostGrantsExtendWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*types.PostGrantsExtendResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostGrantsExtendWithBodyWithResponse indicates an expected call of PostGrantsExtendWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) PostGrantsExtendWithBodyWithResponse(arg0, arg1, arg2, arg3 interface{}, arg4 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3}, arg4...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostGrantsExtendWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).PostGrantsExtendWithBodyWithResponse), varargs...)
+}
+
+// PostGrantsExtendWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) PostGrantsExtendWithResponse(arg0 context.Context, arg1 string, arg2 types.PostGrantsExtendJSONRequestBody, arg3 ...types.RequestEditorFn) (*types.PostGrantsExtendResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PostGrantsExtendWithResponse", varargs...)
+	ret0, _ := ret[0].(*types.PostGrantsExtendResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostGrantsExtendWithResponse indicates an expected call of PostGrantsExtendWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) PostGrantsExtendWithResponse(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostGrantsExtendWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).PostGrantsExtendWithResponse), varargs...)
+}
+
 // PostGrantsRevokeWithBodyWithResponse mocks base method.
 func (m *MockClientWithResponsesInterface) PostGrantsRevokeWithBodyWithResponse(arg0 context.Context, arg1, arg2 string, arg3 io.Reader, arg4 ...types.RequestEditorFn) (*types.PostGrantsRevokeResponse, error) {
 	m.ctrl.T.Helper()
@@ -355,3 +455,43 @@ func (mr *MockClientWithResponsesInterfaceMockRecorder) ValidateSetupWithRespons
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateSetupWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ValidateSetupWithResponse), varargs...)
 }
+
+// VerifyGrantWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) VerifyGrantWithBodyWithResponse(arg0 context.Context, arg1 string, arg2 io.Reader, arg3 ...types.RequestEditorFn) (*types.VerifyGrantResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "VerifyGrantWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*types.VerifyGrantResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyGrantWithBodyWithResponse indicates an expected call of VerifyGrantWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) VerifyGrantWithBodyWithResponse(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyGrantWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).VerifyGrantWithBodyWithResponse), varargs...)
+}
+
+// VerifyGrantWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) VerifyGrantWithResponse(arg0 context.Context, arg1 types.GrantAccess, arg2 ...types.RequestEditorFn) (*types.VerifyGrantResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "VerifyGrantWithResponse", varargs...)
+	ret0, _ := ret[0].(*types.VerifyGrantResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyGrantWithResponse indicates an expected call of VerifyGrantWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) VerifyGrantWithResponse(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyGrantWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).VerifyGrantWithResponse), varargs...)
+}
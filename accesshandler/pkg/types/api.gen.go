@@ -161,6 +161,26 @@ type Grant_With struct {
 	AdditionalProperties map[string]string `json:"-"`
 }
 
+// The access which is provisioned for a grant.
+type GrantAccess struct {
+	// The ID of the grant.
+	Id string `json:"id"`
+
+	// The ID of the provider.
+	Provider string `json:"provider"`
+
+	// The email address of the user the access is for.
+	Subject openapi_types.Email `json:"subject"`
+
+	// Provider-specific grant data.
+	With GrantAccess_With `json:"with"`
+}
+
+// Provider-specific grant data.
+type GrantAccess_With struct {
+	AdditionalProperties map[string]string `json:"-"`
+}
+
 // Group defines model for Group.
 type Group struct {
 	Description *string `json:"description,omitempty"`
@@ -209,6 +229,20 @@ type Provider struct {
 	Type string `json:"type"`
 }
 
+// Access which currently exists in a provider.
+type ProviderAssignment struct {
+	// The email address of the user who has the access.
+	Subject string `json:"subject"`
+
+	// The provider-specific arguments which grant the same access.
+	With ProviderAssignment_With `json:"with"`
+}
+
+// The provider-specific arguments which grant the same access.
+type ProviderAssignment_With struct {
+	AdditionalProperties map[string]string `json:"-"`
+}
+
 // A validation against the configuration values of the Access Provider.
 type ProviderConfigValidation struct {
 	// The particular config fields validated, if any.
@@ -252,11 +286,28 @@ type GrantResponse struct {
 	Grant Grant `json:"grant"`
 }
 
+// GrantVerificationResponse defines model for GrantVerificationResponse.
+type GrantVerificationResponse struct {
+	// True if the access is currently provisioned in the provider.
+	Active bool `json:"active"`
+
+	// False if the provider can't verify grants, in which case active is always false.
+	Supported bool `json:"supported"`
+}
+
 // HealthResponse defines model for HealthResponse.
 type HealthResponse struct {
 	Health *ProviderHealth `json:"health,omitempty"`
 }
 
+// ListAssignmentsResponse defines model for ListAssignmentsResponse.
+type ListAssignmentsResponse struct {
+	Assignments []ProviderAssignment `json:"assignments"`
+
+	// False if the provider can't list assignments, in which case assignments is always empty.
+	Supported bool `json:"supported"`
+}
+
 // ValidateResponse defines model for ValidateResponse.
 type ValidateResponse struct {
 	Validations []ProviderConfigValidation `json:"validations"`
@@ -274,9 +325,21 @@ type ValidateRequest struct {
 // PostGrantsJSONBody defines parameters for PostGrants.
 type PostGrantsJSONBody = CreateGrant
 
+// DeprovisionGrantJSONBody defines parameters for DeprovisionGrant.
+type DeprovisionGrantJSONBody = GrantAccess
+
 // ValidateGrantJSONBody defines parameters for ValidateGrant.
 type ValidateGrantJSONBody = CreateGrant
 
+// VerifyGrantJSONBody defines parameters for VerifyGrant.
+type VerifyGrantJSONBody = GrantAccess
+
+// PostGrantsExtendJSONBody defines parameters for PostGrantsExtend.
+type PostGrantsExtendJSONBody struct {
+	// The new end time of the grant in ISO8601 format.
+	End iso8601.Time `json:"end"`
+}
+
 // PostGrantsRevokeJSONBody defines parameters for PostGrantsRevoke.
 type PostGrantsRevokeJSONBody struct {
 	// An id representiing the user calling this API will be included in the GrantRevoked event
@@ -301,9 +364,18 @@ type GetAccessInstructionsParams struct {
 // PostGrantsJSONRequestBody defines body for PostGrants for application/json ContentType.
 type PostGrantsJSONRequestBody = PostGrantsJSONBody
 
+// DeprovisionGrantJSONRequestBody defines body for DeprovisionGrant for application/json ContentType.
+type DeprovisionGrantJSONRequestBody = DeprovisionGrantJSONBody
+
 // ValidateGrantJSONRequestBody defines body for ValidateGrant for application/json ContentType.
 type ValidateGrantJSONRequestBody = ValidateGrantJSONBody
 
+// VerifyGrantJSONRequestBody defines body for VerifyGrant for application/json ContentType.
+type VerifyGrantJSONRequestBody = VerifyGrantJSONBody
+
+// PostGrantsExtendJSONRequestBody defines body for PostGrantsExtend for application/json ContentType.
+type PostGrantsExtendJSONRequestBody PostGrantsExtendJSONBody
+
 // PostGrantsRevokeJSONRequestBody defines body for PostGrantsRevoke for application/json ContentType.
 type PostGrantsRevokeJSONRequestBody PostGrantsRevokeJSONBody
 
@@ -522,6 +594,59 @@ func (a Grant_With) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
+// Getter for additional properties for GrantAccess_With. Returns the specified
+// element and whether it was found
+func (a GrantAccess_With) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for GrantAccess_With
+func (a *GrantAccess_With) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for GrantAccess_With to handle AdditionalProperties
+func (a *GrantAccess_With) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for GrantAccess_With to handle AdditionalProperties
+func (a GrantAccess_With) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
 // Getter for additional properties for Groups. Returns the specified
 // element and whether it was found
 func (a Groups) Get(fieldName string) (value []GroupOption, found bool) {
@@ -575,6 +700,59 @@ func (a Groups) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
+// Getter for additional properties for ProviderAssignment_With. Returns the specified
+// element and whether it was found
+func (a ProviderAssignment_With) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for ProviderAssignment_With
+func (a *ProviderAssignment_With) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for ProviderAssignment_With to handle AdditionalProperties
+func (a *ProviderAssignment_With) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for ProviderAssignment_With to handle AdditionalProperties
+func (a ProviderAssignment_With) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -656,11 +834,26 @@ type ClientInterface interface {
 
 	PostGrants(ctx context.Context, body PostGrantsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeprovisionGrant request with any body
+	DeprovisionGrantWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DeprovisionGrant(ctx context.Context, body DeprovisionGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ValidateGrant request with any body
 	ValidateGrantWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	ValidateGrant(ctx context.Context, body ValidateGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// VerifyGrant request with any body
+	VerifyGrantWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	VerifyGrant(ctx context.Context, body VerifyGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostGrantsExtend request with any body
+	PostGrantsExtendWithBody(ctx context.Context, grantId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostGrantsExtend(ctx context.Context, grantId string, body PostGrantsExtendJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostGrantsRevoke request with any body
 	PostGrantsRevokeWithBody(ctx context.Context, grantId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -687,6 +880,9 @@ type ClientInterface interface {
 	// ListProviderArgOptions request
 	ListProviderArgOptions(ctx context.Context, providerId string, argId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListProviderAssignments request
+	ListProviderAssignments(ctx context.Context, providerId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ValidateSetup request with any body
 	ValidateSetupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -729,6 +925,30 @@ func (c *Client) PostGrants(ctx context.Context, body PostGrantsJSONRequestBody,
 	return c.Client.Do(req)
 }
 
+func (c *Client) DeprovisionGrantWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeprovisionGrantRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeprovisionGrant(ctx context.Context, body DeprovisionGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeprovisionGrantRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) ValidateGrantWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewValidateGrantRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -753,6 +973,54 @@ func (c *Client) ValidateGrant(ctx context.Context, body ValidateGrantJSONReques
 	return c.Client.Do(req)
 }
 
+func (c *Client) VerifyGrantWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyGrantRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VerifyGrant(ctx context.Context, body VerifyGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyGrantRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostGrantsExtendWithBody(ctx context.Context, grantId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostGrantsExtendRequestWithBody(c.Server, grantId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostGrantsExtend(ctx context.Context, grantId string, body PostGrantsExtendJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostGrantsExtendRequest(c.Server, grantId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) PostGrantsRevokeWithBody(ctx context.Context, grantId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewPostGrantsRevokeRequestWithBody(c.Server, grantId, contentType, body)
 	if err != nil {
@@ -861,6 +1129,18 @@ func (c *Client) ListProviderArgOptions(ctx context.Context, providerId string,
 	return c.Client.Do(req)
 }
 
+func (c *Client) ListProviderAssignments(ctx context.Context, providerId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListProviderAssignmentsRequest(c.Server, providerId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) ValidateSetupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewValidateSetupRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -952,6 +1232,46 @@ func NewPostGrantsRequestWithBody(server string, contentType string, body io.Rea
 	return req, nil
 }
 
+// NewDeprovisionGrantRequest calls the generic DeprovisionGrant builder with application/json body
+func NewDeprovisionGrantRequest(server string, body DeprovisionGrantJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeprovisionGrantRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewDeprovisionGrantRequestWithBody generates requests for DeprovisionGrant with any type of body
+func NewDeprovisionGrantRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/grants/deprovision")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewValidateGrantRequest calls the generic ValidateGrant builder with application/json body
 func NewValidateGrantRequest(server string, body ValidateGrantJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -992,6 +1312,93 @@ func NewValidateGrantRequestWithBody(server string, contentType string, body io.
 	return req, nil
 }
 
+// NewVerifyGrantRequest calls the generic VerifyGrant builder with application/json body
+func NewVerifyGrantRequest(server string, body VerifyGrantJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewVerifyGrantRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewVerifyGrantRequestWithBody generates requests for VerifyGrant with any type of body
+func NewVerifyGrantRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/grants/verify")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostGrantsExtendRequest calls the generic PostGrantsExtend builder with application/json body
+func NewPostGrantsExtendRequest(server string, grantId string, body PostGrantsExtendJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostGrantsExtendRequestWithBody(server, grantId, "application/json", bodyReader)
+}
+
+// NewPostGrantsExtendRequestWithBody generates requests for PostGrantsExtend with any type of body
+func NewPostGrantsExtendRequestWithBody(server string, grantId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "grantId", runtime.ParamLocationPath, grantId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/grants/%s/extend", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewPostGrantsRevokeRequest calls the generic PostGrantsRevoke builder with application/json body
 func NewPostGrantsRevokeRequest(server string, grantId string, body PostGrantsRevokeJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -1315,6 +1722,40 @@ func NewListProviderArgOptionsRequest(server string, providerId string, argId st
 	return req, nil
 }
 
+// NewListProviderAssignmentsRequest generates requests for ListProviderAssignments
+func NewListProviderAssignmentsRequest(server string, providerId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "providerId", runtime.ParamLocationPath, providerId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/providers/%s/assignments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewValidateSetupRequest calls the generic ValidateSetup builder with application/json body
 func NewValidateSetupRequest(server string, body ValidateSetupJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -1406,11 +1847,26 @@ type ClientWithResponsesInterface interface {
 
 	PostGrantsWithResponse(ctx context.Context, body PostGrantsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostGrantsResponse, error)
 
+	// DeprovisionGrant request with any body
+	DeprovisionGrantWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeprovisionGrantResponse, error)
+
+	DeprovisionGrantWithResponse(ctx context.Context, body DeprovisionGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*DeprovisionGrantResponse, error)
+
 	// ValidateGrant request with any body
 	ValidateGrantWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateGrantResponse, error)
 
 	ValidateGrantWithResponse(ctx context.Context, body ValidateGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateGrantResponse, error)
 
+	// VerifyGrant request with any body
+	VerifyGrantWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyGrantResponse, error)
+
+	VerifyGrantWithResponse(ctx context.Context, body VerifyGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyGrantResponse, error)
+
+	// PostGrantsExtend request with any body
+	PostGrantsExtendWithBodyWithResponse(ctx context.Context, grantId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostGrantsExtendResponse, error)
+
+	PostGrantsExtendWithResponse(ctx context.Context, grantId string, body PostGrantsExtendJSONRequestBody, reqEditors ...RequestEditorFn) (*PostGrantsExtendResponse, error)
+
 	// PostGrantsRevoke request with any body
 	PostGrantsRevokeWithBodyWithResponse(ctx context.Context, grantId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostGrantsRevokeResponse, error)
 
@@ -1437,6 +1893,9 @@ type ClientWithResponsesInterface interface {
 	// ListProviderArgOptions request
 	ListProviderArgOptionsWithResponse(ctx context.Context, providerId string, argId string, reqEditors ...RequestEditorFn) (*ListProviderArgOptionsResponse, error)
 
+	// ListProviderAssignments request
+	ListProviderAssignmentsWithResponse(ctx context.Context, providerId string, reqEditors ...RequestEditorFn) (*ListProviderAssignmentsResponse, error)
+
 	// ValidateSetup request with any body
 	ValidateSetupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateSetupResponse, error)
 
@@ -1501,6 +1960,33 @@ func (r PostGrantsResponse) StatusCode() int {
 	return 0
 }
 
+type DeprovisionGrantResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *struct {
+		Error *string `json:"error,omitempty"`
+	}
+	JSON500 *struct {
+		Error *string `json:"error,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r DeprovisionGrantResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeprovisionGrantResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type ValidateGrantResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -1528,6 +2014,74 @@ func (r ValidateGrantResponse) StatusCode() int {
 	return 0
 }
 
+type VerifyGrantResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// True if the access is currently provisioned in the provider.
+		Active bool `json:"active"`
+
+		// False if the provider can't verify grants, in which case active is always false.
+		Supported bool `json:"supported"`
+	}
+	JSON400 *struct {
+		Error *string `json:"error,omitempty"`
+	}
+	JSON500 *struct {
+		Error *string `json:"error,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r VerifyGrantResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VerifyGrantResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostGrantsExtendResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// A temporary assignment of a user to a principal.
+		Grant Grant `json:"grant"`
+	}
+	JSON400 *struct {
+		Error *string `json:"error,omitempty"`
+	}
+	JSON404 *struct {
+		Error *string `json:"error,omitempty"`
+	}
+	JSON500 *struct {
+		Error *string `json:"error,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PostGrantsExtendResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostGrantsExtendResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type PostGrantsRevokeResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -1745,6 +2299,39 @@ func (r ListProviderArgOptionsResponse) StatusCode() int {
 	return 0
 }
 
+type ListProviderAssignmentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Assignments []ProviderAssignment `json:"assignments"`
+
+		// False if the provider can't list assignments, in which case assignments is always empty.
+		Supported bool `json:"supported"`
+	}
+	JSON404 *struct {
+		Error *string `json:"error,omitempty"`
+	}
+	JSON500 *struct {
+		Error *string `json:"error,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r ListProviderAssignmentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListProviderAssignmentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type ValidateSetupResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -1798,6 +2385,23 @@ func (c *ClientWithResponses) PostGrantsWithResponse(ctx context.Context, body P
 	return ParsePostGrantsResponse(rsp)
 }
 
+// DeprovisionGrantWithBodyWithResponse request with arbitrary body returning *DeprovisionGrantResponse
+func (c *ClientWithResponses) DeprovisionGrantWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeprovisionGrantResponse, error) {
+	rsp, err := c.DeprovisionGrantWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeprovisionGrantResponse(rsp)
+}
+
+func (c *ClientWithResponses) DeprovisionGrantWithResponse(ctx context.Context, body DeprovisionGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*DeprovisionGrantResponse, error) {
+	rsp, err := c.DeprovisionGrant(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeprovisionGrantResponse(rsp)
+}
+
 // ValidateGrantWithBodyWithResponse request with arbitrary body returning *ValidateGrantResponse
 func (c *ClientWithResponses) ValidateGrantWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateGrantResponse, error) {
 	rsp, err := c.ValidateGrantWithBody(ctx, contentType, body, reqEditors...)
@@ -1815,6 +2419,40 @@ func (c *ClientWithResponses) ValidateGrantWithResponse(ctx context.Context, bod
 	return ParseValidateGrantResponse(rsp)
 }
 
+// VerifyGrantWithBodyWithResponse request with arbitrary body returning *VerifyGrantResponse
+func (c *ClientWithResponses) VerifyGrantWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyGrantResponse, error) {
+	rsp, err := c.VerifyGrantWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyGrantResponse(rsp)
+}
+
+func (c *ClientWithResponses) VerifyGrantWithResponse(ctx context.Context, body VerifyGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyGrantResponse, error) {
+	rsp, err := c.VerifyGrant(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyGrantResponse(rsp)
+}
+
+// PostGrantsExtendWithBodyWithResponse request with arbitrary body returning *PostGrantsExtendResponse
+func (c *ClientWithResponses) PostGrantsExtendWithBodyWithResponse(ctx context.Context, grantId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostGrantsExtendResponse, error) {
+	rsp, err := c.PostGrantsExtendWithBody(ctx, grantId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostGrantsExtendResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostGrantsExtendWithResponse(ctx context.Context, grantId string, body PostGrantsExtendJSONRequestBody, reqEditors ...RequestEditorFn) (*PostGrantsExtendResponse, error) {
+	rsp, err := c.PostGrantsExtend(ctx, grantId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostGrantsExtendResponse(rsp)
+}
+
 // PostGrantsRevokeWithBodyWithResponse request with arbitrary body returning *PostGrantsRevokeResponse
 func (c *ClientWithResponses) PostGrantsRevokeWithBodyWithResponse(ctx context.Context, grantId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostGrantsRevokeResponse, error) {
 	rsp, err := c.PostGrantsRevokeWithBody(ctx, grantId, contentType, body, reqEditors...)
@@ -1895,6 +2533,15 @@ func (c *ClientWithResponses) ListProviderArgOptionsWithResponse(ctx context.Con
 	return ParseListProviderArgOptionsResponse(rsp)
 }
 
+// ListProviderAssignmentsWithResponse request returning *ListProviderAssignmentsResponse
+func (c *ClientWithResponses) ListProviderAssignmentsWithResponse(ctx context.Context, providerId string, reqEditors ...RequestEditorFn) (*ListProviderAssignmentsResponse, error) {
+	rsp, err := c.ListProviderAssignments(ctx, providerId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListProviderAssignmentsResponse(rsp)
+}
+
 // ValidateSetupWithBodyWithResponse request with arbitrary body returning *ValidateSetupResponse
 func (c *ClientWithResponses) ValidateSetupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateSetupResponse, error) {
 	rsp, err := c.ValidateSetupWithBody(ctx, contentType, body, reqEditors...)
@@ -1996,6 +2643,43 @@ func ParsePostGrantsResponse(rsp *http.Response) (*PostGrantsResponse, error) {
 	return response, nil
 }
 
+// ParseDeprovisionGrantResponse parses an HTTP response from a DeprovisionGrantWithResponse call
+func ParseDeprovisionGrantResponse(rsp *http.Response) (*DeprovisionGrantResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeprovisionGrantResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error *string `json:"error,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error *string `json:"error,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseValidateGrantResponse parses an HTTP response from a ValidateGrantWithResponse call
 func ParseValidateGrantResponse(rsp *http.Response) (*ValidateGrantResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
@@ -2033,6 +2717,112 @@ func ParseValidateGrantResponse(rsp *http.Response) (*ValidateGrantResponse, err
 	return response, nil
 }
 
+// ParseVerifyGrantResponse parses an HTTP response from a VerifyGrantWithResponse call
+func ParseVerifyGrantResponse(rsp *http.Response) (*VerifyGrantResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VerifyGrantResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// True if the access is currently provisioned in the provider.
+			Active bool `json:"active"`
+
+			// False if the provider can't verify grants, in which case active is always false.
+			Supported bool `json:"supported"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error *string `json:"error,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error *string `json:"error,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostGrantsExtendResponse parses an HTTP response from a PostGrantsExtendWithResponse call
+func ParsePostGrantsExtendResponse(rsp *http.Response) (*PostGrantsExtendResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostGrantsExtendResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// A temporary assignment of a user to a principal.
+			Grant Grant `json:"grant"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error *string `json:"error,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error *string `json:"error,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error *string `json:"error,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParsePostGrantsRevokeResponse parses an HTTP response from a PostGrantsRevokeWithResponse call
 func ParsePostGrantsRevokeResponse(rsp *http.Response) (*PostGrantsRevokeResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
@@ -2354,6 +3144,55 @@ func ParseListProviderArgOptionsResponse(rsp *http.Response) (*ListProviderArgOp
 	return response, nil
 }
 
+// ParseListProviderAssignmentsResponse parses an HTTP response from a ListProviderAssignmentsWithResponse call
+func ParseListProviderAssignmentsResponse(rsp *http.Response) (*ListProviderAssignmentsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListProviderAssignmentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Assignments []ProviderAssignment `json:"assignments"`
+
+			// False if the provider can't list assignments, in which case assignments is always empty.
+			Supported bool `json:"supported"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error *string `json:"error,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error *string `json:"error,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseValidateSetupResponse parses an HTTP response from a ValidateSetupWithResponse call
 func ParseValidateSetupResponse(rsp *http.Response) (*ValidateSetupResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
@@ -2399,9 +3238,18 @@ type ServerInterface interface {
 	// Create Grant
 	// (POST /api/v1/grants)
 	PostGrants(w http.ResponseWriter, r *http.Request)
+	// Deprovision Grant
+	// (POST /api/v1/grants/deprovision)
+	DeprovisionGrant(w http.ResponseWriter, r *http.Request)
 	// ValidateGrant
 	// (POST /api/v1/grants/validate)
 	ValidateGrant(w http.ResponseWriter, r *http.Request)
+	// Verify Grant
+	// (POST /api/v1/grants/verify)
+	VerifyGrant(w http.ResponseWriter, r *http.Request)
+	// Extend grant
+	// (POST /api/v1/grants/{grantId}/extend)
+	PostGrantsExtend(w http.ResponseWriter, r *http.Request, grantId string)
 	// Revoke grant
 	// (POST /api/v1/grants/{grantId}/revoke)
 	PostGrantsRevoke(w http.ResponseWriter, r *http.Request, grantId string)
@@ -2426,6 +3274,9 @@ type ServerInterface interface {
 	// List provider arg options
 	// (GET /api/v1/providers/{providerId}/args/{argId}/options)
 	ListProviderArgOptions(w http.ResponseWriter, r *http.Request, providerId string, argId string)
+	// List provider assignments
+	// (GET /api/v1/providers/{providerId}/assignments)
+	ListProviderAssignments(w http.ResponseWriter, r *http.Request, providerId string)
 	// Validate an Access Provider's settings
 	// (POST /api/v1/setup/validate)
 	ValidateSetup(w http.ResponseWriter, r *http.Request)
@@ -2470,6 +3321,21 @@ func (siw *ServerInterfaceWrapper) PostGrants(w http.ResponseWriter, r *http.Req
 	handler(w, r.WithContext(ctx))
 }
 
+// DeprovisionGrant operation middleware
+func (siw *ServerInterfaceWrapper) DeprovisionGrant(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeprovisionGrant(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // ValidateGrant operation middleware
 func (siw *ServerInterfaceWrapper) ValidateGrant(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -2485,6 +3351,47 @@ func (siw *ServerInterfaceWrapper) ValidateGrant(w http.ResponseWriter, r *http.
 	handler(w, r.WithContext(ctx))
 }
 
+// VerifyGrant operation middleware
+func (siw *ServerInterfaceWrapper) VerifyGrant(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerifyGrant(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostGrantsExtend operation middleware
+func (siw *ServerInterfaceWrapper) PostGrantsExtend(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "grantId" -------------
+	var grantId string
+
+	err = runtime.BindStyledParameter("simple", false, "grantId", chi.URLParam(r, "grantId"), &grantId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "grantId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostGrantsExtend(w, r, grantId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // PostGrantsRevoke operation middleware
 func (siw *ServerInterfaceWrapper) PostGrantsRevoke(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -2728,6 +3635,32 @@ func (siw *ServerInterfaceWrapper) ListProviderArgOptions(w http.ResponseWriter,
 	handler(w, r.WithContext(ctx))
 }
 
+// ListProviderAssignments operation middleware
+func (siw *ServerInterfaceWrapper) ListProviderAssignments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "providerId" -------------
+	var providerId string
+
+	err = runtime.BindStyledParameter("simple", false, "providerId", chi.URLParam(r, "providerId"), &providerId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "providerId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListProviderAssignments(w, r, providerId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // ValidateSetup operation middleware
 func (siw *ServerInterfaceWrapper) ValidateSetup(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -2862,9 +3795,18 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/grants", wrapper.PostGrants)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/grants/deprovision", wrapper.DeprovisionGrant)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/grants/validate", wrapper.ValidateGrant)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/grants/verify", wrapper.VerifyGrant)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/grants/{grantId}/extend", wrapper.PostGrantsExtend)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/grants/{grantId}/revoke", wrapper.PostGrantsRevoke)
 	})
@@ -2889,6 +3831,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/providers/{providerId}/args/{argId}/options", wrapper.ListProviderArgOptions)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/providers/{providerId}/assignments", wrapper.ListProviderAssignments)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/setup/validate", wrapper.ValidateSetup)
 	})
@@ -2899,59 +3844,68 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xbeW8buRX/KgRbIC0wOnzEjfXXurGTVZO1DdlJiu4GG2pIjRhT5JjkWFEMffeCx9wc",
-	"S5YTZBfY/BN5hsfje793c+5hLBap4IRrBUf3UJLbjCj9b4EpsQ/eI0Yx0mTiXphHseCacPsTpSmjMdJU",
-	"8MFnJbh5puI5WSDzK5UiJVL7lTLl/sdExZKmZg4cwes5AbOMMaBXKQGYzCin5hUQM6DnBKRS3FFMJIwg",
-	"+YIWKSNwZGheCD5DmgzQUvWUEjCCZgE4gkpLyhO4juCS6rklEmO7JGKXNYJaE9qU5bs/UyAWfEaTTNrD",
-	"9sv9xPQziTWM4JdeInr+4QKlv7p1P+bLryPLXCoJhqNfHTc8jR+bi63NPzNepYJ7tp3I5MKSpib+8aNk",
-	"8XdJZnAE/zYo5T1wb9WgXBrafet88K/ATEiAOHgtEdcAySRbEK77hm9nUgq5E1V1hBCzTkAy6wB/mlSe",
-	"cGCnA0l0JjnBYCbFwkLoJI6JUuBnxDEj0lJsD/ENKE7MOpvYazdrAcBN/bjN0YCiPGHEsd7S/zNBTM+/",
-	"wQHmdqFNJ7j0auC23U4gbmw8J/ENyIEMpgKv7AFKs/LkI9y5pSx8R/eQarJQ2x7opVXq98UKsDwZkhKt",
-	"WlKrbraN7MqljUFDDxgUM9eTZ9XdgnbMlZZZXByuvnj1LRAczMUSaAGQw7uBvjfnBBsRiEzGpP8b/40b",
-	"0/aJVmZ/AjNKGAZLyhiYEsCNRaYzwAWoDgNIEoDuEGVoyogxgnVR0KeSKxgBVoc9sW2zbgREtfUCARaF",
-	"rLLSImU0mVtgUQxH8PlRcnS7XA5xOr37YpesWMCAjossVZuV3I5aR1CkHec3XFdZkjiB+GHmiFtB1tG3",
-	"EaD57h8rfCoPtx1/bm7xZ5HKY7G8ZTLnz1Whfl3+dIOLsf4Crmt0+UW3I+vgZpnt06NjOZRpkpPlVm0J",
-	"rcb5gKsvhbrLaaywYdsKRpbQwHZeD18JuThjJCc55GcRM452AYgbZr1uRZPty8ipps6tCY5M4IQyppVR",
-	"qEXGNFWEOU4Sni0MMK7O3p69vK4YrSp1Tt3O0YKEyc8YadCeLzs+v3x3DSP4y7u312O/R/TQZl76ISdf",
-	"xTHFMB/b3r8ObgeB7TD04usR/Tp7fvivVRY7R/ZSEqTJ69yRN12vddOGq1MCYjsUt+0e4Tis7oRjoOmC",
-	"5NGsW41yML66eHE03LPiRLpfi273h/v7veFRb+/gem9vdHA8Ohj2j/f3/gcj6IbDETS+s2dWblnIeiBK",
-	"lTD79K/N0AKfrdCJYmuJlaIJN7/0nCrAydIRHAqui7g8eO7xaTN+N6u60+f2XtRPLW60MQQLyt8SnpiY",
-	"ZC+wrdJI6g7Tal49idvDg2/MbZU5LIaxsUCUAYSxNOzwJGeqk1UFNXbiZlY9Mf3JQ6SeSklMZzT2NGGk",
-	"UR/8kikNFkjH85qUnyngbGQ7QWoqeM6bCpQ8zbmUI6tXFrMVha/qa0jnvWTtES8MpnL9fEivSjTnOPRA",
-	"ewgehXRNMid/8hv3Y7GAJfetozFKhhfU5Vd5+hGyNposUiGRXHldtC7ABo45MEwISXlMU8T+/Hao3AtN",
-	"0RDHU9Qbohdx7/Dg+KCH8PF+7+j4+d7wYP9oun+Murbg1m3B8elfdmlbu6SRzjoi1DiT0qDOjKlT3K9E",
-	"E5dn56fj89cwgicvr8fvz2AEJ2fvL96cncIInv33cjxxvyaTi0kwDPjLNHabRhv7eBlFWxvKio38xtbR",
-	"Rk5bauhultTjsQKrpxhX+/CxGUFH5P74cLUmB0PKdpHp7c3s9vNx+uLrcnXEYHGQi4Li+nHiOWVYEl4r",
-	"ebSpr6WK0UYmMDQlrPvNpSQz+iXkuOxrkNr3ADEmlgqU2gVsaedLHke7YQS7SJP4ycs54QBTlTK0ItiY",
-	"R2QNYyj6vEMs20Iq7jj58KhkWlNGnsvbSQoffllm6cHB8LNMDktJPZhNbpXiV0kJCK9G8ba5PE3iFRmy",
-	"dJbeYpfwvBVJUIIiAYRruWqHFYzcOVS0LbWZZV9XncP4/NUFjOCHk8m5U+ZuN7BQSffCC6IUSjqqQDU5",
-	"WwLdahXRmpNux6U9vjiQ5O74lnw9ntrlu/Rudw3aCbOV03RgdB0V/qjNycvSYzQqdfgBe7GdsTNDKuRV",
-	"tuoksFVuDeCwrLAClCDKlbY2olYtBZY5RWTgC/z5Lm0A2+KmymvOHTFyiqSmccaQ9Ju5mqjKKSLY1l0Q",
-	"X9VqdhttLsWbYtHyyBFQWTwHSIFPjCrdU0r0jP9Tn/ohM8hEsr11MdoQII93FX0eChLduzb9dSvw++Xk",
-	"4vXk7OoKRvDq3cuX7lfp47vMQghulsxKVNQUqWdGAJAt0O1qEhpdkO72VRPSxd93xDepvGWzgKplI1Tx",
-	"Zxq4rsyqnhCcXI5/v754c3YOFIkl0WCOlK0CTgnhRSnQsCpjtj4PR1pmJAAbv3yb1A9zoudENkmq0uMX",
-	"mwrBCOIP4btYYHwazKg25XIhFOSUB8TspbIh6HUhIuUzkXeckEtC/MYvbWcZvELaoC2TDI7gXOtUjQaD",
-	"suvcp6KdFVSmNlqO4ORyDJt9i/ylMfVEKrfGXn/oWgiEo5TCETzoD/tDY8+QnluQDVBKB3d7A5txuP4E",
-	"CWRRb6nSLiuxHQYDUwv+sYH2a6Jfu+mNHvP+cPjUZuhjoh3k+wHNfsbGrtrFGzPvuaM2tEdxqkG9N22b",
-	"bNligeQqZ1LBCY2MMf01P8bHdQRToQK8dRUogHxWnDfUisazSwbHp66bhklKOAaCB3rRzxSQGTcJfB98",
-	"MOGvzDinPDGjTz5cgbdoMcUImHQHXGmSglcZd52uyJVox6dGPc3ClN8JJ6eKY6nPAUshb2ZMLM02bVRc",
-	"ClWFRX4PZLUFIppZJahGJEXcs12eKcnt73v7B4fPj55el4vnkqqf6nq7IXvc7sZEtQgZwOd1UeRpNA7W",
-	"LYXb2wzh+mWFdQQPdwD+N1AXj/uiuNDUl3XUsE6DPHKytiKoS+8RMwMMhpHOVcopjsrimBBs0S8yb82M",
-	"chhku/JPC8N5PJDT+DgY7yT7ddiGhgzWD5JbnSkBMd3b/8d4PZDkTtw4cSGJFkQTaWR834nv8anRW/PM",
-	"eKg8ThtBvyKs+nAXjZRMbkZ9H7sM7sRSBRAHKLZBVFGO7LJgbsYTAFD3bI4tctzZPJMklUQRrmkOUFut",
-	"jBFj7gFVJggo7ldQHrMMuyqHGe1V3OyCAbkjoZ5bIx4qaQpeHwuj8s9haLy8ky0MTXl/KRgGTaxPVsAE",
-	"fHLh7+E4lruZuaf0bhggjovAVfXB2OZ7fqy9y6TADFHmW/L+VlMsMClE+3w4BP8Yc00kRwxcEXlHJLCn",
-	"/WcwEivi1sfLq3ELbFvWN6fVeF+5tlVhvWdPnfcFnx6OQsthzdOb15eVt0+KRR917ysQdn7XILPkQZCB",
-	"A0lmkqh5t5+cECYQtmCMUTwnuFn0aN9PrfN64nZws/7YTG/YAkt387hdjLzPf47xuhOWr4muXMUD0xWg",
-	"OKiblZrWk9i0HXe6IHg4PPwRVthwKa2IrhERBJx+yfvH+f2Nkhy4cK/XvF/YLV2HltrdRXt/uTiRTdty",
-	"9/Dz9fUl2B8OwcUbl2wh8IlnjOXXIs3Uxn3JZuEGC2JLN/5BiIIgxIKXGB8MvvLo4pmq90nzQOw2I3JV",
-	"CqVsH24vkSi0Z37lG6RoZY0R5eA/VxfnvnvdsT2SiXra3mU6m/fNa7wKbbpL9BmFgtx3k7f55tXyDkZq",
-	"PhVI4o79Z9JaBvxOssdGwN/NzgSA9oDF2SXu28VOtSyOt/N1On+w8ZGJ2hhhGohYbXBXWoELxX3pd1qk",
-	"rV6HlL/XSVVpkB5yQCe5Fn0vcBRXcf/IXsiwz1+i+CNgYnCPZGL+qNz47g6DjfhFGnJFlY9pHoqQa9e4",
-	"H58sBL4f+nFSrQXFVqw5D7+nXKPgYlaIO+NDEZ2l2xW6qKt0KaI15UkrIAGngriGjqekdU9tanLOhCpN",
-	"JMGgBxBjjb6osSdIKYLBHUW1e+P2y5tqzINs1HM4HJZ5bDOmiRF3/aVqC9RQ7eu9+QTfLqXKXtrggHI7",
-	"vnKtLVyluzK8axdpwrCqfJc4aH6UuFO1o/UJ0o6OL1hrM2xopCzPStE7K6tsbcCBvOw0jQYDJmLE5kLp",
-	"0fHweB+asMAn4ve1CMdoS/EkT9HXH9f/DwAA//+8wYgx1TkAAA==",
+	"H4sIAAAAAAAC/+xce2/buJb/KoR2ge4Ciu08mp34r5tN0o53MkmQpJ3F3ltMafFYZiuRCknZcQt/9wVJ",
+	"vUXZipPe5gLzVxxLIg/P+Z33kb97AY8TzoAp6Y2/ewIeUpDqvzmhYL74iCNKsIJbe0F/FXCmgJmPOEki",
+	"GmBFORt+kZzp72QwhxjrT4ngCQiVrZRK+5eADARN9DPe2LufA5qlUYTUKgFEYEYZ1ZcQnyE1B5QIvqAE",
+	"hOd78IjjJAJvrGmOOZthBUO8lHtScs/39ALe2JNKUBZ6a99bUjU3RBJilsTRTY2g1gNtyvLd30gUcDaj",
+	"YSrMYQflfnz6BQLl+d7jXsj3si9jnPzdrvspX37tG+ZSAcQb/91yI6PxU3Ox9Xpt75cJZxnbTkV4bUiT",
+	"t9nXT5LFvwuYeWPv34alvIf2qhyWS3vrdYsP2SU04wJhht4LzBTCIkxjYGqg+XYhBBc7UVVHCOh1HJJZ",
+	"O/jTpPKUIfM4EqBSwYCgmeCxgdBpEICU6FfMSATCUGwO8QIUh3qdbew1m7UAYB/91OdoSFIWRmBZX9L/",
+	"EQSdZZS+wFlwoOgCHBoqUkDUaiO2rKQSBakQwFS0sjoiKdc8p6ymtBU1mXIeAWaaeJkmCRcKSHuvdziS",
+	"xWb5KijA7I1CC33cFTJ8k77eajmnwRwFWAKyxGvCcLTEK4lmeiXX/g0xlMT4OQf6yOSPOag5iCpTjH5Y",
+	"8jQdG9my9r1fAUdq/gJym5uFtoHwJtvbbttPp+y9wRyCryi3RWjKycoc4JJKdSolDZk2BPIlEFiupv+l",
+	"CmLZ91wlJV55NCwEXj0DchGVClWoaqGuvFSBHsSJWj0VepWj98HffYm7jJ5CH+GRSk0PQ7iOuNKVP1tS",
+	"C7uUcRlPldSZcaQfixXa8mrwqbpZH96US+sgAm9w4vrZjDzjYg1DJ0wqkQbF4eqLV68iztCcL5HiuSw0",
+	"hrIQCojWGZ6KAAb/YP9gWmKfaeXpz2hGISJoSaMITQExHQXRGWIcVW9DWADCC0wjPI2MRauLgj6XXB4B",
+	"4qIkth1KaQFRZSIvB4tckZBUPIloODfAosQbe2+Pw+OH5XJEkuni0SxZiTocfpWnidzuWM1da9/jScf5",
+	"NddlGoZWINlt+oi9IGvp2wpQnpTgzPlUHq4ff74+kC88ESd8+RCJnD93hfp1xbBbwrq0MIglXdmi/cg6",
+	"/LpMD+jxiRiJJMzJsqu2hFbjvCO8LoW6y2mMsL222/INoY7tMj18x0V8EUFOsiu2xZF23jECe5v+p6rJ",
+	"5qJvVVPl1oT4OlnBaaSkVqg4jRSVEFlOAktjDYy7i8uLs/uK0apSZ9XtCsfgJj+NoEF7vuzk6ubDved7",
+	"v3+4vJ9ke/ibNsuk7wqsqzimxMvvbe9fB7eFQD8M/fLtmH6bvT36r1Ua2MjjTABW8F5gl0xOsxhKcW0V",
+	"A3Mrads9YMSt7sAIUjSGPIO0q1GGJnfXvxyP9o04sRrUMsqD0cHB3uh4b//wfn9/fHgyPhwNTg72/8/z",
+	"PXu7N/a079zTK7csZD35o5LrfQb3+tYCn610hRJjiY3j15/UnErEYGkJdiW0RS7sPPfkvJkz61Xt6XN7",
+	"z+un5l8V9nwvpuwSWKiDyH3HtlJhodx7mkvP4vbo8IW5LVOLRTc2YkwjhAkRmh0ZyansZFVBjXlwO6ue",
+	"WXLIQ6Q9mUCgc7uMJoIVHqDfU6lQjFUwr0n5jUTWRraLEu2IM9fTSlnF0JxL2Td6ZTBbUfiqvrp0PpOs",
+	"OeK1xlSun5v0qkRzjsMMaJvgUUjX01L7W7bxIOCxV3LfOBqtZCSmtqaRp8wua6MgTrjAYlUJ523gmAND",
+	"h5CUBTTB0b++HSr3wlM8IsEU743wL8He0eHJ4R4mJwd7xydv90eHB8fTgxPctQUzbsubnP9ll/raJYVV",
+	"2hGhZsmbJlvVKR5Uoombi6vzydV7z/dOz+4nHy8837u9+Hj928W553sX/3szubWfbm+vb51hwF+msds0",
+	"mtgnk5Hf21BWbOQLW0dK+mvobpY0w2MFVs80rjY3dKOrVqtoVMcqhTNHeku2WZXiwWfHSzsZoR11qlZM",
+	"nXHx2lSqp8J06klTMzJwONI3m9g9OZvsyPqenurUKNWk9MtqHr7OHr6cJL98W66OI2+dH+S6oLh+nGBO",
+	"IyKA1cplbeobdcttTIjwFKLuKzcCZvTRFfSYyygx1xGOIr6UqIQRMmXBxzwHs7cBsVkKZA8v58AQoTKJ",
+	"8MrWuLFxqi5NXOAo7SEVe5z8dr9kWlNGGZf7SYocPS7T5PBw9EWER6WkNlYiepWHqqQ4hFejuG8diIbB",
+	"CkZRMkseiE2WL3nolCAPETAlVm2bGcECovYz2iLpp8zlamAxuXp37fneH6e3V9YRdIcQsQy7F45BShx2",
+	"VBBrcjYE2tUqotUn7celfRYfClicPMC3k6lZvkvvdtegnTBbOU0HRtd+YXjbnLwprajLDXbYi37GbpXU",
+	"yKts1UlgpanSRuBTug/N4+zoNZdzjuZYVrzn4Ac3/kvfmHe88yNnRSqdbODYQc7WFLzpJh1M3yCaVhfF",
+	"YSLKxgnCIaZMWnprTRBkcFuwOZPqTafkTM9CZvtCR3CWYKFokEZYZJvZVofMKdLVUzpDmK1qpfit7nB7",
+	"MFge2UcyDeYIS/Q5olLpIZE9DSL52YmZiIf9Df8ld5LHumq5m3I/e61Nf91A/3lze/3+9uLuThd6P5yd",
+	"2U9l6N5lsV2WwJBZSXaaIs2Y4YBmC3S7WutGN7p7EqQJ6eL/BWTzHpnT8VstXCp1C9d2x1f10P70ZvLn",
+	"/fVvF1dIQiBAGaPCuEJTAJavYFiVRqbt5o2VSMEBm2z5NqnVAYEKSVV62pMRXfguFpicO3OUbSUaFwpy",
+	"yh1izqSyJZe1mR9lM543krG16NnGZ2ZIC73DCjzfS0Xkjb25UokcD4flANeA8rYFrjzamN5BpzcTr9mO",
+	"zC9qLwxC2jX2ByPbGQSGE6pbWYPRYOT5XoLV3IBsiBM6XOwP7USJ/iYEh0vScw7Z1IlmvoapAf9EQ/s9",
+	"qPf28ca41sFo9Ny5oqcEotg199BnxuP6N/3c29Goa4/iVMP6mNfaJL9xjMUqZ1LBCYVDWcw4Se+TzsW5",
+	"dPDWFpaL7D/vkxczXOZrbd5Nk5xAoouqnDnGut5IJFKmaAwD9IfOTETKGGWhvvv0jzt0ieMpwUg7XXSn",
+	"IEHvUmYb2L7tvEzOtXrqhSlbcCunimOpP4OWXHydRXypt2mj4obLKizykcpVD0Q0i0WoGiwWIWm/8pGA",
+	"hz/3Dw6P3h4/v9wezAWVf6vr7ZaiUL/hw2pvoWPQxdkPXLcUbn87hOtzf2vfO9oB+C+gLhnui5phU1/W",
+	"fsM6DQkUJTNjLpzqdAsxX4DsmkqbrlCAo0hrRc0tESogUNHKN+rBU4VCbm8SPA1tSbVQrnutLFTqgNwU",
+	"BITZs17gEzqEdwy+ITxTmU+0FAEjQNr6c14eNmfR07SoH/SqhSnHxG1mwl328ifBpsKXp2Anj7q7gfMR",
+	"R/oGjRysCrwYoyvTIAAgJTT0pRxCOK/q1eWXx5I/UngNu/H6hVdniktMZsC1W0hnehBTq9i20dN+s7kt",
+	"TSagIFCICDrTkbBaAthHyhQl3wQzUl+sDQFzmJ+vvT38gXOO+ifixHDuKfr93fydkPUQHlXWj06wwDEo",
+	"EPrh751OdaI7hlR/p8PiPDkce9mKXjVxsClQKZxmqvmpK8r7XTsI1WiJY5YPbVtKIqw0KE+LpggBc117",
+	"e4Rt5UJPx+RrbIq6LiwbdsddzxZ/lZ5X0uZvpHp5k7L1esnO2vJ8DTkaHf0MvbKgQOEueiVgwb/Ca9Or",
+	"W0NVU5M2KYZ94sUUw7JFTDoH3AQkAiQwRfOIwdRxyyiUSp3RFzPQlAVRSkpnlSFO70IQLMA1F9dAfEnT",
+	"a8P9sxGcybsPgsuXQpw1jVuTYEtEmTU3Zlbestw+mVuzLOw3Dj939nKAJqZ4i+blCyL6jRsa2Wcy6lHA",
+	"CRSifTsaof+YMAVCNxjvQCxAIHPa/3SWVYoi1NPl1Xi1pi/rm4/VeF95F6bC+ow9dd4XfNpcUipva55e",
+	"X76pXH1WYelJ72Y4akg/tGJU8sDJwKGAmQA535TxRhwTA8YAB3MgzQ5G+73NOq9v7Q72qdfN9IYtMHQ3",
+	"j9vFyO/5xwlZd8LyPahK106XCyhx6mald/gsNvXjThcEf1IcobmUVETXiAgcTr/k/dP8/lZJDm3+t9d8",
+	"B6hbull0Xbk/Sx6LTE7XYHP38Ov9/Q06GI3Q9W+2corRZ90SyV9d0o823mlqdmEIB9OHyb5wUeCEmPNF",
+	"o43BVx5dvJH1DnIeiD2kIFalUMpGbH+J+K4988YwSvDKGCPK0P/cXV9lgX/H9liE8nl7Nybgaizt2HSX",
+	"6NN3Bbkfbi/zzau9GoLlfMqxIB37z4SxDOSDiJ4aAf8wO+MA2gaL88/Kd1oWJ7PzdTp/svERodwaYWqI",
+	"GG2wr50hG4pnfdxpUUcshivsu1dUlgZpkwM6zbXoR4GjeF3uNXshzb5s0Pk1YGL4HYtQ/1N5K7M7DNbi",
+	"54nLFVV+ZGJThFx71fLpyYLjdzV+nlRrQbERa87DHylX37mYEeKL46P+in8nLGo9rO4Zs2qo4ReVcS7M",
+	"MAdVaIml9Y5AdDhb8VXt8veMMoKkwoxoq1TOc21AXuUwu0Cv6xcUXg3+Kuf7Z9kVCSpN+nWsqG1ZSVCK",
+	"srAVyKJzDnaqJ6Ok9Q7SFJCAkEoFAgja03PQjeE47Yew1ABZUFx7J9j8DEY1VsYmWj4ajcr6h+NHJeyQ",
+	"UXUOTlOdFYbzBywNiNoxSqYHEvT9lUqyu912p3nXLu654VD5nadh80eedqqStX5eYseAydk002xopLpv",
+	"StFb7yxNTcmCsxw3Gg+HEQ9wNOdSjU9GJwfe+lNRwPlei4w1yotv8tLO+tP6/wcAi0rS2CVLAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,16 @@
+package audit
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+var Command = cli.Command{
+	Name:        "audit",
+	Description: "Utilities for the audit log which is exported to S3, and the history of configuration changes",
+	Usage:       "Utilities for the audit log and the history of configuration changes",
+	Action:      cli.ShowSubcommandHelp,
+	Subcommands: []*cli.Command{
+		&VerifyCommand,
+		&ChangesCommand,
+	},
+}
@@ -0,0 +1,112 @@
+package audit
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/common-fate/clio"
+	"github.com/common-fate/common-fate/pkg/cfaws"
+	"github.com/common-fate/common-fate/pkg/configchange"
+	"github.com/common-fate/common-fate/pkg/deploy"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli/v2"
+)
+
+var ChangesCommand = cli.Command{
+	Name:        "changes",
+	Description: "List the changes made by administrators to access rules, target groups, handlers, provider setups and internal groups, newest first",
+	Usage:       "List configuration changes made by administrators",
+	Flags: []cli.Flag{
+		&cli.StringFlag{Name: "resource-type", Usage: "Only list changes to this type of resource: accessrule, targetgroup, targetgrouplink, handler, providersetup, group or user"},
+		&cli.StringFlag{Name: "resource-id", Usage: "Only list changes to this resource. Requires --resource-type"},
+		&cli.IntFlag{Name: "limit", Value: 20, Usage: "The maximum number of changes to list"},
+		&cli.BoolFlag{Name: "fields", Usage: "Show the values of the fields which were changed"},
+	},
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		resourceType := configchange.ResourceType(c.String("resource-type"))
+		resourceID := c.String("resource-id")
+		if resourceID != "" && resourceType == "" {
+			return fmt.Errorf("--resource-type must be provided with --resource-id")
+		}
+
+		dc, err := deploy.ConfigFromContext(ctx)
+		if err != nil {
+			return err
+		}
+		o, err := dc.LoadOutput(ctx)
+		if err != nil {
+			return err
+		}
+		cfg, err := cfaws.ConfigFromContextOrDefault(ctx)
+		if err != nil {
+			return err
+		}
+		db, err := ddb.New(ctx, o.DynamoDBTable, ddb.WithDynamoDBClient(dynamodb.NewFromConfig(cfg)))
+		if err != nil {
+			return err
+		}
+
+		var changes []configchange.Change
+		opts := []func(*ddb.QueryOpts){ddb.Limit(int32(c.Int("limit")))}
+		if resourceID != "" {
+			q := storage.ListConfigChangesForResource{ResourceType: resourceType, ResourceID: resourceID}
+			_, err = db.Query(ctx, &q, opts...)
+			changes = q.Result
+		} else if resourceType != "" {
+			q := storage.ListConfigChangesForResourceType{ResourceType: resourceType}
+			_, err = db.Query(ctx, &q, opts...)
+			changes = q.Result
+		} else {
+			q := storage.ListConfigChanges{}
+			_, err = db.Query(ctx, &q, opts...)
+			changes = q.Result
+		}
+		if err != nil && err != ddb.ErrNoItems {
+			return err
+		}
+		if len(changes) == 0 {
+			clio.Info("No configuration changes have been recorded")
+			return nil
+		}
+
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetAutoWrapText(false)
+		header := []string{"Time", "Change", "Resource", "Actor", "Reason"}
+		if c.Bool("fields") {
+			header = append(header, "Fields")
+		}
+		table.SetHeader(header)
+		for _, change := range changes {
+			row := []string{
+				change.CreatedAt.Format(time.RFC3339),
+				string(change.ResourceType) + "." + string(change.Action),
+				change.ResourceID,
+				change.ActorID,
+				change.Reason,
+			}
+			if c.Bool("fields") {
+				var fields []string
+				for _, f := range change.Fields {
+					fields = append(fields, fmt.Sprintf("%s: %s -> %s", f.Field, valueOrNone(f.Before), valueOrNone(f.After)))
+				}
+				row = append(row, strings.Join(fields, "\n"))
+			}
+			table.Append(row)
+		}
+		table.Render()
+		return nil
+	},
+}
+
+func valueOrNone(v []byte) string {
+	if len(v) == 0 {
+		return "(none)"
+	}
+	return string(v)
+}
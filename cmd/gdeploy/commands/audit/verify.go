@@ -0,0 +1,127 @@
+package audit
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/common-fate/clio"
+	"github.com/common-fate/common-fate/pkg/audit"
+	"github.com/common-fate/common-fate/pkg/cfaws"
+	"github.com/common-fate/common-fate/pkg/deploy"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+	"github.com/urfave/cli/v2"
+)
+
+var VerifyCommand = cli.Command{
+	Name:        "verify",
+	Description: "Download the audit log exported to S3 and check that no entries have been changed, removed or reordered",
+	Usage:       "Check the exported audit log for tampering or gaps",
+	Flags: []cli.Flag{
+		&cli.StringFlag{Name: "bucket", Usage: "The bucket the audit log is exported to. Defaults to the audit log bucket of the deployment"},
+	},
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		dc, err := deploy.ConfigFromContext(ctx)
+		if err != nil {
+			return err
+		}
+		o, err := dc.LoadOutput(ctx)
+		if err != nil {
+			return err
+		}
+		bucket := c.String("bucket")
+		if bucket == "" {
+			bucket = o.AuditLogBucketName
+		}
+		if bucket == "" {
+			return errors.New("the audit log bucket wasn't found in the stack outputs. Update your deployment with 'gdeploy update' or provide the bucket with --bucket")
+		}
+		cfg, err := cfaws.ConfigFromContextOrDefault(ctx)
+		if err != nil {
+			return err
+		}
+		client := s3.NewFromConfig(cfg)
+
+		var entries []audit.Entry
+		var problems []audit.Problem
+		var objects int
+		p := s3.NewListObjectsV2Paginator(client, &s3.ListObjectsV2Input{
+			Bucket: aws.String(bucket),
+			Prefix: aws.String(audit.ObjectPrefix),
+		})
+		// objects are named by the sequence numbers of their entries, so they are listed in order.
+		for p.HasMorePages() {
+			page, err := p.NextPage(ctx)
+			if err != nil {
+				return err
+			}
+			for _, obj := range page.Contents {
+				key := aws.ToString(obj.Key)
+				clio.Debugf("reading audit log object %s", key)
+				res, err := client.GetObject(ctx, &s3.GetObjectInput{
+					Bucket: aws.String(bucket),
+					Key:    obj.Key,
+					// the SDK checks the object against the checksum it was uploaded with.
+					ChecksumMode: types.ChecksumModeEnabled,
+				})
+				if err != nil {
+					return err
+				}
+				got, err := audit.ReadJSONL(res.Body)
+				res.Body.Close()
+				if err != nil {
+					return fmt.Errorf("reading %s: %w", key, err)
+				}
+				objects++
+				if len(got) == 0 {
+					problems = append(problems, audit.Problem{Reason: fmt.Sprintf("object %s is empty", key)})
+					continue
+				}
+				first, last := got[0], got[len(got)-1]
+				if key != audit.ObjectKey(first.Sequence, last.Sequence) {
+					problems = append(problems, audit.Problem{Sequence: first.Sequence, Reason: fmt.Sprintf("object %s contains entries %d to %d", key, first.Sequence, last.Sequence)})
+				}
+				entries = append(entries, got...)
+			}
+		}
+
+		problems = append(problems, audit.Verify(entries)...)
+
+		// entries removed from the end of the log don't break the chain,
+		// so check that every entry which has been exported is in the bucket.
+		db, err := ddb.New(ctx, o.DynamoDBTable, ddb.WithDynamoDBClient(dynamodb.NewFromConfig(cfg)))
+		if err != nil {
+			return err
+		}
+		q := storage.GetAuditLogExported{}
+		_, err = db.Query(ctx, &q)
+		if err != nil && err != ddb.ErrNoItems {
+			return err
+		}
+		if err == nil {
+			var last audit.Entry
+			if len(entries) > 0 {
+				last = entries[len(entries)-1]
+			}
+			if last.Sequence < q.Result.Sequence {
+				problems = append(problems, audit.Problem{Sequence: last.Sequence + 1, Reason: fmt.Sprintf("entries %d to %d have been exported but are missing from the bucket", last.Sequence+1, q.Result.Sequence)})
+			} else if last.Sequence == q.Result.Sequence && last.Hash != q.Result.Hash {
+				problems = append(problems, audit.Problem{Sequence: last.Sequence, Reason: "hash does not match the hash recorded when the entry was exported"})
+			}
+		}
+
+		if len(problems) > 0 {
+			for _, p := range problems {
+				clio.Error(p.String())
+			}
+			return fmt.Errorf("audit log verification failed with %d problems", len(problems))
+		}
+		clio.Successf("Verified %d audit log entries in %d objects from %s", len(entries), objects, bucket)
+		return nil
+	},
+}
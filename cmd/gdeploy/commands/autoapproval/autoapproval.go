@@ -0,0 +1,14 @@
+package autoapproval
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+var Command = cli.Command{
+	Name:        "auto-approval",
+	Aliases:     []string{"autoapproval"},
+	Description: "Test your auto-approval policy",
+	Usage:       "Test your auto-approval policy",
+	Action:      cli.ShowSubcommandHelp,
+	Subcommands: []*cli.Command{&dryRunCommand},
+}
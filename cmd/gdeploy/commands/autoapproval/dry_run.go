@@ -0,0 +1,69 @@
+package autoapproval
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/common-fate/clio"
+	"github.com/common-fate/clio/clierr"
+	"github.com/common-fate/common-fate/pkg/autoapproval"
+	"github.com/common-fate/common-fate/pkg/deploy"
+	"github.com/urfave/cli/v2"
+)
+
+var dryRunCommand = cli.Command{
+	Name:        "dry-run",
+	Description: "Evaluate an auto-approval policy locally against an example access request, without making any changes",
+	Usage:       "Evaluate an auto-approval policy locally against an example access request",
+	Flags: []cli.Flag{
+		&cli.PathFlag{Name: "policy", Usage: "Path to a YAML or JSON policy file. Defaults to the AutoApprovalPolicy parameter in your deployment configuration"},
+		&cli.PathFlag{Name: "input", Usage: "Path to a JSON file describing the user, rule, with, timing and history of the access request", Required: true},
+	},
+	Action: func(c *cli.Context) error {
+		var policyData []byte
+		if c.Path("policy") != "" {
+			b, err := os.ReadFile(c.Path("policy"))
+			if err != nil {
+				return err
+			}
+			policyData = b
+		} else {
+			dc, err := deploy.LoadConfig(c.Path("file"))
+			if err != nil {
+				return clierr.New("Unable to load your deployment configuration.", clierr.Info("Use the --policy flag to provide a policy file instead."))
+			}
+			if dc.Deployment.Parameters.AutoApprovalPolicy == "" {
+				return clierr.New("AutoApprovalPolicy is not set in your deployment configuration.", clierr.Info("Use the --policy flag to provide a policy file instead."))
+			}
+			policyData = []byte(dc.Deployment.Parameters.AutoApprovalPolicy)
+		}
+
+		policy, err := autoapproval.ParsePolicy(policyData)
+		if err != nil {
+			return clierr.New(fmt.Sprintf("Invalid auto-approval policy: %s", err))
+		}
+
+		b, err := os.ReadFile(c.Path("input"))
+		if err != nil {
+			return err
+		}
+		var in autoapproval.Input
+		err = json.Unmarshal(b, &in)
+		if err != nil {
+			return clierr.New(fmt.Sprintf("Invalid input file: %s", err))
+		}
+		if in.Now.IsZero() {
+			in.Now = time.Now()
+		}
+
+		res := policy.Evaluate(in)
+		if res.AutoApproved() {
+			clio.Successf("%s: %s (statement %s)", res.Decision, res.Justification, res.Statement)
+		} else {
+			clio.Warnf("%s: %s", res.Decision, res.Justification)
+		}
+		return nil
+	},
+}
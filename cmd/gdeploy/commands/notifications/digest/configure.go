@@ -0,0 +1,128 @@
+package digest
+
+import (
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/common-fate/clio"
+	"github.com/common-fate/common-fate/pkg/deploy"
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	"github.com/common-fate/common-fate/pkg/notifiers/digest"
+	"github.com/common-fate/common-fate/pkg/notifiers/email"
+	"github.com/urfave/cli/v2"
+)
+
+var configureDigestCommand = cli.Command{
+	Name:        "configure",
+	Description: "configure when digests are sent and who they are sent to",
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		f := c.Path("file")
+		dc, err := deploy.ConfigFromContext(ctx)
+		if err != nil {
+			return err
+		}
+		if dc.Deployment.Parameters.NotificationsConfiguration == nil {
+			dc.Deployment.Parameters.NotificationsConfiguration = &deploy.Notifications{}
+		}
+		notifications := dc.Deployment.Parameters.NotificationsConfiguration
+
+		clio.Info("Digests summarise pending reviews, failed grants, and the most requested rules. They can be sent to Slack channels and email recipients.")
+
+		var settings digest.Settings
+		cfg := settings.Config()
+		// if digests are already configured, the current values are used as defaults when prompting.
+		if notifications.Digest != nil {
+			err = cfg.Load(ctx, &gconfig.MapLoader{Values: notifications.Digest})
+			if err != nil {
+				return err
+			}
+		}
+		for _, v := range cfg {
+			err := deploy.CLIPrompt(v)
+			if err != nil {
+				return err
+			}
+		}
+		err = settings.Validate()
+		if err != nil {
+			return err
+		}
+		if len(settings.SlackChannels()) > 0 && notifications.Slack == nil {
+			clio.Warn("Digests won't be sent to Slack channels until Slack is configured. Run 'gdeploy notifications slack configure' to set up Slack.")
+		}
+
+		digestConfig, err := cfg.Dump(ctx, gconfig.SSMDumper{Suffix: dc.Deployment.Parameters.DeploymentSuffix})
+		if err != nil {
+			return err
+		}
+
+		// email is needed to send digests to email recipients, and is also used to send approver digests
+		configureEmail := len(settings.EmailRecipients()) > 0
+		if !configureEmail && settings.ApproverDigests() {
+			p := &survey.Confirm{Message: "Would you like to email approvers their digests, in addition to sending them in Slack?", Default: notifications.Email != nil}
+			err = survey.AskOne(p, &configureEmail)
+			if err != nil {
+				return err
+			}
+		}
+
+		if configureEmail {
+			var smtp email.SMTPSender
+			emailCfg := smtp.Config()
+			if notifications.Email != nil {
+				err = emailCfg.Load(ctx, &gconfig.MapLoader{Values: notifications.Email})
+				if err != nil {
+					return err
+				}
+			}
+			for _, v := range emailCfg {
+				err := deploy.CLIPrompt(v)
+				if err != nil {
+					return err
+				}
+			}
+			err = deploy.RunConfigTest(ctx, &smtp)
+			if err != nil {
+				return err
+			}
+			emailConfig, err := emailCfg.Dump(ctx, gconfig.SSMDumper{Suffix: dc.Deployment.Parameters.DeploymentSuffix})
+			if err != nil {
+				return err
+			}
+			notifications.Email = emailConfig
+		}
+
+		notifications.Digest = digestConfig
+		err = dc.Save(f)
+		if err != nil {
+			return err
+		}
+
+		clio.Success("Successfully configured digests")
+		clio.Warn("Your changes won't be applied until you redeploy. Run 'gdeploy update' to apply the changes to your CloudFormation deployment.")
+		return nil
+	},
+}
+
+var disableDigestCommand = cli.Command{
+	Name:        "disable",
+	Description: "stop sending digests",
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		f := c.Path("file")
+
+		dc, err := deploy.ConfigFromContext(ctx)
+		if err != nil {
+			return err
+		}
+		if dc.Deployment.Parameters.NotificationsConfiguration != nil {
+			dc.Deployment.Parameters.NotificationsConfiguration.Digest = nil
+		}
+		err = dc.Save(f)
+		if err != nil {
+			return err
+		}
+		clio.Success("Successfully disabled digests")
+		clio.Warn("Your changes won't be applied until you redeploy. Run 'gdeploy update' to apply the changes to your CloudFormation deployment.")
+		return nil
+	},
+}
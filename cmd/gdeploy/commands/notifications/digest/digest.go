@@ -0,0 +1,11 @@
+package digest
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+var Command = cli.Command{
+	Name:        "digest",
+	Description: "configure daily or weekly digests of access request activity",
+	Subcommands: []*cli.Command{&configureDigestCommand, &disableDigestCommand},
+}
@@ -1,16 +1,20 @@
 package notifications
 
 import (
+	"github.com/common-fate/common-fate/cmd/gdeploy/commands/notifications/digest"
+	"github.com/common-fate/common-fate/cmd/gdeploy/commands/notifications/reminders"
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands/notifications/slack"
 	slackwebhook "github.com/common-fate/common-fate/cmd/gdeploy/commands/notifications/slack-webhook"
+	"github.com/common-fate/common-fate/cmd/gdeploy/commands/notifications/teams"
+	"github.com/common-fate/common-fate/cmd/gdeploy/commands/notifications/webhook"
 	"github.com/urfave/cli/v2"
 )
 
 var Command = cli.Command{
 	Name:        "notifications",
 	Aliases:     []string{"notification"},
-	Description: "Manage your notification channels like Slack",
-	Usage:       "Manage your notification channels like Slack",
+	Description: "Manage your notification channels like Slack and Microsoft Teams",
+	Usage:       "Manage your notification channels like Slack and Microsoft Teams",
 	Action:      cli.ShowSubcommandHelp,
-	Subcommands: []*cli.Command{&slack.Command, &slackwebhook.Command},
+	Subcommands: []*cli.Command{&slack.Command, &slackwebhook.Command, &teams.Command, &webhook.Command, &digest.Command, &reminders.Command},
 }
@@ -0,0 +1,89 @@
+package reminders
+
+import (
+	"github.com/common-fate/clio"
+	"github.com/common-fate/common-fate/pkg/deploy"
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	"github.com/common-fate/common-fate/pkg/service/remindersvc"
+	"github.com/urfave/cli/v2"
+)
+
+var configureRemindersCommand = cli.Command{
+	Name:        "configure",
+	Description: "configure how long before access expires users are reminded",
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		f := c.Path("file")
+		dc, err := deploy.ConfigFromContext(ctx)
+		if err != nil {
+			return err
+		}
+		if dc.Deployment.Parameters.NotificationsConfiguration == nil {
+			dc.Deployment.Parameters.NotificationsConfiguration = &deploy.Notifications{}
+		}
+		notifications := dc.Deployment.Parameters.NotificationsConfiguration
+
+		clio.Info("Reminders are sent to users in Slack before their access expires, with a link to request an extension.")
+
+		var settings remindersvc.Settings
+		cfg := settings.Config()
+		// if reminders are already configured, the current values are used as defaults when prompting.
+		if notifications.Reminders != nil {
+			err = cfg.Load(ctx, &gconfig.MapLoader{Values: notifications.Reminders})
+			if err != nil {
+				return err
+			}
+		}
+		for _, v := range cfg {
+			err := deploy.CLIPrompt(v)
+			if err != nil {
+				return err
+			}
+		}
+		_, err = settings.ExpiryReminders()
+		if err != nil {
+			return err
+		}
+		if notifications.Slack == nil {
+			clio.Warn("Reminders won't be delivered until Slack is configured. Run 'gdeploy notifications slack configure' to set up Slack.")
+		}
+
+		remindersConfig, err := cfg.Dump(ctx, gconfig.SSMDumper{Suffix: dc.Deployment.Parameters.DeploymentSuffix})
+		if err != nil {
+			return err
+		}
+		notifications.Reminders = remindersConfig
+		err = dc.Save(f)
+		if err != nil {
+			return err
+		}
+
+		clio.Success("Successfully configured expiry reminders")
+		clio.Warn("Your changes won't be applied until you redeploy. Run 'gdeploy update' to apply the changes to your CloudFormation deployment.")
+		return nil
+	},
+}
+
+var disableRemindersCommand = cli.Command{
+	Name:        "disable",
+	Description: "stop sending reminders before access expires",
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		f := c.Path("file")
+
+		dc, err := deploy.ConfigFromContext(ctx)
+		if err != nil {
+			return err
+		}
+		if dc.Deployment.Parameters.NotificationsConfiguration != nil {
+			dc.Deployment.Parameters.NotificationsConfiguration.Reminders = nil
+		}
+		err = dc.Save(f)
+		if err != nil {
+			return err
+		}
+		clio.Success("Successfully disabled expiry reminders")
+		clio.Warn("Your changes won't be applied until you redeploy. Run 'gdeploy update' to apply the changes to your CloudFormation deployment.")
+		return nil
+	},
+}
@@ -0,0 +1,11 @@
+package reminders
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+var Command = cli.Command{
+	Name:        "reminders",
+	Description: "configure reminders sent to users before their access expires",
+	Subcommands: []*cli.Command{&configureRemindersCommand, &disableRemindersCommand},
+}
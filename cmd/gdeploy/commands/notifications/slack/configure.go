@@ -9,6 +9,7 @@ import (
 	"strings"
 	"text/template"
 
+	"github.com/AlecAivazis/survey/v2"
 	"github.com/common-fate/clio"
 	"github.com/common-fate/common-fate/pkg/deploy"
 	"github.com/common-fate/common-fate/pkg/gconfig"
@@ -36,7 +37,10 @@ var configureSlackCommand = cli.Command{
 		}
 		apiUrl := o.APIURL
 
-		appManifest, err := RenderSlackAppManifest(SlackManifestConfig{WebhookURL: strings.TrimSuffix(apiUrl, "/") + "/webhook/v1/slack/interactivity"})
+		appManifest, err := RenderSlackAppManifest(SlackManifestConfig{
+			WebhookURL: strings.TrimSuffix(apiUrl, "/") + "/webhook/v1/slack/interactivity",
+			CommandURL: strings.TrimSuffix(apiUrl, "/") + "/webhook/v1/slack/command",
+		})
 		if err != nil {
 			return err
 		}
@@ -76,6 +80,40 @@ var configureSlackCommand = cli.Command{
 			return err
 		}
 
+		// interactive approvals let reviewers approve and deny requests from the Slack message.
+		// They require the signing secret of the Slack app to verify requests from Slack.
+		existing := dc.Deployment.Parameters.NotificationsConfiguration.Slack
+		_, hasSigningSecret := existing["signingSecret"]
+		enableInteractivity := true
+		err = survey.AskOne(&survey.Confirm{Message: "Enable interactive approvals and the /access command, so that users can review and request access from Slack?", Default: true}, &enableInteractivity)
+		if err != nil {
+			return err
+		}
+		if enableInteractivity {
+			clio.Info("You can find your Signing Secret in the Basic Information tab of your Slack app.")
+			var interactivity slacknotifier.SlackInteractivity
+			interactivityCfg := interactivity.Config()
+			if hasSigningSecret {
+				err = interactivityCfg.Load(ctx, &gconfig.MapLoader{Values: existing})
+				if err != nil {
+					return err
+				}
+			}
+			for _, v := range interactivityCfg {
+				err := deploy.CLIPrompt(v)
+				if err != nil {
+					return err
+				}
+			}
+			interactivityConfig, err := interactivityCfg.Dump(ctx, gconfig.SSMDumper{Suffix: dc.Deployment.Parameters.DeploymentSuffix})
+			if err != nil {
+				return err
+			}
+			for k, v := range interactivityConfig {
+				newConfig[k] = v
+			}
+		}
+
 		dc.Deployment.Parameters.NotificationsConfiguration.Slack = newConfig
 
 		err = dc.Save(f)
@@ -93,6 +131,8 @@ var configureSlackCommand = cli.Command{
 
 type SlackManifestConfig struct {
 	WebhookURL string
+	// CommandURL is the request URL of the /access slash command.
+	CommandURL string
 }
 
 func RenderSlackAppManifest(s SlackManifestConfig) (string, error) {
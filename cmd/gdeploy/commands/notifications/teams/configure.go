@@ -0,0 +1,123 @@
+package teams
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/common-fate/clio"
+	"github.com/common-fate/common-fate/pkg/deploy"
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	teamsnotifier "github.com/common-fate/common-fate/pkg/notifiers/teams"
+	"github.com/urfave/cli/v2"
+)
+
+var configureTeamsCommand = cli.Command{
+	Name:        "configure",
+	Description: "add a Microsoft Teams channel to send notifications to using an incoming webhook",
+	Flags: []cli.Flag{
+		&cli.StringFlag{Name: "channel-alias", Aliases: []string{"c"}},
+	},
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		f := c.Path("file")
+		dc, err := deploy.ConfigFromContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		channel := c.String("channel-alias")
+		if channel == "" {
+			p := survey.Input{
+				Message: "Enter a name for this Teams channel",
+			}
+			err = survey.AskOne(&p, &channel, survey.WithValidator(survey.MinLength(1)))
+			if err != nil {
+				return err
+			}
+		}
+		// clean the channel ID
+		r := regexp.MustCompile(`[^a-zA-Z0-9_.-]`)
+		channel = r.ReplaceAllString(channel, "-")
+
+		clio.Info("In Microsoft Teams, add an Incoming Webhook to the channel and copy the webhook URL.")
+
+		var teams teamsnotifier.TeamsIncomingWebhook
+		if dc.Deployment.Parameters.NotificationsConfiguration == nil {
+			dc.Deployment.Parameters.NotificationsConfiguration = &deploy.Notifications{}
+		}
+		cfg := teams.Config()
+		// if the channel already exists, the current values are used as defaults when prompting.
+		if existing, ok := dc.Deployment.Parameters.NotificationsConfiguration.Teams[channel]; ok {
+			err = cfg.Load(ctx, &gconfig.MapLoader{Values: existing})
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, v := range cfg {
+			err := deploy.CLIPrompt(v)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = deploy.RunConfigTest(ctx, &teams)
+		if err != nil {
+			return err
+		}
+
+		// if tests pass, dump the config and update in the deployment config
+		itemLoaded, err := cfg.Dump(ctx, gconfig.SSMDumper{Suffix: dc.Deployment.Parameters.DeploymentSuffix, SecretPathArgs: []interface{}{channel}})
+		if err != nil {
+			return err
+		}
+		dc.Deployment.Parameters.NotificationsConfiguration.Teams.Upsert(channel, itemLoaded)
+
+		err = dc.Save(f)
+		if err != nil {
+			return err
+		}
+
+		clio.Success(fmt.Sprintf("Successfully configured Teams channel %s", channel))
+		clio.Warn("Your changes won't be applied until you redeploy. Run 'gdeploy update' to apply the changes to your CloudFormation deployment.")
+		return nil
+	},
+}
+
+var removeTeamsCommand = cli.Command{
+	Name:        "remove",
+	Description: "stop sending notifications to a Microsoft Teams channel",
+	Flags: []cli.Flag{
+		&cli.StringFlag{Name: "channel-alias", Aliases: []string{"c"}, Required: true},
+	},
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		f := c.Path("file")
+		dc, err := deploy.ConfigFromContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		channel := c.String("channel-alias")
+		if dc.Deployment.Parameters.NotificationsConfiguration == nil {
+			return fmt.Errorf("no Teams channel with id %s is configured", channel)
+		}
+		if _, ok := dc.Deployment.Parameters.NotificationsConfiguration.Teams[channel]; !ok {
+			return fmt.Errorf("no Teams channel with id %s is configured", channel)
+		}
+
+		// Note: gconfig doesn't currently support ssm:DeleteParameter, so the webhook url isn't removed
+		// from the parameter store. It's just removed from the config file.
+		dc.Deployment.Parameters.NotificationsConfiguration.Teams.Remove(channel)
+
+		err = dc.Save(f)
+		if err != nil {
+			return err
+		}
+
+		clio.Success(fmt.Sprintf("Successfully removed Teams channel %s", channel))
+		clio.Warn("Your changes won't be applied until you redeploy. Run 'gdeploy update' to apply the changes to your CloudFormation deployment.")
+		return nil
+	},
+}
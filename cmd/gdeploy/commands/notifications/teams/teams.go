@@ -0,0 +1,11 @@
+package teams
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+var Command = cli.Command{
+	Name:        "teams",
+	Description: "configure and enable Microsoft Teams integration",
+	Subcommands: []*cli.Command{&configureTeamsCommand, &removeTeamsCommand},
+}
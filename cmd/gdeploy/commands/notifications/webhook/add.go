@@ -0,0 +1,119 @@
+package webhook
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/common-fate/clio"
+	"github.com/common-fate/common-fate/pkg/deploy"
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	webhooknotifier "github.com/common-fate/common-fate/pkg/notifiers/webhook"
+	"github.com/urfave/cli/v2"
+)
+
+var addCommand = cli.Command{
+	Name:        "add",
+	Description: "add or update a webhook endpoint. A signing key is generated if one isn't provided",
+	Flags: []cli.Flag{
+		&cli.StringFlag{Name: "id", Usage: "a name for the endpoint"},
+		&cli.StringFlag{Name: "url", Usage: "the URL to send events to"},
+		&cli.StringFlag{Name: "event-types", Usage: "a comma separated list of event types to send, such as 'request.created,grant.*'"},
+		&cli.StringFlag{Name: "signing-key", Usage: "the key used to sign payloads"},
+	},
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		f := c.Path("file")
+		dc, err := deploy.ConfigFromContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		id := c.String("id")
+		if id == "" {
+			p := survey.Input{
+				Message: "Enter a name for this webhook endpoint",
+			}
+			err = survey.AskOne(&p, &id, survey.WithValidator(survey.MinLength(1)))
+			if err != nil {
+				return err
+			}
+		}
+		// clean the endpoint ID
+		r := regexp.MustCompile(`[^a-zA-Z0-9_.-]`)
+		id = r.ReplaceAllString(id, "-")
+
+		if dc.Deployment.Parameters.NotificationsConfiguration == nil {
+			dc.Deployment.Parameters.NotificationsConfiguration = &deploy.Notifications{}
+		}
+		var endpoint webhooknotifier.Endpoint
+		cfg := endpoint.Config()
+		// if the endpoint already exists, the current values are used as defaults when prompting.
+		existing, exists := dc.Deployment.Parameters.NotificationsConfiguration.Webhooks[id]
+		if exists {
+			err = cfg.Load(ctx, &gconfig.MapLoader{Values: existing})
+			if err != nil {
+				return err
+			}
+		}
+
+		flagValues := map[string]string{
+			"url":        c.String("url"),
+			"eventTypes": c.String("event-types"),
+			"signingKey": c.String("signing-key"),
+		}
+		var generatedKey bool
+		if !exists && flagValues["signingKey"] == "" {
+			flagValues["signingKey"], err = generateSigningKey()
+			if err != nil {
+				return err
+			}
+			generatedKey = true
+		}
+
+		for _, v := range cfg {
+			if flagValues[v.Key()] != "" {
+				err = v.Set(flagValues[v.Key()])
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			err := deploy.CLIPrompt(v)
+			if err != nil {
+				return err
+			}
+		}
+
+		itemLoaded, err := cfg.Dump(ctx, gconfig.SSMDumper{Suffix: dc.Deployment.Parameters.DeploymentSuffix, SecretPathArgs: []interface{}{id}})
+		if err != nil {
+			return err
+		}
+		dc.Deployment.Parameters.NotificationsConfiguration.Webhooks.Upsert(id, itemLoaded)
+
+		err = dc.Save(f)
+		if err != nil {
+			return err
+		}
+
+		clio.Success(fmt.Sprintf("Successfully configured webhook endpoint %s", id))
+		if generatedKey {
+			clio.Info("Payloads will be signed with the following key. Use it to verify the X-CommonFate-Signature header in your endpoint:")
+			fmt.Printf("\n%s\n\n", flagValues["signingKey"])
+		}
+		clio.Warn("Your changes won't be applied until you redeploy. Run 'gdeploy update' to apply the changes to your CloudFormation deployment.")
+		clio.Warn(fmt.Sprintf("Run: `gdeploy notifications webhook test --id=%s` to send a test event", id))
+		return nil
+	},
+}
+
+func generateSigningKey() (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,55 @@
+package webhook
+
+import (
+	"os"
+	"sort"
+
+	"github.com/common-fate/clio"
+	"github.com/common-fate/common-fate/pkg/deploy"
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	webhooknotifier "github.com/common-fate/common-fate/pkg/notifiers/webhook"
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli/v2"
+)
+
+var listCommand = cli.Command{
+	Name:        "list",
+	Description: "list the configured webhook endpoints",
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		dc, err := deploy.ConfigFromContext(ctx)
+		if err != nil {
+			return err
+		}
+		if dc.Deployment.Parameters.NotificationsConfiguration == nil || len(dc.Deployment.Parameters.NotificationsConfiguration.Webhooks) == 0 {
+			clio.Info("No webhook endpoints are configured. Run 'gdeploy notifications webhook add' to add one.")
+			return nil
+		}
+		webhooks := dc.Deployment.Parameters.NotificationsConfiguration.Webhooks
+
+		var ids []string
+		for id := range webhooks {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+
+		table := tablewriter.NewWriter(os.Stderr)
+		table.SetHeader([]string{"ID", "URL", "Event Types"})
+		for _, id := range ids {
+			var endpoint webhooknotifier.Endpoint
+			// the signing key isn't displayed, so there's no need to fetch it from SSM.
+			err = endpoint.Config().Load(ctx, &gconfig.MapLoader{Values: webhooks[id], SkipLoadingSecrets: true})
+			if err != nil {
+				return err
+			}
+			eventTypes := endpoint.EventTypes()
+			filter := "all"
+			if len(eventTypes) > 0 {
+				filter = webhooks[id]["eventTypes"]
+			}
+			table.Append([]string{id, endpoint.URL(), filter})
+		}
+		table.Render()
+		return nil
+	},
+}
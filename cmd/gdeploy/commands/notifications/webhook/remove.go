@@ -0,0 +1,46 @@
+package webhook
+
+import (
+	"fmt"
+
+	"github.com/common-fate/clio"
+	"github.com/common-fate/common-fate/pkg/deploy"
+	"github.com/urfave/cli/v2"
+)
+
+var removeCommand = cli.Command{
+	Name:        "remove",
+	Description: "stop sending events to a webhook endpoint",
+	Flags: []cli.Flag{
+		&cli.StringFlag{Name: "id", Required: true},
+	},
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		f := c.Path("file")
+		dc, err := deploy.ConfigFromContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		id := c.String("id")
+		if dc.Deployment.Parameters.NotificationsConfiguration == nil {
+			return fmt.Errorf("no webhook endpoint with id %s is configured", id)
+		}
+		if _, ok := dc.Deployment.Parameters.NotificationsConfiguration.Webhooks[id]; !ok {
+			return fmt.Errorf("no webhook endpoint with id %s is configured", id)
+		}
+
+		// Note: gconfig doesn't currently support ssm:DeleteParameter, so the signing key isn't removed
+		// from the parameter store. It's just removed from the config file.
+		dc.Deployment.Parameters.NotificationsConfiguration.Webhooks.Remove(id)
+
+		err = dc.Save(f)
+		if err != nil {
+			return err
+		}
+
+		clio.Success(fmt.Sprintf("Successfully removed webhook endpoint %s", id))
+		clio.Warn("Your changes won't be applied until you redeploy. Run 'gdeploy update' to apply the changes to your CloudFormation deployment.")
+		return nil
+	},
+}
@@ -0,0 +1,41 @@
+package webhook
+
+import (
+	"fmt"
+
+	"github.com/common-fate/common-fate/pkg/deploy"
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	webhooknotifier "github.com/common-fate/common-fate/pkg/notifiers/webhook"
+	"github.com/urfave/cli/v2"
+)
+
+var testCommand = cli.Command{
+	Name:        "test",
+	Description: "send a signed test event to a webhook endpoint",
+	Flags: []cli.Flag{
+		&cli.StringFlag{Name: "id", Required: true},
+	},
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		dc, err := deploy.ConfigFromContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		id := c.String("id")
+		if dc.Deployment.Parameters.NotificationsConfiguration == nil {
+			return fmt.Errorf("no webhook endpoint with id %s is configured", id)
+		}
+		values, ok := dc.Deployment.Parameters.NotificationsConfiguration.Webhooks[id]
+		if !ok {
+			return fmt.Errorf("no webhook endpoint with id %s is configured", id)
+		}
+
+		endpoint := webhooknotifier.Endpoint{ID: id}
+		err = endpoint.Config().Load(ctx, &gconfig.MapLoader{Values: values})
+		if err != nil {
+			return err
+		}
+		return deploy.RunConfigTest(ctx, &endpoint)
+	},
+}
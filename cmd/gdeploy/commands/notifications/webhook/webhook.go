@@ -0,0 +1,12 @@
+package webhook
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+var Command = cli.Command{
+	Name:        "webhook",
+	Aliases:     []string{"webhooks"},
+	Description: "send request and grant events to HTTP endpoints, with signed payloads",
+	Subcommands: []*cli.Command{&addCommand, &listCommand, &removeCommand, &testCommand},
+}
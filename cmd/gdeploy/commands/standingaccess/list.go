@@ -0,0 +1,39 @@
+package standingaccess
+
+import (
+	"os"
+
+	"github.com/common-fate/clio"
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli/v2"
+)
+
+var ListCommand = cli.Command{
+	Name:        "list",
+	Description: "List access which users hold in providers without an active Common Fate grant. Only providers which support listing their assignments are checked",
+	Usage:       "List access held outside of Common Fate",
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		f, err := newFinder(ctx)
+		if err != nil {
+			return err
+		}
+		found, err := f.Find(ctx)
+		if err != nil {
+			return err
+		}
+		if len(found) == 0 {
+			clio.Success("No standing access was found")
+			return nil
+		}
+
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetAutoWrapText(false)
+		table.SetHeader([]string{"Provider", "Type", "Subject", "With"})
+		for _, a := range found {
+			table.Append([]string{a.ProviderID, a.ProviderType, a.Subject, formatWith(a.With)})
+		}
+		table.Render()
+		return nil
+	},
+}
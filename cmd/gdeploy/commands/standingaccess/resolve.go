@@ -0,0 +1,143 @@
+package standingaccess
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/apikit/userid"
+	"github.com/common-fate/clio"
+	"github.com/common-fate/common-fate/pkg/configchange"
+	"github.com/common-fate/common-fate/pkg/service/cachesvc"
+	"github.com/common-fate/common-fate/pkg/service/rulesvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	convertOption = "Convert to an access rule"
+	removeOption  = "Remove the access"
+	skipOption    = "Skip"
+)
+
+var ResolveCommand = cli.Command{
+	Name:        "resolve",
+	Description: "Go through the access which users hold without a Common Fate grant, and choose whether to convert each into an access rule, remove it from the provider, or skip it",
+	Usage:       "Convert access held outside of Common Fate into access rules, or remove it",
+	Flags: []cli.Flag{
+		&cli.StringFlag{Name: "admin-email", Usage: "The email address of the Common Fate administrator to record as creating the access rules", Required: true},
+		&cli.DurationFlag{Name: "max-duration", Value: time.Hour, Usage: "The maximum duration of the access rules which are created"},
+	},
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		f, err := newFinder(ctx)
+		if err != nil {
+			return err
+		}
+
+		admin := storage.GetUserByEmail{Email: c.String("admin-email")}
+		_, err = f.DB.Query(ctx, &admin)
+		if err == ddb.ErrNoItems {
+			return fmt.Errorf("no user was found with the email %s", c.String("admin-email"))
+		}
+		if err != nil {
+			return err
+		}
+		ctx = userid.Set(ctx, admin.Result.ID)
+		ctx = configchange.WithReason(ctx, "converted standing access with gdeploy")
+
+		maxDuration := c.Duration("max-duration")
+		if maxDuration < time.Minute {
+			return errors.New("--max-duration must be at least 1 minute")
+		}
+
+		found, err := f.Find(ctx)
+		if err != nil {
+			return err
+		}
+		if len(found) == 0 {
+			clio.Success("No standing access was found")
+			return nil
+		}
+
+		groups := storage.ListGroups{}
+		_, err = f.DB.Query(ctx, &groups)
+		if err != nil && err != ddb.ErrNoItems {
+			return err
+		}
+		groupIDs := map[string]string{}
+		var groupNames []string
+		for _, g := range groups.Result {
+			groupIDs[g.Name] = g.ID
+			groupNames = append(groupNames, g.Name)
+		}
+
+		rs := rulesvc.Service{
+			Clock:    clock.New(),
+			AHClient: f.AHClient,
+			DB:       f.DB,
+			Cache:    &cachesvc.Service{DB: f.DB, AccessHandlerClient: f.AHClient},
+		}
+
+		var converted, removed int
+		for _, a := range found {
+			var choice string
+			err = survey.AskOne(&survey.Select{
+				Message: fmt.Sprintf("%s has access to %s (%s) outside of Common Fate", a.Subject, a.ProviderID, formatWith(a.With)),
+				Options: []string{convertOption, removeOption, skipOption},
+				Default: skipOption,
+			}, &choice)
+			if err != nil {
+				return err
+			}
+
+			switch choice {
+			case convertOption:
+				if len(groupNames) == 0 {
+					return errors.New("there are no groups which can be given access to the access rule")
+				}
+				var selected []string
+				err = survey.AskOne(&survey.MultiSelect{Message: "The groups who can request this access", Options: groupNames}, &selected, survey.WithValidator(survey.MinItems(1)))
+				if err != nil {
+					return err
+				}
+				var ids []string
+				for _, name := range selected {
+					ids = append(ids, groupIDs[name])
+				}
+				rul, err := rs.CreateAccessRule(ctx, admin.Result.ID, a.CreateAccessRuleRequest(ids, int(maxDuration.Seconds())))
+				if err != nil {
+					return err
+				}
+				clio.Successf("Created access rule %s (%s)", rul.Name, rul.ID)
+
+				remove := true
+				err = survey.AskOne(&survey.Confirm{Message: "Remove the standing access now that it can be requested?", Default: true}, &remove)
+				if err != nil {
+					return err
+				}
+				if remove {
+					err = f.Remove(ctx, a)
+					if err != nil {
+						return err
+					}
+					clio.Successf("Removed access to %s for %s", a.ProviderID, a.Subject)
+					removed++
+				}
+				converted++
+			case removeOption:
+				err = f.Remove(ctx, a)
+				if err != nil {
+					return err
+				}
+				clio.Successf("Removed access to %s for %s", a.ProviderID, a.Subject)
+				removed++
+			}
+		}
+		clio.Infof("Converted %d and removed %d of %d standing access assignments", converted, removed, len(found))
+		return nil
+	},
+}
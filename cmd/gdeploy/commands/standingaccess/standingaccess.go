@@ -0,0 +1,68 @@
+package standingaccess
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/common-fate/common-fate/internal"
+	"github.com/common-fate/common-fate/pkg/cfaws"
+	"github.com/common-fate/common-fate/pkg/deploy"
+	sa "github.com/common-fate/common-fate/pkg/standingaccess"
+	"github.com/common-fate/ddb"
+	"github.com/urfave/cli/v2"
+)
+
+var Command = cli.Command{
+	Name:        "standing-access",
+	Description: "Find access which users hold in providers without a Common Fate grant, and convert it into access rules or remove it",
+	Usage:       "Find and resolve access held outside of Common Fate",
+	Action:      cli.ShowSubcommandHelp,
+	Subcommands: []*cli.Command{
+		&ListCommand,
+		&ResolveCommand,
+	},
+}
+
+// newFinder returns a finder which uses the DynamoDB table and Access Handler of the deployment.
+func newFinder(ctx context.Context) (*sa.Finder, error) {
+	dc, err := deploy.ConfigFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	o, err := dc.LoadOutput(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if o.AccessHandlerApiURL == "" {
+		return nil, errors.New("the Access Handler URL wasn't found in the stack outputs, update your deployment to use this command")
+	}
+	cfg, err := cfaws.ConfigFromContextOrDefault(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db, err := ddb.New(ctx, o.DynamoDBTable, ddb.WithDynamoDBClient(dynamodb.NewFromConfig(cfg)))
+	if err != nil {
+		return nil, err
+	}
+	ahc, err := internal.BuildAccessHandlerClient(ctx, internal.BuildAccessHandlerClientOpts{
+		Region:           o.Region,
+		AccessHandlerURL: o.AccessHandlerApiURL,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &sa.Finder{DB: db, AHClient: ahc}, nil
+}
+
+// formatWith formats the arguments of an assignment as key=value pairs.
+func formatWith(with map[string]string) string {
+	var args []string
+	for k, v := range with {
+		args = append(args, k+"="+v)
+	}
+	sort.Strings(args)
+	return strings.Join(args, ", ")
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/common-fate/clio"
 	"github.com/common-fate/clio/clierr"
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands"
+	"github.com/common-fate/common-fate/cmd/gdeploy/commands/audit"
+	"github.com/common-fate/common-fate/cmd/gdeploy/commands/autoapproval"
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands/backup"
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands/cache"
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands/dashboard"
@@ -15,6 +17,7 @@ import (
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands/provider"
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands/release"
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands/restore"
+	"github.com/common-fate/common-fate/cmd/gdeploy/commands/standingaccess"
 	mw "github.com/common-fate/common-fate/cmd/gdeploy/middleware"
 	"github.com/common-fate/common-fate/internal"
 	"github.com/common-fate/common-fate/internal/build"
@@ -54,6 +57,8 @@ func main() {
 			mw.WithBeforeFuncs(&commands.UpdateCommand, mw.RequireDeploymentConfig(), mw.PreventDevUsage(), mw.VerifyGDeployCompatibility(), mw.RequireAWSCredentials(), mw.RequireCleanGitWorktree()),
 			mw.WithBeforeFuncs(&identity.Command, mw.RequireDeploymentConfig(), mw.VerifyGDeployCompatibility(), mw.RequireAWSCredentials()),
 			mw.WithBeforeFuncs(&backup.Command, mw.RequireDeploymentConfig(), mw.PreventDevUsage(), mw.VerifyGDeployCompatibility(), mw.RequireAWSCredentials()),
+			mw.WithBeforeFuncs(&audit.Command, mw.RequireDeploymentConfig(), mw.VerifyGDeployCompatibility(), mw.RequireAWSCredentials()),
+			mw.WithBeforeFuncs(&standingaccess.Command, mw.RequireDeploymentConfig(), mw.VerifyGDeployCompatibility(), mw.RequireAWSCredentials()),
 			mw.WithBeforeFuncs(&restore.Command, mw.RequireDeploymentConfig(), mw.PreventDevUsage(), mw.VerifyGDeployCompatibility(), mw.RequireAWSCredentials()),
 			mw.WithBeforeFuncs(&provider.Command, mw.RequireDeploymentConfig(), mw.VerifyGDeployCompatibility(), mw.RequireAWSCredentials()),
 			mw.WithBeforeFuncs(&notifications.Command, mw.RequireDeploymentConfig(), mw.VerifyGDeployCompatibility(), mw.RequireAWSCredentials()),
@@ -61,6 +66,7 @@ func main() {
 			mw.WithBeforeFuncs(&cache.Command, mw.RequireDeploymentConfig(), mw.RequireAWSCredentials()),
 			mw.WithBeforeFuncs(&commands.InitCommand, mw.RequireAWSCredentials()),
 			mw.WithBeforeFuncs(&release.Command, mw.RequireDeploymentConfig()),
+			&autoapproval.Command,
 		},
 	}
 
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+
+	"github.com/aws/aws-lambda-go/lambda"
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/pkg/activity/readers"
+	"github.com/common-fate/common-fate/pkg/config"
+	"github.com/common-fate/common-fate/pkg/deploy"
+	"github.com/common-fate/common-fate/pkg/service/activitysvc"
+	"github.com/common-fate/ddb"
+	"github.com/sethvargo/go-envconfig"
+	"go.uber.org/zap"
+)
+
+func main() {
+	var cfg config.ActivityConfig
+	ctx := context.Background()
+
+	err := envconfig.Process(ctx, &cfg)
+	if err != nil {
+		panic(err)
+	}
+	db, err := ddb.New(ctx, cfg.TableName)
+	if err != nil {
+		panic(err)
+	}
+	settings, err := deploy.UnmarshalFeatureMap(cfg.ActivitySettings)
+	if err != nil {
+		panic(err)
+	}
+	activityReaders, err := readers.Load(ctx, settings)
+	if err != nil {
+		panic(err)
+	}
+
+	activities := activitysvc.Service{
+		Clock:   clock.New(),
+		DB:      db,
+		Readers: activityReaders,
+	}
+	log, err := logger.Build(cfg.LogLevel)
+	if err != nil {
+		panic(err)
+	}
+	zap.ReplaceGlobals(log.Desugar())
+	zap.S().Infow("starting activity ingestion", "readers", len(activityReaders))
+	lambda.Start(activities.Run)
+}
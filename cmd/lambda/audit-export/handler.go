@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-lambda-go/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/pkg/cfaws"
+	"github.com/common-fate/common-fate/pkg/config"
+	"github.com/common-fate/common-fate/pkg/service/auditsvc"
+	"github.com/common-fate/ddb"
+	"github.com/sethvargo/go-envconfig"
+	"go.uber.org/zap"
+)
+
+func main() {
+	var cfg config.AuditLogConfig
+	ctx := context.Background()
+
+	err := envconfig.Process(ctx, &cfg)
+	if err != nil {
+		panic(err)
+	}
+	db, err := ddb.New(ctx, cfg.TableName)
+	if err != nil {
+		panic(err)
+	}
+	awsCfg, err := cfaws.ConfigFromContextOrDefault(ctx)
+	if err != nil {
+		panic(err)
+	}
+	log, err := logger.Build(cfg.LogLevel)
+	if err != nil {
+		panic(err)
+	}
+	zap.ReplaceGlobals(log.Desugar())
+
+	s := auditsvc.Service{
+		Clock:     clock.New(),
+		DB:        db,
+		S3:        s3.NewFromConfig(awsCfg),
+		Bucket:    cfg.AuditLogBucket,
+		Retention: time.Duration(cfg.AuditLogRetentionDays) * 24 * time.Hour,
+	}
+	lambda.Start(s.Export)
+}
@@ -16,9 +16,10 @@ import (
 	"github.com/common-fate/common-fate/pkg/auth"
 	"github.com/common-fate/common-fate/pkg/config"
 	"github.com/common-fate/common-fate/pkg/deploy"
-	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/eventsink"
 	"github.com/common-fate/common-fate/pkg/identity/identitysync"
 	"github.com/common-fate/common-fate/pkg/server"
+	"github.com/common-fate/ddb"
 	"github.com/common-fate/provider-registry-sdk-go/pkg/providerregistrysdk"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/sethvargo/go-envconfig"
@@ -58,12 +59,22 @@ func buildHandler() (*Lambda, error) {
 		return nil, err
 	}
 
-	eventBus, err := gevent.NewSender(ctx, gevent.SenderOpts{
-		EventBusARN: cfg.EventBusArn,
+	sink, err := eventsink.New(ctx, eventsink.Opts{
+		EventBusARN:  cfg.EventBusArn,
+		SNSTopicARN:  cfg.EventSNSTopicARN,
+		SQSQueueURL:  cfg.EventSQSQueueURL,
+		KafkaBrokers: cfg.EventKafkaBrokers,
+		KafkaTopic:   cfg.EventKafkaTopic,
 	})
 	if err != nil {
 		return nil, err
 	}
+	db, err := ddb.New(ctx, cfg.DynamoTable)
+	if err != nil {
+		return nil, err
+	}
+	// events which can't be published are written to the outbox, and published by the event relay.
+	eventBus := &eventsink.Publisher{Sink: sink, DB: db}
 
 	dc, err := deploy.GetDeploymentConfig()
 	if err != nil {
@@ -110,6 +121,9 @@ func buildHandler() (*Lambda, error) {
 		ProviderRegistryClient: registryClient,
 		StateMachineARN:        cfg.StateMachineARN,
 		FrontendURL:            cfg.FrontendURL,
+		AutoApprovalPolicy:     cfg.AutoApprovalPolicy,
+		AutoApprovalLambdaARN:  cfg.AutoApprovalLambdaArn,
+		TicketValidatorURL:     cfg.TicketValidatorURL,
 	})
 	if err != nil {
 		return nil, err
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+
+	"github.com/aws/aws-lambda-go/lambda"
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/pkg/config"
+	"github.com/common-fate/common-fate/pkg/deploy"
+	"github.com/common-fate/common-fate/pkg/notifiers/digest"
+	"github.com/common-fate/ddb"
+	"github.com/sethvargo/go-envconfig"
+	"go.uber.org/zap"
+)
+
+func main() {
+	var cfg config.DigestConfig
+	ctx := context.Background()
+
+	err := envconfig.Process(ctx, &cfg)
+	if err != nil {
+		panic(err)
+	}
+	log, err := logger.Build(cfg.LogLevel)
+	if err != nil {
+		panic(err)
+	}
+	zap.ReplaceGlobals(log.Desugar())
+	db, err := ddb.New(ctx, cfg.TableName)
+	if err != nil {
+		panic(err)
+	}
+
+	h := handler{
+		DB:          db,
+		FrontendURL: cfg.FrontendURL,
+	}
+	lambda.Start(h.run)
+}
+
+type handler struct {
+	DB          ddb.Storage
+	FrontendURL string
+}
+
+func (h *handler) run(ctx context.Context) error {
+	dc, err := deploy.GetDeploymentConfig()
+	if err != nil {
+		return err
+	}
+
+	// re-read the notifications config each time the digest runs, so that changes
+	// to the remote config are picked up without redeploying.
+	notificationsConfig, err := dc.ReadNotifications(ctx)
+	if err != nil {
+		return err
+	}
+
+	notifier := digest.DigestNotifier{
+		DB:          h.DB,
+		Clock:       clock.New(),
+		FrontendURL: h.FrontendURL,
+	}
+	err = notifier.Init(ctx, notificationsConfig)
+	if err != nil {
+		zap.S().Errorw("failed to initialise digest notifier", zap.Error(err))
+		return err
+	}
+	return notifier.Run(ctx)
+}
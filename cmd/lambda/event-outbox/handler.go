@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-lambda-go/lambda"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/pkg/config"
+	"github.com/common-fate/common-fate/pkg/eventsink"
+	"github.com/common-fate/ddb"
+	"github.com/sethvargo/go-envconfig"
+	"go.uber.org/zap"
+)
+
+func main() {
+	var cfg config.EventOutboxConfig
+	ctx := context.Background()
+
+	err := envconfig.Process(ctx, &cfg)
+	if err != nil {
+		panic(err)
+	}
+	db, err := ddb.New(ctx, cfg.TableName)
+	if err != nil {
+		panic(err)
+	}
+	sink, err := eventsink.New(ctx, eventsink.Opts{
+		EventBusARN:  cfg.EventBusArn,
+		SNSTopicARN:  cfg.EventSNSTopicARN,
+		SQSQueueURL:  cfg.EventSQSQueueURL,
+		KafkaBrokers: cfg.EventKafkaBrokers,
+		KafkaTopic:   cfg.EventKafkaTopic,
+	})
+	if err != nil {
+		panic(err)
+	}
+	log, err := logger.Build(cfg.LogLevel)
+	if err != nil {
+		panic(err)
+	}
+	zap.ReplaceGlobals(log.Desugar())
+
+	// events are published by the event relay as they are added to the outbox,
+	// so only events which the relay couldn't publish are redelivered here.
+	p := eventsink.Publisher{Sink: sink, DB: db, RedeliverAfter: 5 * time.Minute}
+	lambda.Start(p.Redeliver)
+}
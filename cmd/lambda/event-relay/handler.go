@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-lambda-go/lambda"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/pkg/config"
+	"github.com/common-fate/common-fate/pkg/eventsink"
+	"github.com/common-fate/ddb"
+	"github.com/sethvargo/go-envconfig"
+	"go.uber.org/zap"
+)
+
+func main() {
+	var cfg config.EventOutboxConfig
+	ctx := context.Background()
+
+	err := envconfig.Process(ctx, &cfg)
+	if err != nil {
+		panic(err)
+	}
+	db, err := ddb.New(ctx, cfg.TableName)
+	if err != nil {
+		panic(err)
+	}
+	sink, err := eventsink.New(ctx, eventsink.Opts{
+		EventBusARN:  cfg.EventBusArn,
+		SNSTopicARN:  cfg.EventSNSTopicARN,
+		SQSQueueURL:  cfg.EventSQSQueueURL,
+		KafkaBrokers: cfg.EventKafkaBrokers,
+		KafkaTopic:   cfg.EventKafkaTopic,
+	})
+	if err != nil {
+		panic(err)
+	}
+	log, err := logger.Build(cfg.LogLevel)
+	if err != nil {
+		panic(err)
+	}
+	zap.ReplaceGlobals(log.Desugar())
+
+	h := handler{Publisher: &eventsink.Publisher{Sink: sink, DB: db}}
+	lambda.Start(h.run)
+}
+
+type handler struct {
+	Publisher *eventsink.Publisher
+}
+
+// run relays the events which were added to the outbox, as reported by the DynamoDB stream of the table.
+// Records for events which couldn't be published are reported as batch item failures so that they are retried.
+func (h *handler) run(ctx context.Context, e events.DynamoDBEvent) (events.DynamoDBEventResponse, error) {
+	var outboxKeys []ddb.GetKey
+	sequenceNumbers := map[ddb.GetKey]string{}
+	for _, r := range e.Records {
+		if r.EventName != string(events.DynamoDBOperationTypeInsert) {
+			continue
+		}
+		k := ddb.GetKey{PK: r.Change.Keys["PK"].String(), SK: r.Change.Keys["SK"].String()}
+		outboxKeys = append(outboxKeys, k)
+		sequenceNumbers[k] = r.Change.SequenceNumber
+	}
+
+	var res events.DynamoDBEventResponse
+	for _, k := range h.Publisher.Relay(ctx, outboxKeys) {
+		res.BatchItemFailures = append(res.BatchItemFailures, events.DynamoDBBatchItemFailure{ItemIdentifier: sequenceNumbers[k]})
+	}
+	return res, nil
+}
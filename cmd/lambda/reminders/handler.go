@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+
+	"github.com/aws/aws-lambda-go/lambda"
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/pkg/config"
+	"github.com/common-fate/common-fate/pkg/deploy"
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	"github.com/common-fate/common-fate/pkg/service/remindersvc"
+	"github.com/common-fate/ddb"
+	"github.com/sethvargo/go-envconfig"
+	"go.uber.org/zap"
+)
+
+func main() {
+	var cfg config.RemindersConfig
+	ctx := context.Background()
+
+	err := envconfig.Process(ctx, &cfg)
+	if err != nil {
+		panic(err)
+	}
+	db, err := ddb.New(ctx, cfg.TableName)
+	if err != nil {
+		panic(err)
+	}
+	log, err := logger.Build(cfg.LogLevel)
+	if err != nil {
+		panic(err)
+	}
+	zap.ReplaceGlobals(log.Desugar())
+
+	h := handler{DB: db}
+	lambda.Start(h.run)
+}
+
+type handler struct {
+	DB ddb.Storage
+}
+
+func (h *handler) run(ctx context.Context) error {
+	dc, err := deploy.GetDeploymentConfig()
+	if err != nil {
+		return err
+	}
+	// re-read the notifications config each time the Lambda runs, so that changes
+	// to the remote config are picked up without redeploying.
+	notificationsConfig, err := dc.ReadNotifications(ctx)
+	if err != nil {
+		return err
+	}
+	if notificationsConfig.Reminders == nil {
+		zap.S().Info("expiry reminders are not configured, skipping")
+		return nil
+	}
+	var settings remindersvc.Settings
+	err = settings.Config().Load(ctx, &gconfig.MapLoader{Values: notificationsConfig.Reminders})
+	if err != nil {
+		return err
+	}
+	reminders, err := settings.ExpiryReminders()
+	if err != nil {
+		return err
+	}
+
+	// grant expiring events are written to the outbox with the request, and published by the outbox relay.
+	svc := remindersvc.Service{
+		Clock:           clock.New(),
+		DB:              h.DB,
+		ExpiryReminders: reminders,
+	}
+	return svc.Run(ctx)
+}
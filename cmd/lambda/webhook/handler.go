@@ -2,16 +2,36 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/aws/aws-lambda-go/events"
 	"github.com/aws/aws-lambda-go/lambda"
+	"github.com/aws/aws-lambda-go/lambdacontext"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awslambda "github.com/aws/aws-sdk-go-v2/service/lambda"
+	lambdaTypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/awslabs/aws-lambda-go-api-proxy/handlerfunc"
+	"github.com/benbjohnson/clock"
 	"github.com/common-fate/apikit/apio"
 	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/internal"
 	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/autoapproval"
+	"github.com/common-fate/common-fate/pkg/cfaws"
+	"github.com/common-fate/common-fate/pkg/deploy"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	slacknotifier "github.com/common-fate/common-fate/pkg/notifiers/slack"
+	"github.com/common-fate/common-fate/pkg/service/accesssvc"
+	"github.com/common-fate/common-fate/pkg/service/cachesvc"
+	"github.com/common-fate/common-fate/pkg/service/requestroutersvc"
+	"github.com/common-fate/common-fate/pkg/service/rulesvc"
+	"github.com/common-fate/common-fate/pkg/service/workflowsvc"
+	"github.com/common-fate/common-fate/pkg/service/workflowsvc/runtimes/live"
 	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/ticket"
 	"github.com/common-fate/ddb"
 	"github.com/go-chi/chi/v5"
 	"github.com/pkg/errors"
@@ -55,10 +75,26 @@ func buildHandler() (*Lambda, error) {
 type Config struct {
 	LogLevel    string `env:"LOG_LEVEL,default=info"`
 	DynamoTable string `env:"COMMONFATE_TABLE_NAME,required"`
+	// The below config is used by Slack interactivity and the Slack command, which act on behalf of Slack users.
+	Region            string `env:"AWS_REGION"`
+	FrontendURL       string `env:"COMMONFATE_FRONTEND_URL"`
+	AccessHandlerURL  string `env:"COMMONFATE_ACCESS_HANDLER_URL,default=http://0.0.0.0:9092"`
+	MockAccessHandler bool   `env:"COMMONFATE_MOCK_ACCESS_HANDLER,default=false"`
+	EventBusArn       string `env:"COMMONFATE_EVENT_BUS_ARN"`
+	StateMachineARN   string `env:"COMMONFATE_GRANTER_V2_STATE_MACHINE_ARN"`
+	// The below config is used by the Slack command to create requests in the same way as the API.
+	AutoApprovalLambdaArn string `env:"COMMONFATE_AUTO_APPROVAL_LAMBDA_ARN"`
+	AutoApprovalPolicy    string `env:"COMMONFATE_AUTO_APPROVAL_POLICY"`
+	TicketValidatorURL    string `env:"COMMONFATE_TICKET_VALIDATOR_URL"`
+	// AdminGroup is used by the Slack command to let admins revoke any request.
+	AdminGroup string `env:"COMMONFATE_ADMIN_GROUP"`
 }
 
 type Server struct {
-	db *ddb.Client
+	db  *ddb.Client
+	cfg Config
+	// lambda invokes the webhook Lambda to handle Slack requests after they have been acknowledged.
+	lambda *awslambda.Client
 }
 
 func NewServer(ctx context.Context, cfg Config) (*Server, error) {
@@ -66,17 +102,22 @@ func NewServer(ctx context.Context, cfg Config) (*Server, error) {
 	if err != nil {
 		return nil, err
 	}
+	awsCfg, err := cfaws.ConfigFromContextOrDefault(ctx)
+	if err != nil {
+		return nil, err
+	}
 	s := Server{
-		db: db,
+		db:     db,
+		cfg:    cfg,
+		lambda: awslambda.NewFromConfig(awsCfg),
 	}
 	return &s, nil
 }
 
 func (s *Server) Routes() http.Handler {
 	r := chi.NewRouter()
-	r.Post("/webhook/v1/slack/interactivity", func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-	})
+	r.Post("/webhook/v1/slack/interactivity", s.handleSlackInteractivity)
+	r.Post("/webhook/v1/slack/command", s.handleSlackCommand)
 
 	r.Post("/webhook/v1/access-token/verify", func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
@@ -171,13 +212,207 @@ func (s *Server) Routes() http.Handler {
 	return r
 }
 
+// handleSlackInteractivity handles review actions from Slack messages.
+// If Slack interactivity isn't configured, requests are acknowledged and ignored.
+func (s *Server) handleSlackInteractivity(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	notifier, svc, err := s.buildSlackServices(ctx)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	if notifier == nil {
+		logger.Get(ctx).Infow("slack interactivity is not configured, ignoring request")
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	h := slacknotifier.InteractivityHandler{
+		DB:            s.db,
+		Access:        svc.access,
+		Notifier:      notifier,
+		SigningSecret: notifier.Interactivity().SigningSecret(),
+		Defer:         s.deferSlackRequest(ctx),
+	}
+	h.ServeHTTP(w, r)
+}
+
+// handleSlackCommand handles the /access slash command.
+// If Slack interactivity isn't configured, requests are acknowledged and ignored.
+func (s *Server) handleSlackCommand(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	notifier, svc, err := s.buildSlackServices(ctx)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	if notifier == nil {
+		logger.Get(ctx).Infow("slack interactivity is not configured, ignoring command")
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	h := slacknotifier.CommandHandler{
+		DB:            s.db,
+		Rules:         svc.rules,
+		Access:        svc.access,
+		Workflow:      svc.workflow,
+		SigningSecret: notifier.Interactivity().SigningSecret(),
+		FrontendURL:   s.cfg.FrontendURL,
+		AdminGroup:    s.cfg.AdminGroup,
+		Slack:         notifier.Client(),
+		Defer:         s.deferSlackRequest(ctx),
+	}
+	h.ServeHTTP(w, r)
+}
+
+// deferSlackRequest returns a function which invokes the webhook Lambda asynchronously with the Slack request
+// being served, so that it's handled after Slack has been sent an acknowledgement.
+// nil is returned if the request is already being handled after it was deferred.
+func (s *Server) deferSlackRequest(ctx context.Context) slacknotifier.DeferFunc {
+	req, ok := ctx.Value(proxyRequestKey{}).(events.APIGatewayProxyRequest)
+	if !ok {
+		return nil
+	}
+	return func(ctx context.Context) error {
+		payload, err := json.Marshal(deferredSlackRequest{Request: &req})
+		if err != nil {
+			return err
+		}
+		_, err = s.lambda.Invoke(ctx, &awslambda.InvokeInput{
+			FunctionName:   aws.String(lambdacontext.FunctionName),
+			InvocationType: lambdaTypes.InvocationTypeEvent,
+			Payload:        payload,
+		})
+		return err
+	}
+}
+
+type services struct {
+	access   *accesssvc.Service
+	rules    *rulesvc.Service
+	workflow *workflowsvc.Service
+}
+
+// buildSlackServices initialises the Slack notifier and the services used to act on behalf of Slack users.
+// The notification config is read on each request so that changes to the Slack settings
+// are picked up without redeploying the Lambda.
+// A nil notifier is returned if Slack interactivity isn't configured.
+func (s *Server) buildSlackServices(ctx context.Context) (*slacknotifier.SlackNotifier, *services, error) {
+	dc, err := deploy.GetDeploymentConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+	notificationsConfig, err := dc.ReadNotifications(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	notifier := slacknotifier.SlackNotifier{
+		DB:          s.db,
+		FrontendURL: s.cfg.FrontendURL,
+	}
+	err = notifier.Init(ctx, notificationsConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	if notifier.Interactivity() == nil {
+		return nil, nil, nil
+	}
+
+	ahc, err := internal.BuildAccessHandlerClient(ctx, internal.BuildAccessHandlerClientOpts{Region: s.cfg.Region, AccessHandlerURL: s.cfg.AccessHandlerURL, MockAccessHandler: s.cfg.MockAccessHandler})
+	if err != nil {
+		return nil, nil, err
+	}
+	eventBus, err := gevent.NewSender(ctx, gevent.SenderOpts{
+		EventBusARN: s.cfg.EventBusArn,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	autoApproval := autoapproval.Service{LambdaARN: s.cfg.AutoApprovalLambdaArn}
+	if s.cfg.AutoApprovalPolicy != "" {
+		autoApproval.Policy, err = autoapproval.ParsePolicy([]byte(s.cfg.AutoApprovalPolicy))
+		if err != nil {
+			return nil, nil, fmt.Errorf("parsing auto-approval policy: %w", err)
+		}
+	}
+	var ticketValidator ticket.Validator
+	if s.cfg.TicketValidatorURL != "" {
+		ticketValidator = &ticket.WebhookValidator{URL: s.cfg.TicketValidatorURL}
+	}
+
+	clk := clock.New()
+	cache := &cachesvc.Service{
+		ProviderConfigReader: dc,
+		DB:                   s.db,
+		AccessHandlerClient:  ahc,
+	}
+	rules := &rulesvc.Service{
+		Clock:    clk,
+		DB:       s.db,
+		AHClient: ahc,
+		Cache:    cache,
+	}
+	workflow := &workflowsvc.Service{
+		Runtime: &live.Runtime{
+			StateMachineARN: s.cfg.StateMachineARN,
+			AHClient:        ahc,
+			Eventbus:        eventBus,
+			DB:              s.db,
+			RequestRouter: &requestroutersvc.Service{
+				DB: s.db,
+			},
+		},
+		DB:  s.db,
+		Clk: clk,
+	}
+	svc := services{
+		access: &accesssvc.Service{
+			Clock:           clk,
+			DB:              s.db,
+			Cache:           cache,
+			Rules:           rules,
+			AHClient:        ahc,
+			AutoApproval:    autoApproval,
+			TicketValidator: ticketValidator,
+			Workflow:        workflow,
+		},
+		rules:    rules,
+		workflow: workflow,
+	}
+	return &notifier, &svc, nil
+}
+
 type Lambda struct {
 	Server http.Handler
 }
 
-func (h *Lambda) Handler(ctx context.Context, req events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+// deferredSlackRequest is the event which the webhook Lambda invokes itself with to handle a Slack request
+// after Slack has been sent an acknowledgement.
+type deferredSlackRequest struct {
+	Request *events.APIGatewayProxyRequest `json:"deferredSlackRequest"`
+}
+
+// proxyRequestKey is the context key of the API Gateway request being served, which is used to defer Slack requests.
+type proxyRequestKey struct{}
+
+func (h *Lambda) Handler(ctx context.Context, event json.RawMessage) (events.APIGatewayProxyResponse, error) {
 	adapter := handlerfunc.New(h.Server.ServeHTTP)
-	return adapter.ProxyWithContext(ctx, req)
+
+	var deferred deferredSlackRequest
+	err := json.Unmarshal(event, &deferred)
+	if err != nil {
+		return events.APIGatewayProxyResponse{}, err
+	}
+	if deferred.Request != nil {
+		// Slack has already been sent a response, so the response to a deferred request isn't used.
+		return adapter.ProxyWithContext(ctx, *deferred.Request)
+	}
+
+	var req events.APIGatewayProxyRequest
+	err = json.Unmarshal(event, &req)
+	if err != nil {
+		return events.APIGatewayProxyResponse{}, err
+	}
+	return adapter.ProxyWithContext(context.WithValue(ctx, proxyRequestKey{}, req), req)
 }
 
 type RecordingEventBody struct {
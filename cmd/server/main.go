@@ -3,22 +3,36 @@ package main
 import (
 	"context"
 	"log"
+	"time"
 
 	"github.com/common-fate/apikit/logger"
 	ahConfig "github.com/common-fate/common-fate/accesshandler/pkg/config"
 	"github.com/common-fate/common-fate/accesshandler/pkg/psetup"
+	"github.com/common-fate/common-fate/accesshandler/pkg/runtime/local"
 	ahServer "github.com/common-fate/common-fate/accesshandler/pkg/server"
 	"github.com/common-fate/common-fate/internal"
 	"github.com/common-fate/common-fate/internal/build"
+	"github.com/common-fate/common-fate/pkg/activity/readers"
 	"github.com/common-fate/common-fate/pkg/api"
 	"github.com/common-fate/common-fate/pkg/auth"
 	"github.com/common-fate/common-fate/pkg/auth/localauth"
 	"github.com/common-fate/common-fate/pkg/auth/nolocalauth"
 	"github.com/common-fate/common-fate/pkg/deploy"
+	"github.com/common-fate/common-fate/pkg/eventhandler"
+	"github.com/common-fate/common-fate/pkg/eventsink"
+	"github.com/common-fate/common-fate/pkg/gconfig"
 	"github.com/common-fate/common-fate/pkg/gevent"
 	"github.com/common-fate/common-fate/pkg/identity/identitysync"
+	"github.com/common-fate/common-fate/pkg/schedule"
+	"github.com/common-fate/common-fate/pkg/service/activitysvc"
+	"github.com/common-fate/common-fate/pkg/service/auditsvc"
+	"github.com/common-fate/common-fate/pkg/service/escalationsvc"
+	"github.com/common-fate/common-fate/pkg/service/reconcilesvc"
+	"github.com/common-fate/common-fate/pkg/service/remindersvc"
+	"github.com/common-fate/ddb"
 	"github.com/common-fate/provider-registry-sdk-go/pkg/providerregistrysdk"
 
+	"github.com/benbjohnson/clock"
 	"github.com/common-fate/common-fate/pkg/config"
 	"github.com/common-fate/common-fate/pkg/server"
 	"github.com/getsentry/sentry-go"
@@ -28,12 +42,6 @@ import (
 )
 
 func main() {
-	go func() {
-		err := runAccessHandler()
-		if err != nil {
-			log.Fatal(err)
-		}
-	}()
 	err := run()
 	if err != nil {
 		log.Fatal(err)
@@ -89,12 +97,45 @@ func run() error {
 		return err
 	}
 
-	eventBus, err := gevent.NewSender(ctx, gevent.SenderOpts{
-		EventBusARN: cfg.EventBusArn,
-	})
+	db, err := ddb.New(ctx, cfg.DynamoTable)
+	if err != nil {
+		return err
+	}
+	sinkOpts := eventsink.Opts{
+		EventBusARN:  cfg.EventBusArn,
+		SNSTopicARN:  cfg.EventSNSTopicARN,
+		SQSQueueURL:  cfg.EventSQSQueueURL,
+		KafkaBrokers: cfg.EventKafkaBrokers,
+		KafkaTopic:   cfg.EventKafkaTopic,
+	}
+	if cfg.LocalEvents {
+		// there is no EventBridge rule to invoke the event handler when running locally,
+		// so events are handled in-process instead.
+		eh, err := eventhandler.New(ctx, db)
+		if err != nil {
+			return err
+		}
+		sinkOpts.Bus = &eventsink.Bus{}
+		sinkOpts.Bus.Subscribe(eh)
+		// the audit log isn't exported locally, but entries are appended so that the log can be inspected.
+		sinkOpts.Bus.Subscribe(&auditsvc.Service{Clock: clock.New(), DB: db})
+		sinkOpts.EventBusARN = ""
+	}
+	sink, err := eventsink.New(ctx, sinkOpts)
 	if err != nil {
 		return err
 	}
+	eventBus := &eventsink.Publisher{Sink: sink, DB: db}
+	// the deployed stack relays events from the outbox using the DynamoDB stream of the table,
+	// so when running locally we poll the outbox instead.
+	go schedule.RunEvery(ctx, clock.New(), 2*time.Second, "failed to redeliver outbox events", eventBus.Redeliver)
+
+	go func() {
+		err := runAccessHandler(eventBus)
+		if err != nil {
+			log.Fatalw("failed to run access handler", zap.Error(err))
+		}
+	}()
 
 	dc, err := deploy.GetDeploymentConfig()
 	if err != nil {
@@ -143,10 +184,72 @@ func run() error {
 		ProviderRegistryClient: registryClient,
 		StateMachineARN:        cfg.StateMachineARN,
 		FrontendURL:            cfg.FrontendURL,
+		AutoApprovalPolicy:     cfg.AutoApprovalPolicy,
+		AutoApprovalLambdaARN:  cfg.AutoApprovalLambdaArn,
+		TicketValidatorURL:     cfg.TicketValidatorURL,
 	})
 	if err != nil {
 		return err
 	}
+	// the deployed stack runs escalations from a scheduled Lambda function,
+	// so when running locally we check for requests to escalate in the background.
+	escalations := escalationsvc.Service{
+		Clock: clock.New(),
+		DB:    db,
+	}
+	go escalations.RunEvery(ctx, time.Minute)
+
+	// expiry reminders are also sent from a scheduled Lambda function in the deployed stack.
+	notificationsConfig, err := dc.ReadNotifications(ctx)
+	if err != nil {
+		log.Infow("notifications are not configured, expiry reminders won't be sent", zap.Error(err))
+	} else if notificationsConfig.Reminders != nil {
+		var settings remindersvc.Settings
+		err = settings.Config().Load(ctx, &gconfig.MapLoader{Values: notificationsConfig.Reminders})
+		if err != nil {
+			return err
+		}
+		expiryReminders, err := settings.ExpiryReminders()
+		if err != nil {
+			return err
+		}
+		reminders := remindersvc.Service{
+			Clock:           clock.New(),
+			DB:              db,
+			ExpiryReminders: expiryReminders,
+		}
+		go reminders.RunEvery(ctx, time.Minute)
+	}
+
+	// grants are reconciled against the providers from a scheduled Lambda function in the deployed stack.
+	// There's nothing to reconcile against when the Access Handler is mocked.
+	if ahc != nil {
+		reconciler := reconcilesvc.Service{
+			Clock:      clock.New(),
+			DB:         db,
+			AHClient:   ahc,
+			AutoRevoke: cfg.ReconcilerAutoRevoke,
+		}
+		go reconciler.RunEvery(ctx, 15*time.Minute)
+	}
+
+	activitySettings, err := deploy.UnmarshalFeatureMap(cfg.ActivitySettings)
+	if err != nil {
+		return err
+	}
+	if len(activitySettings) > 0 {
+		activityReaders, err := readers.Load(ctx, activitySettings)
+		if err != nil {
+			return err
+		}
+		activities := activitysvc.Service{
+			Clock:   clock.New(),
+			DB:      db,
+			Readers: activityReaders,
+		}
+		go activities.RunEvery(ctx, 5*time.Minute)
+	}
+
 	s, err := server.New(ctx, server.Config{
 		Config:         cfg,
 		Log:            log,
@@ -161,8 +264,9 @@ func run() error {
 	return s.Start(ctx)
 }
 
-// runAccessHandler runs a version of the access handler locally if COMMONFATE_RUN_ACCESS_HANDLER env var is not false, if not set it defaults to true
-func runAccessHandler() error {
+// runAccessHandler runs a version of the access handler locally if COMMONFATE_RUN_ACCESS_HANDLER env var is not false, if not set it defaults to true.
+// When using the local runtime, grant events are emitted to eventPutter so that they are handled in the same way as events from the API.
+func runAccessHandler(eventPutter gevent.EventPutter) error {
 	ctx := context.Background()
 	_ = godotenv.Load()
 
@@ -179,7 +283,12 @@ func runAccessHandler() error {
 			return err
 		}
 
-		s, err := ahServer.New(ctx, cfg)
+		var s *ahServer.Server
+		if cfg.Runtime == "local" {
+			s, err = ahServer.NewWithRuntime(ctx, cfg, &local.Runtime{EventPutter: eventPutter})
+		} else {
+			s, err = ahServer.New(ctx, cfg)
+		}
 		if err != nil {
 			return err
 		}
@@ -18,7 +18,15 @@ const samlMetadata = app.node.tryGetContext("samlMetadata");
 const adminGroupId = app.node.tryGetContext("adminGroupId");
 const providerConfig = app.node.tryGetContext("providerConfiguration");
 const identityConfig = app.node.tryGetContext("identityConfiguration");
+const activityConfig = app.node.tryGetContext("activityConfiguration");
 const autoApprovalLambdaARN = app.node.tryGetContext("autoApprovalLambdaARN");
+const autoApprovalPolicy = app.node.tryGetContext("autoApprovalPolicy");
+const ticketValidatorUrl = app.node.tryGetContext("ticketValidatorUrl");
+const reconcilerAutoRevoke = app.node.tryGetContext("reconcilerAutoRevoke");
+const eventSnsTopicArn = app.node.tryGetContext("eventSnsTopicArn");
+const eventSqsQueueUrl = app.node.tryGetContext("eventSqsQueueUrl");
+const eventKafkaBrokers = app.node.tryGetContext("eventKafkaBrokers");
+const eventKafkaTopic = app.node.tryGetContext("eventKafkaTopic");
 const notificationsConfiguration = app.node.tryGetContext(
   "notificationsConfiguration"
 );
@@ -108,6 +116,16 @@ if (stackTarget === "dev") {
     idpSyncSchedule: idpSyncSchedule || "rate(5 minutes)",
     idpSyncTimeoutSeconds: idpSyncTimeoutSeconds || 30,
     autoApprovalLambdaARN: autoApprovalLambdaARN,
+    autoApprovalPolicy: autoApprovalPolicy || "",
+    ticketValidatorUrl: ticketValidatorUrl || "",
+    activityConfiguration: activityConfig || "{}",
+    reconcilerAutoRevoke: reconcilerAutoRevoke || "false",
+    eventSinks: {
+      snsTopicArn: eventSnsTopicArn || "",
+      sqsQueueUrl: eventSqsQueueUrl || "",
+      kafkaBrokers: eventKafkaBrokers || "",
+      kafkaTopic: eventKafkaTopic || "",
+    },
   });
 } else if (stackTarget === "prod") {
   new CommonFateStackProd(app, "Granted", {
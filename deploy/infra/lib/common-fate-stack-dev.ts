@@ -14,6 +14,7 @@ import { generateOutputs } from "./helpers/outputs";
 import { IdentityProviderTypes } from "./helpers/registry";
 import { Governance } from "./constructs/governance";
 import { TargetGroupGranter } from "./constructs/targetgroup-granter";
+import { EventSinkConfiguration } from "./helpers/event-sinks";
 
 interface Props extends cdk.StackProps {
   stage: string;
@@ -40,6 +41,11 @@ interface Props extends cdk.StackProps {
   idpSyncSchedule: string;
   idpSyncMemory: number;
   autoApprovalLambdaARN: string;
+  autoApprovalPolicy: string;
+  ticketValidatorUrl: string;
+  activityConfiguration: string;
+  reconcilerAutoRevoke: string;
+  eventSinks: EventSinkConfiguration;
 }
 
 export class CommonFateStackDev extends cdk.Stack {
@@ -69,6 +75,11 @@ export class CommonFateStackDev extends cdk.Stack {
       idpSyncSchedule,
       idpSyncMemory,
       autoApprovalLambdaARN,
+      autoApprovalPolicy,
+      ticketValidatorUrl,
+      activityConfiguration,
+      reconcilerAutoRevoke,
+      eventSinks,
     } = props;
     const appName = `common-fate-${stage}`;
 
@@ -164,7 +175,12 @@ export class CommonFateStackDev extends cdk.Stack {
         props.shouldRunCronHealthCheckCacheSync || false,
       targetGroupGranter: targetGroupGranter,
       identityGroupFilter,
-      autoApprovalLambdaARN: autoApprovalLambdaARN
+      autoApprovalLambdaARN: autoApprovalLambdaARN,
+      autoApprovalPolicy: autoApprovalPolicy,
+      ticketValidatorUrl: ticketValidatorUrl,
+      activityConfiguration: activityConfiguration,
+      reconcilerAutoRevoke: reconcilerAutoRevoke,
+      eventSinks: eventSinks,
     });
 
     /* Outputs */
@@ -209,6 +225,8 @@ export class CommonFateStackDev extends cdk.Stack {
       HealthcheckFunctionName: appBackend.getHealthChecker().getFunctionName(),
       HealthcheckLogGroupName: appBackend.getHealthChecker().getLogGroupName(),
       GranterV2StateMachineArn: targetGroupGranter.getStateMachineARN(),
+      AuditLogBucketName: appBackend.getAuditLog().getBucketName(),
+      AccessHandlerApiURL: accessHandler.getApiUrl(),
     });
   }
 }
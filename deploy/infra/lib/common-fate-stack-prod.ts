@@ -217,6 +217,67 @@ export class CommonFateStackProd extends cdk.Stack {
         }
     )
 
+    const autoApprovalPolicy = new CfnParameter(this, "AutoApprovalPolicy", {
+      type: "String",
+      description:
+        "A YAML or JSON auto-approval policy which is evaluated when an access request is created.",
+      default: "",
+    });
+
+    const ticketValidatorUrl = new CfnParameter(this, "TicketValidatorURL", {
+      type: "String",
+      description:
+        "An optional URL which ticket references on access requests are sent to for validation.",
+      default: "",
+    });
+
+    const reconcilerAutoRevoke = new CfnParameter(
+      this,
+      "ReconcilerAutoRevoke",
+      {
+        type: "String",
+        description:
+          "If true, access which remains in a provider after the grant has ended is removed by the grant reconciler.",
+        allowedValues: ["true", "false"],
+        default: "false",
+      }
+    );
+
+    const eventSnsTopicArn = new CfnParameter(this, "EventSNSTopicARN", {
+      type: "String",
+      description:
+        "An optional SNS topic which events are published to in addition to the event bus.",
+      default: "",
+    });
+
+    const eventSqsQueueUrl = new CfnParameter(this, "EventSQSQueueURL", {
+      type: "String",
+      description:
+        "An optional SQS queue URL which events are sent to in addition to the event bus.",
+      default: "",
+    });
+
+    const eventKafkaBrokers = new CfnParameter(this, "EventKafkaBrokers", {
+      type: "String",
+      description:
+        "An optional comma separated list of Kafka brokers which events are written to in addition to the event bus.",
+      default: "",
+    });
+
+    const eventKafkaTopic = new CfnParameter(this, "EventKafkaTopic", {
+      type: "String",
+      description:
+        "The Kafka topic which events are written to. Required if EventKafkaBrokers is set.",
+      default: "",
+    });
+
+    const activityConfig = new CfnParameter(this, "ActivityConfiguration", {
+      type: "String",
+      description:
+        "The provider audit log configuration for the per-grant activity trail in JSON format",
+      default: "{}",
+    });
+
     const appName = this.stackName + suffix.valueAsString;
 
     const db = new Database(this, "Database", {
@@ -312,7 +373,17 @@ export class CommonFateStackProd extends cdk.Stack {
       idpSyncTimeoutSeconds: idpSyncTimeoutSeconds.valueAsNumber,
       targetGroupGranter: targetGroupGranter,
       identityGroupFilter: identityGroupFilter.valueAsString,
-      autoApprovalLambdaARN: autoApprovalLambdaARN.valueAsString
+      autoApprovalLambdaARN: autoApprovalLambdaARN.valueAsString,
+      autoApprovalPolicy: autoApprovalPolicy.valueAsString,
+      ticketValidatorUrl: ticketValidatorUrl.valueAsString,
+      activityConfiguration: activityConfig.valueAsString,
+      reconcilerAutoRevoke: reconcilerAutoRevoke.valueAsString,
+      eventSinks: {
+        snsTopicArn: eventSnsTopicArn.valueAsString,
+        sqsQueueUrl: eventSqsQueueUrl.valueAsString,
+        kafkaBrokers: eventKafkaBrokers.valueAsString,
+        kafkaTopic: eventKafkaTopic.valueAsString,
+      },
     });
 
     new ProductionFrontendDeployer(this, "FrontendDeployer", {
@@ -371,6 +442,8 @@ export class CommonFateStackProd extends cdk.Stack {
       HealthcheckFunctionName: appBackend.getHealthChecker().getFunctionName(),
       HealthcheckLogGroupName: appBackend.getHealthChecker().getLogGroupName(),
       GranterV2StateMachineArn: targetGroupGranter.getStateMachineARN(),
+      AuditLogBucketName: appBackend.getAuditLog().getBucketName(),
+      AccessHandlerApiURL: accessHandler.getApiUrl(),
     });
   }
 }
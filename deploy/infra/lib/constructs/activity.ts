@@ -0,0 +1,81 @@
+import { Duration, Stack } from "aws-cdk-lib";
+import { Table } from "aws-cdk-lib/aws-dynamodb";
+import * as events from "aws-cdk-lib/aws-events";
+import * as targets from "aws-cdk-lib/aws-events-targets";
+import { PolicyStatement } from "aws-cdk-lib/aws-iam";
+import * as lambda from "aws-cdk-lib/aws-lambda";
+import { Construct } from "constructs";
+import * as path from "path";
+
+interface Props {
+  dynamoTable: Table;
+  activityConfiguration: string;
+}
+
+// Activity periodically reads provider audit logs, such as AWS CloudTrail and the Okta System Log,
+// and attributes the actions users performed to the grants of their access requests.
+export class Activity extends Construct {
+  private _lambda: lambda.Function;
+  private eventRule: events.Rule;
+
+  constructor(scope: Construct, id: string, props: Props) {
+    super(scope, id);
+    const code = lambda.Code.fromAsset(
+      path.join(__dirname, "..", "..", "..", "..", "bin", "activity.zip")
+    );
+
+    this._lambda = new lambda.Function(this, "HandlerFunction", {
+      code,
+      timeout: Duration.minutes(5),
+      environment: {
+        COMMONFATE_TABLE_NAME: props.dynamoTable.tableName,
+        COMMONFATE_ACTIVITY_SETTINGS: props.activityConfiguration,
+      },
+      runtime: lambda.Runtime.GO_1_X,
+      handler: "activity",
+    });
+
+    props.dynamoTable.grantReadWriteData(this._lambda);
+
+    //add event bridge trigger to lambda every 5 minutes
+    this.eventRule = new events.Rule(this, "EventBridgeCronRule", {
+      schedule: events.Schedule.cron({ minute: "0/5" }),
+    });
+
+    // add the Lambda function as a target for the Event Rule
+    this.eventRule.addTarget(new targets.LambdaFunction(this._lambda));
+
+    // allow the Event Rule to invoke the Lambda function
+    targets.addLambdaPermission(this.eventRule, this._lambda);
+
+    this._lambda.addToRolePolicy(
+      new PolicyStatement({
+        actions: ["cloudtrail:LookupEvents"],
+        resources: ["*"],
+      })
+    );
+    this._lambda.addToRolePolicy(
+      new PolicyStatement({
+        actions: ["ssm:GetParameter"],
+        resources: [
+          `arn:aws:ssm:${Stack.of(this).region}:${
+            Stack.of(this).account
+          }:parameter/granted/secrets/activity/*`,
+        ],
+      })
+    );
+    // CloudTrail readers may be configured with a role in another account which has access to its audit log.
+    this._lambda.addToRolePolicy(
+      new PolicyStatement({
+        actions: ["sts:AssumeRole"],
+        resources: ["*"],
+      })
+    );
+  }
+  getLogGroupName(): string {
+    return this._lambda.logGroup.logGroupName;
+  }
+  getFunctionName(): string {
+    return this._lambda.functionName;
+  }
+}
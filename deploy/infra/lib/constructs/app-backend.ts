@@ -17,11 +17,23 @@ import { Governance } from "./governance";
 import { IdpSync } from "./idp-sync";
 import { Notifiers } from "./notifiers";
 import { HealthChecker } from "./healthchecker";
+import { Digest } from "./digest";
+import { Escalation } from "./escalation";
+import { Reminders } from "./reminders";
+import { Reconciler } from "./reconciler";
+import { EventOutbox } from "./event-outbox";
+import { Activity } from "./activity";
+import { AuditLog } from "./audit-log";
 import { TargetGroupGranter } from "./targetgroup-granter";
 import {
   grantAssumeHandlerRole,
   grantAssumeIdentitySyncRole,
 } from "../helpers/permissions";
+import {
+  EventSinkConfiguration,
+  eventSinkEnvironment,
+  grantPublishToEventSinks,
+} from "../helpers/event-sinks";
 
 interface Props {
   appName: string;
@@ -52,6 +64,11 @@ interface Props {
   targetGroupGranter: TargetGroupGranter;
   identityGroupFilter: string;
   autoApprovalLambdaARN: string;
+  autoApprovalPolicy: string;
+  ticketValidatorUrl: string;
+  activityConfiguration: string;
+  reconcilerAutoRevoke: string;
+  eventSinks: EventSinkConfiguration;
 }
 
 export class AppBackend extends Construct {
@@ -64,6 +81,13 @@ export class AppBackend extends Construct {
   private _idpSync: IdpSync;
   private _cacheSync: CacheSync;
   private _healthChecker: HealthChecker;
+  private _escalation: Escalation;
+  private _digest: Digest;
+  private _reminders: Reminders;
+  private _reconciler: Reconciler;
+  private _eventOutbox: EventOutbox;
+  private _activity: Activity;
+  private _auditLog: AuditLog;
   private _KMSkey: cdk.aws_kms.Key;
   private _webhook: apigateway.Resource;
   private _webhookLambda: lambda.Function;
@@ -75,6 +99,9 @@ export class AppBackend extends Construct {
 
     this._dynamoTable = props.dynamoTable;
 
+    // the access handler granter reads extensions to grants from the requests in the table
+    props.accessHandler.getGranter().allowExtensions(this._dynamoTable);
+
     this._KMSkey = props.kmsKey;
 
     // used to handle webhook events from third party integrations such as Slack
@@ -87,11 +114,75 @@ export class AppBackend extends Construct {
       handler: "webhook",
       environment: {
         COMMONFATE_TABLE_NAME: this._dynamoTable.tableName,
+        COMMONFATE_FRONTEND_URL: props.frontendUrl,
+        COMMONFATE_MOCK_ACCESS_HANDLER: "false",
+        COMMONFATE_ACCESS_HANDLER_URL: props.accessHandler.getApiUrl(),
+        COMMONFATE_EVENT_BUS_ARN: props.eventBus.eventBusArn,
+        COMMONFATE_GRANTER_V2_STATE_MACHINE_ARN:
+          props.targetGroupGranter.getStateMachineARN(),
+        COMMONFATE_NOTIFICATIONS_SETTINGS: props.notificationsConfiguration,
+        COMMONFATE_PROVIDER_CONFIG: props.providerConfig,
+        COMMONFATE_ACCESS_REMOTE_CONFIG_URL: props.remoteConfigUrl,
+        COMMONFATE_REMOTE_CONFIG_HEADERS: props.remoteConfigHeaders,
+        COMMONFATE_AUTO_APPROVAL_LAMBDA_ARN: props.autoApprovalLambdaARN,
+        COMMONFATE_AUTO_APPROVAL_POLICY: props.autoApprovalPolicy,
+        COMMONFATE_TICKET_VALIDATOR_URL: props.ticketValidatorUrl,
+        COMMONFATE_ADMIN_GROUP: props.adminGroupId,
       },
     });
 
     this._dynamoTable.grantReadWriteData(this._webhookLambda);
 
+    // Slack requests are acknowledged straight away, and handled by invoking the webhook lambda again asynchronously.
+    // The policy is separate from the lambda's default policy, which the lambda depends on, to avoid a circular dependency.
+    new iam.Policy(this, "WebhookInvokeSelfPolicy", {
+      roles: [this._webhookLambda.role!],
+      statements: [
+        new PolicyStatement({
+          actions: ["lambda:InvokeFunction"],
+          resources: [this._webhookLambda.functionArn],
+        }),
+      ],
+    });
+
+    // The Slack command creates requests, which may be auto-approved by the auto-approval lambda.
+    if (props.autoApprovalLambdaARN.length !== 0) {
+      this._webhookLambda.addToRolePolicy(
+        new PolicyStatement({
+          effect: iam.Effect.ALLOW,
+          resources: [props.autoApprovalLambdaARN],
+          actions: ["lambda:InvokeFunction"],
+        })
+      );
+    }
+
+    // Slack interactivity and the Slack command act on behalf of Slack users, which requires
+    // the Slack secrets and the same permissions used by the API to grant access.
+    this._webhookLambda.addToRolePolicy(
+      new PolicyStatement({
+        actions: ["ssm:GetParameter"],
+        resources: [
+          `arn:aws:ssm:${Stack.of(this).region}:${
+            Stack.of(this).account
+          }:parameter/granted/secrets/notifications/*`,
+        ],
+      })
+    );
+    this._webhookLambda.addToRolePolicy(
+      new PolicyStatement({
+        actions: ["states:StartExecution", "states:StopExecution"],
+        // @TODO this should be specific to the v2 granter step function
+        resources: ["*"],
+      })
+    );
+    this._webhookLambda.addToRolePolicy(
+      new PolicyStatement({
+        resources: [props.accessHandler.getApiGateway().arnForExecuteApi()],
+        actions: ["execute-api:Invoke"],
+      })
+    );
+    props.eventBus.grantPutEventsTo(this._webhookLambda);
+
     this._apigateway = new apigateway.RestApi(this, "RestAPI", {
       restApiName: this._appName,
     });
@@ -145,11 +236,17 @@ export class AppBackend extends Construct {
         CF_ANALYTICS_DEPLOYMENT_STAGE: props.analyticsDeploymentStage,
         COMMONFATE_IDENTITY_GROUP_FILTER: props.identityGroupFilter,
         COMMONFATE_AUTO_APPROVAL_LAMBDA_ARN: props.autoApprovalLambdaARN,
+        COMMONFATE_AUTO_APPROVAL_POLICY: props.autoApprovalPolicy,
+        COMMONFATE_TICKET_VALIDATOR_URL: props.ticketValidatorUrl,
+        ...eventSinkEnvironment(props.eventSinks),
       },
       runtime: lambda.Runtime.GO_1_X,
       handler: "commonfate",
     });
 
+    // the API publishes events as requests are updated, and writes them to the outbox if they can't be published.
+    grantPublishToEventSinks(this, props.eventSinks, [this._lambda]);
+
     this._KMSkey.grantEncryptDecrypt(this._lambda);
 
     // If an auto-approval lambda ARN is specified we need to grant permissions to RestAPIHandlerFunction to invoke it.
@@ -345,6 +442,48 @@ export class AppBackend extends Construct {
       dynamoTable: this._dynamoTable,
       shouldRunAsCron: props.shouldRunCronHealthCheckCacheSync,
     });
+
+    this._escalation = new Escalation(this, "Escalation", {
+      dynamoTable: this._dynamoTable,
+    });
+
+    this._reminders = new Reminders(this, "Reminders", {
+      dynamoTable: this._dynamoTable,
+      notificationsConfig: props.notificationsConfiguration,
+      remoteConfigUrl: props.remoteConfigUrl,
+      remoteConfigHeaders: props.remoteConfigHeaders,
+    });
+
+    this._reconciler = new Reconciler(this, "Reconciler", {
+      dynamoTable: this._dynamoTable,
+      accessHandler: props.accessHandler,
+      reconcilerAutoRevoke: props.reconcilerAutoRevoke,
+    });
+
+    this._eventOutbox = new EventOutbox(this, "EventOutbox", {
+      dynamoTable: this._dynamoTable,
+      eventBus: props.eventBus,
+      eventSinks: props.eventSinks,
+    });
+
+    this._digest = new Digest(this, "Digest", {
+      dynamoTable: this._dynamoTable,
+      frontendUrl: props.frontendUrl,
+      notificationsConfig: props.notificationsConfiguration,
+      remoteConfigUrl: props.remoteConfigUrl,
+      remoteConfigHeaders: props.remoteConfigHeaders,
+    });
+
+    this._activity = new Activity(this, "Activity", {
+      dynamoTable: this._dynamoTable,
+      activityConfiguration: props.activityConfiguration,
+    });
+
+    this._auditLog = new AuditLog(this, "AuditLog", {
+      dynamoTable: this._dynamoTable,
+      eventBus: props.eventBus,
+      eventBusSourceName: props.eventBusSourceName,
+    });
   }
 
   /**
@@ -417,6 +556,9 @@ export class AppBackend extends Construct {
   getHealthChecker(): HealthChecker {
     return this._healthChecker;
   }
+  getAuditLog(): AuditLog {
+    return this._auditLog;
+  }
 
   getKmsKeyArn(): string {
     return this._KMSkey.keyArn;
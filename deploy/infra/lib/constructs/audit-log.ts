@@ -0,0 +1,118 @@
+import { Duration, RemovalPolicy } from "aws-cdk-lib";
+import { Table } from "aws-cdk-lib/aws-dynamodb";
+import * as events from "aws-cdk-lib/aws-events";
+import { EventBus } from "aws-cdk-lib/aws-events";
+import * as targets from "aws-cdk-lib/aws-events-targets";
+import * as iam from "aws-cdk-lib/aws-iam";
+import * as lambda from "aws-cdk-lib/aws-lambda";
+import * as s3 from "aws-cdk-lib/aws-s3";
+import { Construct } from "constructs";
+import * as path from "path";
+
+// exported audit log objects are locked in compliance mode for this many days,
+// which means they can't be changed or deleted by any user, including the root user.
+const AUDIT_LOG_RETENTION_DAYS = 365;
+
+interface Props {
+  dynamoTable: Table;
+  eventBus: EventBus;
+  eventBusSourceName: string;
+}
+
+// AuditLog appends every event to a hash-chained audit log in DynamoDB,
+// and exports the log to an S3 bucket with object lock every hour.
+export class AuditLog extends Construct {
+  private _lambda: lambda.Function;
+  private _exportLambda: lambda.Function;
+  private _bucket: s3.Bucket;
+  private eventRule: events.Rule;
+
+  constructor(scope: Construct, id: string, props: Props) {
+    super(scope, id);
+
+    this._bucket = new s3.Bucket(this, "Bucket", {
+      blockPublicAccess: s3.BlockPublicAccess.BLOCK_ALL,
+      encryption: s3.BucketEncryption.S3_MANAGED,
+      enforceSSL: true,
+      versioned: true,
+      objectLockEnabled: true,
+      objectLockDefaultRetention: s3.ObjectLockRetention.compliance(
+        Duration.days(AUDIT_LOG_RETENTION_DAYS)
+      ),
+      // the audit log must outlive the deployment.
+      removalPolicy: RemovalPolicy.RETAIN,
+    });
+
+    const code = lambda.Code.fromAsset(
+      path.join(__dirname, "..", "..", "..", "..", "bin", "audit-log.zip")
+    );
+
+    this._lambda = new lambda.Function(this, "HandlerFunction", {
+      code,
+      timeout: Duration.seconds(20),
+      environment: {
+        COMMONFATE_TABLE_NAME: props.dynamoTable.tableName,
+      },
+      runtime: lambda.Runtime.GO_1_X,
+      handler: "audit-log",
+    });
+
+    props.dynamoTable.grantReadWriteData(this._lambda);
+
+    new events.Rule(this, "EventBusRule", {
+      eventBus: props.eventBus,
+      eventPattern: { source: [props.eventBusSourceName] },
+      targets: [
+        new targets.LambdaFunction(this._lambda, {
+          retryAttempts: 10,
+        }),
+      ],
+    });
+
+    const exportCode = lambda.Code.fromAsset(
+      path.join(__dirname, "..", "..", "..", "..", "bin", "audit-export.zip")
+    );
+
+    this._exportLambda = new lambda.Function(this, "ExportFunction", {
+      code: exportCode,
+      timeout: Duration.minutes(5),
+      environment: {
+        COMMONFATE_TABLE_NAME: props.dynamoTable.tableName,
+        COMMONFATE_AUDIT_LOG_BUCKET: this._bucket.bucketName,
+        COMMONFATE_AUDIT_LOG_RETENTION_DAYS: AUDIT_LOG_RETENTION_DAYS.toString(),
+      },
+      runtime: lambda.Runtime.GO_1_X,
+      handler: "audit-export",
+    });
+
+    props.dynamoTable.grantReadWriteData(this._exportLambda);
+    this._bucket.grantPut(this._exportLambda);
+    // setting the object lock retention when an object is written requires an additional permission.
+    this._exportLambda.addToRolePolicy(
+      new iam.PolicyStatement({
+        actions: ["s3:PutObjectRetention"],
+        resources: [this._bucket.arnForObjects("*")],
+      })
+    );
+
+    //add event bridge trigger to lambda every hour
+    this.eventRule = new events.Rule(this, "EventBridgeCronRule", {
+      schedule: events.Schedule.cron({ minute: "0" }),
+    });
+
+    // add the Lambda function as a target for the Event Rule
+    this.eventRule.addTarget(new targets.LambdaFunction(this._exportLambda));
+
+    // allow the Event Rule to invoke the Lambda function
+    targets.addLambdaPermission(this.eventRule, this._exportLambda);
+  }
+  getBucketName(): string {
+    return this._bucket.bucketName;
+  }
+  getLogGroupName(): string {
+    return this._lambda.logGroup.logGroupName;
+  }
+  getExportLogGroupName(): string {
+    return this._exportLambda.logGroup.logGroupName;
+  }
+}
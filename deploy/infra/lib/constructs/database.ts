@@ -22,6 +22,8 @@ export class Database extends Construct {
       sortKey: { name: "SK", type: dynamodb.AttributeType.STRING },
       billingMode: dynamodb.BillingMode.PAY_PER_REQUEST,
       pointInTimeRecovery: true,
+      // the stream is used to relay events from the outbox.
+      stream: dynamodb.StreamViewType.KEYS_ONLY,
     });
 
     const gsi1: dynamodb.GlobalSecondaryIndexProps = {
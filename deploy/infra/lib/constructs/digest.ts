@@ -0,0 +1,73 @@
+import { Duration, Stack } from "aws-cdk-lib";
+import { Table } from "aws-cdk-lib/aws-dynamodb";
+import * as events from "aws-cdk-lib/aws-events";
+import * as targets from "aws-cdk-lib/aws-events-targets";
+import * as iam from "aws-cdk-lib/aws-iam";
+import * as lambda from "aws-cdk-lib/aws-lambda";
+import { Construct } from "constructs";
+import * as path from "path";
+
+interface Props {
+  dynamoTable: Table;
+  frontendUrl: string;
+  notificationsConfig: string;
+  remoteConfigUrl: string;
+  remoteConfigHeaders: string;
+}
+
+// Digest sends a daily or weekly digest of access request activity to admins and approvers.
+// The Lambda runs daily, and weekly digests are only sent on the configured weekday.
+export class Digest extends Construct {
+  private _lambda: lambda.Function;
+  private eventRule: events.Rule;
+
+  constructor(scope: Construct, id: string, props: Props) {
+    super(scope, id);
+    const code = lambda.Code.fromAsset(
+      path.join(__dirname, "..", "..", "..", "..", "bin", "digest.zip")
+    );
+
+    this._lambda = new lambda.Function(this, "HandlerFunction", {
+      code,
+      timeout: Duration.minutes(5),
+      environment: {
+        COMMONFATE_TABLE_NAME: props.dynamoTable.tableName,
+        COMMONFATE_FRONTEND_URL: props.frontendUrl,
+        COMMONFATE_NOTIFICATIONS_SETTINGS: props.notificationsConfig,
+        COMMONFATE_ACCESS_REMOTE_CONFIG_URL: props.remoteConfigUrl,
+        COMMONFATE_REMOTE_CONFIG_HEADERS: props.remoteConfigHeaders,
+      },
+      runtime: lambda.Runtime.GO_1_X,
+      handler: "digest",
+    });
+
+    props.dynamoTable.grantReadData(this._lambda);
+    this._lambda.addToRolePolicy(
+      new iam.PolicyStatement({
+        actions: ["ssm:GetParameter"],
+        resources: [
+          `arn:aws:ssm:${Stack.of(this).region}:${
+            Stack.of(this).account
+          }:parameter/granted/secrets/notifications/*`,
+        ],
+      })
+    );
+
+    // run the digest every day at 09:00 UTC
+    this.eventRule = new events.Rule(this, "EventBridgeCronRule", {
+      schedule: events.Schedule.cron({ minute: "0", hour: "9" }),
+    });
+
+    // add the Lambda function as a target for the Event Rule
+    this.eventRule.addTarget(new targets.LambdaFunction(this._lambda));
+
+    // allow the Event Rule to invoke the Lambda function
+    targets.addLambdaPermission(this.eventRule, this._lambda);
+  }
+  getLogGroupName(): string {
+    return this._lambda.logGroup.logGroupName;
+  }
+  getFunctionName(): string {
+    return this._lambda.functionName;
+  }
+}
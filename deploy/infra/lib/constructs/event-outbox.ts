@@ -0,0 +1,116 @@
+import { Duration } from "aws-cdk-lib";
+import { Table } from "aws-cdk-lib/aws-dynamodb";
+import * as events from "aws-cdk-lib/aws-events";
+import { EventBus } from "aws-cdk-lib/aws-events";
+import * as targets from "aws-cdk-lib/aws-events-targets";
+import * as lambda from "aws-cdk-lib/aws-lambda";
+import { DynamoEventSource } from "aws-cdk-lib/aws-lambda-event-sources";
+import { Construct } from "constructs";
+import * as path from "path";
+import {
+  EventSinkConfiguration,
+  eventSinkEnvironment,
+  grantPublishToEventSinks,
+} from "../helpers/event-sinks";
+
+interface Props {
+  dynamoTable: Table;
+  eventBus: EventBus;
+  eventSinks: EventSinkConfiguration;
+}
+
+// EventOutbox publishes the events which are written to the outbox.
+// The relay function publishes events as they are added to the outbox, using the
+// DynamoDB stream of the table, and the scheduled function republishes any events
+// which the relay couldn't publish.
+export class EventOutbox extends Construct {
+  private _lambda: lambda.Function;
+  private _relayLambda: lambda.Function;
+  private eventRule: events.Rule;
+
+  constructor(scope: Construct, id: string, props: Props) {
+    super(scope, id);
+    const code = lambda.Code.fromAsset(
+      path.join(__dirname, "..", "..", "..", "..", "bin", "event-outbox.zip")
+    );
+
+    this._lambda = new lambda.Function(this, "HandlerFunction", {
+      code,
+      timeout: Duration.minutes(1),
+      environment: {
+        COMMONFATE_TABLE_NAME: props.dynamoTable.tableName,
+        COMMONFATE_EVENT_BUS_ARN: props.eventBus.eventBusArn,
+        ...eventSinkEnvironment(props.eventSinks),
+      },
+      runtime: lambda.Runtime.GO_1_X,
+      handler: "event-outbox",
+    });
+
+    props.dynamoTable.grantReadWriteData(this._lambda);
+    props.eventBus.grantPutEventsTo(this._lambda);
+
+    //add event bridge trigger to lambda every 5 minutes
+    this.eventRule = new events.Rule(this, "EventBridgeCronRule", {
+      schedule: events.Schedule.cron({ minute: "0/5" }),
+    });
+
+    // add the Lambda function as a target for the Event Rule
+    this.eventRule.addTarget(new targets.LambdaFunction(this._lambda));
+
+    // allow the Event Rule to invoke the Lambda function
+    targets.addLambdaPermission(this.eventRule, this._lambda);
+
+    const relayCode = lambda.Code.fromAsset(
+      path.join(__dirname, "..", "..", "..", "..", "bin", "event-relay.zip")
+    );
+
+    this._relayLambda = new lambda.Function(this, "RelayFunction", {
+      code: relayCode,
+      timeout: Duration.minutes(1),
+      environment: {
+        COMMONFATE_TABLE_NAME: props.dynamoTable.tableName,
+        COMMONFATE_EVENT_BUS_ARN: props.eventBus.eventBusArn,
+        ...eventSinkEnvironment(props.eventSinks),
+      },
+      runtime: lambda.Runtime.GO_1_X,
+      handler: "event-relay",
+    });
+
+    props.dynamoTable.grantReadWriteData(this._relayLambda);
+    props.eventBus.grantPutEventsTo(this._relayLambda);
+    grantPublishToEventSinks(this, props.eventSinks, [
+      this._lambda,
+      this._relayLambda,
+    ]);
+
+    // only new items in the outbox partition invoke the relay.
+    this._relayLambda.addEventSource(
+      new DynamoEventSource(props.dynamoTable, {
+        startingPosition: lambda.StartingPosition.LATEST,
+        batchSize: 10,
+        retryAttempts: 3,
+        reportBatchItemFailures: true,
+        filters: [
+          lambda.FilterCriteria.filter({
+            eventName: lambda.FilterRule.isEqual("INSERT"),
+            dynamodb: {
+              Keys: { PK: { S: lambda.FilterRule.isEqual("EVENT_OUTBOX#") } },
+            },
+          }),
+        ],
+      })
+    );
+  }
+  getLogGroupName(): string {
+    return this._lambda.logGroup.logGroupName;
+  }
+  getFunctionName(): string {
+    return this._lambda.functionName;
+  }
+  getRelayLogGroupName(): string {
+    return this._relayLambda.logGroup.logGroupName;
+  }
+  getRelayFunctionName(): string {
+    return this._relayLambda.functionName;
+  }
+}
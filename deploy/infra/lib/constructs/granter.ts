@@ -1,3 +1,4 @@
+import { Table } from "aws-cdk-lib/aws-dynamodb";
 import * as iam from "aws-cdk-lib/aws-iam";
 import * as lambda from "aws-cdk-lib/aws-lambda";
 import * as sfn from "aws-cdk-lib/aws-stepfunctions";
@@ -87,7 +88,54 @@ export class Granter extends Construct {
         "Wait for Window End": {
           Type: "Wait",
           TimestampPath: "$.grant.end",
-          Next: "Expire Access",
+          Next: "Check for Extension",
+        },
+        "Check for Extension": {
+          Type: "Task",
+          Resource: "arn:aws:states:::lambda:invoke",
+          Parameters: {
+            FunctionName: this._lambda.functionArn,
+            // Returns the grant with an updated end time if the request has been extended
+            Payload: {
+              "action": "EXTEND",
+              "grant.$": "$.grant",
+            },
+          },
+          Retry: [
+            {
+              ErrorEquals: [
+                "Lambda.ServiceException",
+                "Lambda.AWSLambdaException",
+                "Lambda.SdkClientException",
+              ],
+              IntervalSeconds: 2,
+              MaxAttempts: 6,
+              BackoffRate: 2,
+            },
+          ],
+          // If the extension can't be checked, access is deactivated at the original end time
+          Catch: [
+            {
+              ErrorEquals: ["States.ALL"],
+              ResultPath: "$.extensionError",
+              Next: "Expire Access",
+            },
+          ],
+          Next: "Has Grant Been Extended",
+          ResultPath: "$",
+          OutputPath: "$.Payload",
+        },
+        "Has Grant Been Extended": {
+          Type: "Choice",
+          Choices: [
+            {
+              Variable: "$.grant.end",
+              TimestampGreaterThanPath: "$$.State.EnteredTime",
+              Next: "Wait for Window End",
+            },
+          ],
+          Default: "Expire Access",
+          Comment: "Wait for the new end time if the grant has been extended",
         },
         "Expire Access": {
           Type: "Task",
@@ -138,6 +186,11 @@ export class Granter extends Construct {
     );
     this._lambda.grantInvoke(smRole);
   }
+  // allowExtensions lets the granter read the end time of extended grants from the Common Fate table.
+  allowExtensions(table: Table) {
+    this._lambda.addEnvironment("COMMONFATE_TABLE_NAME", table.tableName);
+    table.grantReadData(this._lambda);
+  }
   getStateMachineARN(): string {
     return this._stateMachine.stateMachineArn;
   }
@@ -22,6 +22,10 @@ interface Props {
 export class Notifiers extends Construct {
   private _slackLambda: lambda.Function;
   private _slackRule: Rule;
+  private _teamsLambda: lambda.Function;
+  private _teamsRule: Rule;
+  private _webhookLambda: lambda.Function;
+  private _webhookRule: Rule;
   constructor(scope: Construct, id: string, props: Props) {
     super(scope, id);
 
@@ -70,6 +74,96 @@ export class Notifiers extends Construct {
         actions: ["cognito-idp:AdminGetUser"],
       })
     );
+
+    const teamsCode = lambda.Code.fromAsset(
+      path.join(__dirname, "..", "..", "..", "..", "bin", "teams-notifier.zip")
+    );
+    this._teamsLambda = new lambda.Function(this, "TeamsNotifierFunction", {
+      code: teamsCode,
+      timeout: Duration.seconds(20),
+      environment: {
+        COMMONFATE_TABLE_NAME: props.dynamoTable.tableName,
+        COMMONFATE_FRONTEND_URL: props.frontendUrl,
+        COMMONFATE_NOTIFICATIONS_SETTINGS: props.notificationsConfig,
+        COMMONFATE_ACCESS_REMOTE_CONFIG_URL: props.remoteConfigUrl,
+        COMMONFATE_REMOTE_CONFIG_HEADERS: props.remoteConfigHeaders,
+      },
+      runtime: lambda.Runtime.GO_1_X,
+      handler: "teams-notifier",
+    });
+
+    this._teamsLambda.addToRolePolicy(
+      new iam.PolicyStatement({
+        actions: ["ssm:GetParameter"],
+        resources: [
+          `arn:aws:ssm:${Stack.of(this).region}:${
+            Stack.of(this).account
+          }:parameter/granted/secrets/notifications/*`,
+        ],
+      })
+    );
+    this._teamsRule = new Rule(this, "TeamsNotifierEventBridgeRule", {
+      eventBus: props.eventBus,
+      eventPattern: { source: [props.eventBusSourceName] },
+      targets: [
+        new LambdaFunction(this._teamsLambda, {
+          retryAttempts: 2,
+        }),
+      ],
+    });
+
+    props.dynamoTable.grantReadData(this._teamsLambda);
+
+    const webhookCode = lambda.Code.fromAsset(
+      path.join(
+        __dirname,
+        "..",
+        "..",
+        "..",
+        "..",
+        "bin",
+        "webhook-notifier.zip"
+      )
+    );
+    this._webhookLambda = new lambda.Function(
+      this,
+      "WebhookNotifierFunction",
+      {
+        code: webhookCode,
+        // deliveries are retried with backoff before a dead letter is written.
+        timeout: Duration.seconds(60),
+        environment: {
+          COMMONFATE_TABLE_NAME: props.dynamoTable.tableName,
+          COMMONFATE_NOTIFICATIONS_SETTINGS: props.notificationsConfig,
+          COMMONFATE_ACCESS_REMOTE_CONFIG_URL: props.remoteConfigUrl,
+          COMMONFATE_REMOTE_CONFIG_HEADERS: props.remoteConfigHeaders,
+        },
+        runtime: lambda.Runtime.GO_1_X,
+        handler: "webhook-notifier",
+      }
+    );
+
+    this._webhookLambda.addToRolePolicy(
+      new iam.PolicyStatement({
+        actions: ["ssm:GetParameter"],
+        resources: [
+          `arn:aws:ssm:${Stack.of(this).region}:${
+            Stack.of(this).account
+          }:parameter/granted/secrets/notifications/*`,
+        ],
+      })
+    );
+    this._webhookRule = new Rule(this, "WebhookNotifierEventBridgeRule", {
+      eventBus: props.eventBus,
+      eventPattern: { source: [props.eventBusSourceName] },
+      targets: [
+        new LambdaFunction(this._webhookLambda, {
+          retryAttempts: 2,
+        }),
+      ],
+    });
+
+    props.dynamoTable.grantWriteData(this._webhookLambda);
   }
   getSlackRuleName(): string {
     return this._slackRule.ruleName;
@@ -77,4 +171,16 @@ export class Notifiers extends Construct {
   getSlackLogGroupName(): string {
     return this._slackLambda.logGroup.logGroupName;
   }
+  getTeamsRuleName(): string {
+    return this._teamsRule.ruleName;
+  }
+  getTeamsLogGroupName(): string {
+    return this._teamsLambda.logGroup.logGroupName;
+  }
+  getWebhookRuleName(): string {
+    return this._webhookRule.ruleName;
+  }
+  getWebhookLogGroupName(): string {
+    return this._webhookLambda.logGroup.logGroupName;
+  }
 }
@@ -97,7 +97,55 @@ export class TargetGroupGranter extends Construct {
         "Wait for Window End": {
           Type: "Wait",
           TimestampPath: "$.grant.end",
-          Next: "Expire Access",
+          Next: "Check for Extension",
+        },
+        "Check for Extension": {
+          Type: "Task",
+          Resource: "arn:aws:states:::lambda:invoke",
+          Parameters: {
+            FunctionName: this._lambda.functionArn,
+            // Returns the grant with an updated end time if the request has been extended
+            Payload: {
+              "action": "EXTEND",
+              "grant.$": "$.grant",
+              "state.$": "$.state",
+            },
+          },
+          Retry: [
+            {
+              ErrorEquals: [
+                "Lambda.ServiceException",
+                "Lambda.AWSLambdaException",
+                "Lambda.SdkClientException",
+              ],
+              IntervalSeconds: 2,
+              MaxAttempts: 6,
+              BackoffRate: 2,
+            },
+          ],
+          // If the extension can't be checked, access is deactivated at the original end time
+          Catch: [
+            {
+              ErrorEquals: ["States.ALL"],
+              ResultPath: "$.extensionError",
+              Next: "Expire Access",
+            },
+          ],
+          Next: "Has Grant Been Extended",
+          ResultPath: "$",
+          OutputPath: "$.Payload",
+        },
+        "Has Grant Been Extended": {
+          Type: "Choice",
+          Choices: [
+            {
+              Variable: "$.grant.end",
+              TimestampGreaterThanPath: "$$.State.EnteredTime",
+              Next: "Wait for Window End",
+            },
+          ],
+          Default: "Expire Access",
+          Comment: "Wait for the new end time if the grant has been extended",
         },
         "Expire Access": {
           Type: "Task",
@@ -0,0 +1,98 @@
+import * as cdk from "aws-cdk-lib";
+import * as iam from "aws-cdk-lib/aws-iam";
+import * as lambda from "aws-cdk-lib/aws-lambda";
+import { Construct } from "constructs";
+
+// EventSinkConfiguration is the optional sinks which events are published to in addition to the event bus.
+// Each sink is enabled by setting its parameters, and left disabled if they are empty.
+export interface EventSinkConfiguration {
+  snsTopicArn: string;
+  sqsQueueUrl: string;
+  // a comma separated list of broker addresses
+  kafkaBrokers: string;
+  kafkaTopic: string;
+}
+
+/**
+ * returns the environment variables which configure the event sinks of a lambda function
+ * @param config
+ */
+export const eventSinkEnvironment = (config: EventSinkConfiguration) => ({
+  COMMONFATE_EVENT_SNS_TOPIC_ARN: config.snsTopicArn,
+  COMMONFATE_EVENT_SQS_QUEUE_URL: config.sqsQueueUrl,
+  COMMONFATE_EVENT_KAFKA_BROKERS: config.kafkaBrokers,
+  COMMONFATE_EVENT_KAFKA_TOPIC: config.kafkaTopic,
+});
+
+/**
+ * grants lambda functions permissions to publish to the configured SNS topic and SQS queue.
+ * The configuration may be CloudFormation parameters in the production stack, so each policy
+ * is only created if its topic or queue is set.
+ * @param scope
+ * @param config
+ * @param functions
+ */
+export const grantPublishToEventSinks = (
+  scope: Construct,
+  config: EventSinkConfiguration,
+  functions: lambda.Function[]
+) => {
+  const roles = functions.map((fn) => fn.role!);
+
+  if (isSet(config.snsTopicArn)) {
+    const policy = new iam.Policy(scope, "EventSNSTopicPolicy", {
+      roles,
+      statements: [
+        new iam.PolicyStatement({
+          actions: ["sns:Publish"],
+          resources: [config.snsTopicArn],
+        }),
+      ],
+    });
+    onlyIfSet(scope, "EventSNSTopicCondition", policy, config.snsTopicArn);
+  }
+
+  if (isSet(config.sqsQueueUrl)) {
+    // queue URLs have the form https://sqs.<region>.amazonaws.com/<account>/<queue>
+    const urlParts = cdk.Fn.split("/", config.sqsQueueUrl);
+    const region = cdk.Fn.select(
+      1,
+      cdk.Fn.split(".", cdk.Fn.select(2, urlParts))
+    );
+    const queueArn = cdk.Fn.join(":", [
+      "arn",
+      cdk.Aws.PARTITION,
+      "sqs",
+      region,
+      cdk.Fn.select(3, urlParts),
+      cdk.Fn.select(4, urlParts),
+    ]);
+    const policy = new iam.Policy(scope, "EventSQSQueuePolicy", {
+      roles,
+      statements: [
+        new iam.PolicyStatement({
+          actions: ["sqs:SendMessage"],
+          resources: [queueArn],
+        }),
+      ],
+    });
+    onlyIfSet(scope, "EventSQSQueueCondition", policy, config.sqsQueueUrl);
+  }
+};
+
+// isSet returns false if the value is known to be empty when the stack is synthesised.
+const isSet = (value: string) =>
+  cdk.Token.isUnresolved(value) || value.length !== 0;
+
+// onlyIfSet adds a condition to the policy so that it is only created if the value is not empty when the stack is deployed.
+const onlyIfSet = (
+  scope: Construct,
+  id: string,
+  policy: iam.Policy,
+  value: string
+) => {
+  const condition = new cdk.CfnCondition(scope, id, {
+    expression: cdk.Fn.conditionNot(cdk.Fn.conditionEquals(value, "")),
+  });
+  (policy.node.defaultChild as iam.CfnPolicy).cfnOptions.condition = condition;
+};
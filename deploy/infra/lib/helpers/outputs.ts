@@ -38,6 +38,8 @@ export type StackOutputs = {
   HealthcheckFunctionName: string;
   HealthcheckLogGroupName: string;
   GranterV2StateMachineArn: string;
+  AuditLogBucketName: string;
+  AccessHandlerApiURL: string;
 };
 /**
  * generateOutputs creates a Cloudformation Output for each key-value pair in the type StackOutputs
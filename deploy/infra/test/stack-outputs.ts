@@ -41,6 +41,8 @@ const testOutputs: StackOutputs = {
   HealthcheckFunctionName: "abcdefg",
   HealthcheckLogGroupName: "abcdefg",
   GranterV2StateMachineArn: "abcdefg",
+  AuditLogBucketName: "abcdefg",
+  AccessHandlerApiURL: "abcdefg",
 };
 
 // Write the json object to ./testOutputs.json so that it can be parsed by a go test in pkg/deploy.output_test.go
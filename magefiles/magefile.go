@@ -122,6 +122,69 @@ func (Build) HealthChecker() error {
 	}
 	return sh.RunWith(env, "go", "build", "-ldflags", ldFlags(), "-o", "bin/healthcheck", "cmd/lambda/healthcheck/handler.go")
 }
+func (Build) Escalation() error {
+	env := map[string]string{
+		"GOOS":   "linux",
+		"GOARCH": "amd64",
+	}
+	return sh.RunWith(env, "go", "build", "-ldflags", ldFlags(), "-o", "bin/escalation", "cmd/lambda/escalation/handler.go")
+}
+func (Build) Reminders() error {
+	env := map[string]string{
+		"GOOS":   "linux",
+		"GOARCH": "amd64",
+	}
+	return sh.RunWith(env, "go", "build", "-ldflags", ldFlags(), "-o", "bin/reminders", "cmd/lambda/reminders/handler.go")
+}
+func (Build) Reconciler() error {
+	env := map[string]string{
+		"GOOS":   "linux",
+		"GOARCH": "amd64",
+	}
+	return sh.RunWith(env, "go", "build", "-ldflags", ldFlags(), "-o", "bin/reconciler", "cmd/lambda/reconciler/handler.go")
+}
+func (Build) EventOutbox() error {
+	env := map[string]string{
+		"GOOS":   "linux",
+		"GOARCH": "amd64",
+	}
+	return sh.RunWith(env, "go", "build", "-ldflags", ldFlags(), "-o", "bin/event-outbox", "cmd/lambda/event-outbox/handler.go")
+}
+func (Build) EventRelay() error {
+	env := map[string]string{
+		"GOOS":   "linux",
+		"GOARCH": "amd64",
+	}
+	return sh.RunWith(env, "go", "build", "-ldflags", ldFlags(), "-o", "bin/event-relay", "cmd/lambda/event-relay/handler.go")
+}
+func (Build) AuditLog() error {
+	env := map[string]string{
+		"GOOS":   "linux",
+		"GOARCH": "amd64",
+	}
+	return sh.RunWith(env, "go", "build", "-ldflags", ldFlags(), "-o", "bin/audit-log", "cmd/lambda/audit-log/handler.go")
+}
+func (Build) AuditExport() error {
+	env := map[string]string{
+		"GOOS":   "linux",
+		"GOARCH": "amd64",
+	}
+	return sh.RunWith(env, "go", "build", "-ldflags", ldFlags(), "-o", "bin/audit-export", "cmd/lambda/audit-export/handler.go")
+}
+func (Build) Digest() error {
+	env := map[string]string{
+		"GOOS":   "linux",
+		"GOARCH": "amd64",
+	}
+	return sh.RunWith(env, "go", "build", "-ldflags", ldFlags(), "-o", "bin/digest", "cmd/lambda/digest/handler.go")
+}
+func (Build) Activity() error {
+	env := map[string]string{
+		"GOOS":   "linux",
+		"GOARCH": "amd64",
+	}
+	return sh.RunWith(env, "go", "build", "-ldflags", ldFlags(), "-o", "bin/activity", "cmd/lambda/activity/handler.go")
+}
 func (Build) CacheSyncer() error {
 	env := map[string]string{
 		"GOOS":   "linux",
@@ -138,6 +201,22 @@ func (Build) SlackNotifier() error {
 	return sh.RunWith(env, "go", "build", "-ldflags", ldFlags(), "-o", "bin/slack-notifier", "cmd/lambda/event-handlers/notifiers/slack/handler.go")
 }
 
+func (Build) TeamsNotifier() error {
+	env := map[string]string{
+		"GOOS":   "linux",
+		"GOARCH": "amd64",
+	}
+	return sh.RunWith(env, "go", "build", "-ldflags", ldFlags(), "-o", "bin/teams-notifier", "cmd/lambda/event-handlers/notifiers/teams/handler.go")
+}
+
+func (Build) WebhookNotifier() error {
+	env := map[string]string{
+		"GOOS":   "linux",
+		"GOARCH": "amd64",
+	}
+	return sh.RunWith(env, "go", "build", "-ldflags", ldFlags(), "-o", "bin/webhook-notifier", "cmd/lambda/event-handlers/notifiers/webhook/handler.go")
+}
+
 func (Build) EventHandler() error {
 	env := map[string]string{
 		"GOOS":   "linux",
@@ -228,10 +307,64 @@ func PackageHealthChecker() error {
 	return sh.Run("zip", "--junk-paths", "bin/healthcheck.zip", "bin/healthcheck")
 }
 
+// PackageEscalation zips the Go request escalation handler so that it can be deployed to Lambda.
+func PackageEscalation() error {
+	mg.Deps(Build.Escalation)
+	return sh.Run("zip", "--junk-paths", "bin/escalation.zip", "bin/escalation")
+}
+
+// PackageReminders zips the Go grant expiry reminder handler so that it can be deployed to Lambda.
+func PackageReminders() error {
+	mg.Deps(Build.Reminders)
+	return sh.Run("zip", "--junk-paths", "bin/reminders.zip", "bin/reminders")
+}
+
+// PackageReconciler zips the Go grant reconciler so that it can be deployed to Lambda.
+func PackageReconciler() error {
+	mg.Deps(Build.Reconciler)
+	return sh.Run("zip", "--junk-paths", "bin/reconciler.zip", "bin/reconciler")
+}
+
+// PackageEventOutbox zips the Go event outbox redelivery handler so that it can be deployed to Lambda.
+func PackageEventOutbox() error {
+	mg.Deps(Build.EventOutbox)
+	return sh.Run("zip", "--junk-paths", "bin/event-outbox.zip", "bin/event-outbox")
+}
+
+// PackageEventRelay zips the Go event outbox relay so that it can be deployed to Lambda.
+func PackageEventRelay() error {
+	mg.Deps(Build.EventRelay)
+	return sh.Run("zip", "--junk-paths", "bin/event-relay.zip", "bin/event-relay")
+}
+
+// PackageAuditLog zips the Go audit log handler so that it can be deployed to Lambda.
+func PackageAuditLog() error {
+	mg.Deps(Build.AuditLog)
+	return sh.Run("zip", "--junk-paths", "bin/audit-log.zip", "bin/audit-log")
+}
+
+// PackageAuditExport zips the Go audit log exporter so that it can be deployed to Lambda.
+func PackageAuditExport() error {
+	mg.Deps(Build.AuditExport)
+	return sh.Run("zip", "--junk-paths", "bin/audit-export.zip", "bin/audit-export")
+}
+
+// PackageDigest zips the Go digest notifier so that it can be deployed to Lambda.
+func PackageDigest() error {
+	mg.Deps(Build.Digest)
+	return sh.Run("zip", "--junk-paths", "bin/digest.zip", "bin/digest")
+}
+
+// PackageActivity zips the Go activity ingestion handler so that it can be deployed to Lambda.
+func PackageActivity() error {
+	mg.Deps(Build.Activity)
+	return sh.Run("zip", "--junk-paths", "bin/activity.zip", "bin/activity")
+}
+
 func Package() {
-	mg.Deps(PackageBackend, PackageGranter, PackageAccessHandler, PackageSlackNotifier)
+	mg.Deps(PackageBackend, PackageGranter, PackageAccessHandler, PackageSlackNotifier, PackageTeamsNotifier, PackageWebhookNotifier)
 	mg.Deps(PackageEventHandler, PackageSyncer, PackageWebhook, PackageGovernance, PackageFrontendDeployer)
-	mg.Deps(PackageCacheSyncer, PackageHealthChecker, PackageTargetGroupGranter)
+	mg.Deps(PackageCacheSyncer, PackageHealthChecker, PackageTargetGroupGranter, PackageEscalation, PackageReminders, PackageReconciler, PackageDigest, PackageActivity, PackageEventOutbox, PackageEventRelay, PackageAuditLog, PackageAuditExport)
 }
 
 // PackageGranter zips the Go granter so that it can be deployed to Lambda.
@@ -270,6 +403,18 @@ func PackageSlackNotifier() error {
 	return sh.Run("zip", "--junk-paths", "bin/slack-notifier.zip", "bin/slack-notifier")
 }
 
+// PackageTeamsNotifier zips the Go Teams notifier so that it can be deployed to Lambda.
+func PackageTeamsNotifier() error {
+	mg.Deps(Build.TeamsNotifier)
+	return sh.Run("zip", "--junk-paths", "bin/teams-notifier.zip", "bin/teams-notifier")
+}
+
+// PackageWebhookNotifier zips the Go webhook notifier so that it can be deployed to Lambda.
+func PackageWebhookNotifier() error {
+	mg.Deps(Build.WebhookNotifier)
+	return sh.Run("zip", "--junk-paths", "bin/webhook-notifier.zip", "bin/webhook-notifier")
+}
+
 // PackageEventHandler zips the Go event handler so that it can be deployed to Lambda.
 func PackageEventHandler() error {
 	mg.Deps(Build.EventHandler)
@@ -0,0 +1,74 @@
+package access
+
+import (
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// BreakGlassReviewStatus is the status of the post-incident review of a break-glass request.
+type BreakGlassReviewStatus string
+
+const (
+	// BreakGlassReviewOpen means the request has not yet been reviewed by an approver.
+	BreakGlassReviewOpen BreakGlassReviewStatus = "OPEN"
+	// BreakGlassReviewAcknowledged means an approver has reviewed the request and accepted the use of break-glass access.
+	BreakGlassReviewAcknowledged BreakGlassReviewStatus = "ACKNOWLEDGED"
+	// BreakGlassReviewFlagged means an approver has reviewed the request and flagged the use of break-glass access for follow up.
+	BreakGlassReviewFlagged BreakGlassReviewStatus = "FLAGGED"
+)
+
+// BreakGlassReview is a post-incident review task for a request which was approved using break-glass access.
+// It is created when the request is made and stays open until one of the approvers of the access rule
+// acknowledges or flags it.
+type BreakGlassReview struct {
+	// RequestID is the ID of the break-glass request. There is one review per request.
+	RequestID   string `json:"requestId" dynamodbav:"requestId"`
+	Rule        string `json:"rule" dynamodbav:"rule"`
+	RequestedBy string `json:"requestedBy" dynamodbav:"requestedBy"`
+	// Reason is the mandatory reason given by the user when using break-glass access.
+	Reason string `json:"reason" dynamodbav:"reason"`
+	// Approvers are the IDs of the users who can complete the review.
+	Approvers  []string               `json:"approvers" dynamodbav:"approvers"`
+	Status     BreakGlassReviewStatus `json:"status" dynamodbav:"status"`
+	ReviewedBy *string                `json:"reviewedBy,omitempty" dynamodbav:"reviewedBy,omitempty"`
+	Comment    *string                `json:"comment,omitempty" dynamodbav:"comment,omitempty"`
+	CreatedAt  time.Time              `json:"createdAt" dynamodbav:"createdAt"`
+	UpdatedAt  time.Time              `json:"updatedAt" dynamodbav:"updatedAt"`
+}
+
+// IsApprover returns true if the user can complete the review.
+func (b *BreakGlassReview) IsApprover(userID string) bool {
+	for _, a := range b.Approvers {
+		if a == userID {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *BreakGlassReview) ToAPI() types.BreakGlassReview {
+	return types.BreakGlassReview{
+		RequestId:    b.RequestID,
+		AccessRuleId: b.Rule,
+		RequestedBy:  b.RequestedBy,
+		Reason:       b.Reason,
+		Status:       types.BreakGlassReviewStatus(b.Status),
+		ReviewedBy:   b.ReviewedBy,
+		Comment:      b.Comment,
+		CreatedAt:    b.CreatedAt,
+		UpdatedAt:    b.UpdatedAt,
+	}
+}
+
+func (b *BreakGlassReview) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK:     keys.BreakGlassReview.PK1,
+		SK:     keys.BreakGlassReview.SK1(b.RequestID),
+		GSI1PK: keys.BreakGlassReview.GSI1PK,
+		GSI1SK: keys.BreakGlassReview.GSI1SK(string(b.Status), b.RequestID),
+	}
+	return keys, nil
+}
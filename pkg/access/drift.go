@@ -0,0 +1,69 @@
+package access
+
+import (
+	"time"
+
+	ac_types "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/types"
+)
+
+// DriftKind describes how the access in the provider differs from the status of the grant.
+type DriftKind string
+
+const (
+	// MISSING_ACCESS means the grant is active but the access wasn't found in the provider,
+	// such as when the assignment was removed directly in the provider.
+	MISSING_ACCESS DriftKind = "MISSING_ACCESS"
+	// ORPHANED_ACCESS means the grant has ended but the access remains in the provider,
+	// such as when revoking the access failed.
+	ORPHANED_ACCESS DriftKind = "ORPHANED_ACCESS"
+)
+
+// GrantDrift records drift detected between a grant and the access in the provider.
+type GrantDrift struct {
+	Kind       DriftKind `json:"kind" dynamodbav:"kind"`
+	DetectedAt time.Time `json:"detectedAt" dynamodbav:"detectedAt"`
+	// AutoRevoked is true if orphaned access was removed from the provider by the reconciler.
+	AutoRevoked bool `json:"autoRevoked" dynamodbav:"autoRevoked"`
+}
+
+func (d *GrantDrift) ToAPI() types.RequestGrantDrift {
+	return types.RequestGrantDrift{
+		Kind:        types.RequestGrantDriftKind(d.Kind),
+		AutoRevoked: d.AutoRevoked,
+	}
+}
+
+// GrantShouldBeActive returns whether the access for the grant is expected to be in effect in the provider.
+//
+// check is false if the grant shouldn't be verified at now. Pending grants aren't verified, nor are grants
+// whose status changed within settle, as providers may take some time to apply a change. Grants which ended
+// more than lookback ago are also skipped, so that the reconciler doesn't verify every grant ever made.
+func (r *Request) GrantShouldBeActive(now time.Time, settle, lookback time.Duration) (active bool, check bool) {
+	if r.Grant == nil {
+		return false, false
+	}
+	since := now.Sub(r.Grant.UpdatedAt)
+	if since < settle {
+		return false, false
+	}
+	switch r.Grant.Status {
+	case ac_types.GrantStatusACTIVE:
+		return true, true
+	case ac_types.GrantStatusEXPIRED, ac_types.GrantStatusREVOKED, ac_types.GrantStatusERROR:
+		return false, since <= lookback
+	}
+	return false, false
+}
+
+// DetectDrift compares whether the grant should be active with whether the provider reports the access as active.
+// It returns false if the two are in sync.
+func DetectDrift(shouldBeActive, isActive bool) (DriftKind, bool) {
+	if shouldBeActive && !isActive {
+		return MISSING_ACCESS, true
+	}
+	if !shouldBeActive && isActive {
+		return ORPHANED_ACCESS, true
+	}
+	return "", false
+}
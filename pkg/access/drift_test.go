@@ -0,0 +1,57 @@
+package access
+
+import (
+	"testing"
+	"time"
+
+	ac_types "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrantShouldBeActive(t *testing.T) {
+	now := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+	settle := 5 * time.Minute
+	lookback := 24 * time.Hour
+
+	tests := []struct {
+		name       string
+		grant      *Grant
+		wantActive bool
+		wantCheck  bool
+	}{
+		{name: "no grant"},
+		{name: "pending", grant: &Grant{Status: ac_types.GrantStatusPENDING, UpdatedAt: now.Add(-time.Hour)}},
+		{name: "active", grant: &Grant{Status: ac_types.GrantStatusACTIVE, UpdatedAt: now.Add(-time.Hour)}, wantActive: true, wantCheck: true},
+		{name: "active within settle", grant: &Grant{Status: ac_types.GrantStatusACTIVE, UpdatedAt: now.Add(-time.Minute)}},
+		{name: "expired", grant: &Grant{Status: ac_types.GrantStatusEXPIRED, UpdatedAt: now.Add(-time.Hour)}, wantCheck: true},
+		{name: "revoked", grant: &Grant{Status: ac_types.GrantStatusREVOKED, UpdatedAt: now.Add(-time.Hour)}, wantCheck: true},
+		{name: "expired outside of lookback", grant: &Grant{Status: ac_types.GrantStatusEXPIRED, UpdatedAt: now.Add(-48 * time.Hour)}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := Request{Grant: tc.grant}
+			active, check := r.GrantShouldBeActive(now, settle, lookback)
+			assert.Equal(t, tc.wantActive, active)
+			assert.Equal(t, tc.wantCheck, check)
+		})
+	}
+}
+
+func TestDetectDrift(t *testing.T) {
+	tests := []struct {
+		shouldBeActive bool
+		isActive       bool
+		want           DriftKind
+		wantDrift      bool
+	}{
+		{shouldBeActive: true, isActive: true},
+		{shouldBeActive: false, isActive: false},
+		{shouldBeActive: true, isActive: false, want: MISSING_ACCESS, wantDrift: true},
+		{shouldBeActive: false, isActive: true, want: ORPHANED_ACCESS, wantDrift: true},
+	}
+	for _, tc := range tests {
+		got, drifted := DetectDrift(tc.shouldBeActive, tc.isActive)
+		assert.Equal(t, tc.want, got)
+		assert.Equal(t, tc.wantDrift, drifted)
+	}
+}
@@ -0,0 +1,60 @@
+package access
+
+import (
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/types"
+)
+
+// ExtensionStatus is the status of a request to extend an active grant.
+type ExtensionStatus string
+
+const (
+	ExtensionPending  ExtensionStatus = "PENDING"
+	ExtensionApproved ExtensionStatus = "APPROVED"
+	ExtensionDeclined ExtensionStatus = "DECLINED"
+)
+
+// Extension is a request from the requestor for more time on an active grant.
+// Extensions go through the same approval steps as the original request.
+type Extension struct {
+	Status ExtensionStatus `json:"status" dynamodbav:"status"`
+	// ExtendBy is the amount of time added to the end of the grant if the extension is approved.
+	ExtendBy time.Duration `json:"extendBy" dynamodbav:"extendBy"`
+	Reason   *string       `json:"reason,omitempty" dynamodbav:"reason,omitempty"`
+	// Approvals records the approvals made towards each approval step of the access rule for this extension.
+	Approvals   []StepApproval `json:"approvals,omitempty" dynamodbav:"approvals,omitempty"`
+	RequestedAt time.Time      `json:"requestedAt" dynamodbav:"requestedAt"`
+	UpdatedAt   time.Time      `json:"updatedAt" dynamodbav:"updatedAt"`
+}
+
+// ApprovalsForStep returns the number of approvals the extension has received for the step.
+func (e *Extension) ApprovalsForStep(step int) int {
+	count := 0
+	for _, a := range e.Approvals {
+		if a.Step == step {
+			count++
+		}
+	}
+	return count
+}
+
+// HasApprovalFrom returns true if the reviewer has already approved any step of the extension.
+func (e *Extension) HasApprovalFrom(reviewerID string) bool {
+	for _, a := range e.Approvals {
+		if a.ReviewerID == reviewerID {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *Extension) ToAPI() types.RequestExtension {
+	return types.RequestExtension{
+		Status:          types.RequestExtensionStatus(e.Status),
+		ExtendBySeconds: int(e.ExtendBy.Seconds()),
+		Reason:          e.Reason,
+		RequestedAt:     e.RequestedAt,
+		UpdatedAt:       e.UpdatedAt,
+	}
+}
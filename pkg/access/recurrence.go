@@ -0,0 +1,202 @@
+package access
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/types"
+)
+
+// Frequency is how often a recurring request repeats.
+type Frequency string
+
+const (
+	FrequencyDaily  Frequency = "DAILY"
+	FrequencyWeekly Frequency = "WEEKLY"
+)
+
+const (
+	// MaxOccurrences is the maximum number of occurrences a recurring request can have.
+	// Each occurrence is granted separately, so this bounds the number of grants created when the request is approved.
+	MaxOccurrences = 100
+	// MaxRecurrencePeriod is how far after the first occurrence a recurring request can end.
+	// Grants are scheduled when the request is approved, and the granter can't wait longer than a year to start a grant.
+	MaxRecurrencePeriod = 365 * 24 * time.Hour
+)
+
+// rruleWeekdays maps weekdays to the day codes used in iCalendar RRULEs.
+var rruleWeekdays = map[time.Weekday]string{
+	time.Monday:    "MO",
+	time.Tuesday:   "TU",
+	time.Wednesday: "WE",
+	time.Thursday:  "TH",
+	time.Friday:    "FR",
+	time.Saturday:  "SA",
+	time.Sunday:    "SU",
+}
+
+// Recurrence describes how a scheduled request repeats. It follows a subset of the iCalendar RRULE format.
+//
+// Occurrences start at the same time of day as the start time of the request, in the time zone offset of the start time,
+// and each occurrence lasts for the duration of the request.
+type Recurrence struct {
+	Frequency Frequency `json:"frequency" dynamodbav:"frequency"`
+	// Interval is the number of days or weeks between occurrences. An interval of 2 with a weekly frequency means every second week.
+	Interval int `json:"interval" dynamodbav:"interval"`
+	// Weekdays are the days of the week that a weekly recurrence occurs on.
+	// If empty, the recurrence occurs on the weekday of the start time.
+	Weekdays []time.Weekday `json:"weekdays,omitempty" dynamodbav:"weekdays,omitempty"`
+	// Until is the end date of the recurrence. No occurrences start at or after this time.
+	Until time.Time `json:"until" dynamodbav:"until"`
+}
+
+// Interval is a single window of access.
+type Interval struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Occurrences returns the windows of access for the recurrence, starting with the first occurrence at start.
+// At most MaxOccurrences are returned.
+func (r Recurrence) Occurrences(start time.Time, duration time.Duration) []Interval {
+	return r.occurrences(start, duration, MaxOccurrences)
+}
+
+// occurrences returns at most limit windows of access for the recurrence.
+func (r Recurrence) occurrences(start time.Time, duration time.Duration, limit int) []Interval {
+	interval := r.Interval
+	if interval < 1 {
+		interval = 1
+	}
+	var res []Interval
+	add := func(t time.Time) bool {
+		if !t.Before(r.Until) || len(res) == limit {
+			return false
+		}
+		res = append(res, Interval{Start: t, End: t.Add(duration)})
+		return true
+	}
+
+	switch r.Frequency {
+	case FrequencyDaily:
+		for t := start; add(t); t = t.AddDate(0, 0, interval) {
+		}
+	case FrequencyWeekly:
+		weekdays := r.weekdays(start)
+		// walk through the week containing the start time, then jump forward by the interval.
+		weekStart := start.AddDate(0, 0, -int(start.Weekday()))
+		for week := weekStart; week.Before(r.Until) && len(res) < limit; week = week.AddDate(0, 0, 7*interval) {
+			for i := 0; i < 7; i++ {
+				t := week.AddDate(0, 0, i)
+				if t.Before(start) || !weekdays[t.Weekday()] {
+					continue
+				}
+				if !add(t) {
+					return res
+				}
+			}
+		}
+	}
+	return res
+}
+
+func (r Recurrence) weekdays(start time.Time) map[time.Weekday]bool {
+	res := make(map[time.Weekday]bool)
+	for _, d := range r.Weekdays {
+		res[d] = true
+	}
+	if len(res) == 0 {
+		res[start.Weekday()] = true
+	}
+	return res
+}
+
+// Validate returns an error if the recurrence can't be used for a request starting at start and lasting for duration.
+func (r Recurrence) Validate(start time.Time, duration time.Duration) error {
+	if r.Frequency != FrequencyDaily && r.Frequency != FrequencyWeekly {
+		return fmt.Errorf("unsupported recurrence frequency %q", r.Frequency)
+	}
+	if r.Interval < 1 {
+		return errors.New("recurrence interval must be at least 1")
+	}
+	if r.Frequency == FrequencyDaily && len(r.Weekdays) > 0 {
+		return errors.New("weekdays can only be set for a weekly recurrence")
+	}
+	if !r.Until.After(start) {
+		return errors.New("recurrence must end after the start time of the request")
+	}
+	if r.Until.Sub(start) > MaxRecurrencePeriod {
+		return fmt.Errorf("recurrence must end within %d days of the start time of the request", int(MaxRecurrencePeriod.Hours()/24))
+	}
+	// expand one occurrence past the maximum so that recurrences which would be truncated are rejected.
+	occurrences := r.occurrences(start, duration, MaxOccurrences+1)
+	if len(occurrences) == 0 {
+		return errors.New("recurrence has no occurrences before it ends")
+	}
+	if len(occurrences) > MaxOccurrences {
+		return fmt.Errorf("recurrence can't have more than %d occurrences", MaxOccurrences)
+	}
+	for i := 1; i < len(occurrences); i++ {
+		if occurrences[i].Start.Before(occurrences[i-1].End) {
+			return errors.New("the duration of the request is longer than the time between occurrences")
+		}
+	}
+	return nil
+}
+
+// String returns the recurrence in iCalendar RRULE format, e.g. "FREQ=WEEKLY;INTERVAL=1;BYDAY=TU;UNTIL=20230101T000000Z".
+func (r Recurrence) String() string {
+	parts := []string{"FREQ=" + string(r.Frequency), fmt.Sprintf("INTERVAL=%d", r.Interval)}
+	if len(r.Weekdays) > 0 {
+		days := make([]string, len(r.Weekdays))
+		for i, d := range r.Weekdays {
+			days[i] = rruleWeekdays[d]
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+	return strings.Join(parts, ";")
+}
+
+func (r Recurrence) ToAPI() types.RequestRecurrence {
+	rrule := r.String()
+	res := types.RequestRecurrence{
+		Frequency: types.RecurrenceFrequency(r.Frequency),
+		Interval:  &r.Interval,
+		Until:     r.Until,
+		Rrule:     &rrule,
+	}
+	if len(r.Weekdays) > 0 {
+		weekdays := make([]types.Weekday, len(r.Weekdays))
+		for i, d := range r.Weekdays {
+			weekdays[i] = types.Weekday(rruleWeekdays[d])
+		}
+		res.ByWeekday = &weekdays
+	}
+	return res
+}
+
+// RecurrenceFromAPI converts from the api type to the internal type.
+// The recurrence should be checked with Validate before it is used.
+func RecurrenceFromAPI(r types.RequestRecurrence) Recurrence {
+	res := Recurrence{
+		Frequency: Frequency(r.Frequency),
+		Interval:  1,
+		Until:     r.Until,
+	}
+	if r.Interval != nil {
+		res.Interval = *r.Interval
+	}
+	if r.ByWeekday != nil {
+		for _, d := range *r.ByWeekday {
+			for weekday, code := range rruleWeekdays {
+				if string(d) == code {
+					res.Weekdays = append(res.Weekdays, weekday)
+				}
+			}
+		}
+	}
+	return res
+}
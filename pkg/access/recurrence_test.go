@@ -0,0 +1,142 @@
+package access
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecurrenceOccurrences(t *testing.T) {
+	// 2022-01-04 is a Tuesday
+	start := time.Date(2022, 1, 4, 2, 0, 0, 0, time.UTC)
+	duration := 2 * time.Hour
+
+	type testcase struct {
+		name string
+		give Recurrence
+		want []time.Time
+	}
+
+	testcases := []testcase{
+		{
+			name: "weekly maintenance window",
+			give: Recurrence{Frequency: FrequencyWeekly, Interval: 1, Until: start.AddDate(0, 0, 21)},
+			want: []time.Time{start, start.AddDate(0, 0, 7), start.AddDate(0, 0, 14)},
+		},
+		{
+			name: "every second week on tuesday and thursday",
+			give: Recurrence{Frequency: FrequencyWeekly, Interval: 2, Weekdays: []time.Weekday{time.Tuesday, time.Thursday}, Until: start.AddDate(0, 0, 28)},
+			want: []time.Time{start, start.AddDate(0, 0, 2), start.AddDate(0, 0, 14), start.AddDate(0, 0, 16)},
+		},
+		{
+			name: "weekdays before the start time are skipped",
+			give: Recurrence{Frequency: FrequencyWeekly, Interval: 1, Weekdays: []time.Weekday{time.Monday}, Until: start.AddDate(0, 0, 7)},
+			want: []time.Time{start.AddDate(0, 0, 6)},
+		},
+		{
+			name: "daily",
+			give: Recurrence{Frequency: FrequencyDaily, Interval: 3, Until: start.AddDate(0, 0, 7)},
+			want: []time.Time{start, start.AddDate(0, 0, 3), start.AddDate(0, 0, 6)},
+		},
+		{
+			name: "limited to max occurrences",
+			give: Recurrence{Frequency: FrequencyDaily, Interval: 1, Until: start.AddDate(0, 0, 365)},
+			want: func() []time.Time {
+				var res []time.Time
+				for i := 0; i < MaxOccurrences; i++ {
+					res = append(res, start.AddDate(0, 0, i))
+				}
+				return res
+			}(),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			var want []Interval
+			for _, s := range tc.want {
+				want = append(want, Interval{Start: s, End: s.Add(duration)})
+			}
+			got := tc.give.Occurrences(start, duration)
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+func TestRecurrenceValidate(t *testing.T) {
+	start := time.Date(2022, 1, 4, 2, 0, 0, 0, time.UTC)
+
+	type testcase struct {
+		name         string
+		give         Recurrence
+		giveDuration time.Duration
+		wantErr      error
+	}
+
+	testcases := []testcase{
+		{
+			name:         "ok",
+			give:         Recurrence{Frequency: FrequencyWeekly, Interval: 1, Weekdays: []time.Weekday{time.Tuesday}, Until: start.AddDate(0, 3, 0)},
+			giveDuration: 2 * time.Hour,
+		},
+		{
+			name:         "unsupported frequency",
+			give:         Recurrence{Frequency: "MONTHLY", Interval: 1, Until: start.AddDate(0, 3, 0)},
+			giveDuration: 2 * time.Hour,
+			wantErr:      errors.New(`unsupported recurrence frequency "MONTHLY"`),
+		},
+		{
+			name:         "weekdays on daily recurrence",
+			give:         Recurrence{Frequency: FrequencyDaily, Interval: 1, Weekdays: []time.Weekday{time.Tuesday}, Until: start.AddDate(0, 3, 0)},
+			giveDuration: 2 * time.Hour,
+			wantErr:      errors.New("weekdays can only be set for a weekly recurrence"),
+		},
+		{
+			name:         "ends before start",
+			give:         Recurrence{Frequency: FrequencyDaily, Interval: 1, Until: start},
+			giveDuration: 2 * time.Hour,
+			wantErr:      errors.New("recurrence must end after the start time of the request"),
+		},
+		{
+			name:         "ends more than a year after start",
+			give:         Recurrence{Frequency: FrequencyWeekly, Interval: 1, Until: start.AddDate(2, 0, 0)},
+			giveDuration: 2 * time.Hour,
+			wantErr:      errors.New("recurrence must end within 365 days of the start time of the request"),
+		},
+		{
+			name:         "occurrences overlap",
+			give:         Recurrence{Frequency: FrequencyDaily, Interval: 1, Until: start.AddDate(0, 0, 7)},
+			giveDuration: 25 * time.Hour,
+			wantErr:      errors.New("the duration of the request is longer than the time between occurrences"),
+		},
+		{
+			name:         "exactly the maximum number of occurrences",
+			give:         Recurrence{Frequency: FrequencyDaily, Interval: 1, Until: start.AddDate(0, 0, MaxOccurrences)},
+			giveDuration: 2 * time.Hour,
+		},
+		{
+			name:         "more than the maximum number of occurrences",
+			give:         Recurrence{Frequency: FrequencyDaily, Interval: 1, Until: start.AddDate(0, 0, MaxOccurrences+1)},
+			giveDuration: 2 * time.Hour,
+			wantErr:      errors.New("recurrence can't have more than 100 occurrences"),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.give.Validate(start, tc.giveDuration)
+			if tc.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.wantErr.Error())
+		})
+	}
+}
+
+func TestRecurrenceString(t *testing.T) {
+	r := Recurrence{Frequency: FrequencyWeekly, Interval: 1, Weekdays: []time.Weekday{time.Tuesday}, Until: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)}
+	assert.Equal(t, "FREQ=WEEKLY;INTERVAL=1;BYDAY=TU;UNTIL=20230101T000000Z", r.String())
+}
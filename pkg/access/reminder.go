@@ -0,0 +1,43 @@
+package access
+
+import (
+	"time"
+)
+
+// ExpiryReminder records the most recent reminder sent to the requestor before their grant expires.
+type ExpiryReminder struct {
+	// GrantEnd is the end time of the grant when the reminder was sent.
+	// If the grant is extended the end time changes, so reminders are sent again for the new end time.
+	GrantEnd time.Time `json:"grantEnd" dynamodbav:"grantEnd"`
+	// Before is how long before the end of the grant the reminder was scheduled for.
+	Before time.Duration `json:"before" dynamodbav:"before"`
+	SentAt time.Time     `json:"sentAt" dynamodbav:"sentAt"`
+}
+
+// DueExpiryReminder returns the reminder which is due to be sent for the grant at now, if any.
+//
+// reminders are how long before the end of the grant to remind the requestor. If more than one reminder is due,
+// only the one closest to the end of the grant is returned so that the requestor doesn't receive several at once.
+// Reminders that fall before the start of the grant are skipped, as are reminders which have already been sent.
+func (r *Request) DueExpiryReminder(now time.Time, reminders []time.Duration) (before time.Duration, due bool) {
+	if r.Grant == nil || !now.Before(r.Grant.End) {
+		return 0, false
+	}
+	for _, d := range reminders {
+		remindAt := r.Grant.End.Add(-d)
+		if d <= 0 || now.Before(remindAt) || !remindAt.After(r.Grant.Start) {
+			continue
+		}
+		if !due || d < before {
+			before, due = d, true
+		}
+	}
+	if !due {
+		return 0, false
+	}
+	sent := r.ExpiryReminder
+	if sent != nil && sent.GrantEnd.Equal(r.Grant.End) && sent.Before <= before {
+		return 0, false
+	}
+	return before, true
+}
@@ -0,0 +1,72 @@
+package access
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDueExpiryReminder(t *testing.T) {
+	start := time.Date(2022, 1, 1, 9, 0, 0, 0, time.UTC)
+	end := start.Add(2 * time.Hour)
+	reminders := []time.Duration{time.Hour, 10 * time.Minute}
+
+	tests := []struct {
+		name       string
+		grant      *Grant
+		sent       *ExpiryReminder
+		now        time.Time
+		wantBefore time.Duration
+		wantDue    bool
+	}{
+		{name: "no grant", now: end.Add(-5 * time.Minute)},
+		{name: "not yet due", grant: &Grant{Start: start, End: end}, now: start.Add(30 * time.Minute)},
+		{name: "first reminder", grant: &Grant{Start: start, End: end}, now: end.Add(-50 * time.Minute), wantBefore: time.Hour, wantDue: true},
+		{
+			name:  "first reminder already sent",
+			grant: &Grant{Start: start, End: end},
+			sent:  &ExpiryReminder{GrantEnd: end, Before: time.Hour},
+			now:   end.Add(-50 * time.Minute),
+		},
+		{
+			name:       "second reminder",
+			grant:      &Grant{Start: start, End: end},
+			sent:       &ExpiryReminder{GrantEnd: end, Before: time.Hour},
+			now:        end.Add(-5 * time.Minute),
+			wantBefore: 10 * time.Minute,
+			wantDue:    true,
+		},
+		{
+			name:       "only the closest reminder is sent when several are due",
+			grant:      &Grant{Start: start, End: end},
+			now:        end.Add(-5 * time.Minute),
+			wantBefore: 10 * time.Minute,
+			wantDue:    true,
+		},
+		{
+			name:       "reminders are sent again after an extension",
+			grant:      &Grant{Start: start, End: end.Add(time.Hour)},
+			sent:       &ExpiryReminder{GrantEnd: end, Before: 10 * time.Minute},
+			now:        end.Add(5 * time.Minute),
+			wantBefore: time.Hour,
+			wantDue:    true,
+		},
+		{
+			name:       "reminders before the start of the grant are skipped",
+			grant:      &Grant{Start: start, End: start.Add(30 * time.Minute)},
+			now:        start.Add(25 * time.Minute),
+			wantBefore: 10 * time.Minute,
+			wantDue:    true,
+		},
+		{name: "expired", grant: &Grant{Start: start, End: end}, now: end.Add(time.Minute)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := Request{Grant: tc.grant, ExpiryReminder: tc.sent}
+			before, due := r.DueExpiryReminder(tc.now, reminders)
+			assert.Equal(t, tc.wantBefore, before)
+			assert.Equal(t, tc.wantDue, due)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package access
 
 import (
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/common-fate/analytics-go"
@@ -36,6 +38,22 @@ type Grant struct {
 	UpdatedAt time.Time            `json:"updatedAt" dynamodbav:"updatedAt"`
 }
 
+// AccessKey identifies the access the grant provides, so that grants for the same access can be matched.
+func (g *Grant) AccessKey() string {
+	return AccessKey(g.Provider, g.Subject, g.With.AdditionalProperties)
+}
+
+// AccessKey identifies access to a resource for a subject, independent of the case of the subject
+// and the order of the arguments.
+func AccessKey(providerID, subject string, with map[string]string) string {
+	args := make([]string, 0, len(with))
+	for k, v := range with {
+		args = append(args, k+"="+v)
+	}
+	sort.Strings(args)
+	return providerID + "|" + strings.ToLower(subject) + "|" + strings.Join(args, ",")
+}
+
 func (g *Grant) ToAHGrant(requestID string) ac_types.Grant {
 	return ac_types.Grant{
 		ID:       requestID,
@@ -90,6 +108,24 @@ type Request struct {
 	Grant *Grant `json:"grant,omitempty" dynamodbav:"grant,omitempty"`
 	// ApprovalMethod explains whether an approval was AUTOMATIC, or REVIEWED
 	ApprovalMethod *types.ApprovalMethod `json:"approvalMethod,omitempty" dynamodbav:"approvalMethod,omitempty"`
+	// Approvals records the approvals made towards each approval step of the access rule.
+	// The request remains PENDING until every step has received enough approvals.
+	Approvals []StepApproval `json:"approvals,omitempty" dynamodbav:"approvals,omitempty"`
+	// BreakGlass is true if the requestor approved the request themselves using break-glass access.
+	BreakGlass bool `json:"breakGlass,omitempty" dynamodbav:"breakGlass,omitempty"`
+	// Extension is the most recent request to extend the grant. It is nil if an extension has never been requested.
+	Extension *Extension `json:"extension,omitempty" dynamodbav:"extension,omitempty"`
+	// ExpiryReminder is the most recent reminder sent to the requestor before their grant expires.
+	ExpiryReminder *ExpiryReminder `json:"expiryReminder,omitempty" dynamodbav:"expiryReminder,omitempty"`
+	// RecurrenceOf is the ID of the recurring request which this request is an occurrence of.
+	// Occurrences are created when the recurring request is approved, and each has its own grant.
+	RecurrenceOf *string `json:"recurrenceOf,omitempty" dynamodbav:"recurrenceOf,omitempty"`
+	// EscalatedAt is set when the request has been escalated to additional reviewers
+	// because it was not reviewed in time.
+	EscalatedAt *time.Time `json:"escalatedAt,omitempty" dynamodbav:"escalatedAt,omitempty"`
+	// GrantDrift is set by the reconciler when the access in the provider doesn't match the status of the grant.
+	// It is cleared once the grant and the provider are back in sync.
+	GrantDrift *GrantDrift `json:"grantDrift,omitempty" dynamodbav:"grantDrift,omitempty"`
 	// CreatedAt is a read-only field after the request has been created.
 	CreatedAt time.Time `json:"createdAt" dynamodbav:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt" dynamodbav:"updatedAt"`
@@ -104,6 +140,34 @@ func WithNow(t time.Time) func(o *GetIntervalOpts) {
 	return func(o *GetIntervalOpts) { o.Now = t }
 }
 
+// StepApproval is an approval made by a reviewer towards a single approval step.
+type StepApproval struct {
+	Step       int       `json:"step" dynamodbav:"step"`
+	ReviewerID string    `json:"reviewerId" dynamodbav:"reviewerId"`
+	ApprovedAt time.Time `json:"approvedAt" dynamodbav:"approvedAt"`
+}
+
+// ApprovalsForStep returns the number of approvals the request has received for the step.
+func (r *Request) ApprovalsForStep(step int) int {
+	count := 0
+	for _, a := range r.Approvals {
+		if a.Step == step {
+			count++
+		}
+	}
+	return count
+}
+
+// HasApprovalFrom returns true if the reviewer has already approved any step of the request.
+func (r *Request) HasApprovalFrom(reviewerID string) bool {
+	for _, a := range r.Approvals {
+		if a.ReviewerID == reviewerID {
+			return true
+		}
+	}
+	return false
+}
+
 // HasReason returns true if the request has a non-empty reason associated with it.
 func (r *Request) HasReason() bool {
 	return r.Data.Reason != nil && *r.Data.Reason != ""
@@ -117,6 +181,22 @@ func (r *Request) GetInterval(opts ...func(o *GetIntervalOpts)) (start time.Time
 	return r.RequestedTiming.GetInterval(opts...)
 }
 
+// Intervals returns every window of access for the request, taking into account any override by an approver.
+func (r *Request) Intervals(opts ...func(o *GetIntervalOpts)) []Interval {
+	if r.OverrideTiming != nil {
+		return r.OverrideTiming.Intervals(opts...)
+	}
+	return r.RequestedTiming.Intervals(opts...)
+}
+
+// IsRecurring will return true if this request repeats, first checking for override timing, then for original timing
+func (r *Request) IsRecurring() bool {
+	if r.OverrideTiming != nil {
+		return r.OverrideTiming.IsRecurring()
+	}
+	return r.RequestedTiming.IsRecurring()
+}
+
 // IsScheduled will return true if this request is scheduled, first checking for override timing, then for original timing
 func (r *Request) IsScheduled() bool {
 	if r.OverrideTiming != nil {
@@ -147,6 +227,12 @@ func (r *Request) ToAPI() types.Request {
 	if r.OverrideTiming != nil {
 		req.Timing = r.OverrideTiming.ToAPI()
 	}
+	if r.Extension != nil {
+		e := r.Extension.ToAPI()
+		req.Extension = &e
+	}
+	req.RecurrenceOf = r.RecurrenceOf
+	req.TicketReference = r.Data.TicketReference
 
 	return req
 }
@@ -207,6 +293,12 @@ func (r *Request) ToAPIDetail(accessRule rule.AccessRule, canReview bool, reques
 	if r.OverrideTiming != nil {
 		req.Timing = r.OverrideTiming.ToAPI()
 	}
+	if r.Extension != nil {
+		e := r.Extension.ToAPI()
+		req.Extension = &e
+	}
+	req.RecurrenceOf = r.RecurrenceOf
+	req.TicketReference = r.Data.TicketReference
 
 	return req
 }
@@ -226,6 +318,11 @@ func (r *Request) DDBKeys() (ddb.Keys, error) {
 			if !(r.Grant.Status == ac_types.GrantStatusREVOKED || r.Grant.Status == ac_types.GrantStatusERROR) {
 				end = r.Grant.End
 			}
+		} else if r.IsRecurring() {
+			// recurring requests don't have a grant themselves, they end when their last occurrence ends.
+			if intervals := r.Intervals(); len(intervals) > 0 {
+				end = intervals[len(intervals)-1].End
+			}
 		} else if r.IsScheduled() {
 			_, end = r.GetInterval()
 		} else {
@@ -259,6 +356,8 @@ type Timing struct {
 	Duration time.Duration `json:"duration" dynamodbav:"duration"`
 	// If the start time is not nil, this request is for scheduled access, if it is nil, then the request is for asap access
 	StartTime *time.Time `json:"start,omitempty" dynamodbav:"start,omitempty"`
+	// Recurrence is set if the scheduled access repeats. Each occurrence is granted separately.
+	Recurrence *Recurrence `json:"recurrence,omitempty" dynamodbav:"recurrence,omitempty"`
 }
 
 func (t Timing) ToAnalytics() analytics.Timing {
@@ -275,10 +374,15 @@ func (t Timing) ToAnalytics() analytics.Timing {
 
 // TimingFromRequestTiming converts from the api type to the internal type
 func TimingFromRequestTiming(r types.RequestTiming) Timing {
-	return Timing{
+	t := Timing{
 		Duration:  time.Second * time.Duration(r.DurationSeconds),
 		StartTime: r.StartTime,
 	}
+	if r.Recurrence != nil {
+		recurrence := RecurrenceFromAPI(*r.Recurrence)
+		t.Recurrence = &recurrence
+	}
+	return t
 }
 
 // IsScheduled is true if the startTime is not nil
@@ -288,10 +392,30 @@ func (t *Timing) IsScheduled() bool {
 
 // ToAPI returns the api representation of the timing information
 func (t *Timing) ToAPI() types.RequestTiming {
-	return types.RequestTiming{
+	res := types.RequestTiming{
 		DurationSeconds: int(t.Duration.Seconds()),
 		StartTime:       t.StartTime,
 	}
+	if t.Recurrence != nil {
+		recurrence := t.Recurrence.ToAPI()
+		res.Recurrence = &recurrence
+	}
+	return res
+}
+
+// IsRecurring is true if the timing is scheduled and repeats.
+func (t *Timing) IsRecurring() bool {
+	return t.IsScheduled() && t.Recurrence != nil
+}
+
+// Intervals returns every window of access for this timing.
+// For recurring timing this is each occurrence, otherwise it is the single interval returned by GetInterval.
+func (t *Timing) Intervals(opts ...func(o *GetIntervalOpts)) []Interval {
+	if t.IsRecurring() {
+		return t.Recurrence.Occurrences(*t.StartTime, t.Duration)
+	}
+	start, end := t.GetInterval(opts...)
+	return []Interval{{Start: start, End: end}}
 }
 
 // GetInterval returns a start and end time for this timing information
@@ -316,4 +440,6 @@ func (t *Timing) GetInterval(opts ...func(o *GetIntervalOpts)) (start time.Time,
 // through filling in form fields in the web application.
 type RequestData struct {
 	Reason *string `json:"reason,omitempty" dynamodbav:"reason,omitempty"`
+	// TicketReference is a reference to a ticket for the request, such as a Jira issue key or incident ID.
+	TicketReference *string `json:"ticketReference,omitempty" dynamodbav:"ticketReference,omitempty"`
 }
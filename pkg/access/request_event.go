@@ -26,6 +26,87 @@ type RequestEvent struct {
 	GrantFailureReason *string               `json:"grantFailureReason,omitempty" dynamodbav:"grantFailureReason,omitempty"`
 	RequestCreated     *bool                 `json:"requestCreated,omitempty" dynamodbav:"requestCreated,omitempty"`
 	RecordedEvent      *map[string]string    `json:"recordedEvent,omitempty" dynamodbav:"recordedEvent,omitempty"`
+	ApprovalProgress   *ApprovalProgress     `json:"approvalProgress,omitempty" dynamodbav:"approvalProgress,omitempty"`
+	// OnBehalfOf holds the IDs of the approvers who delegated their review to the actor
+	OnBehalfOf []string           `json:"onBehalfOf,omitempty" dynamodbav:"onBehalfOf,omitempty"`
+	Escalation *RequestEscalation `json:"escalation,omitempty" dynamodbav:"escalation,omitempty"`
+	BreakGlass *RequestBreakGlass `json:"breakGlass,omitempty" dynamodbav:"breakGlass,omitempty"`
+	GrantDrift *GrantDrift        `json:"grantDrift,omitempty" dynamodbav:"grantDrift,omitempty"`
+	// AutoApproval is set when the request or its extension was approved by the auto-approval policy
+	AutoApproval *RequestAutoApproval `json:"autoApproval,omitempty" dynamodbav:"autoApproval,omitempty"`
+}
+
+// RequestAutoApproval records the auto-approval policy statement which approved a request.
+type RequestAutoApproval struct {
+	Statement     string `json:"statement" dynamodbav:"statement"`
+	Justification string `json:"justification" dynamodbav:"justification"`
+}
+
+func (a *RequestAutoApproval) ToAPI() types.RequestAutoApproval {
+	return types.RequestAutoApproval{
+		Statement:     a.Statement,
+		Justification: a.Justification,
+	}
+}
+
+// RequestBreakGlass records the use of break-glass access for a request, or the outcome of its post-incident review.
+type RequestBreakGlass struct {
+	// Reason is set when the event records the use of break-glass access.
+	Reason       *string                `json:"reason,omitempty" dynamodbav:"reason,omitempty"`
+	ReviewStatus BreakGlassReviewStatus `json:"reviewStatus" dynamodbav:"reviewStatus"`
+	Comment      *string                `json:"comment,omitempty" dynamodbav:"comment,omitempty"`
+}
+
+func (b *RequestBreakGlass) ToAPI() types.RequestBreakGlass {
+	return types.RequestBreakGlass{
+		Reason:       b.Reason,
+		ReviewStatus: types.BreakGlassReviewStatus(b.ReviewStatus),
+		Comment:      b.Comment,
+	}
+}
+
+// EscalationAction is the action taken when escalating a request which was not reviewed in time.
+type EscalationAction string
+
+const (
+	// ESCALATED means additional reviewers were added to the request.
+	ESCALATED EscalationAction = "ESCALATED"
+	// AUTO_DECLINED means the request was declined because it was not reviewed before the deadline.
+	AUTO_DECLINED EscalationAction = "AUTO_DECLINED"
+)
+
+// RequestEscalation records an escalation applied to a request.
+type RequestEscalation struct {
+	Action         EscalationAction `json:"action" dynamodbav:"action"`
+	AddedReviewers []string         `json:"addedReviewers" dynamodbav:"addedReviewers"`
+}
+
+func (e *RequestEscalation) ToAPI() types.RequestEscalation {
+	added := []string{}
+	if e.AddedReviewers != nil {
+		added = e.AddedReviewers
+	}
+	return types.RequestEscalation{
+		Action:         types.RequestEscalationAction(e.Action),
+		AddedReviewers: added,
+	}
+}
+
+// ApprovalProgress records an approval made towards an approval step of a request.
+type ApprovalProgress struct {
+	Step              int    `json:"step" dynamodbav:"step"`
+	StepName          string `json:"stepName" dynamodbav:"stepName"`
+	Approvals         int    `json:"approvals" dynamodbav:"approvals"`
+	RequiredApprovals int    `json:"requiredApprovals" dynamodbav:"requiredApprovals"`
+}
+
+func (p *ApprovalProgress) ToAPI() types.ApprovalProgress {
+	return types.ApprovalProgress{
+		Step:              p.Step,
+		StepName:          p.StepName,
+		Approvals:         p.Approvals,
+		RequiredApprovals: p.RequiredApprovals,
+	}
 }
 
 func NewRequestCreatedEvent(requestID string, createdAt time.Time, actor *string) RequestEvent {
@@ -58,6 +139,26 @@ func NewRecordedEvent(requestID string, actor *string, createdAt time.Time, even
 	return RequestEvent{ID: types.NewHistoryID(), Actor: actor, CreatedAt: createdAt, RequestID: requestID, RecordedEvent: &event}
 }
 
+func NewApprovalProgressEvent(requestID string, createdAt time.Time, actor *string, progress ApprovalProgress) RequestEvent {
+	return RequestEvent{ID: types.NewHistoryID(), CreatedAt: createdAt, Actor: actor, RequestID: requestID, ApprovalProgress: &progress}
+}
+
+func NewEscalationEvent(requestID string, createdAt time.Time, escalation RequestEscalation) RequestEvent {
+	return RequestEvent{ID: types.NewHistoryID(), CreatedAt: createdAt, RequestID: requestID, Escalation: &escalation}
+}
+
+func NewBreakGlassUsedEvent(requestID string, createdAt time.Time, actor *string, reason string) RequestEvent {
+	return RequestEvent{ID: types.NewHistoryID(), CreatedAt: createdAt, Actor: actor, RequestID: requestID, BreakGlass: &RequestBreakGlass{Reason: &reason, ReviewStatus: BreakGlassReviewOpen}}
+}
+
+func NewAutoApprovedEvent(requestID string, createdAt time.Time, autoApproval RequestAutoApproval) RequestEvent {
+	return RequestEvent{ID: types.NewHistoryID(), CreatedAt: createdAt, RequestID: requestID, AutoApproval: &autoApproval}
+}
+
+func NewBreakGlassReviewedEvent(requestID string, createdAt time.Time, actor *string, status BreakGlassReviewStatus, comment *string) RequestEvent {
+	return RequestEvent{ID: types.NewHistoryID(), CreatedAt: createdAt, Actor: actor, RequestID: requestID, BreakGlass: &RequestBreakGlass{ReviewStatus: status, Comment: comment}}
+}
+
 func (r *RequestEvent) ToAPI() types.RequestEvent {
 	var toTiming *types.RequestTiming
 	var fromTiming *types.RequestTiming
@@ -69,6 +170,35 @@ func (r *RequestEvent) ToAPI() types.RequestEvent {
 		ft := r.FromTiming.ToAPI()
 		fromTiming = &ft
 	}
+	var approvalProgress *types.ApprovalProgress
+	if r.ApprovalProgress != nil {
+		ap := r.ApprovalProgress.ToAPI()
+		approvalProgress = &ap
+	}
+	var onBehalfOf *[]string
+	if len(r.OnBehalfOf) > 0 {
+		onBehalfOf = &r.OnBehalfOf
+	}
+	var escalation *types.RequestEscalation
+	if r.Escalation != nil {
+		e := r.Escalation.ToAPI()
+		escalation = &e
+	}
+	var breakGlass *types.RequestBreakGlass
+	if r.BreakGlass != nil {
+		bg := r.BreakGlass.ToAPI()
+		breakGlass = &bg
+	}
+	var grantDrift *types.RequestGrantDrift
+	if r.GrantDrift != nil {
+		gd := r.GrantDrift.ToAPI()
+		grantDrift = &gd
+	}
+	var autoApproval *types.RequestAutoApproval
+	if r.AutoApproval != nil {
+		aa := r.AutoApproval.ToAPI()
+		autoApproval = &aa
+	}
 	return types.RequestEvent{
 		Id:                 r.ID,
 		RequestId:          r.RequestID,
@@ -84,6 +214,12 @@ func (r *RequestEvent) ToAPI() types.RequestEvent {
 		RequestCreated:     r.RequestCreated,
 		GrantFailureReason: r.GrantFailureReason,
 		RecordedEvent:      r.RecordedEvent,
+		ApprovalProgress:   approvalProgress,
+		OnBehalfOf:         onBehalfOf,
+		Escalation:         escalation,
+		BreakGlass:         breakGlass,
+		GrantDrift:         grantDrift,
+		AutoApproval:       autoApproval,
 	}
 }
 
@@ -95,3 +231,7 @@ func (r *RequestEvent) DDBKeys() (ddb.Keys, error) {
 
 	return keys, nil
 }
+
+func NewGrantDriftedEvent(requestID string, createdAt time.Time, drift GrantDrift) RequestEvent {
+	return RequestEvent{ID: types.NewHistoryID(), CreatedAt: createdAt, RequestID: requestID, GrantDrift: &drift}
+}
@@ -208,3 +208,12 @@ func TestRequestGetInterval(t *testing.T) {
 		})
 	}
 }
+
+func TestAccessKey(t *testing.T) {
+	a := AccessKey("aws", "User@example.com", map[string]string{"accountId": "123", "permissionSetArn": "arn"})
+	b := AccessKey("aws", "user@example.com", map[string]string{"permissionSetArn": "arn", "accountId": "123"})
+	assert.Equal(t, a, b)
+
+	c := AccessKey("aws", "user@example.com", map[string]string{"permissionSetArn": "arn", "accountId": "456"})
+	assert.NotEqual(t, a, c)
+}
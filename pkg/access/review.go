@@ -25,6 +25,8 @@ type Review struct {
 	Decision        Decision `json:"decision" dynamodbav:"decision"`
 	Comment         *string  `json:"comment,omitempty" dynamodbav:"comment,omitempty"`
 	OverrideTimings *Timing  `json:"overrideTimings,omitempty" dynamodbav:"overrideTimings,omitempty"`
+	// OnBehalfOf holds the IDs of the approvers who delegated their review to the reviewer
+	OnBehalfOf []string `json:"onBehalfOf,omitempty" dynamodbav:"onBehalfOf,omitempty"`
 }
 
 func (r *Review) DDBKeys() (ddb.Keys, error) {
@@ -13,6 +13,27 @@ type Reviewer struct {
 	// Request is the associated request.
 	Request       Request       `json:"request" dynamodbav:"request"`
 	Notifications Notifications `json:"notifications" dynamodbav:"notifications"`
+	// Steps are the indexes of the approval steps which the reviewer is eligible to approve.
+	// Reviewers created before multi-step approvals were introduced have no steps and are treated as eligible for the first step.
+	Steps []int `json:"steps,omitempty" dynamodbav:"steps,omitempty"`
+	// Decision is set once the reviewer has reviewed the request.
+	Decision *Decision `json:"decision,omitempty" dynamodbav:"decision,omitempty"`
+	// OnBehalfOf holds the IDs of the approvers who had delegated their reviews to this reviewer
+	// when the request was created. It is empty if the reviewer is not a delegate.
+	OnBehalfOf []string `json:"onBehalfOf,omitempty" dynamodbav:"onBehalfOf,omitempty"`
+}
+
+// CanApproveStep returns true if the reviewer is eligible to approve the approval step.
+func (r *Reviewer) CanApproveStep(step int) bool {
+	if len(r.Steps) == 0 {
+		return step == 0
+	}
+	for _, s := range r.Steps {
+		if s == step {
+			return true
+		}
+	}
+	return false
 }
 
 type Notifications struct {
@@ -0,0 +1,23 @@
+package access
+
+import (
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/ddb"
+)
+
+// RequestTicket records the ticket reference given on a request, so that requests can be looked up by ticket reference.
+type RequestTicket struct {
+	Reference string    `json:"reference" dynamodbav:"reference"`
+	RequestID string    `json:"requestId" dynamodbav:"requestId"`
+	CreatedAt time.Time `json:"createdAt" dynamodbav:"createdAt"`
+}
+
+func (t *RequestTicket) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.RequestTicket.PK1,
+		SK: keys.RequestTicket.SK1(t.Reference, t.RequestID),
+	}
+	return keys, nil
+}
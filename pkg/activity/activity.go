@@ -0,0 +1,123 @@
+// Package activity correlates what users did while their access was active with the
+// access requests which granted the access.
+//
+// Provider audit logs (such as AWS CloudTrail or the Okta System Log) are read by a Reader,
+// normalised into Records and attributed to the grants which were active at the time.
+// Attributed records are stored per request as Events.
+package activity
+
+import (
+	"context"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// Record is a single entry from a provider audit log, normalised so that it can be attributed to a grant.
+type Record struct {
+	// ID uniquely identifies the record in the source audit log.
+	ID string `json:"id"`
+	// Source is the audit log the record was read from, e.g. "aws-cloudtrail".
+	Source string `json:"source"`
+	// Subject is the identity which performed the action, usually an email address.
+	Subject string `json:"subject"`
+	// SessionID identifies the session the action was performed in, if the audit log records one.
+	// Records which share a session with an attributed record are attributed to the same grant.
+	SessionID string `json:"sessionId,omitempty"`
+	// Account is the account or tenant the action was performed in, if known.
+	// For AWS this is the account ID.
+	Account string `json:"account,omitempty"`
+	// Action is the name of the action which was performed, e.g. "s3:GetObject".
+	Action string `json:"action"`
+	// Resource is the resource the action was performed on, if known.
+	Resource string `json:"resource,omitempty"`
+	// SourceIP is the IP address the action was performed from, if known.
+	SourceIP  string    `json:"sourceIp,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// Reader reads records from a provider audit log.
+type Reader interface {
+	// Read returns the records in the audit log which occurred between from (inclusive) and to (exclusive).
+	Read(ctx context.Context, from time.Time, to time.Time) ([]Record, error)
+}
+
+// Event is a record from a provider audit log which has been attributed to the grant of an access request.
+type Event struct {
+	RequestID string `json:"requestId" dynamodbav:"requestId"`
+	// ID is the ID of the record in the source audit log.
+	ID        string    `json:"id" dynamodbav:"id"`
+	Source    string    `json:"source" dynamodbav:"source"`
+	Subject   string    `json:"subject" dynamodbav:"subject"`
+	SessionID string    `json:"sessionId,omitempty" dynamodbav:"sessionId,omitempty"`
+	Account   string    `json:"account,omitempty" dynamodbav:"account,omitempty"`
+	Action    string    `json:"action" dynamodbav:"action"`
+	Resource  string    `json:"resource,omitempty" dynamodbav:"resource,omitempty"`
+	SourceIP  string    `json:"sourceIp,omitempty" dynamodbav:"sourceIp,omitempty"`
+	Timestamp time.Time `json:"timestamp" dynamodbav:"timestamp"`
+	// AttributedBy is how the record was matched to the grant.
+	AttributedBy Attribution `json:"attributedBy" dynamodbav:"attributedBy"`
+	CreatedAt    time.Time   `json:"createdAt" dynamodbav:"createdAt"`
+}
+
+// Attribution describes how a record was matched to a grant.
+type Attribution string
+
+const (
+	// AttributedBySubject means the subject of the record matched the subject of the grant.
+	AttributedBySubject Attribution = "SUBJECT"
+	// AttributedBySession means the record shared a session with a record which was attributed by subject.
+	AttributedBySession Attribution = "SESSION"
+)
+
+func (e *Event) ToAPI() types.RequestActivity {
+	a := types.RequestActivity{
+		Id:           e.ID,
+		RequestId:    e.RequestID,
+		Source:       e.Source,
+		Subject:      e.Subject,
+		Action:       e.Action,
+		Timestamp:    e.Timestamp,
+		AttributedBy: types.RequestActivityAttribution(e.AttributedBy),
+	}
+	if e.SessionID != "" {
+		a.SessionId = &e.SessionID
+	}
+	if e.Account != "" {
+		a.Account = &e.Account
+	}
+	if e.Resource != "" {
+		a.Resource = &e.Resource
+	}
+	if e.SourceIP != "" {
+		a.SourceIp = &e.SourceIP
+	}
+	return a
+}
+
+func (e *Event) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.RequestActivity.PK1,
+		SK: keys.RequestActivity.SK1(e.RequestID, e.Timestamp.Format(time.RFC3339Nano), e.Source, e.ID),
+	}
+	return keys, nil
+}
+
+// Cursor records how far through an audit log activity has been ingested,
+// so that each run of the ingestion only reads new records.
+type Cursor struct {
+	// Source is the name of the audit log.
+	Source string `json:"source" dynamodbav:"source"`
+	// ReadUntil is the time up to which records have been read.
+	ReadUntil time.Time `json:"readUntil" dynamodbav:"readUntil"`
+}
+
+func (c *Cursor) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.ActivityCursor.PK1,
+		SK: keys.ActivityCursor.SK1(c.Source),
+	}
+	return keys, nil
+}
@@ -0,0 +1,102 @@
+package activity
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/access"
+)
+
+// Attribute matches audit log records to the grants of the given requests.
+//
+// A record is attributed to a grant if it occurred while the grant was active and either:
+//   - the subject of the record is the subject of the grant, or
+//   - the record shares a session with a record which was attributed to the grant by subject.
+//
+// If the grant targets a specific account (an "accountId" argument) records from other accounts are ignored.
+// A record may be attributed to more than one grant if the user had several overlapping grants.
+func Attribute(records []Record, requests []access.Request, now time.Time) []Event {
+	sorted := make([]Record, len(records))
+	copy(sorted, records)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Timestamp.Before(sorted[j].Timestamp) })
+
+	// sessions maps a session in an audit log to the requests which it has been attributed to.
+	sessions := map[string][]access.Request{}
+
+	var events []Event
+	for _, r := range sorted {
+		var sessionKey string
+		if r.SessionID != "" {
+			sessionKey = r.Source + "#" + r.SessionID
+		}
+		var matched bool
+		for _, req := range requests {
+			if !activeAt(req, r.Timestamp) || !strings.EqualFold(req.Grant.Subject, r.Subject) || !accountMatches(req, r) {
+				continue
+			}
+			matched = true
+			events = append(events, newEvent(req.ID, r, AttributedBySubject, now))
+			if sessionKey != "" && !containsRequest(sessions[sessionKey], req.ID) {
+				sessions[sessionKey] = append(sessions[sessionKey], req)
+			}
+		}
+		if matched || sessionKey == "" {
+			continue
+		}
+		for _, req := range sessions[sessionKey] {
+			if activeAt(req, r.Timestamp) {
+				events = append(events, newEvent(req.ID, r, AttributedBySession, now))
+			}
+		}
+	}
+	return events
+}
+
+// activeAt returns true if the request had a grant which was active at time t.
+func activeAt(req access.Request, t time.Time) bool {
+	if req.Grant == nil {
+		return false
+	}
+	end := req.Grant.End
+	// a revoked grant stops being active when it is revoked, rather than at its scheduled end.
+	if req.Grant.Status == ahTypes.GrantStatusREVOKED && req.Grant.UpdatedAt.Before(end) {
+		end = req.Grant.UpdatedAt
+	}
+	return !t.Before(req.Grant.Start) && t.Before(end)
+}
+
+func accountMatches(req access.Request, r Record) bool {
+	if r.Account == "" {
+		return true
+	}
+	accountID, ok := req.Grant.With.AdditionalProperties["accountId"]
+	return !ok || accountID == r.Account
+}
+
+func containsRequest(requests []access.Request, id string) bool {
+	for _, r := range requests {
+		if r.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func newEvent(requestID string, r Record, by Attribution, now time.Time) Event {
+	return Event{
+		RequestID:    requestID,
+		ID:           r.ID,
+		Source:       r.Source,
+		Subject:      r.Subject,
+		SessionID:    r.SessionID,
+		Account:      r.Account,
+		Action:       r.Action,
+		Resource:     r.Resource,
+		SourceIP:     r.SourceIP,
+		Timestamp:    r.Timestamp,
+		AttributedBy: by,
+		CreatedAt:    now,
+	}
+}
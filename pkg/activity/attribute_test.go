@@ -0,0 +1,95 @@
+package activity
+
+import (
+	"testing"
+	"time"
+
+	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttribute(t *testing.T) {
+	start := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+	now := start.Add(2 * time.Hour)
+	grant := func(subject string, accountID string) *access.Grant {
+		g := &access.Grant{Subject: subject, Start: start, End: start.Add(time.Hour), Status: ahTypes.GrantStatusACTIVE}
+		if accountID != "" {
+			g.With.AdditionalProperties = map[string]string{"accountId": accountID}
+		}
+		return g
+	}
+	revoked := grant("alice@example.com", "")
+	revoked.Status = ahTypes.GrantStatusREVOKED
+	revoked.UpdatedAt = start.Add(10 * time.Minute)
+
+	type testcase struct {
+		name         string
+		giveRecords  []Record
+		giveRequests []access.Request
+		want         []Event
+	}
+
+	testcases := []testcase{
+		{
+			name: "subject match",
+			giveRecords: []Record{
+				{ID: "1", Source: "file", Subject: "Alice@example.com", Action: "s3:GetObject", Timestamp: start.Add(time.Minute)},
+			},
+			giveRequests: []access.Request{{ID: "req_1", Grant: grant("alice@example.com", "")}},
+			want: []Event{
+				{RequestID: "req_1", ID: "1", Source: "file", Subject: "Alice@example.com", Action: "s3:GetObject", Timestamp: start.Add(time.Minute), AttributedBy: AttributedBySubject, CreatedAt: now},
+			},
+		},
+		{
+			name: "outside grant window",
+			giveRecords: []Record{
+				{ID: "1", Source: "file", Subject: "alice@example.com", Action: "s3:GetObject", Timestamp: start.Add(-time.Minute)},
+				{ID: "2", Source: "file", Subject: "alice@example.com", Action: "s3:GetObject", Timestamp: start.Add(time.Hour)},
+			},
+			giveRequests: []access.Request{{ID: "req_1", Grant: grant("alice@example.com", "")}},
+		},
+		{
+			name: "after revocation",
+			giveRecords: []Record{
+				{ID: "1", Source: "file", Subject: "alice@example.com", Action: "s3:GetObject", Timestamp: start.Add(20 * time.Minute)},
+			},
+			giveRequests: []access.Request{{ID: "req_1", Grant: revoked}},
+		},
+		{
+			name: "different account",
+			giveRecords: []Record{
+				{ID: "1", Source: "file", Subject: "alice@example.com", Account: "222222222222", Action: "s3:GetObject", Timestamp: start.Add(time.Minute)},
+			},
+			giveRequests: []access.Request{{ID: "req_1", Grant: grant("alice@example.com", "111111111111")}},
+		},
+		{
+			name: "session match",
+			giveRecords: []Record{
+				// records are attributed in time order, so the session is known before the second record is checked.
+				{ID: "2", Source: "file", Subject: "i-0123456789", SessionID: "sess_1", Action: "ssm:SendCommand", Timestamp: start.Add(2 * time.Minute)},
+				{ID: "1", Source: "file", Subject: "alice@example.com", SessionID: "sess_1", Action: "ssm:StartSession", Timestamp: start.Add(time.Minute)},
+				{ID: "3", Source: "other", Subject: "i-0123456789", SessionID: "sess_1", Action: "ssm:SendCommand", Timestamp: start.Add(3 * time.Minute)},
+			},
+			giveRequests: []access.Request{{ID: "req_1", Grant: grant("alice@example.com", "")}, {ID: "req_2", Grant: grant("bob@example.com", "")}},
+			want: []Event{
+				{RequestID: "req_1", ID: "1", Source: "file", Subject: "alice@example.com", SessionID: "sess_1", Action: "ssm:StartSession", Timestamp: start.Add(time.Minute), AttributedBy: AttributedBySubject, CreatedAt: now},
+				{RequestID: "req_1", ID: "2", Source: "file", Subject: "i-0123456789", SessionID: "sess_1", Action: "ssm:SendCommand", Timestamp: start.Add(2 * time.Minute), AttributedBy: AttributedBySession, CreatedAt: now},
+			},
+		},
+		{
+			name: "request without a grant",
+			giveRecords: []Record{
+				{ID: "1", Source: "file", Subject: "alice@example.com", Action: "s3:GetObject", Timestamp: start.Add(time.Minute)},
+			},
+			giveRequests: []access.Request{{ID: "req_1"}},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := Attribute(tc.giveRecords, tc.giveRequests, now)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package readers
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	ctTypes "github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+	ecsshellsso "github.com/common-fate/common-fate/accesshandler/pkg/providers/aws/ecs-shell-sso"
+	"github.com/common-fate/common-fate/pkg/activity"
+	"github.com/common-fate/common-fate/pkg/cfaws"
+	"github.com/common-fate/common-fate/pkg/gconfig"
+)
+
+// CloudTrail reads activity records from AWS CloudTrail management events.
+// It attributes actions performed by AWS SSO users, including ECS shell sessions, to their grants.
+type CloudTrail struct {
+	client  *cloudtrail.Client
+	region  gconfig.StringValue
+	roleARN gconfig.OptionalStringValue
+}
+
+func (c *CloudTrail) Config() gconfig.Config {
+	return gconfig.Config{
+		gconfig.StringField("region", &c.region, "the AWS region to read CloudTrail events from"),
+		gconfig.OptionalStringField("roleArn", &c.roleARN, "an AWS IAM Role with permission to look up CloudTrail events"),
+	}
+}
+
+func (c *CloudTrail) Init(ctx context.Context) error {
+	opts := []func(*config.LoadOptions) error{config.WithRegion(c.region.Get())}
+	if c.roleARN.IsSet() {
+		opts = append(opts, config.WithCredentialsProvider(cfaws.NewAssumeRoleCredentialsCache(ctx, c.roleARN.Get(), cfaws.WithRoleSessionName("common-fate-activity"))))
+	}
+	cfg, err := config.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return err
+	}
+	c.client = cloudtrail.NewFromConfig(cfg)
+	return nil
+}
+
+func (c *CloudTrail) Read(ctx context.Context, from time.Time, to time.Time) ([]activity.Record, error) {
+	var records []activity.Record
+	p := cloudtrail.NewLookupEventsPaginator(c.client, &cloudtrail.LookupEventsInput{
+		StartTime: aws.Time(from),
+		EndTime:   aws.Time(to),
+	})
+	for p.HasMorePages() {
+		page, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range page.Events {
+			r, ok, err := recordFromCloudTrailEvent(e)
+			if err != nil {
+				return nil, err
+			}
+			// LookupEvents includes events at the end time, which are read in the next window instead.
+			if ok && r.Timestamp.Before(to) {
+				records = append(records, r)
+			}
+		}
+	}
+	return records, nil
+}
+
+// recordFromCloudTrailEvent converts a CloudTrail event to an activity record.
+// It returns false if the event wasn't performed with an assumed role session, which is how AWS SSO users access AWS.
+func recordFromCloudTrailEvent(e ctTypes.Event) (activity.Record, bool, error) {
+	if e.CloudTrailEvent == nil {
+		return activity.Record{}, false, nil
+	}
+	var ct ecsshellsso.CloudTrailEvent
+	err := json.Unmarshal([]byte(*e.CloudTrailEvent), &ct)
+	if err != nil {
+		return activity.Record{}, false, err
+	}
+	if ct.UserIdentity.Type != "AssumedRole" {
+		return activity.Record{}, false, nil
+	}
+	// the ARN of an assumed role session ends with the session name.
+	// For AWS SSO users this is the username of the user, which is usually their email address.
+	// e.g. arn:aws:sts::123456789012:assumed-role/AWSReservedSSO_ReadOnly_abcdef/alice@example.com
+	arn := ct.UserIdentity.Arn
+	subject := arn[strings.LastIndex(arn, "/")+1:]
+
+	r := activity.Record{
+		ID:        ct.EventID,
+		Source:    ReaderTypeCloudTrail,
+		Subject:   subject,
+		SessionID: ct.UserIdentity.AccessKeyID,
+		Account:   ct.RecipientAccountID,
+		Action:    strings.TrimSuffix(ct.EventSource, ".amazonaws.com") + ":" + ct.EventName,
+		SourceIP:  ct.SourceIPAddress,
+		Timestamp: ct.EventTime,
+	}
+	// ECS shell sessions are started with ssm:StartSession, and the target is the ECS task.
+	if ct.RequestParameters.Target != "" {
+		r.Resource = ct.RequestParameters.Target
+	} else if len(e.Resources) > 0 {
+		r.Resource = aws.ToString(e.Resources[0].ResourceName)
+	}
+	return r, true, nil
+}
@@ -0,0 +1,74 @@
+package readers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ctTypes "github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+	"github.com/common-fate/common-fate/pkg/activity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordFromCloudTrailEvent(t *testing.T) {
+	type testcase struct {
+		name   string
+		give   ctTypes.Event
+		want   activity.Record
+		wantOK bool
+	}
+
+	testcases := []testcase{
+		{
+			name: "assumed role",
+			give: ctTypes.Event{
+				CloudTrailEvent: aws.String(`{"eventID":"evt_1","eventTime":"2022-01-01T10:00:00Z","eventSource":"s3.amazonaws.com","eventName":"GetObject","sourceIPAddress":"1.2.3.4","recipientAccountId":"123456789012","userIdentity":{"type":"AssumedRole","arn":"arn:aws:sts::123456789012:assumed-role/AWSReservedSSO_ReadOnly_abcdef/alice@example.com","accessKeyId":"ASIA123"}}`),
+				Resources:       []ctTypes.Resource{{ResourceName: aws.String("my-bucket")}},
+			},
+			want: activity.Record{
+				ID:        "evt_1",
+				Source:    ReaderTypeCloudTrail,
+				Subject:   "alice@example.com",
+				SessionID: "ASIA123",
+				Account:   "123456789012",
+				Action:    "s3:GetObject",
+				Resource:  "my-bucket",
+				SourceIP:  "1.2.3.4",
+				Timestamp: time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC),
+			},
+			wantOK: true,
+		},
+		{
+			name: "ecs shell session",
+			give: ctTypes.Event{
+				CloudTrailEvent: aws.String(`{"eventID":"evt_2","eventTime":"2022-01-01T10:00:00Z","eventSource":"ssm.amazonaws.com","eventName":"StartSession","recipientAccountId":"123456789012","userIdentity":{"type":"AssumedRole","arn":"arn:aws:sts::123456789012:assumed-role/AWSReservedSSO_Shell_abcdef/alice@example.com","accessKeyId":"ASIA123"},"requestParameters":{"target":"ecs:cluster_task_container"}}`),
+			},
+			want: activity.Record{
+				ID:        "evt_2",
+				Source:    ReaderTypeCloudTrail,
+				Subject:   "alice@example.com",
+				SessionID: "ASIA123",
+				Account:   "123456789012",
+				Action:    "ssm:StartSession",
+				Resource:  "ecs:cluster_task_container",
+				Timestamp: time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC),
+			},
+			wantOK: true,
+		},
+		{
+			name: "IAM user is ignored",
+			give: ctTypes.Event{
+				CloudTrailEvent: aws.String(`{"eventID":"evt_3","eventTime":"2022-01-01T10:00:00Z","userIdentity":{"type":"IAMUser","arn":"arn:aws:iam::123456789012:user/bob"}}`),
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok, err := recordFromCloudTrailEvent(tc.give)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantOK, ok)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
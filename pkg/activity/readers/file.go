@@ -0,0 +1,58 @@
+package readers
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/activity"
+	"github.com/common-fate/common-fate/pkg/gconfig"
+)
+
+// File reads activity records from a file containing one JSON-encoded activity.Record per line.
+// It's used for testing activity ingestion without a provider audit log.
+type File struct {
+	path gconfig.StringValue
+}
+
+func (f *File) Config() gconfig.Config {
+	return gconfig.Config{
+		gconfig.StringField("path", &f.path, "the path to the JSON lines file containing activity records"),
+	}
+}
+
+func (f *File) Init(ctx context.Context) error {
+	return nil
+}
+
+func (f *File) Read(ctx context.Context, from time.Time, to time.Time) ([]activity.Record, error) {
+	file, err := os.Open(f.path.Get())
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var records []activity.Record
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var r activity.Record
+		err = json.Unmarshal(line, &r)
+		if err != nil {
+			return nil, err
+		}
+		if r.Timestamp.Before(from) || !r.Timestamp.Before(to) {
+			continue
+		}
+		if r.Source == "" {
+			r.Source = ReaderTypeFile
+		}
+		records = append(records, r)
+	}
+	return records, scanner.Err()
+}
@@ -0,0 +1,41 @@
+package readers
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/activity"
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileRead(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "activity.jsonl")
+	data := `{"id":"1","subject":"alice@example.com","action":"s3:GetObject","timestamp":"2022-01-01T09:59:59Z"}
+{"id":"2","subject":"alice@example.com","action":"s3:GetObject","timestamp":"2022-01-01T10:00:00Z"}
+
+{"id":"3","source":"aws-cloudtrail","subject":"alice@example.com","action":"s3:PutObject","timestamp":"2022-01-01T10:30:00Z"}
+{"id":"4","subject":"alice@example.com","action":"s3:GetObject","timestamp":"2022-01-01T11:00:00Z"}
+`
+	err := os.WriteFile(path, []byte(data), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var f File
+	err = f.Config().Load(context.Background(), &gconfig.MapLoader{Values: map[string]string{"path": path}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	from := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+	got, err := f.Read(context.Background(), from, from.Add(time.Hour))
+	assert.NoError(t, err)
+	want := []activity.Record{
+		{ID: "2", Source: ReaderTypeFile, Subject: "alice@example.com", Action: "s3:GetObject", Timestamp: from},
+		{ID: "3", Source: ReaderTypeCloudTrail, Subject: "alice@example.com", Action: "s3:PutObject", Timestamp: from.Add(30 * time.Minute)},
+	}
+	assert.Equal(t, want, got)
+}
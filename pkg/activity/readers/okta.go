@@ -0,0 +1,97 @@
+package readers
+
+import (
+	"context"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/activity"
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+// Okta reads activity records from the Okta System Log.
+type Okta struct {
+	client   *okta.Client
+	orgURL   gconfig.StringValue
+	apiToken gconfig.SecretStringValue
+}
+
+func (o *Okta) Config() gconfig.Config {
+	return gconfig.Config{
+		gconfig.StringField("orgUrl", &o.orgURL, "the Okta organization URL"),
+		gconfig.SecretStringField("apiToken", &o.apiToken, "the Okta API token", gconfig.WithNoArgs("/granted/secrets/activity/okta/token")),
+	}
+}
+
+func (o *Okta) Init(ctx context.Context) error {
+	_, client, err := okta.NewClient(
+		ctx,
+		okta.WithOrgUrl(o.orgURL.Get()),
+		okta.WithToken(o.apiToken.Get()),
+	)
+	if err != nil {
+		return err
+	}
+	o.client = client
+	return nil
+}
+
+func (o *Okta) Read(ctx context.Context, from time.Time, to time.Time) ([]activity.Record, error) {
+	logs, res, err := o.client.LogEvent.GetLogs(ctx, query.NewQueryParams(
+		query.WithSince(from.UTC().Format(time.RFC3339)),
+		query.WithUntil(to.UTC().Format(time.RFC3339)),
+		query.WithLimit(1000),
+	))
+	if err != nil {
+		return nil, err
+	}
+	for res.HasNextPage() {
+		var next []*okta.LogEvent
+		res, err = res.Next(ctx, &next)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, next...)
+	}
+
+	var records []activity.Record
+	for _, l := range logs {
+		r, ok := recordFromOktaLogEvent(l)
+		if ok {
+			records = append(records, r)
+		}
+	}
+	return records, nil
+}
+
+// recordFromOktaLogEvent converts an Okta System Log event to an activity record.
+// It returns false if the event wasn't performed by a user.
+func recordFromOktaLogEvent(l *okta.LogEvent) (activity.Record, bool) {
+	if l.Actor == nil || l.Published == nil || l.Actor.Type != "User" {
+		return activity.Record{}, false
+	}
+	r := activity.Record{
+		ID:        l.Uuid,
+		Source:    ReaderTypeOkta,
+		Subject:   l.Actor.AlternateId,
+		Action:    l.EventType,
+		Timestamp: *l.Published,
+	}
+	if l.AuthenticationContext != nil {
+		// Okta reports "unknown" for events which aren't tied to a session.
+		if l.AuthenticationContext.ExternalSessionId != "unknown" {
+			r.SessionID = l.AuthenticationContext.ExternalSessionId
+		}
+	}
+	if l.Client != nil {
+		r.SourceIP = l.Client.IpAddress
+	}
+	if len(l.Target) > 0 && l.Target[0] != nil {
+		r.Resource = l.Target[0].AlternateId
+		if r.Resource == "" {
+			r.Resource = l.Target[0].DisplayName
+		}
+	}
+	return r, true
+}
@@ -0,0 +1,91 @@
+package readers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/common-fate/common-fate/pkg/activity"
+	"github.com/common-fate/common-fate/pkg/gconfig"
+)
+
+const (
+	ReaderTypeCloudTrail = "aws-cloudtrail"
+	ReaderTypeOkta       = "okta"
+	ReaderTypeFile       = "file"
+)
+
+// ConfigurableReader is an activity reader which is configured with gconfig.
+type ConfigurableReader interface {
+	activity.Reader
+	gconfig.Configer
+	gconfig.Initer
+}
+
+type RegisteredReader struct {
+	// New returns a new, unconfigured reader.
+	New         func() ConfigurableReader
+	Description string
+}
+
+type ReaderRegistry struct {
+	Readers map[string]RegisteredReader
+}
+
+func Registry() ReaderRegistry {
+	return ReaderRegistry{
+		Readers: map[string]RegisteredReader{
+			ReaderTypeCloudTrail: {
+				New:         func() ConfigurableReader { return &CloudTrail{} },
+				Description: "AWS CloudTrail",
+			},
+			ReaderTypeOkta: {
+				New:         func() ConfigurableReader { return &Okta{} },
+				Description: "Okta System Log",
+			},
+			ReaderTypeFile: {
+				New:         func() ConfigurableReader { return &File{} },
+				Description: "JSON lines file (for testing)",
+			},
+		},
+	}
+}
+
+// Lookup a reader by its type.
+func (r ReaderRegistry) Lookup(uses string) (*RegisteredReader, error) {
+	reader, ok := r.Readers[uses]
+	if !ok {
+		return nil, fmt.Errorf("could not find activity reader %s", uses)
+	}
+	return &reader, nil
+}
+
+// Load configures and initialises the readers in the settings map, which is keyed by reader type.
+// The returned map is keyed by reader type, which is used as the source of the records.
+func Load(ctx context.Context, settings map[string]map[string]string) (map[string]activity.Reader, error) {
+	// load the readers in a consistent order so that configuration errors are reported deterministically.
+	var types []string
+	for t := range settings {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+
+	res := make(map[string]activity.Reader)
+	for _, t := range types {
+		rr, err := Registry().Lookup(t)
+		if err != nil {
+			return nil, err
+		}
+		reader := rr.New()
+		err = reader.Config().Load(ctx, &gconfig.MapLoader{Values: settings[t]})
+		if err != nil {
+			return nil, fmt.Errorf("loading configuration for activity reader %s: %w", t, err)
+		}
+		err = reader.Init(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("initialising activity reader %s: %w", t, err)
+		}
+		res[t] = reader
+	}
+	return res, nil
+}
@@ -289,7 +289,7 @@ func (a *API) UserGetAccessRule(w http.ResponseWriter, r *http.Request, ruleId s
 		apio.Error(ctx, w, err)
 		return
 	}
-	apio.JSON(ctx, w, result.Rule.ToRequestAccessRuleAPI(requestArguments, result.CanRequest), http.StatusOK)
+	apio.JSON(ctx, w, result.Rule.ToRequestAccessRuleAPI(requestArguments, result.CanRequest, result.CanBreakGlass), http.StatusOK)
 }
 
 func (a *API) UserGetAccessRuleApprovers(w http.ResponseWriter, r *http.Request, ruleId string) {
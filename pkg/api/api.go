@@ -4,6 +4,7 @@ package api
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/benbjohnson/clock"
@@ -14,6 +15,7 @@ import (
 	ahtypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
 	"github.com/common-fate/common-fate/pkg/access"
 	"github.com/common-fate/common-fate/pkg/auth"
+	"github.com/common-fate/common-fate/pkg/autoapproval"
 	"github.com/common-fate/common-fate/pkg/cache"
 	"github.com/common-fate/common-fate/pkg/deploy"
 	"github.com/common-fate/common-fate/pkg/gconfig"
@@ -29,6 +31,7 @@ import (
 	"github.com/common-fate/common-fate/pkg/service/healthchecksvc"
 	"github.com/common-fate/common-fate/pkg/service/requestroutersvc"
 
+	"github.com/common-fate/common-fate/pkg/service/delegationsvc"
 	"github.com/common-fate/common-fate/pkg/service/handlersvc"
 	"github.com/common-fate/common-fate/pkg/service/internalidentitysvc"
 	"github.com/common-fate/common-fate/pkg/service/psetupsvc"
@@ -37,6 +40,7 @@ import (
 	"github.com/common-fate/common-fate/pkg/service/workflowsvc"
 	"github.com/common-fate/common-fate/pkg/service/workflowsvc/runtimes/live"
 	"github.com/common-fate/common-fate/pkg/target"
+	"github.com/common-fate/common-fate/pkg/ticket"
 
 	"github.com/common-fate/common-fate/pkg/types"
 	"github.com/common-fate/ddb"
@@ -83,6 +87,7 @@ type API struct {
 	HandlerService     HandlerService
 	Workflow           Workflow
 	HealthcheckService HealthcheckService
+	Delegations        DelegationService
 }
 
 //go:generate go run github.com/golang/mock/mockgen -destination=mocks/mock_cognito_service.go -package=mocks . CognitoService
@@ -97,6 +102,8 @@ type CognitoService interface {
 type ProviderSetupService interface {
 	Create(ctx context.Context, providerType string, existingProviders deploy.ProviderMap, r providerregistry.ProviderRegistry) (*providersetup.Setup, error)
 	CompleteStep(ctx context.Context, setupID string, stepIndex int, body types.ProviderSetupStepCompleteRequest) (*providersetup.Setup, error)
+	Delete(ctx context.Context, setupID string) (*providersetup.Setup, error)
+	Complete(ctx context.Context, setup providersetup.Setup) error
 }
 
 //go:generate go run github.com/golang/mock/mockgen -destination=mocks/mock_access_service.go -package=mocks . AccessService
@@ -108,6 +115,9 @@ type AccessService interface {
 	CancelRequest(ctx context.Context, opts accesssvc.CancelRequestOpts) error
 	CreateFavorite(ctx context.Context, in accesssvc.CreateFavoriteOpts) (*access.Favorite, error)
 	UpdateFavorite(ctx context.Context, in accesssvc.UpdateFavoriteOpts) (*access.Favorite, error)
+	ReviewBreakGlass(ctx context.Context, opts accesssvc.ReviewBreakGlassOpts) (*access.BreakGlassReview, error)
+	RequestExtension(ctx context.Context, opts accesssvc.RequestExtensionOpts) (*access.Request, error)
+	ReviewExtension(ctx context.Context, opts accesssvc.ReviewExtensionOpts) (*access.Request, error)
 }
 
 //go:generate go run github.com/golang/mock/mockgen -destination=mocks/mock_accessrule_service.go -package=mocks . AccessRuleService
@@ -141,6 +151,7 @@ type TargetService interface {
 	CreateGroup(ctx context.Context, targetGroup types.CreateTargetGroupRequest) (*target.Group, error)
 	CreateRoute(ctx context.Context, group string, req types.CreateTargetGroupLink) (*target.Route, error)
 	DeleteGroup(ctx context.Context, group *target.Group) error
+	DeleteRoute(ctx context.Context, route target.Route) error
 }
 
 //go:generate go run github.com/golang/mock/mockgen -destination=mocks/mock_handler_service.go -package=mocks . HandlerService
@@ -154,6 +165,11 @@ type Workflow interface {
 	Revoke(ctx context.Context, request access.Request, revokerID string, revokerEmail string) (*access.Request, error)
 }
 
+//go:generate go run github.com/golang/mock/mockgen -destination=mocks/mock_delegation_service.go -package=mocks . DelegationService
+type DelegationService interface {
+	CreateDelegation(ctx context.Context, in delegationsvc.CreateDelegationOpts) (*identity.Delegation, error)
+}
+
 //go:generate go run github.com/golang/mock/mockgen -destination=mocks/mock_healthcheck_service.go -package=mocks . HealthcheckService
 type HealthcheckService interface {
 	Check(ctx context.Context) error
@@ -166,7 +182,7 @@ type Opts struct {
 	Log                    *zap.SugaredLogger
 	AccessHandlerClient    ahtypes.ClientWithResponsesInterface
 	ProviderRegistryClient registry_types.ClientWithResponsesInterface
-	EventSender            *gevent.Sender
+	EventSender            gevent.EventPutter
 	IdentitySyncer         auth.IdentitySyncer
 	DeploymentConfig       deploy.DeployConfigReader
 	DynamoTable            string
@@ -179,6 +195,10 @@ type Opts struct {
 	AdminGroupID           string
 	StateMachineARN        string
 	FrontendURL            string
+	AutoApprovalPolicy     string
+	AutoApprovalLambdaARN  string
+	// TicketValidatorURL is optional. If set, ticket references are validated by sending them to the URL.
+	TicketValidatorURL string
 }
 
 // New creates a new API.
@@ -205,8 +225,17 @@ func New(ctx context.Context, opts Opts) (*API, error) {
 
 	clk := clock.New()
 
-	if err != nil {
-		return nil, err
+	autoApproval := autoapproval.Service{LambdaARN: opts.AutoApprovalLambdaARN}
+	if opts.AutoApprovalPolicy != "" {
+		autoApproval.Policy, err = autoapproval.ParsePolicy([]byte(opts.AutoApprovalPolicy))
+		if err != nil {
+			return nil, fmt.Errorf("parsing auto-approval policy: %w", err)
+		}
+	}
+
+	var ticketValidator ticket.Validator
+	if opts.TicketValidatorURL != "" {
+		ticketValidator = &ticket.WebhookValidator{URL: opts.TicketValidatorURL}
 	}
 
 	a := API{
@@ -218,9 +247,8 @@ func New(ctx context.Context, opts Opts) (*API, error) {
 			Clock: clk,
 		},
 		Access: &accesssvc.Service{
-			Clock:       clk,
-			DB:          db,
-			EventPutter: opts.EventSender,
+			Clock: clk,
+			DB:    db,
 			Cache: &cachesvc.Service{
 				ProviderConfigReader: opts.DeploymentConfig,
 				DB:                   db,
@@ -236,7 +264,9 @@ func New(ctx context.Context, opts Opts) (*API, error) {
 					AccessHandlerClient:  opts.AccessHandlerClient,
 				},
 			},
-			AHClient: opts.AccessHandlerClient,
+			AHClient:        opts.AccessHandlerClient,
+			AutoApproval:    autoApproval,
+			TicketValidator: ticketValidator,
 			Workflow: &workflowsvc.Service{
 				Runtime: &live.Runtime{
 					StateMachineARN: opts.StateMachineARN,
@@ -247,9 +277,8 @@ func New(ctx context.Context, opts Opts) (*API, error) {
 						DB: db,
 					},
 				},
-				DB:       db,
-				Clk:      clk,
-				Eventbus: opts.EventSender,
+				DB:  db,
+				Clk: clk,
 			},
 		},
 		Cache: &cachesvc.Service{
@@ -268,6 +297,7 @@ func New(ctx context.Context, opts Opts) (*API, error) {
 			},
 		},
 		ProviderSetup: &psetupsvc.Service{
+			Clock:            clk,
 			DB:               db,
 			TemplateData:     opts.TemplateData,
 			DeploymentSuffix: opts.DeploymentSuffix,
@@ -285,6 +315,10 @@ func New(ctx context.Context, opts Opts) (*API, error) {
 			DB:    db,
 			Clock: clk,
 		},
+		Delegations: &delegationsvc.Service{
+			DB:    db,
+			Clock: clk,
+		},
 		Workflow: &workflowsvc.Service{
 			Runtime: &live.Runtime{
 				StateMachineARN: opts.StateMachineARN,
@@ -295,9 +329,8 @@ func New(ctx context.Context, opts Opts) (*API, error) {
 					DB: db,
 				},
 			},
-			DB:       db,
-			Clk:      clk,
-			Eventbus: opts.EventSender,
+			DB:  db,
+			Clk: clk,
 		},
 		HealthcheckService: &healthchecksvc.Service{
 			DB:            db,
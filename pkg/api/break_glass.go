@@ -0,0 +1,78 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/auth"
+	"github.com/common-fate/common-fate/pkg/service/accesssvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// Returns the post-incident reviews of break-glass requests which the user is an approver for.
+// (GET /api/v1/break-glass-reviews)
+func (a *API) UserListBreakGlassReviews(w http.ResponseWriter, r *http.Request, params types.UserListBreakGlassReviewsParams) {
+	ctx := r.Context()
+	u := auth.UserFromContext(ctx)
+	status := access.BreakGlassReviewOpen
+	if params.Status != nil {
+		status = access.BreakGlassReviewStatus(*params.Status)
+	}
+	q := storage.ListBreakGlassReviewsForStatus{Status: status}
+	_, err := a.DB.Query(ctx, &q)
+	if err != nil && err != ddb.ErrNoItems {
+		apio.Error(ctx, w, err)
+		return
+	}
+	res := types.ListBreakGlassReviewsResponse{
+		Reviews: []types.BreakGlassReview{},
+	}
+	// there is no access pattern for reviews by approver, so the reviews are filtered here.
+	for _, review := range q.Result {
+		if review.IsApprover(u.ID) {
+			res.Reviews = append(res.Reviews, review.ToAPI())
+		}
+	}
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
+
+// Acknowledge or flag a request which was made using break-glass access.
+// (POST /api/v1/break-glass-reviews/{requestId}/review)
+func (a *API) UserReviewBreakGlassRequest(w http.ResponseWriter, r *http.Request, requestId string) {
+	ctx := r.Context()
+	var b types.ReviewBreakGlassRequest
+	err := apio.DecodeJSONBody(w, r, &b)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	u := auth.UserFromContext(ctx)
+	review, err := a.Access.ReviewBreakGlass(ctx, accesssvc.ReviewBreakGlassOpts{
+		RequestID:     requestId,
+		ReviewerID:    u.ID,
+		ReviewerEmail: u.Email,
+		Status:        access.BreakGlassReviewStatus(b.Status),
+		Comment:       b.Comment,
+	})
+	if err == ddb.ErrNoItems {
+		apio.Error(ctx, w, apio.NewRequestError(errors.New("this break-glass request doesn't exist or you don't have access to it"), http.StatusNotFound))
+		return
+	}
+	if err == accesssvc.ErrUserNotAuthorized {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusUnauthorized))
+		return
+	}
+	if err == accesssvc.ErrBreakGlassReviewClosed {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusBadRequest))
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, review.ToAPI(), http.StatusOK)
+}
@@ -0,0 +1,101 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/api/mocks"
+	"github.com/common-fate/common-fate/pkg/service/accesssvc"
+	"github.com/common-fate/ddb"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserReviewBreakGlassRequest(t *testing.T) {
+	now := time.Date(2022, 1, 1, 9, 0, 0, 0, time.UTC)
+	reviewer := "usr_456"
+	comment := "expected during the outage"
+
+	type testcase struct {
+		name          string
+		give          string
+		mockReview    *access.BreakGlassReview
+		mockReviewErr error
+		wantCode      int
+		wantBody      string
+	}
+
+	testcases := []testcase{
+		{
+			name: "ok",
+			give: `{"status":"ACKNOWLEDGED","comment":"expected during the outage"}`,
+			mockReview: &access.BreakGlassReview{
+				RequestID:   "req_123",
+				Rule:        "rul_123",
+				RequestedBy: "usr_123",
+				Reason:      "incident",
+				Status:      access.BreakGlassReviewAcknowledged,
+				ReviewedBy:  &reviewer,
+				Comment:     &comment,
+				CreatedAt:   now,
+				UpdatedAt:   now,
+			},
+			wantCode: http.StatusOK,
+			wantBody: `{"accessRuleId":"rul_123","comment":"expected during the outage","createdAt":"2022-01-01T09:00:00Z","reason":"incident","requestId":"req_123","requestedBy":"usr_123","reviewedBy":"usr_456","status":"ACKNOWLEDGED","updatedAt":"2022-01-01T09:00:00Z"}`,
+		},
+		{
+			name:          "not found",
+			give:          `{"status":"FLAGGED"}`,
+			mockReviewErr: ddb.ErrNoItems,
+			wantCode:      http.StatusNotFound,
+			wantBody:      `{"error":"this break-glass request doesn't exist or you don't have access to it"}`,
+		},
+		{
+			name:          "not an approver",
+			give:          `{"status":"FLAGGED"}`,
+			mockReviewErr: accesssvc.ErrUserNotAuthorized,
+			wantCode:      http.StatusUnauthorized,
+			wantBody:      `{"error":"user is not authorized to perform this action"}`,
+		},
+		{
+			name:          "already reviewed",
+			give:          `{"status":"FLAGGED"}`,
+			mockReviewErr: accesssvc.ErrBreakGlassReviewClosed,
+			wantCode:      http.StatusBadRequest,
+			wantBody:      `{"error":"the break-glass request has already been reviewed"}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAccess := mocks.NewMockAccessService(ctrl)
+			mockAccess.EXPECT().ReviewBreakGlass(gomock.Any(), gomock.Any()).Return(tc.mockReview, tc.mockReviewErr).AnyTimes()
+			a := API{Access: mockAccess}
+			handler := newTestServer(t, &a)
+
+			req, err := http.NewRequest("POST", "/api/v1/break-glass-reviews/req_123/review", strings.NewReader(tc.give))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, tc.wantBody, strings.TrimSpace(string(data)))
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/common-fate/pkg/configchange"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// List configuration changes
+// (GET /api/v1/admin/config-changes)
+func (a *API) AdminListConfigChanges(w http.ResponseWriter, r *http.Request, params types.AdminListConfigChangesParams) {
+	ctx := r.Context()
+
+	queryOpts := []func(*ddb.QueryOpts){ddb.Limit(50)}
+	if params.NextToken != nil {
+		queryOpts = append(queryOpts, ddb.Page(*params.NextToken))
+	}
+
+	var changes []configchange.Change
+	var qr *ddb.QueryResult
+	var err error
+	if params.ResourceId != nil {
+		if params.ResourceType == nil {
+			apio.Error(ctx, w, apio.NewRequestError(errors.New("resourceType must be provided with resourceId"), http.StatusBadRequest))
+			return
+		}
+		q := storage.ListConfigChangesForResource{ResourceType: configchange.ResourceType(*params.ResourceType), ResourceID: *params.ResourceId}
+		qr, err = a.DB.Query(ctx, &q, queryOpts...)
+		changes = q.Result
+	} else if params.ResourceType != nil {
+		q := storage.ListConfigChangesForResourceType{ResourceType: configchange.ResourceType(*params.ResourceType)}
+		qr, err = a.DB.Query(ctx, &q, queryOpts...)
+		changes = q.Result
+	} else {
+		q := storage.ListConfigChanges{}
+		qr, err = a.DB.Query(ctx, &q, queryOpts...)
+		changes = q.Result
+	}
+	// don't return an error response when there are no changes
+	if err != nil && err != ddb.ErrNoItems {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	res := types.ListConfigChangesResponse{
+		Changes: make([]types.ConfigChange, len(changes)),
+	}
+	for i, c := range changes {
+		res.Changes[i] = c.ToAPI()
+	}
+	if qr != nil && qr.NextPage != "" {
+		res.Next = &qr.NextPage
+	}
+
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
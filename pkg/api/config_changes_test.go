@@ -0,0 +1,85 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/configchange"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminListConfigChanges(t *testing.T) {
+	ts := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+	change := configchange.Change{
+		ID:           "his_1",
+		ResourceType: configchange.AccessRule,
+		ResourceID:   "rul_1",
+		Action:       configchange.UPDATED,
+		ActorID:      "usr_1",
+		Reason:       "add the on-call group",
+		Fields:       []configchange.FieldChange{{Field: "groups", Before: []byte(`["a"]`), After: []byte(`["a","b"]`)}},
+		CreatedAt:    ts,
+	}
+
+	type testcase struct {
+		name     string
+		query    string
+		wantCode int
+		wantBody string
+	}
+
+	testcases := []testcase{
+		{
+			name:     "all changes",
+			wantCode: http.StatusOK,
+			wantBody: `{"changes":[{"action":"updated","actorId":"usr_1","createdAt":"2022-01-01T10:00:00Z","fields":[{"after":["a","b"],"before":["a"],"field":"groups"}],"id":"his_1","reason":"add the on-call group","resourceId":"rul_1","resourceType":"accessrule"}],"next":null}`,
+		},
+		{
+			name:     "changes to a resource",
+			query:    "?resourceType=accessrule&resourceId=rul_1",
+			wantCode: http.StatusOK,
+			wantBody: `{"changes":[{"action":"updated","actorId":"usr_1","createdAt":"2022-01-01T10:00:00Z","fields":[{"after":["a","b"],"before":["a"],"field":"groups"}],"id":"his_1","reason":"add the on-call group","resourceId":"rul_1","resourceType":"accessrule"}],"next":null}`,
+		},
+		{
+			name:     "resource ID without type",
+			query:    "?resourceId=rul_1",
+			wantCode: http.StatusBadRequest,
+			wantBody: `{"error":"resourceType must be provided with resourceId"}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.ListConfigChanges{Result: []configchange.Change{change}})
+			db.MockQuery(&storage.ListConfigChangesForResource{Result: []configchange.Change{change}})
+			a := API{DB: db}
+			handler := newTestServer(t, &a)
+
+			req, err := http.NewRequest("GET", "/api/v1/admin/config-changes"+tc.query, strings.NewReader(""))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.wantBody, string(data))
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/common-fate/pkg/auth"
+	"github.com/common-fate/common-fate/pkg/service/delegationsvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// (GET /api/v1/delegations)
+func (a *API) UserListDelegations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	u := auth.UserFromContext(ctx)
+	q := storage.ListDelegationsForUser{
+		UserID: u.ID,
+	}
+	qr, err := a.DB.Query(ctx, &q)
+	if err != nil && err != ddb.ErrNoItems {
+		apio.Error(ctx, w, err)
+		return
+	}
+	res := types.ListDelegationsResponse{
+		Delegations: []types.Delegation{},
+	}
+	if qr != nil && qr.NextPage != "" {
+		res.Next = &qr.NextPage
+	}
+	for _, d := range q.Result {
+		res.Delegations = append(res.Delegations, d.ToAPI())
+	}
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
+
+// (POST /api/v1/delegations)
+func (a *API) UserCreateDelegation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var b types.CreateDelegationRequest
+	err := apio.DecodeJSONBody(w, r, &b)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	u := auth.UserFromContext(ctx)
+	d, err := a.Delegations.CreateDelegation(ctx, delegationsvc.CreateDelegationOpts{
+		User:   *u,
+		Create: b,
+	})
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, d.ToAPI(), http.StatusCreated)
+}
+
+// (DELETE /api/v1/delegations/{id})
+func (a *API) UserDeleteDelegation(w http.ResponseWriter, r *http.Request, id string) {
+	ctx := r.Context()
+	u := auth.UserFromContext(ctx)
+	q := storage.GetDelegationForUser{
+		UserID: u.ID,
+		ID:     id,
+	}
+	_, err := a.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		apio.Error(ctx, w, apio.NewRequestError(errors.New("this delegation doesn't exist or you don't have access to it"), http.StatusNotFound))
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	err = a.DB.Delete(ctx, q.Result)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, nil, http.StatusOK)
+}
@@ -0,0 +1,81 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/common-fate/pkg/api/mocks"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/service/delegationsvc"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserCreateDelegation(t *testing.T) {
+	start := time.Date(2022, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	type testcase struct {
+		name          string
+		give          string
+		mockCreate    *identity.Delegation
+		mockCreateErr error
+		wantCode      int
+		wantBody      string
+	}
+
+	testcases := []testcase{
+		{
+			name: "ok",
+			give: `{"delegateId":"usr_456","startTime":"2022-01-01T09:00:00Z","endTime":"2022-01-08T09:00:00Z"}`,
+			mockCreate: &identity.Delegation{
+				ID:         "dlg_123",
+				UserID:     "usr_123",
+				DelegateID: "usr_456",
+				StartTime:  start,
+				EndTime:    start.Add(7 * 24 * time.Hour),
+			},
+			wantCode: http.StatusCreated,
+			wantBody: `{"delegateId":"usr_456","endTime":"2022-01-08T09:00:00Z","id":"dlg_123","startTime":"2022-01-01T09:00:00Z"}`,
+		},
+		{
+			name:          "validation error",
+			give:          `{"delegateId":"usr_123","startTime":"2022-01-01T09:00:00Z","endTime":"2022-01-08T09:00:00Z"}`,
+			mockCreateErr: apio.NewRequestError(delegationsvc.ErrDelegateToSelf, http.StatusBadRequest),
+			wantCode:      http.StatusBadRequest,
+			wantBody:      `{"error":"you cannot delegate approvals to yourself"}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDelegations := mocks.NewMockDelegationService(ctrl)
+			mockDelegations.EXPECT().CreateDelegation(gomock.Any(), gomock.Any()).Return(tc.mockCreate, tc.mockCreateErr).AnyTimes()
+			a := API{Delegations: mockDelegations}
+			handler := newTestServer(t, &a)
+
+			req, err := http.NewRequest("POST", "/api/v1/delegations", strings.NewReader(tc.give))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, tc.wantBody, strings.TrimSpace(string(data)))
+		})
+	}
+}
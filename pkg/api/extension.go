@@ -0,0 +1,148 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/auth"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/service/accesssvc"
+	"github.com/common-fate/common-fate/pkg/service/workflowsvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+	"golang.org/x/sync/errgroup"
+)
+
+// Extend a request
+// (POST /api/v1/requests/{requestId}/extend)
+func (a *API) UserExtendRequest(w http.ResponseWriter, r *http.Request, requestId string) {
+	ctx := r.Context()
+	var b types.UserExtendRequestJSONRequestBody
+	err := apio.DecodeJSONBody(w, r, &b)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	user := auth.UserFromContext(ctx)
+
+	q := storage.GetRequest{ID: requestId}
+	_, err = a.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusNotFound))
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	ruleq := storage.GetAccessRuleCurrent{ID: q.Result.Rule}
+	_, err = a.DB.Query(ctx, &ruleq)
+	if err == ddb.ErrNoItems {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusNotFound))
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	result, err := a.Access.RequestExtension(ctx, accesssvc.RequestExtensionOpts{
+		User:       *user,
+		Request:    *q.Result,
+		AccessRule: *ruleq.Result,
+		ExtendBy:   time.Duration(b.ExtendBySeconds) * time.Second,
+		Reason:     b.Reason,
+	})
+	if err == accesssvc.ErrUserNotAuthorized {
+		err = apio.NewRequestError(errors.New("you can only extend your own requests"), http.StatusUnauthorized)
+	}
+	if err == accesssvc.ErrGrantNotActive || err == accesssvc.ErrExtensionPending || err == workflowsvc.ErrGrantNotExtendable || err == workflowsvc.ErrExtendNotSupported {
+		// wrap the error in a 400 status code
+		err = apio.NewRequestError(err, http.StatusBadRequest)
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, result.ToAPI(), http.StatusOK)
+}
+
+// Review a request extension
+// (POST /api/v1/requests/{requestId}/extension/review)
+func (a *API) UserReviewRequestExtension(w http.ResponseWriter, r *http.Request, requestId string) {
+	ctx := r.Context()
+	var b types.UserReviewRequestExtensionJSONRequestBody
+	err := apio.DecodeJSONBody(w, r, &b)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	user := auth.UserFromContext(ctx)
+
+	// load the request and the reviewers, so that we can process the review.
+	g, fetchctx := errgroup.WithContext(ctx)
+
+	var req *access.Request
+	var accessRule *rule.AccessRule
+	g.Go(func() error {
+		q := storage.GetRequest{ID: requestId}
+		_, err := a.DB.Query(fetchctx, &q)
+		if err == ddb.ErrNoItems {
+			return apio.NewRequestError(err, http.StatusNotFound)
+		}
+		if err != nil {
+			return err
+		}
+		req = q.Result
+		ruleq := storage.GetAccessRuleCurrent{ID: req.Rule}
+		_, err = a.DB.Query(fetchctx, &ruleq)
+		if err == ddb.ErrNoItems {
+			return apio.NewRequestError(err, http.StatusNotFound)
+		}
+		accessRule = ruleq.Result
+		return err
+	})
+
+	reviewers := storage.ListRequestReviewers{RequestID: requestId}
+	g.Go(func() error {
+		_, err := a.DB.Query(fetchctx, &reviewers)
+		return err
+	})
+
+	err = g.Wait()
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	result, err := a.Access.ReviewExtension(ctx, accesssvc.ReviewExtensionOpts{
+		ReviewerID:      user.ID,
+		ReviewerEmail:   user.Email,
+		ReviewerIsAdmin: user.BelongsToGroup(a.AdminGroup),
+		Reviewers:       reviewers.Result,
+		Decision:        access.Decision(b.Decision),
+		Request:         *req,
+		AccessRule:      *accessRule,
+	})
+	if err == accesssvc.ErrUserNotAuthorized {
+		// wrap the error in a 401 status code
+		err = apio.NewRequestError(errors.New("you are not a reviewer of this request"), http.StatusUnauthorized)
+	}
+	if err == accesssvc.ErrNotApproverForStep {
+		// wrap the error in a 401 status code
+		err = apio.NewRequestError(err, http.StatusUnauthorized)
+	}
+	if err == accesssvc.ErrNoPendingExtension || err == accesssvc.ErrReviewerAlreadyApproved || err == workflowsvc.ErrGrantNotExtendable || err == workflowsvc.ErrExtendNotSupported {
+		// wrap the error in a 400 status code
+		err = apio.NewRequestError(err, http.StatusBadRequest)
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, result.ToAPI(), http.StatusOK)
+}
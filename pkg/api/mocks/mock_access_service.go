@@ -95,6 +95,51 @@ func (mr *MockAccessServiceMockRecorder) CreateRequests(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRequests", reflect.TypeOf((*MockAccessService)(nil).CreateRequests), arg0, arg1)
 }
 
+// RequestExtension mocks base method.
+func (m *MockAccessService) RequestExtension(arg0 context.Context, arg1 accesssvc.RequestExtensionOpts) (*access.Request, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestExtension", arg0, arg1)
+	ret0, _ := ret[0].(*access.Request)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestExtension indicates an expected call of RequestExtension.
+func (mr *MockAccessServiceMockRecorder) RequestExtension(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestExtension", reflect.TypeOf((*MockAccessService)(nil).RequestExtension), arg0, arg1)
+}
+
+// ReviewBreakGlass mocks base method.
+func (m *MockAccessService) ReviewBreakGlass(arg0 context.Context, arg1 accesssvc.ReviewBreakGlassOpts) (*access.BreakGlassReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewBreakGlass", arg0, arg1)
+	ret0, _ := ret[0].(*access.BreakGlassReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewBreakGlass indicates an expected call of ReviewBreakGlass.
+func (mr *MockAccessServiceMockRecorder) ReviewBreakGlass(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewBreakGlass", reflect.TypeOf((*MockAccessService)(nil).ReviewBreakGlass), arg0, arg1)
+}
+
+// ReviewExtension mocks base method.
+func (m *MockAccessService) ReviewExtension(arg0 context.Context, arg1 accesssvc.ReviewExtensionOpts) (*access.Request, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewExtension", arg0, arg1)
+	ret0, _ := ret[0].(*access.Request)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewExtension indicates an expected call of ReviewExtension.
+func (mr *MockAccessServiceMockRecorder) ReviewExtension(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewExtension", reflect.TypeOf((*MockAccessService)(nil).ReviewExtension), arg0, arg1)
+}
+
 // UpdateFavorite mocks base method.
 func (m *MockAccessService) UpdateFavorite(arg0 context.Context, arg1 accesssvc.UpdateFavoriteOpts) (*access.Favorite, error) {
 	m.ctrl.T.Helper()
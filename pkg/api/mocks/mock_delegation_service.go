@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/common-fate/common-fate/pkg/api (interfaces: DelegationService)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	identity "github.com/common-fate/common-fate/pkg/identity"
+	delegationsvc "github.com/common-fate/common-fate/pkg/service/delegationsvc"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDelegationService is a mock of DelegationService interface.
+type MockDelegationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDelegationServiceMockRecorder
+}
+
+// MockDelegationServiceMockRecorder is the mock recorder for MockDelegationService.
+type MockDelegationServiceMockRecorder struct {
+	mock *MockDelegationService
+}
+
+// NewMockDelegationService creates a new mock instance.
+func NewMockDelegationService(ctrl *gomock.Controller) *MockDelegationService {
+	mock := &MockDelegationService{ctrl: ctrl}
+	mock.recorder = &MockDelegationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDelegationService) EXPECT() *MockDelegationServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateDelegation mocks base method.
+func (m *MockDelegationService) CreateDelegation(arg0 context.Context, arg1 delegationsvc.CreateDelegationOpts) (*identity.Delegation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDelegation", arg0, arg1)
+	ret0, _ := ret[0].(*identity.Delegation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDelegation indicates an expected call of CreateDelegation.
+func (mr *MockDelegationServiceMockRecorder) CreateDelegation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDelegation", reflect.TypeOf((*MockDelegationService)(nil).CreateDelegation), arg0, arg1)
+}
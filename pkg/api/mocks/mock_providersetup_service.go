@@ -38,6 +38,20 @@ func (m *MockProviderSetupService) EXPECT() *MockProviderSetupServiceMockRecorde
 	return m.recorder
 }
 
+// Complete mocks base method.
+func (m *MockProviderSetupService) Complete(arg0 context.Context, arg1 providersetup.Setup) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockProviderSetupServiceMockRecorder) Complete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockProviderSetupService)(nil).Complete), arg0, arg1)
+}
+
 // CompleteStep mocks base method.
 func (m *MockProviderSetupService) CompleteStep(arg0 context.Context, arg1 string, arg2 int, arg3 types.ProviderSetupStepCompleteRequest) (*providersetup.Setup, error) {
 	m.ctrl.T.Helper()
@@ -67,3 +81,18 @@ func (mr *MockProviderSetupServiceMockRecorder) Create(arg0, arg1, arg2, arg3 in
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProviderSetupService)(nil).Create), arg0, arg1, arg2, arg3)
 }
+
+// Delete mocks base method.
+func (m *MockProviderSetupService) Delete(arg0 context.Context, arg1 string) (*providersetup.Setup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(*providersetup.Setup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockProviderSetupServiceMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockProviderSetupService)(nil).Delete), arg0, arg1)
+}
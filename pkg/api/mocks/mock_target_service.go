@@ -79,3 +79,17 @@ func (mr *MockTargetServiceMockRecorder) DeleteGroup(arg0, arg1 interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroup", reflect.TypeOf((*MockTargetService)(nil).DeleteGroup), arg0, arg1)
 }
+
+// DeleteRoute mocks base method.
+func (m *MockTargetService) DeleteRoute(arg0 context.Context, arg1 target.Route) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRoute", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRoute indicates an expected call of DeleteRoute.
+func (mr *MockTargetServiceMockRecorder) DeleteRoute(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoute", reflect.TypeOf((*MockTargetService)(nil).DeleteRoute), arg0, arg1)
+}
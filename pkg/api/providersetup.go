@@ -15,7 +15,6 @@ import (
 	"github.com/common-fate/common-fate/pkg/storage"
 	"github.com/common-fate/common-fate/pkg/types"
 	"github.com/common-fate/ddb"
-	"golang.org/x/sync/errgroup"
 )
 
 // List the provider setups in progress
@@ -103,51 +102,11 @@ func (a *API) AdminCreateProvidersetup(w http.ResponseWriter, r *http.Request) {
 func (a *API) AdminDeleteProvidersetup(w http.ResponseWriter, r *http.Request, providersetupId string) {
 	ctx := r.Context()
 
-	g, gctx := errgroup.WithContext(ctx)
-
-	var setup providersetup.Setup
-	g.Go(func() error {
-		q := storage.GetProviderSetup{
-			ID: providersetupId,
-		}
-
-		_, err := a.DB.Query(gctx, &q)
-		if err == ddb.ErrNoItems {
-			return &apio.APIError{Status: http.StatusNotFound, Err: errors.New("provider setup not found")}
-		}
-		if err != nil {
-			return err
-		}
-		setup = *q.Result
-		return nil
-	})
-
-	var steps []providersetup.Step
-	g.Go(func() error {
-		q := storage.ListProviderSetupSteps{
-			SetupID: providersetupId,
-		}
-
-		_, err := a.DB.Query(gctx, &q)
-		if err != nil {
-			return err
-		}
-		steps = q.Result
-		return nil
-	})
-	err := g.Wait()
-	if err != nil {
-		apio.Error(ctx, w, err)
+	setup, err := a.ProviderSetup.Delete(ctx, providersetupId)
+	if err == psetupsvc.ErrProviderSetupNotFound {
+		apio.Error(ctx, w, &apio.APIError{Status: http.StatusNotFound, Err: err})
 		return
 	}
-
-	items := []ddb.Keyer{&setup}
-	for _, step := range steps {
-		s := step
-		items = append(items, &s)
-	}
-
-	err = a.DB.DeleteBatch(ctx, items...)
 	if err != nil {
 		apio.Error(ctx, w, err)
 		return
@@ -351,7 +310,7 @@ func (a *API) AdminCompleteProvidersetup(w http.ResponseWriter, r *http.Request,
 	}
 
 	// remove the setup as we've written the provider config.
-	err = a.DB.Delete(ctx, setup)
+	err = a.ProviderSetup.Complete(ctx, *setup)
 	if err != nil {
 		apio.Error(ctx, w, err)
 		return
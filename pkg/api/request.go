@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -225,10 +226,12 @@ func (a *API) UserCreateRequest(w http.ResponseWriter, r *http.Request) {
 	result, err := a.Access.CreateRequests(ctx, accesssvc.CreateRequestsOpts{
 		User: *u,
 		Create: accesssvc.CreateRequests{
-			AccessRuleId: incomingRequest.AccessRuleId,
-			Reason:       incomingRequest.Reason,
-			Timing:       incomingRequest.Timing,
-			With:         incomingRequest.With,
+			AccessRuleId:    incomingRequest.AccessRuleId,
+			Reason:          incomingRequest.Reason,
+			Timing:          incomingRequest.Timing,
+			With:            incomingRequest.With,
+			BreakGlass:      incomingRequest.BreakGlass != nil && *incomingRequest.BreakGlass,
+			TicketReference: incomingRequest.TicketReference,
 		},
 	})
 	var me *multierror.Error
@@ -411,38 +414,11 @@ func (a *API) UserGetAccessInstructions(w http.ResponseWriter, r *http.Request,
 
 func (a *API) UserListRequestEvents(w http.ResponseWriter, r *http.Request, requestId string) {
 	ctx := r.Context()
-	u := auth.UserFromContext(ctx)
-	canView := auth.IsAdmin(ctx)
-	q := storage.GetRequest{ID: requestId}
-	_, err := a.DB.Query(ctx, &q)
-	if err == ddb.ErrNoItems {
-		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusUnauthorized))
-		return
-	} else if err != nil {
+	err := a.checkCanViewRequest(ctx, requestId)
+	if err != nil {
 		apio.Error(ctx, w, err)
 		return
 	}
-	if !canView {
-		if q.Result.RequestedBy == u.ID {
-			canView = true
-		} else {
-			qrv := storage.GetRequestReviewer{RequestID: requestId, ReviewerID: u.ID}
-			_, err = a.DB.Query(ctx, &qrv)
-			if err == ddb.ErrNoItems {
-				// user is not a reviewer of this request or the requestor
-				apio.Error(ctx, w, apio.NewRequestError(err, http.StatusNotFound))
-				return
-			} else if err != nil {
-				apio.Error(ctx, w, err)
-				return
-			}
-			canView = true
-		}
-	}
-	if !canView {
-		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusNotFound))
-		return
-	}
 
 	qre := &storage.ListRequestEvents{
 		RequestID: requestId,
@@ -461,6 +437,64 @@ func (a *API) UserListRequestEvents(w http.ResponseWriter, r *http.Request, requ
 	apio.JSON(ctx, w, res, http.StatusOK)
 }
 
+// List request activity
+// (GET /api/v1/requests/{requestId}/activity)
+func (a *API) UserListRequestActivity(w http.ResponseWriter, r *http.Request, requestId string, params types.UserListRequestActivityParams) {
+	ctx := r.Context()
+	err := a.checkCanViewRequest(ctx, requestId)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	queryOpts := []func(*ddb.QueryOpts){ddb.Limit(100)}
+	if params.NextToken != nil {
+		queryOpts = append(queryOpts, ddb.Page(*params.NextToken))
+	}
+	q := storage.ListRequestActivity{RequestID: requestId}
+	qr, err := a.DB.Query(ctx, &q, queryOpts...)
+	if err != nil && err != ddb.ErrNoItems {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	var next *string
+	if qr != nil && qr.NextPage != "" {
+		next = &qr.NextPage
+	}
+	res := types.ListRequestActivityResponse{
+		Activity: make([]types.RequestActivity, len(q.Result)),
+		Next:     next,
+	}
+	for i, e := range q.Result {
+		res.Activity[i] = e.ToAPI()
+	}
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
+
+// checkCanViewRequest returns an error if the user in the context is not an admin, the requestor or a reviewer of the request.
+// The request is looked up for admins too, so that a request which doesn't exist returns an error for every user.
+func (a *API) checkCanViewRequest(ctx context.Context, requestID string) error {
+	u := auth.UserFromContext(ctx)
+	q := storage.GetRequest{ID: requestID}
+	_, err := a.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		return apio.NewRequestError(err, http.StatusUnauthorized)
+	} else if err != nil {
+		return err
+	}
+	if auth.IsAdmin(ctx) || q.Result.RequestedBy == u.ID {
+		return nil
+	}
+	qrv := storage.GetRequestReviewer{RequestID: requestID, ReviewerID: u.ID}
+	_, err = a.DB.Query(ctx, &qrv)
+	if err == ddb.ErrNoItems {
+		// user is not a reviewer of this request or the requestor
+		return apio.NewRequestError(err, http.StatusNotFound)
+	}
+	return err
+}
+
 // (GET /api/v1/requests/{requestId}/access-token)
 func (a *API) UserGetAccessToken(w http.ResponseWriter, r *http.Request, requestId string) {
 	ctx := r.Context()
@@ -1,8 +1,10 @@
 package api
 
 import (
+	"context"
 	"errors"
 	"net/http"
+	"strings"
 
 	"github.com/common-fate/apikit/apio"
 	"github.com/common-fate/common-fate/pkg/access"
@@ -25,7 +27,13 @@ func (a *API) AdminListRequests(w http.ResponseWriter, r *http.Request, params t
 	if params.NextToken != nil {
 		queryOpts = append(queryOpts, ddb.Page(*params.NextToken))
 	}
-	if params.Status != nil {
+	if params.TicketReference != nil {
+		dbRes, next, err = a.listRequestsForTicket(ctx, *params.TicketReference, params.Status, queryOpts...)
+		if err != nil {
+			apio.Error(ctx, w, err)
+			return
+		}
+	} else if params.Status != nil {
 		q := storage.ListRequestsForStatus{Status: access.Status(*params.Status)}
 		qR, err := a.DB.Query(ctx, &q, queryOpts...)
 		if err == ddb.ErrNoItems {
@@ -74,6 +82,36 @@ func (a *API) AdminListRequests(w http.ResponseWriter, r *http.Request, params t
 	apio.JSON(ctx, w, res, http.StatusOK)
 }
 
+// listRequestsForTicket returns the requests which were made with the ticket reference, optionally filtered by status.
+func (a *API) listRequestsForTicket(ctx context.Context, ref string, status *types.AdminListRequestsParamsStatus, queryOpts ...func(*ddb.QueryOpts)) ([]access.Request, *string, error) {
+	q := storage.ListRequestTickets{Reference: strings.TrimSpace(ref)}
+	qR, err := a.DB.Query(ctx, &q, queryOpts...)
+	if err != nil && err != ddb.ErrNoItems {
+		return nil, nil, err
+	}
+	var next *string
+	if qR != nil && qR.NextPage != "" {
+		next = &qR.NextPage
+	}
+
+	requests := []access.Request{}
+	for _, t := range q.Result {
+		rq := storage.GetRequest{ID: t.RequestID}
+		_, err := a.DB.Query(ctx, &rq)
+		if err == ddb.ErrNoItems {
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if status != nil && string(rq.Result.Status) != string(*status) {
+			continue
+		}
+		requests = append(requests, *rq.Result)
+	}
+	return requests, next, nil
+}
+
 // Get a request
 // (GET /api/v1/admin/requests/{requestId})
 func (a *API) AdminGetRequest(w http.ResponseWriter, r *http.Request, requestId string) {
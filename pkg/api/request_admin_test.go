@@ -0,0 +1,87 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminListRequestsForTicket(t *testing.T) {
+	type testcase struct {
+		name        string
+		giveQuery   string
+		withTickets []access.RequestTicket
+		withRequest *access.Request
+		wantCode    int
+		wantBody    string
+	}
+	ref := "OPS-123"
+	request := access.Request{
+		ID:          "req_123",
+		Status:      access.APPROVED,
+		Rule:        "abcd",
+		RuleVersion: "efgh",
+		Data:        access.RequestData{TicketReference: &ref},
+	}
+
+	testcases := []testcase{
+		{
+			name:        "ok",
+			giveQuery:   "ticketReference=OPS-123",
+			withTickets: []access.RequestTicket{{Reference: ref, RequestID: "req_123"}},
+			withRequest: &request,
+			wantCode:    http.StatusOK,
+			wantBody:    `{"next":null,"requests":[{"accessRuleId":"abcd","accessRuleVersion":"efgh","id":"req_123","requestedAt":"0001-01-01T00:00:00Z","requestor":"","status":"APPROVED","ticketReference":"OPS-123","timing":{"durationSeconds":0},"updatedAt":"0001-01-01T00:00:00Z"}]}`,
+		},
+		{
+			name:        "filtered by status",
+			giveQuery:   "ticketReference=OPS-123&status=PENDING",
+			withTickets: []access.RequestTicket{{Reference: ref, RequestID: "req_123"}},
+			withRequest: &request,
+			wantCode:    http.StatusOK,
+			wantBody:    `{"next":null,"requests":[]}`,
+		},
+		{
+			name:      "no requests for ticket",
+			giveQuery: "ticketReference=OPS-456",
+			wantCode:  http.StatusOK,
+			wantBody:  `{"next":null,"requests":[]}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.ListRequestTickets{Result: tc.withTickets})
+			if tc.withRequest != nil {
+				db.MockQuery(&storage.GetRequest{Result: tc.withRequest})
+			}
+			a := API{DB: db}
+			handler := newTestServer(t, &a, withIsAdmin(true))
+
+			req, err := http.NewRequest("GET", "/api/v1/admin/requests?"+tc.giveQuery, strings.NewReader(""))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.wantBody, string(data))
+		})
+	}
+}
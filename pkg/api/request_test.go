@@ -8,9 +8,11 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/common-fate/apikit/apio"
 	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/activity"
 	"github.com/common-fate/common-fate/pkg/api/mocks"
 	"github.com/common-fate/common-fate/pkg/cache"
 	"github.com/common-fate/common-fate/pkg/identity"
@@ -654,6 +656,14 @@ func TestUserListRequestEvents(t *testing.T) {
 			wantCode:          http.StatusUnauthorized,
 			mockGetRequestErr: ddb.ErrNoItems,
 
+			wantBody: `{"error":"item query returned no items"}`,
+		},
+		{
+			name:              "admin not found",
+			wantCode:          http.StatusUnauthorized,
+			mockGetRequestErr: ddb.ErrNoItems,
+			apiUserIsAdmin:    true,
+
 			wantBody: `{"error":"item query returned no items"}`,
 		},
 	}
@@ -694,6 +704,80 @@ func TestUserListRequestEvents(t *testing.T) {
 
 }
 
+func TestUserListRequestActivity(t *testing.T) {
+	ts := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	type testcase struct {
+		name                      string
+		mockGetRequest            storage.GetRequest
+		mockGetRequestErr         error
+		mockGetRequestReviewerErr error
+		mockListActivity          storage.ListRequestActivity
+		apiUserID                 string
+		wantCode                  int
+		wantBody                  string
+	}
+
+	testcases := []testcase{
+		{
+			name:     "ok requestor",
+			wantCode: http.StatusOK,
+			mockGetRequest: storage.GetRequest{
+				ID:     "1234",
+				Result: &access.Request{ID: "1234", RequestedBy: "abcd"},
+			},
+			mockListActivity: storage.ListRequestActivity{
+				RequestID: "1234",
+				Result: []activity.Event{
+					{ID: "evt", RequestID: "1234", Source: "aws-cloudtrail", Subject: "a@example.com", Action: "s3:GetObject", Timestamp: ts, AttributedBy: activity.AttributedBySubject},
+				},
+			},
+			apiUserID: "abcd",
+			wantBody:  `{"activity":[{"action":"s3:GetObject","attributedBy":"SUBJECT","id":"evt","requestId":"1234","source":"aws-cloudtrail","subject":"a@example.com","timestamp":"2022-01-01T10:00:00Z"}],"next":null}`,
+		},
+		{
+			name:     "not requestor or reviewer",
+			wantCode: http.StatusNotFound,
+			mockGetRequest: storage.GetRequest{
+				ID:     "1234",
+				Result: &access.Request{ID: "1234", RequestedBy: "wrong"},
+			},
+			mockGetRequestReviewerErr: ddb.ErrNoItems,
+			apiUserID:                 "abcd",
+			wantBody:                  `{"error":"item query returned no items"}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			db := ddbmock.New(t)
+			db.MockQueryWithErr(&tc.mockGetRequest, tc.mockGetRequestErr)
+			db.MockQueryWithErr(&storage.GetRequestReviewer{}, tc.mockGetRequestReviewerErr)
+			db.MockQuery(&tc.mockListActivity)
+			a := API{DB: db}
+			handler := newTestServer(t, &a, withRequestUser(identity.User{ID: tc.apiUserID}))
+
+			req, err := http.NewRequest("GET", "/api/v1/requests/1234/activity", strings.NewReader(""))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.wantBody, string(data))
+		})
+	}
+}
+
 func TestGetAccessToken(t *testing.T) {
 	type testcase struct {
 		name               string
@@ -93,6 +93,14 @@ func (a *API) UserReviewRequest(w http.ResponseWriter, r *http.Request, requestI
 		// wrap the error in a 400 status code
 		err = apio.NewRequestError(err, http.StatusBadRequest)
 	}
+	if err == accesssvc.ErrReviewerAlreadyApproved {
+		// wrap the error in a 400 status code
+		err = apio.NewRequestError(err, http.StatusBadRequest)
+	}
+	if err == accesssvc.ErrNotApproverForStep {
+		// wrap the error in a 401 status code
+		err = apio.NewRequestError(err, http.StatusUnauthorized)
+	}
 	if err == accesssvc.ErrUserNotAuthorized {
 		// wrap the error in a 401 status code
 		err = apio.NewRequestError(errors.New("you are not a reviewer of this request"), http.StatusUnauthorized)
@@ -0,0 +1,30 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/common-fate/pkg/standingaccess"
+	"github.com/common-fate/common-fate/pkg/types"
+)
+
+// List standing access
+// (GET /api/v1/admin/standing-access)
+func (a *API) AdminListStandingAccess(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	f := standingaccess.Finder{Clock: clock.New(), DB: a.DB, AHClient: a.AccessHandlerClient}
+	found, err := f.Find(ctx)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	res := types.ListStandingAccessResponse{
+		StandingAccess: make([]types.StandingAccess, len(found)),
+	}
+	for i, sa := range found {
+		res.StandingAccess[i] = sa.ToAPI()
+	}
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
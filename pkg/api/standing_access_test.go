@@ -0,0 +1,55 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ahtypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/accesshandler/pkg/types/ahmocks"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminListStandingAccess(t *testing.T) {
+	db := ddbmock.New(t)
+	db.MockQueryWithErr(&storage.ListApprovedRequestsEndingAfter{}, ddb.ErrNoItems)
+
+	ctrl := gomock.NewController(t)
+	ah := ahmocks.NewMockClientWithResponsesInterface(ctrl)
+	ah.EXPECT().ListProvidersWithResponse(gomock.Any()).Return(&ahtypes.ListProvidersResponse{
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+		JSON200:      &[]ahtypes.Provider{{Id: "okta", Type: "okta"}},
+	}, nil)
+	ah.EXPECT().ListProviderAssignmentsWithResponse(gomock.Any(), "okta").Return(&ahtypes.ListProviderAssignmentsResponse{
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+		JSON200: &struct {
+			Assignments []ahtypes.ProviderAssignment `json:"assignments"`
+			Supported   bool                         `json:"supported"`
+		}{
+			Assignments: []ahtypes.ProviderAssignment{{Subject: "user@example.com", With: ahtypes.ProviderAssignment_With{AdditionalProperties: map[string]string{"groupId": "admins"}}}},
+			Supported:   true,
+		},
+	}, nil)
+
+	a := API{DB: db, AccessHandlerClient: ah}
+	handler := newTestServer(t, &a)
+
+	req, err := http.NewRequest("GET", "/api/v1/admin/standing-access", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	data, err := io.ReadAll(rr.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, `{"standingAccess":[{"providerId":"okta","providerType":"okta","subject":"user@example.com","with":{"groupId":"admins"}}]}`, string(data))
+}
@@ -111,7 +111,7 @@ func (a *API) AdminRemoveTargetGroupLink(w http.ResponseWriter, r *http.Request,
 		Handler: params.DeploymentId,
 		Kind:    params.Kind,
 	}
-	err := a.DB.Delete(ctx, &route)
+	err := a.TargetService.DeleteRoute(ctx, route)
 	if err != nil {
 		apio.Error(ctx, w, err)
 		return
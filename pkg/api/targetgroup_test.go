@@ -371,8 +371,13 @@ func TestRemoveTargetGroupLink(t *testing.T) {
 			db := ddbmock.New(t)
 			db.MockQueryWithErr(&storage.GetTargetGroup{Result: &tc.mockGetTargetGroupResponse}, tc.mockGetTargetGroupErr)
 			db.MockQueryWithErr(&storage.GetHandler{Result: &tc.mockGetTargetGroupDeploymentResponse}, tc.mockGetTargetGroupErr)
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
 
-			a := API{DB: db}
+			m := mocks.NewMockTargetService(ctrl)
+			m.EXPECT().DeleteRoute(gomock.Any(), target.Route{Group: "123", Handler: tc.deploymentId, Kind: tc.kind}).Return(nil)
+
+			a := API{DB: db, TargetService: m}
 			handler := newTestServer(t, &a)
 
 			req, err := http.NewRequest("POST", fmt.Sprintf("/api/v1/admin/target-groups/123/unlink?deploymentId=%s&kind=%s", tc.deploymentId, tc.kind), strings.NewReader(""))
@@ -0,0 +1,144 @@
+// Package audit implements an append-only audit log.
+//
+// Every event emitted by Common Fate is appended to the log as an Entry.
+// Each entry contains the hash of the entry before it, so removing, reordering or
+// editing an entry breaks the chain and is detected by Verify.
+// Entries are exported to an S3 bucket with object lock so that they can't be changed once written.
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/ddb"
+)
+
+// ObjectPrefix is the prefix of the audit log objects exported to S3.
+const ObjectPrefix = "audit-log/"
+
+// ObjectKey returns the S3 key for an export containing the entries from first to last.
+// The sequence numbers are zero-padded so that objects are listed in the order they were exported.
+func ObjectKey(first, last int64) string {
+	return fmt.Sprintf("%s%020d-%020d.jsonl", ObjectPrefix, first, last)
+}
+
+// Entry is an entry in the audit log.
+type Entry struct {
+	// Sequence is the position of the entry in the log, starting at 1.
+	Sequence int64 `json:"sequence" dynamodbav:"sequence"`
+	// EventID is the ID of the event which was recorded.
+	EventID string          `json:"eventId" dynamodbav:"eventId"`
+	Type    string          `json:"type" dynamodbav:"type"`
+	Time    time.Time       `json:"time" dynamodbav:"time"`
+	Detail  json.RawMessage `json:"detail" dynamodbav:"detail"`
+	// RecordedAt is when the entry was appended to the log.
+	RecordedAt time.Time `json:"recordedAt" dynamodbav:"recordedAt"`
+	// PrevHash is the hash of the previous entry, or empty for the first entry.
+	PrevHash string `json:"prevHash" dynamodbav:"prevHash"`
+	Hash     string `json:"hash" dynamodbav:"hash"`
+}
+
+func (e *Entry) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.AuditLog.PK1,
+		SK: keys.AuditLog.SK1(e.Sequence),
+	}
+	return keys, nil
+}
+
+// NewEntry returns the entry recording evt, chained to the head of the log.
+func NewEntry(head Head, evt gevent.Event, now time.Time) Entry {
+	e := Entry{
+		Sequence:   head.Sequence + 1,
+		EventID:    evt.ID,
+		Type:       evt.Type,
+		Time:       evt.Time.UTC(),
+		Detail:     evt.Detail,
+		RecordedAt: now.UTC(),
+		PrevHash:   head.Hash,
+	}
+	e.Hash = e.ComputeHash()
+	return e
+}
+
+// ComputeHash returns the SHA-256 hash of the entry's fields other than Hash, hex encoded.
+// PrevHash is included, which chains the entry to the one before it.
+func (e Entry) ComputeHash() string {
+	// times are formatted explicitly so that the hash doesn't depend on the
+	// time zone the entry was decoded in.
+	h := struct {
+		Sequence   int64           `json:"sequence"`
+		EventID    string          `json:"eventId"`
+		Type       string          `json:"type"`
+		Time       string          `json:"time"`
+		Detail     json.RawMessage `json:"detail"`
+		RecordedAt string          `json:"recordedAt"`
+		PrevHash   string          `json:"prevHash"`
+	}{
+		Sequence:   e.Sequence,
+		EventID:    e.EventID,
+		Type:       e.Type,
+		Time:       e.Time.UTC().Format(time.RFC3339Nano),
+		Detail:     e.Detail,
+		RecordedAt: e.RecordedAt.UTC().Format(time.RFC3339Nano),
+		PrevHash:   e.PrevHash,
+	}
+	if h.Detail == nil {
+		h.Detail = json.RawMessage("null")
+	}
+	// marshalling a struct always produces its fields in the same order,
+	// and raw messages are compacted, so the encoding is stable.
+	b, _ := json.Marshal(h)
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// Head is the latest entry in the audit log.
+// Entries are appended in a transaction which is conditional on the head,
+// so that two entries can never be given the same sequence number.
+type Head struct {
+	Sequence int64  `json:"sequence" dynamodbav:"sequence"`
+	Hash     string `json:"hash" dynamodbav:"hash"`
+}
+
+func (h *Head) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.AuditLog.PK1,
+		SK: keys.AuditLog.SK1Head,
+	}
+	return keys, nil
+}
+
+// Exported records the latest entry which has been exported to S3.
+type Exported struct {
+	Sequence   int64     `json:"sequence" dynamodbav:"sequence"`
+	Hash       string    `json:"hash" dynamodbav:"hash"`
+	ExportedAt time.Time `json:"exportedAt" dynamodbav:"exportedAt"`
+}
+
+func (e *Exported) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.AuditLog.PK1,
+		SK: keys.AuditLog.SK1Exported,
+	}
+	return keys, nil
+}
+
+// EventMarker records that an event has been appended to the log.
+type EventMarker struct {
+	EventID  string `json:"eventId" dynamodbav:"eventId"`
+	Sequence int64  `json:"sequence" dynamodbav:"sequence"`
+}
+
+func (m *EventMarker) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.AuditLogEvent.PK1,
+		SK: keys.AuditLogEvent.SK1(m.EventID),
+	}
+	return keys, nil
+}
@@ -0,0 +1,45 @@
+package audit
+
+import "fmt"
+
+// Problem is an issue found when verifying the audit log.
+type Problem struct {
+	Sequence int64
+	Reason   string
+}
+
+func (p Problem) String() string {
+	return fmt.Sprintf("entry %d: %s", p.Sequence, p.Reason)
+}
+
+// Verify checks that the entries form an unbroken hash chain, starting from the first entry in the log.
+// It returns the problems found, which are empty if the log hasn't been tampered with.
+//
+// An entry which has been edited has a hash which doesn't match its contents.
+// An entry which has been removed leaves a gap in the sequence numbers, and an entry which has been
+// removed and had the following entries renumbered breaks the chain of hashes.
+func Verify(entries []Entry) []Problem {
+	return VerifyFrom(Head{}, entries)
+}
+
+// VerifyFrom checks that the entries form an unbroken hash chain following on from prev.
+func VerifyFrom(prev Head, entries []Entry) []Problem {
+	var problems []Problem
+	for _, e := range entries {
+		if e.Sequence != prev.Sequence+1 {
+			if e.Sequence <= prev.Sequence {
+				problems = append(problems, Problem{Sequence: e.Sequence, Reason: fmt.Sprintf("out of order or duplicated after entry %d", prev.Sequence)})
+			} else {
+				problems = append(problems, Problem{Sequence: e.Sequence, Reason: fmt.Sprintf("missing entries %d to %d", prev.Sequence+1, e.Sequence-1)})
+			}
+		}
+		if e.PrevHash != prev.Hash {
+			problems = append(problems, Problem{Sequence: e.Sequence, Reason: "previous hash does not match the hash of the previous entry"})
+		}
+		if got := e.ComputeHash(); got != e.Hash {
+			problems = append(problems, Problem{Sequence: e.Sequence, Reason: "hash does not match the contents of the entry"})
+		}
+		prev = Head{Sequence: e.Sequence, Hash: e.Hash}
+	}
+	return problems
+}
@@ -0,0 +1,118 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/stretchr/testify/assert"
+)
+
+// chain returns a valid audit log with n entries.
+func chain(n int) []Entry {
+	now := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+	var entries []Entry
+	var head Head
+	for i := 0; i < n; i++ {
+		evt := gevent.Event{
+			ID:     fmt.Sprintf("evt_%d", i),
+			Type:   gevent.RequestCreatedType,
+			Time:   now,
+			Detail: json.RawMessage(fmt.Sprintf(`{"request": {"id": "req_%d"}}`, i)),
+		}
+		e := NewEntry(head, evt, now.Add(time.Second))
+		entries = append(entries, e)
+		head = Head{Sequence: e.Sequence, Hash: e.Hash}
+	}
+	return entries
+}
+
+func TestVerify(t *testing.T) {
+	type testcase struct {
+		name   string
+		modify func(entries []Entry) []Entry
+		want   []Problem
+	}
+
+	testcases := []testcase{
+		{
+			name:   "ok",
+			modify: func(entries []Entry) []Entry { return entries },
+		},
+		{
+			name: "edited detail",
+			modify: func(entries []Entry) []Entry {
+				entries[1].Detail = json.RawMessage(`{"request": {"id": "req_other"}}`)
+				return entries
+			},
+			want: []Problem{{Sequence: 2, Reason: "hash does not match the contents of the entry"}},
+		},
+		{
+			name: "edited and rehashed",
+			modify: func(entries []Entry) []Entry {
+				entries[1].Type = gevent.RequestApprovedType
+				entries[1].Hash = entries[1].ComputeHash()
+				return entries
+			},
+			want: []Problem{{Sequence: 3, Reason: "previous hash does not match the hash of the previous entry"}},
+		},
+		{
+			name: "removed entry",
+			modify: func(entries []Entry) []Entry {
+				return append(entries[:1], entries[2:]...)
+			},
+			want: []Problem{
+				{Sequence: 3, Reason: "missing entries 2 to 2"},
+				{Sequence: 3, Reason: "previous hash does not match the hash of the previous entry"},
+			},
+		},
+		{
+			name: "removed first entry",
+			modify: func(entries []Entry) []Entry {
+				return entries[1:]
+			},
+			want: []Problem{
+				{Sequence: 2, Reason: "missing entries 1 to 1"},
+				{Sequence: 2, Reason: "previous hash does not match the hash of the previous entry"},
+			},
+		},
+		{
+			name: "duplicated entry",
+			modify: func(entries []Entry) []Entry {
+				return append(entries[:2], entries[1:]...)
+			},
+			want: []Problem{
+				{Sequence: 2, Reason: "out of order or duplicated after entry 2"},
+				{Sequence: 2, Reason: "previous hash does not match the hash of the previous entry"},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := Verify(tc.modify(chain(3)))
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestJSONLRoundTrip(t *testing.T) {
+	entries := chain(3)
+	var buf bytes.Buffer
+	err := WriteJSONL(&buf, entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 3, bytes.Count(buf.Bytes(), []byte("\n")))
+
+	got, err := ReadJSONL(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, got, 3)
+	// the detail is compacted when it is written, which must not change the hash.
+	assert.Empty(t, Verify(got))
+}
@@ -1,6 +1,7 @@
 package autoapproval
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -8,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/common-fate/common-fate/pkg/access"
 	"github.com/common-fate/common-fate/pkg/identity"
 	"github.com/common-fate/common-fate/pkg/rule"
 )
@@ -25,26 +27,54 @@ type ResponseBody struct {
 }
 
 type RequestBody struct {
-	User identity.User   `json:"user"`
-	Rule rule.AccessRule `json:"rule"`
+	User   identity.User     `json:"user"`
+	Rule   rule.AccessRule   `json:"rule"`
+	With   map[string]string `json:"with,omitempty"`
+	Timing access.Timing     `json:"timing"`
 }
 
+// Service decides whether an access request can be approved without review.
+//
+// If a Policy is configured it is evaluated in-process. Otherwise, if a LambdaARN is configured,
+// the Lambda function is invoked to make the decision.
 type Service struct {
+	Policy    *Policy
+	LambdaARN string
 }
 
-func (s Service) Autoapprove(user identity.User, rule rule.AccessRule, lambdaArn string) (bool, error) {
+// Autoapprove evaluates the request. Requests require approval if neither a policy nor a Lambda is configured.
+func (s Service) Autoapprove(ctx context.Context, in Input) (Result, error) {
+	if s.Policy != nil {
+		return s.Policy.Evaluate(in), nil
+	}
+	if s.LambdaARN != "" {
+		return invokeLambda(in, s.LambdaARN)
+	}
+	return Result{Decision: REQUIRES_APPROVAL}, nil
+}
+
+// HistoryQuery returns the request history needed to evaluate the request.
+// The auto-approval Lambda isn't given the history, so none is needed unless a policy is configured.
+func (s Service) HistoryQuery() HistoryQuery {
+	if s.Policy == nil {
+		return HistoryQuery{}
+	}
+	return s.Policy.HistoryQuery()
+}
+
+func invokeLambda(in Input, lambdaArn string) (Result, error) {
 	sess, err := session.NewSession()
 
 	if err != nil {
-		return false, err
+		return Result{}, err
 	}
 
-	req := RequestBody{User: user, Rule: rule}
+	req := RequestBody{User: in.User, Rule: in.Rule, With: in.With, Timing: in.Timing}
 
 	payload, err := json.Marshal(req)
 
 	if err != nil {
-		return false, err
+		return Result{}, err
 	}
 
 	params := &lambda.InvokeInput{
@@ -58,19 +88,19 @@ func (s Service) Autoapprove(user identity.User, rule rule.AccessRule, lambdaArn
 	resp, err := svc.Invoke(params)
 
 	if err != nil {
-		fmt.Println("Error happened when Invoke lambda ", err)
+		return Result{}, fmt.Errorf("invoking auto-approval lambda: %w", err)
+	}
+
+	if resp.FunctionError != nil {
+		return Result{}, errors.New("Error happened when calling lambda: " + *resp.FunctionError)
 	}
 
 	var output ResponseBody
 	err = json.Unmarshal(resp.Payload, &output)
 
 	if err != nil {
-		return false, err
-	}
-
-	if resp.FunctionError != nil {
-		return false, errors.New("Error happened when calling lambda: " + *resp.FunctionError)
+		return Result{}, err
 	}
 
-	return output.Decision == AUTO_APPROVED, nil
+	return Result{Decision: output.Decision, Justification: output.Justification}, nil
 }
@@ -0,0 +1,191 @@
+package autoapproval
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/rule"
+)
+
+// Input is the information about an access request which a policy is evaluated against.
+type Input struct {
+	User identity.User   `json:"user"`
+	Rule rule.AccessRule `json:"rule"`
+	// With holds the selected value for each argument of the request.
+	With   map[string]string `json:"with,omitempty"`
+	Timing access.Timing     `json:"timing"`
+	// History is the user's previous access requests.
+	History []access.Request `json:"history,omitempty"`
+	// Now is the time the request was made.
+	Now time.Time `json:"now"`
+}
+
+// Result is the outcome of an auto-approval evaluation.
+type Result struct {
+	Decision      Status `json:"decision"`
+	Justification string `json:"justification,omitempty"`
+	// Statement is the ID of the policy statement which auto-approved the request.
+	Statement string `json:"statement,omitempty"`
+}
+
+// AutoApproved is true if the request should be approved without review.
+func (r Result) AutoApproved() bool {
+	return r.Decision == AUTO_APPROVED
+}
+
+// Evaluate the policy against the input. Evaluation is deterministic and has no side effects,
+// so it can be used to dry-run a policy locally.
+func (p Policy) Evaluate(in Input) Result {
+	for _, s := range p.Statements {
+		if s.When.matches(in) {
+			justification := s.Justification
+			if justification == "" {
+				justification = fmt.Sprintf("auto-approved by policy statement %s", s.ID)
+			}
+			return Result{
+				Decision:      AUTO_APPROVED,
+				Justification: justification,
+				Statement:     s.ID,
+			}
+		}
+	}
+	return Result{
+		Decision:      REQUIRES_APPROVAL,
+		Justification: "no auto-approval policy statement matched the request",
+	}
+}
+
+func (c Conditions) matches(in Input) bool {
+	if c.isEmpty() {
+		return false
+	}
+	if len(c.Users) > 0 && !contains(c.Users, in.User.ID) && !contains(c.Users, in.User.Email) {
+		return false
+	}
+	if len(c.Groups) > 0 && !containsAny(c.Groups, in.User.Groups) {
+		return false
+	}
+	if len(c.Rules) > 0 && !contains(c.Rules, in.Rule.ID) {
+		return false
+	}
+	for arg, allowed := range c.With {
+		selected, ok := in.With[arg]
+		if !ok || !contains(allowed, selected) {
+			return false
+		}
+	}
+	if c.MaxDuration != nil && in.Timing.Duration > time.Duration(*c.MaxDuration) {
+		return false
+	}
+	if c.BusinessHours != nil && !c.BusinessHours.contains(in.Timing, in.Now) {
+		return false
+	}
+	if c.History != nil && !c.History.matches(in) {
+		return false
+	}
+	return true
+}
+
+// contains is true if the whole access window for the timing falls within a single business hours window.
+func (b BusinessHours) contains(timing access.Timing, now time.Time) bool {
+	loc, days, window, err := b.parse()
+	if err != nil {
+		return false
+	}
+	start, end := timing.GetInterval(access.WithNow(now))
+	start, end = start.In(loc), end.In(loc)
+	if !days[start.Weekday()] {
+		return false
+	}
+	midnight := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc)
+	return !start.Before(midnight.Add(window[0])) && !end.After(midnight.Add(window[1]))
+}
+
+func (h HistoryConditions) matches(in Input) bool {
+	if h.MaxRequests != nil {
+		since := in.Now.Add(-h.within())
+		count := 0
+		for _, r := range in.History {
+			if r.CreatedAt.After(since) {
+				count++
+			}
+		}
+		if count >= *h.MaxRequests {
+			return false
+		}
+	}
+	if h.MinApprovedForRule != nil {
+		count := 0
+		for _, r := range in.History {
+			if r.Rule == in.Rule.ID && r.Status == access.APPROVED {
+				count++
+			}
+		}
+		if count < *h.MinApprovedForRule {
+			return false
+		}
+	}
+	return true
+}
+
+// within is the lookback period for MaxRequests.
+func (h HistoryConditions) within() time.Duration {
+	if h.Within != nil {
+		return time.Duration(*h.Within)
+	}
+	return 24 * time.Hour
+}
+
+// HistoryQuery describes the part of the user's request history which is needed to evaluate a policy,
+// so that the history doesn't need to be loaded when no statement has a History condition.
+type HistoryQuery struct {
+	// Within is the longest lookback period of the MaxRequests conditions, or zero if there are none.
+	// Requests made before this period don't need to be loaded.
+	Within time.Duration
+	// ForRule is true if a statement counts the user's approved requests for the Access Rule being requested,
+	// in which case all of the user's requests for that rule are needed.
+	ForRule bool
+}
+
+// IsEmpty is true if no request history is needed.
+func (q HistoryQuery) IsEmpty() bool {
+	return q.Within == 0 && !q.ForRule
+}
+
+// HistoryQuery returns the request history needed to evaluate the History conditions of the policy.
+func (p Policy) HistoryQuery() HistoryQuery {
+	var q HistoryQuery
+	for _, s := range p.Statements {
+		h := s.When.History
+		if h == nil {
+			continue
+		}
+		if h.MaxRequests != nil && h.within() > q.Within {
+			q.Within = h.within()
+		}
+		if h.MinApprovedForRule != nil {
+			q.ForRule = true
+		}
+	}
+	return q
+}
+
+func contains(list []string, v string) bool {
+	for _, item := range list {
+		if item == v {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAny(list []string, values []string) bool {
+	for _, v := range values {
+		if contains(list, v) {
+			return true
+		}
+	}
+	return false
+}
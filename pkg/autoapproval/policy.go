@@ -0,0 +1,209 @@
+package autoapproval
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Policy is a declarative auto-approval policy which is evaluated in-process when an access request is created.
+//
+// Statements are evaluated in order. The first statement whose conditions all match the request
+// auto-approves it. If no statement matches, the request requires approval.
+//
+// An example policy:
+//
+//	statements:
+//	  - id: short-business-hours
+//	    justification: short requests during business hours are auto-approved
+//	    when:
+//	      maxDuration: 1h
+//	      businessHours:
+//	        timezone: Australia/Sydney
+//	        days: [Mon, Tue, Wed, Thu, Fri]
+//	        start: "09:00"
+//	        end: "17:00"
+//	  - id: on-call
+//	    justification: on-call engineers are auto-approved
+//	    when:
+//	      groups: [oncall]
+type Policy struct {
+	Statements []Statement `json:"statements" yaml:"statements"`
+}
+
+// Statement auto-approves a request when all of its conditions match.
+type Statement struct {
+	ID            string     `json:"id" yaml:"id"`
+	Justification string     `json:"justification,omitempty" yaml:"justification,omitempty"`
+	When          Conditions `json:"when" yaml:"when"`
+}
+
+// Conditions which must all match for a statement to apply.
+// Conditions which are not set are ignored.
+type Conditions struct {
+	// Users matches if the requesting user's ID or email is in the list.
+	Users []string `json:"users,omitempty" yaml:"users,omitempty"`
+	// Groups matches if the requesting user belongs to any of the groups.
+	Groups []string `json:"groups,omitempty" yaml:"groups,omitempty"`
+	// Rules matches if the request is for any of the Access Rule IDs.
+	Rules []string `json:"rules,omitempty" yaml:"rules,omitempty"`
+	// With matches if, for every argument in the map, the selected value is one of the allowed values.
+	With map[string][]string `json:"with,omitempty" yaml:"with,omitempty"`
+	// MaxDuration matches if the requested duration is less than or equal to the value.
+	MaxDuration *Duration `json:"maxDuration,omitempty" yaml:"maxDuration,omitempty"`
+	// BusinessHours matches if the entire requested access window falls within business hours.
+	BusinessHours *BusinessHours `json:"businessHours,omitempty" yaml:"businessHours,omitempty"`
+	// History matches against the user's previous access requests.
+	History *HistoryConditions `json:"history,omitempty" yaml:"history,omitempty"`
+}
+
+// BusinessHours describes a daily window on specific weekdays.
+type BusinessHours struct {
+	// Timezone is an IANA timezone name, such as Australia/Sydney. Defaults to UTC.
+	Timezone string `json:"timezone,omitempty" yaml:"timezone,omitempty"`
+	// Days are three letter weekday names, such as Mon. Defaults to Monday to Friday.
+	Days []string `json:"days,omitempty" yaml:"days,omitempty"`
+	// Start is the start of the window in 24 hour HH:MM format.
+	Start string `json:"start" yaml:"start"`
+	// End is the end of the window in 24 hour HH:MM format.
+	End string `json:"end" yaml:"end"`
+}
+
+// HistoryConditions match against the requesting user's previous access requests.
+type HistoryConditions struct {
+	// MaxRequests matches if the user has made fewer than this many requests within the Within period.
+	MaxRequests *int `json:"maxRequests,omitempty" yaml:"maxRequests,omitempty"`
+	// Within is the lookback period for MaxRequests. Defaults to 24h.
+	Within *Duration `json:"within,omitempty" yaml:"within,omitempty"`
+	// MinApprovedForRule matches if the user has at least this many previously approved requests for the same Access Rule.
+	MinApprovedForRule *int `json:"minApprovedForRule,omitempty" yaml:"minApprovedForRule,omitempty"`
+}
+
+// Duration is a time.Duration which is written as a string such as "1h30m" in policy documents.
+type Duration time.Duration
+
+func (d *Duration) UnmarshalYAML(value *yaml.Node) error {
+	var s string
+	if err := value.Decode(&s); err != nil {
+		return err
+	}
+	parsed, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+func (d Duration) MarshalYAML() (interface{}, error) {
+	return time.Duration(d).String(), nil
+}
+
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	parsed, err := time.ParseDuration(strings.Trim(string(b), `"`))
+	if err != nil {
+		return err
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + time.Duration(d).String() + `"`), nil
+}
+
+// ParsePolicy parses a YAML or JSON policy document and validates it.
+func ParsePolicy(data []byte) (*Policy, error) {
+	var p Policy
+	err := yaml.Unmarshal(data, &p)
+	if err != nil {
+		return nil, err
+	}
+	err = p.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// Validate returns an error if the policy contains an invalid statement.
+func (p Policy) Validate() error {
+	ids := make(map[string]bool)
+	for i, s := range p.Statements {
+		if s.ID == "" {
+			return fmt.Errorf("statement %d: id is required", i)
+		}
+		if ids[s.ID] {
+			return fmt.Errorf("statement %s: id must be unique", s.ID)
+		}
+		ids[s.ID] = true
+		if s.When.isEmpty() {
+			return fmt.Errorf("statement %s: at least one condition is required", s.ID)
+		}
+		if bh := s.When.BusinessHours; bh != nil {
+			_, _, _, err := bh.parse()
+			if err != nil {
+				return fmt.Errorf("statement %s: businessHours: %w", s.ID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// isEmpty is true if no conditions are set. Statements without conditions are rejected
+// so that a misconfigured policy can't auto-approve every request.
+func (c Conditions) isEmpty() bool {
+	return len(c.Users) == 0 && len(c.Groups) == 0 && len(c.Rules) == 0 && len(c.With) == 0 &&
+		c.MaxDuration == nil && c.BusinessHours == nil && c.History == nil
+}
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// parse returns the location, the allowed weekdays and the start and end offsets from midnight.
+func (b BusinessHours) parse() (*time.Location, map[time.Weekday]bool, [2]time.Duration, error) {
+	var window [2]time.Duration
+	loc := time.UTC
+	if b.Timezone != "" {
+		l, err := time.LoadLocation(b.Timezone)
+		if err != nil {
+			return nil, nil, window, err
+		}
+		loc = l
+	}
+
+	days := make(map[time.Weekday]bool)
+	if len(b.Days) == 0 {
+		for d := time.Monday; d <= time.Friday; d++ {
+			days[d] = true
+		}
+	}
+	for _, d := range b.Days {
+		wd, ok := weekdays[strings.ToLower(d)]
+		if !ok {
+			return nil, nil, window, fmt.Errorf("invalid day %s", d)
+		}
+		days[wd] = true
+	}
+
+	for i, v := range []string{b.Start, b.End} {
+		t, err := time.Parse("15:04", v)
+		if err != nil {
+			return nil, nil, window, fmt.Errorf("invalid time %q, expected HH:MM", v)
+		}
+		window[i] = time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+	}
+	if window[0] >= window[1] {
+		return nil, nil, window, fmt.Errorf("start %s must be before end %s", b.Start, b.End)
+	}
+	return loc, days, window, nil
+}
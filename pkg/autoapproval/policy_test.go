@@ -0,0 +1,233 @@
+package autoapproval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/stretchr/testify/assert"
+)
+
+const testPolicy = `
+statements:
+  - id: short-business-hours
+    justification: short requests during business hours are auto-approved
+    when:
+      maxDuration: 1h
+      businessHours:
+        timezone: UTC
+        start: "09:00"
+        end: "17:00"
+  - id: on-call
+    when:
+      groups: [oncall]
+      rules: [rul_prod]
+  - id: dev-account
+    when:
+      with:
+        accountId: ["123456789012"]
+      history:
+        maxRequests: 3
+        within: 24h
+  - id: trusted
+    when:
+      history:
+        minApprovedForRule: 2
+`
+
+func TestParsePolicy(t *testing.T) {
+	type testcase struct {
+		name    string
+		give    string
+		wantErr string
+	}
+
+	testcases := []testcase{
+		{name: "ok", give: testPolicy},
+		{name: "json", give: `{"statements":[{"id":"a","when":{"maxDuration":"30m"}}]}`},
+		{
+			name:    "missing id",
+			give:    `{"statements":[{"when":{"groups":["a"]}}]}`,
+			wantErr: "statement 0: id is required",
+		},
+		{
+			name:    "duplicate id",
+			give:    `{"statements":[{"id":"a","when":{"groups":["a"]}},{"id":"a","when":{"groups":["b"]}}]}`,
+			wantErr: "statement a: id must be unique",
+		},
+		{
+			name:    "no conditions",
+			give:    `{"statements":[{"id":"a","when":{}}]}`,
+			wantErr: "statement a: at least one condition is required",
+		},
+		{
+			name:    "invalid business hours",
+			give:    `{"statements":[{"id":"a","when":{"businessHours":{"start":"17:00","end":"09:00"}}}]}`,
+			wantErr: "statement a: businessHours: start 17:00 must be before end 09:00",
+		},
+		{
+			name:    "invalid duration",
+			give:    `{"statements":[{"id":"a","when":{"maxDuration":"forever"}}]}`,
+			wantErr: `time: invalid duration "forever"`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParsePolicy([]byte(tc.give))
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	// a Wednesday
+	businessHours := time.Date(2022, 11, 2, 10, 0, 0, 0, time.UTC)
+	afterHours := time.Date(2022, 11, 2, 16, 30, 0, 0, time.UTC)
+	weekend := time.Date(2022, 11, 5, 10, 0, 0, 0, time.UTC)
+
+	type testcase struct {
+		name          string
+		give          Input
+		wantDecision  Status
+		wantStatement string
+	}
+
+	testcases := []testcase{
+		{
+			name: "short request in business hours",
+			give: Input{
+				Rule:   rule.AccessRule{ID: "rul_dev"},
+				Timing: access.Timing{Duration: time.Hour},
+				Now:    businessHours,
+			},
+			wantDecision:  AUTO_APPROVED,
+			wantStatement: "short-business-hours",
+		},
+		{
+			name: "request ending after business hours",
+			give: Input{
+				Rule:   rule.AccessRule{ID: "rul_dev"},
+				Timing: access.Timing{Duration: time.Hour},
+				Now:    afterHours,
+			},
+			wantDecision: REQUIRES_APPROVAL,
+		},
+		{
+			name: "request on the weekend",
+			give: Input{
+				Rule:   rule.AccessRule{ID: "rul_dev"},
+				Timing: access.Timing{Duration: time.Hour},
+				Now:    weekend,
+			},
+			wantDecision: REQUIRES_APPROVAL,
+		},
+		{
+			name: "long request in business hours",
+			give: Input{
+				Rule:   rule.AccessRule{ID: "rul_dev"},
+				Timing: access.Timing{Duration: 2 * time.Hour},
+				Now:    businessHours,
+			},
+			wantDecision: REQUIRES_APPROVAL,
+		},
+		{
+			name: "on-call member",
+			give: Input{
+				User:   identity.User{ID: "usr_1", Groups: []string{"engineering", "oncall"}},
+				Rule:   rule.AccessRule{ID: "rul_prod"},
+				Timing: access.Timing{Duration: 8 * time.Hour},
+				Now:    weekend,
+			},
+			wantDecision:  AUTO_APPROVED,
+			wantStatement: "on-call",
+		},
+		{
+			name: "on-call member for a different rule",
+			give: Input{
+				User:   identity.User{ID: "usr_1", Groups: []string{"oncall"}},
+				Rule:   rule.AccessRule{ID: "rul_other"},
+				Timing: access.Timing{Duration: 8 * time.Hour},
+				Now:    weekend,
+			},
+			wantDecision: REQUIRES_APPROVAL,
+		},
+		{
+			name: "with argument under request limit",
+			give: Input{
+				Rule:   rule.AccessRule{ID: "rul_dev"},
+				With:   map[string]string{"accountId": "123456789012"},
+				Timing: access.Timing{Duration: 8 * time.Hour},
+				History: []access.Request{
+					{CreatedAt: weekend.Add(-time.Hour)},
+					{CreatedAt: weekend.Add(-48 * time.Hour)},
+				},
+				Now: weekend,
+			},
+			wantDecision:  AUTO_APPROVED,
+			wantStatement: "dev-account",
+		},
+		{
+			name: "with argument over request limit",
+			give: Input{
+				Rule:   rule.AccessRule{ID: "rul_dev"},
+				With:   map[string]string{"accountId": "123456789012"},
+				Timing: access.Timing{Duration: 8 * time.Hour},
+				History: []access.Request{
+					{CreatedAt: weekend.Add(-time.Hour)},
+					{CreatedAt: weekend.Add(-2 * time.Hour)},
+					{CreatedAt: weekend.Add(-3 * time.Hour)},
+				},
+				Now: weekend,
+			},
+			wantDecision: REQUIRES_APPROVAL,
+		},
+		{
+			name: "trusted requester",
+			give: Input{
+				Rule:   rule.AccessRule{ID: "rul_dev"},
+				Timing: access.Timing{Duration: 8 * time.Hour},
+				History: []access.Request{
+					{Rule: "rul_dev", Status: access.APPROVED},
+					{Rule: "rul_dev", Status: access.APPROVED},
+					{Rule: "rul_other", Status: access.APPROVED},
+				},
+				Now: weekend,
+			},
+			wantDecision:  AUTO_APPROVED,
+			wantStatement: "trusted",
+		},
+	}
+
+	p, err := ParsePolicy([]byte(testPolicy))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := p.Evaluate(tc.give)
+			assert.Equal(t, tc.wantDecision, got.Decision)
+			assert.Equal(t, tc.wantStatement, got.Statement)
+			assert.NotEmpty(t, got.Justification)
+		})
+	}
+}
+
+func TestPolicyHistoryQuery(t *testing.T) {
+	p, err := ParsePolicy([]byte(testPolicy))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, HistoryQuery{Within: 24 * time.Hour, ForRule: true}, p.HistoryQuery())
+
+	// the history isn't needed if no statement has a history condition.
+	p.Statements = p.Statements[:2]
+	assert.True(t, p.HistoryQuery().IsEmpty())
+}
@@ -15,8 +15,16 @@ type Config struct {
 	SentryDSN         string `env:"COMMONFATE_SENTRY_DSN"`
 	EventBusArn       string `env:"COMMONFATE_EVENT_BUS_ARN,required"`
 	EventBusSource    string `env:"COMMONFATE_EVENT_BUS_SOURCE,required"`
-	IdpProvider       string `env:"COMMONFATE_IDENTITY_PROVIDER,required"`
-	DeploymentSuffix  string `env:"COMMONFATE_DEPLOYMENT_SUFFIX"`
+	// optional sinks which events are published to in addition to the event bus. See eventsink.Opts.
+	EventSNSTopicARN  string   `env:"COMMONFATE_EVENT_SNS_TOPIC_ARN"`
+	EventSQSQueueURL  string   `env:"COMMONFATE_EVENT_SQS_QUEUE_URL"`
+	EventKafkaBrokers []string `env:"COMMONFATE_EVENT_KAFKA_BROKERS"`
+	EventKafkaTopic   string   `env:"COMMONFATE_EVENT_KAFKA_TOPIC"`
+	// when running locally, publish events to an in-process bus which is handled by the
+	// local server, rather than to the event bus.
+	LocalEvents      bool   `env:"COMMONFATE_LOCAL_EVENTS,default=false"`
+	IdpProvider      string `env:"COMMONFATE_IDENTITY_PROVIDER,required"`
+	DeploymentSuffix string `env:"COMMONFATE_DEPLOYMENT_SUFFIX"`
 	// This should be an instance of deploy.FeatureMap which is a specific json format for this
 	// Use deploy.UnmarshalFeatureMap to unmarshal this data into a FeatureMap
 	IdentitySettings              string `env:"COMMONFATE_IDENTITY_SETTINGS,default={}"`
@@ -29,6 +37,15 @@ type Config struct {
 	NoAuthEmail           string `env:"NO_AUTH_EMAIL"`
 	StateMachineARN       string `env:"COMMONFATE_GRANTER_V2_STATE_MACHINE_ARN"`
 	AutoApprovalLambdaArn string `env:"COMMONFATE_AUTO_APPROVAL_LAMBDA_ARN"`
+	// a YAML or JSON auto-approval policy document. See autoapproval.Policy for the format.
+	AutoApprovalPolicy string `env:"COMMONFATE_AUTO_APPROVAL_POLICY"`
+	// an optional URL which ticket references are sent to for validation. See ticket.WebhookValidator.
+	TicketValidatorURL string `env:"COMMONFATE_TICKET_VALIDATOR_URL"`
+	// This should be an instance of deploy.FeatureMap, keyed by activity reader type.
+	// See readers.Registry for the available readers.
+	ActivitySettings string `env:"COMMONFATE_ACTIVITY_SETTINGS,default={}"`
+	// when true, the reconciler removes access which remains in the provider after the grant has ended.
+	ReconcilerAutoRevoke bool `env:"COMMONFATE_RECONCILER_AUTO_REVOKE,default=false"`
 }
 
 type NotificationsConfig struct {
@@ -69,6 +86,58 @@ type HealthCheckerConfig struct {
 	Region    string `env:"AWS_REGION,required"`
 }
 
+type EscalationConfig struct {
+	TableName string `env:"COMMONFATE_TABLE_NAME,required"`
+	LogLevel  string `env:"LOG_LEVEL,default=info"`
+}
+
+type RemindersConfig struct {
+	TableName string `env:"COMMONFATE_TABLE_NAME,required"`
+	LogLevel  string `env:"LOG_LEVEL,default=info"`
+}
+
+type ReconcilerConfig struct {
+	TableName        string `env:"COMMONFATE_TABLE_NAME,required"`
+	LogLevel         string `env:"LOG_LEVEL,default=info"`
+	Region           string `env:"AWS_REGION,required"`
+	AccessHandlerURL string `env:"COMMONFATE_ACCESS_HANDLER_URL,default=http://0.0.0.0:9092"`
+	// when true, access which remains in the provider after the grant has ended is removed.
+	AutoRevoke bool `env:"COMMONFATE_RECONCILER_AUTO_REVOKE,default=false"`
+}
+
+type EventOutboxConfig struct {
+	TableName         string   `env:"COMMONFATE_TABLE_NAME,required"`
+	LogLevel          string   `env:"LOG_LEVEL,default=info"`
+	EventBusArn       string   `env:"COMMONFATE_EVENT_BUS_ARN,required"`
+	EventSNSTopicARN  string   `env:"COMMONFATE_EVENT_SNS_TOPIC_ARN"`
+	EventSQSQueueURL  string   `env:"COMMONFATE_EVENT_SQS_QUEUE_URL"`
+	EventKafkaBrokers []string `env:"COMMONFATE_EVENT_KAFKA_BROKERS"`
+	EventKafkaTopic   string   `env:"COMMONFATE_EVENT_KAFKA_TOPIC"`
+}
+
+type AuditLogConfig struct {
+	TableName string `env:"COMMONFATE_TABLE_NAME,required"`
+	LogLevel  string `env:"LOG_LEVEL,default=info"`
+	// AuditLogBucket is only required by the export Lambda.
+	AuditLogBucket string `env:"COMMONFATE_AUDIT_LOG_BUCKET"`
+	// AuditLogRetentionDays is how long exported objects are locked for.
+	// If zero, the default retention of the bucket is used.
+	AuditLogRetentionDays int `env:"COMMONFATE_AUDIT_LOG_RETENTION_DAYS"`
+}
+
+type DigestConfig struct {
+	TableName   string `env:"COMMONFATE_TABLE_NAME,required"`
+	LogLevel    string `env:"LOG_LEVEL,default=info"`
+	FrontendURL string `env:"COMMONFATE_FRONTEND_URL,required"`
+}
+
+type ActivityConfig struct {
+	TableName string `env:"COMMONFATE_TABLE_NAME,required"`
+	LogLevel  string `env:"LOG_LEVEL,default=info"`
+	// This should be an instance of deploy.FeatureMap, keyed by activity reader type.
+	ActivitySettings string `env:"COMMONFATE_ACTIVITY_SETTINGS,default={}"`
+}
+
 type FrontendDeployerConfig struct {
 	LogLevel                             string `env:"LOG_LEVEL,default=info"`
 	Region                               string `env:"AWS_REGION,required"`
@@ -0,0 +1,123 @@
+// Package configchange records changes made by administrators to the configuration of Common Fate,
+// such as access rules, target groups, handlers, provider setups and internal groups.
+package configchange
+
+import (
+	"context"
+	"time"
+
+	"github.com/common-fate/apikit/userid"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// ResourceType is the type of resource which was changed.
+type ResourceType string
+
+const (
+	AccessRule      ResourceType = "accessrule"
+	TargetGroup     ResourceType = "targetgroup"
+	TargetGroupLink ResourceType = "targetgrouplink"
+	Handler         ResourceType = "handler"
+	ProviderSetup   ResourceType = "providersetup"
+	Group           ResourceType = "group"
+	// User changes record changes to the internal groups a user is a member of.
+	User ResourceType = "user"
+)
+
+// Action is the kind of change which was made to the resource.
+type Action string
+
+const (
+	CREATED   Action = "created"
+	UPDATED   Action = "updated"
+	ARCHIVED  Action = "archived"
+	DELETED   Action = "deleted"
+	COMPLETED Action = "completed"
+)
+
+// Change is a change made to a resource by an administrator.
+// Changes should not be updated once created.
+type Change struct {
+	ID           string       `json:"id" dynamodbav:"id"`
+	ResourceType ResourceType `json:"resourceType" dynamodbav:"resourceType"`
+	ResourceID   string       `json:"resourceId" dynamodbav:"resourceId"`
+	Action       Action       `json:"action" dynamodbav:"action"`
+	// ActorID is the ID of the user who made the change.
+	ActorID string `json:"actorId" dynamodbav:"actorId"`
+	// Reason is the reason given by the actor for making the change, if any.
+	Reason string `json:"reason,omitempty" dynamodbav:"reason,omitempty"`
+	// Fields are the fields of the resource which were changed.
+	Fields    []FieldChange `json:"fields" dynamodbav:"fields"`
+	CreatedAt time.Time     `json:"createdAt" dynamodbav:"createdAt"`
+}
+
+// New returns a change to the resource, made by the user who is making the current API request.
+// before is nil for created resources and after is nil for deleted resources.
+func New(ctx context.Context, now time.Time, resourceType ResourceType, resourceID string, action Action, before, after interface{}) (Change, error) {
+	fields, err := Diff(before, after)
+	if err != nil {
+		return Change{}, err
+	}
+	return Change{
+		ID:           types.NewHistoryID(),
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		Action:       action,
+		ActorID:      userid.Get(ctx),
+		Reason:       ReasonFromContext(ctx),
+		Fields:       fields,
+		CreatedAt:    now,
+	}, nil
+}
+
+func (c *Change) DDBKeys() (ddb.Keys, error) {
+	createdAt := c.CreatedAt.Format(time.RFC3339Nano)
+	keys := ddb.Keys{
+		PK:     keys.ConfigChange.PK1,
+		SK:     keys.ConfigChange.SK1(createdAt, c.ID),
+		GSI1PK: keys.ConfigChange.GSI1PK(string(c.ResourceType), c.ResourceID),
+		GSI1SK: keys.ConfigChange.GSI1SK(createdAt, c.ID),
+		GSI2PK: keys.ConfigChange.GSI2PK(string(c.ResourceType)),
+		GSI2SK: keys.ConfigChange.GSI2SK(createdAt, c.ID),
+	}
+	return keys, nil
+}
+
+func (c *Change) ToAPI() types.ConfigChange {
+	res := types.ConfigChange{
+		Id:           c.ID,
+		ResourceType: types.ConfigChangeResourceType(c.ResourceType),
+		ResourceId:   c.ResourceID,
+		Action:       types.ConfigChangeAction(c.Action),
+		ActorId:      c.ActorID,
+		Fields:       make([]types.ConfigFieldChange, len(c.Fields)),
+		CreatedAt:    c.CreatedAt,
+	}
+	if c.Reason != "" {
+		res.Reason = &c.Reason
+	}
+	for i, f := range c.Fields {
+		res.Fields[i] = f.ToAPI()
+	}
+	return res
+}
+
+// TargetGroupLinkID returns the resource ID used for changes to the link between a target group and a handler.
+func TargetGroupLinkID(group, handler, kind string) string {
+	return group + "/" + handler + "/" + kind
+}
+
+type reasonContext struct{}
+
+// WithReason sets the reason given for changes made in the context.
+func WithReason(ctx context.Context, reason string) context.Context {
+	return context.WithValue(ctx, reasonContext{}, reason)
+}
+
+// ReasonFromContext returns the reason given for changes made in the context, or an empty string if none was given.
+func ReasonFromContext(ctx context.Context) string {
+	reason, _ := ctx.Value(reasonContext{}).(string)
+	return reason
+}
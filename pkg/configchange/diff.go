@@ -0,0 +1,121 @@
+package configchange
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"github.com/common-fate/common-fate/pkg/types"
+)
+
+// FieldChange is a change to a single field of a resource.
+// Before and After hold the JSON values of the field, and are empty if the field wasn't set.
+type FieldChange struct {
+	// Field is the path to the field, with the names of nested fields separated by dots, such as 'approval.users'.
+	Field  string          `json:"field" dynamodbav:"field"`
+	Before json.RawMessage `json:"before,omitempty" dynamodbav:"before,omitempty"`
+	After  json.RawMessage `json:"after,omitempty" dynamodbav:"after,omitempty"`
+}
+
+func (f FieldChange) ToAPI() types.ConfigFieldChange {
+	res := types.ConfigFieldChange{Field: f.Field}
+	// the values were marshalled by Diff, so they are always valid JSON.
+	if len(f.Before) > 0 {
+		var v interface{}
+		_ = json.Unmarshal(f.Before, &v)
+		res.Before = &v
+	}
+	if len(f.After) > 0 {
+		var v interface{}
+		_ = json.Unmarshal(f.After, &v)
+		res.After = &v
+	}
+	return res
+}
+
+// Diff compares the JSON representations of two versions of a resource, and returns the fields which differ.
+// Nested objects are compared field by field, and arrays are compared as a whole.
+// Either version may be nil, in which case every field set in the other version is returned.
+func Diff(before, after interface{}) ([]FieldChange, error) {
+	b, err := toJSONValue(before)
+	if err != nil {
+		return nil, err
+	}
+	a, err := toJSONValue(after)
+	if err != nil {
+		return nil, err
+	}
+	changes := []FieldChange{}
+	err = diff("", b, a, &changes)
+	if err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+func toJSONValue(v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return nil, nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var res interface{}
+	err = json.Unmarshal(b, &res)
+	return res, err
+}
+
+func diff(path string, before, after interface{}, changes *[]FieldChange) error {
+	bm, bIsObject := before.(map[string]interface{})
+	am, aIsObject := after.(map[string]interface{})
+	if (bIsObject || before == nil) && (aIsObject || after == nil) && (bIsObject || aIsObject) {
+		fields := map[string]bool{}
+		for k := range bm {
+			fields[k] = true
+		}
+		for k := range am {
+			fields[k] = true
+		}
+		sorted := make([]string, 0, len(fields))
+		for k := range fields {
+			sorted = append(sorted, k)
+		}
+		sort.Strings(sorted)
+		for _, k := range sorted {
+			p := k
+			if path != "" {
+				p = path + "." + k
+			}
+			err := diff(p, bm[k], am[k], changes)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if reflect.DeepEqual(before, after) {
+		return nil
+	}
+	c := FieldChange{Field: path}
+	if before != nil {
+		b, err := json.Marshal(before)
+		if err != nil {
+			return err
+		}
+		c.Before = b
+	}
+	if after != nil {
+		a, err := json.Marshal(after)
+		if err != nil {
+			return err
+		}
+		c.After = a
+	}
+	*changes = append(*changes, c)
+	return nil
+}
@@ -0,0 +1,71 @@
+package configchange
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testApproval struct {
+	Users  []string `json:"users"`
+	Groups []string `json:"groups,omitempty"`
+}
+
+type testResource struct {
+	Name     string       `json:"name"`
+	Approval testApproval `json:"approval"`
+}
+
+func TestDiff(t *testing.T) {
+	type testcase struct {
+		name   string
+		before interface{}
+		after  interface{}
+		want   []FieldChange
+	}
+
+	testcases := []testcase{
+		{
+			name:   "no changes",
+			before: testResource{Name: "a", Approval: testApproval{Users: []string{"usr_1"}}},
+			after:  testResource{Name: "a", Approval: testApproval{Users: []string{"usr_1"}}},
+			want:   []FieldChange{},
+		},
+		{
+			name:   "nested fields and arrays",
+			before: testResource{Name: "a", Approval: testApproval{Users: []string{"usr_1"}}},
+			after:  testResource{Name: "b", Approval: testApproval{Users: []string{"usr_1", "usr_2"}, Groups: []string{"grp_1"}}},
+			want: []FieldChange{
+				{Field: "approval.groups", After: json.RawMessage(`["grp_1"]`)},
+				{Field: "approval.users", Before: json.RawMessage(`["usr_1"]`), After: json.RawMessage(`["usr_1","usr_2"]`)},
+				{Field: "name", Before: json.RawMessage(`"a"`), After: json.RawMessage(`"b"`)},
+			},
+		},
+		{
+			// fields which are null are treated as not being set.
+			name:  "created",
+			after: &testResource{Name: "a"},
+			want: []FieldChange{
+				{Field: "name", After: json.RawMessage(`"a"`)},
+			},
+		},
+		{
+			name:   "deleted",
+			before: &testResource{Name: "a", Approval: testApproval{Users: []string{}}},
+			after:  (*testResource)(nil),
+			want: []FieldChange{
+				{Field: "approval.users", Before: json.RawMessage(`[]`)},
+				{Field: "name", Before: json.RawMessage(`"a"`)},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Diff(tc.before, tc.after)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -41,6 +41,14 @@ func (c Config) CDKContextArgs() []string {
 		args = append(args, "-c", fmt.Sprintf("identityConfiguration=%s", string(cfg)))
 	}
 
+	if c.Deployment.Parameters.ActivityConfiguration != nil {
+		cfg, err := json.Marshal(c.Deployment.Parameters.ActivityConfiguration)
+		if err != nil {
+			panic(err)
+		}
+		args = append(args, "-c", fmt.Sprintf("activityConfiguration=%s", string(cfg)))
+	}
+
 	if c.Deployment.Parameters.NotificationsConfiguration != nil {
 		cfg, err := json.Marshal(c.Deployment.Parameters.NotificationsConfiguration)
 		if err != nil {
@@ -98,6 +106,27 @@ func (c Config) CDKContextArgs() []string {
 	if c.Deployment.Parameters.AutoApprovalLambdaARN != "" {
 		args = append(args, "-c", fmt.Sprintf("autoApprovalLambdaARN=%s", string(c.Deployment.Parameters.AutoApprovalLambdaARN)))
 	}
+	if c.Deployment.Parameters.AutoApprovalPolicy != "" {
+		args = append(args, "-c", fmt.Sprintf("autoApprovalPolicy=%s", string(c.Deployment.Parameters.AutoApprovalPolicy)))
+	}
+	if c.Deployment.Parameters.TicketValidatorURL != "" {
+		args = append(args, "-c", fmt.Sprintf("ticketValidatorUrl=%s", c.Deployment.Parameters.TicketValidatorURL))
+	}
+	if c.Deployment.Parameters.ReconcilerAutoRevoke != "" {
+		args = append(args, "-c", fmt.Sprintf("reconcilerAutoRevoke=%s", c.Deployment.Parameters.ReconcilerAutoRevoke))
+	}
+	if c.Deployment.Parameters.EventSNSTopicARN != "" {
+		args = append(args, "-c", fmt.Sprintf("eventSnsTopicArn=%s", c.Deployment.Parameters.EventSNSTopicARN))
+	}
+	if c.Deployment.Parameters.EventSQSQueueURL != "" {
+		args = append(args, "-c", fmt.Sprintf("eventSqsQueueUrl=%s", c.Deployment.Parameters.EventSQSQueueURL))
+	}
+	if c.Deployment.Parameters.EventKafkaBrokers != "" {
+		args = append(args, "-c", fmt.Sprintf("eventKafkaBrokers=%s", c.Deployment.Parameters.EventKafkaBrokers))
+	}
+	if c.Deployment.Parameters.EventKafkaTopic != "" {
+		args = append(args, "-c", fmt.Sprintf("eventKafkaTopic=%s", c.Deployment.Parameters.EventKafkaTopic))
+	}
 
 	// CDK deploys always use the dev analytics endpoint and debug mode
 	args = append(args, "-c", "analyticsUrl=https://t-dev.commonfate.io")
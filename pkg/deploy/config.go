@@ -139,6 +139,14 @@ type Provider struct {
 type Notifications struct {
 	Slack                 map[string]string `yaml:"slack,omitempty" json:"slack,omitempty"`
 	SlackIncomingWebhooks FeatureMap        `yaml:"slackIncomingWebhooks,omitempty" json:"slackIncomingWebhooks,omitempty"`
+	Teams                 FeatureMap        `yaml:"teams,omitempty" json:"teams,omitempty"`
+	Webhooks              FeatureMap        `yaml:"webhooks,omitempty" json:"webhooks,omitempty"`
+	// Email holds the SMTP settings used to send email notifications such as digests.
+	Email map[string]string `yaml:"email,omitempty" json:"email,omitempty"`
+	// Digest holds the settings for scheduled digest notifications.
+	Digest map[string]string `yaml:"digest,omitempty" json:"digest,omitempty"`
+	// Reminders holds the settings for reminding requestors before their grant expires.
+	Reminders map[string]string `yaml:"reminders,omitempty" json:"reminders,omitempty"`
 }
 
 // Feature map represents the type used for features like identity and notifications
@@ -190,6 +198,14 @@ type Parameters struct {
 	IDPSyncSchedule                 string         `yaml:"IDPSyncSchedule,omitempty"`
 	IDPSyncMemory                   string         `yaml:"IDPSyncMemory,omitempty"`
 	AutoApprovalLambdaARN           string         `yaml:"AutoApprovalLambdaARN,omitempty"`
+	AutoApprovalPolicy              string         `yaml:"AutoApprovalPolicy,omitempty"`
+	TicketValidatorURL              string         `yaml:"TicketValidatorURL,omitempty"`
+	ReconcilerAutoRevoke            string         `yaml:"ReconcilerAutoRevoke,omitempty"`
+	EventSNSTopicARN                string         `yaml:"EventSNSTopicARN,omitempty"`
+	EventSQSQueueURL                string         `yaml:"EventSQSQueueURL,omitempty"`
+	EventKafkaBrokers               string         `yaml:"EventKafkaBrokers,omitempty"`
+	EventKafkaTopic                 string         `yaml:"EventKafkaTopic,omitempty"`
+	ActivityConfiguration           FeatureMap     `yaml:"ActivityConfiguration,omitempty"`
 }
 
 // UnmarshalFeatureMap parses the JSON configuration data and returns
@@ -428,6 +444,17 @@ func (c *Config) CfnParams() ([]types.Parameter, error) {
 			ParameterValue: &configStr,
 		})
 	}
+	if c.Deployment.Parameters.ActivityConfiguration != nil {
+		config, err := json.Marshal(c.Deployment.Parameters.ActivityConfiguration)
+		if err != nil {
+			return nil, err
+		}
+		configStr := string(config)
+		res = append(res, types.Parameter{
+			ParameterKey:   aws.String("ActivityConfiguration"),
+			ParameterValue: &configStr,
+		})
+	}
 	if p.AdministratorGroupID != "" {
 		res = append(res, types.Parameter{
 			ParameterKey:   aws.String("AdministratorGroupID"),
@@ -544,6 +571,54 @@ func (c *Config) CfnParams() ([]types.Parameter, error) {
 			ParameterValue: &p.IDPSyncTimeoutSeconds,
 		})
 	}
+	if c.Deployment.Parameters.AutoApprovalLambdaARN != "" {
+		res = append(res, types.Parameter{
+			ParameterKey:   aws.String("AutoApprovalLambdaARN"),
+			ParameterValue: &p.AutoApprovalLambdaARN,
+		})
+	}
+	if c.Deployment.Parameters.AutoApprovalPolicy != "" {
+		res = append(res, types.Parameter{
+			ParameterKey:   aws.String("AutoApprovalPolicy"),
+			ParameterValue: &p.AutoApprovalPolicy,
+		})
+	}
+	if c.Deployment.Parameters.TicketValidatorURL != "" {
+		res = append(res, types.Parameter{
+			ParameterKey:   aws.String("TicketValidatorURL"),
+			ParameterValue: &p.TicketValidatorURL,
+		})
+	}
+	if c.Deployment.Parameters.ReconcilerAutoRevoke != "" {
+		res = append(res, types.Parameter{
+			ParameterKey:   aws.String("ReconcilerAutoRevoke"),
+			ParameterValue: &p.ReconcilerAutoRevoke,
+		})
+	}
+	if c.Deployment.Parameters.EventSNSTopicARN != "" {
+		res = append(res, types.Parameter{
+			ParameterKey:   aws.String("EventSNSTopicARN"),
+			ParameterValue: &p.EventSNSTopicARN,
+		})
+	}
+	if c.Deployment.Parameters.EventSQSQueueURL != "" {
+		res = append(res, types.Parameter{
+			ParameterKey:   aws.String("EventSQSQueueURL"),
+			ParameterValue: &p.EventSQSQueueURL,
+		})
+	}
+	if c.Deployment.Parameters.EventKafkaBrokers != "" {
+		res = append(res, types.Parameter{
+			ParameterKey:   aws.String("EventKafkaBrokers"),
+			ParameterValue: &p.EventKafkaBrokers,
+		})
+	}
+	if c.Deployment.Parameters.EventKafkaTopic != "" {
+		res = append(res, types.Parameter{
+			ParameterKey:   aws.String("EventKafkaTopic"),
+			ParameterValue: &p.EventKafkaTopic,
+		})
+	}
 
 	return res, nil
 }
@@ -54,6 +54,8 @@ type Output struct {
 	HealthcheckFunctionName       string `json:"HealthcheckFunctionName"`
 	HealthcheckLogGroupName       string `json:"HealthcheckLogGroupName"`
 	GranterV2StateMachineArn      string `json:"GranterV2StateMachineArn"`
+	AuditLogBucketName            string `json:"AuditLogBucketName"`
+	AccessHandlerApiURL           string `json:"AccessHandlerApiURL"`
 }
 
 func (c Output) FrontendURL() string {
@@ -72,6 +72,8 @@ func TestOutputStructMatchesTSType(t *testing.T) {
 		HealthcheckFunctionName:       "abcdefg",
 		HealthcheckLogGroupName:       "abcdefg",
 		GranterV2StateMachineArn:      "abcdefg",
+		AuditLogBucketName:            "abcdefg",
+		AccessHandlerApiURL:           "abcdefg",
 	}
 	b, err := json.Marshal(output)
 	if err != nil {
@@ -134,6 +136,8 @@ func TestOutput_Get(t *testing.T) {
 		RestAPIExecutionRoleARN       string
 		IDPSyncExecutionRoleARN       string
 		GranterV2StateMachineArn      string
+		AuditLogBucketName            string
+		AccessHandlerApiURL           string
 	}
 	type args struct {
 		key string
@@ -201,6 +205,8 @@ func TestOutput_Get(t *testing.T) {
 				HealthcheckFunctionName:       tt.fields.HealthcheckFunctionName,
 				HealthcheckLogGroupName:       tt.fields.HealthcheckLogGroupName,
 				GranterV2StateMachineArn:      tt.fields.GranterV2StateMachineArn,
+				AuditLogBucketName:            tt.fields.AuditLogBucketName,
+				AccessHandlerApiURL:           tt.fields.AccessHandlerApiURL,
 			}
 			got, err := o.Get(tt.args.key)
 			if (err != nil) != tt.wantErr {
@@ -32,6 +32,11 @@ func (n *EventHandler) HandleEvent(ctx context.Context, event events.CloudWatchE
 		if err != nil {
 			return err
 		}
+	} else if strings.HasPrefix(event.DetailType, "request.breakglass") {
+		err = n.HandleBreakGlassEvent(ctx, log, event)
+		if err != nil {
+			return err
+		}
 	} else {
 		log.Info("ignoring unhandled event type")
 	}
@@ -58,6 +63,26 @@ func (n *EventHandler) HandleGrantEvent(ctx context.Context, log *zap.SugaredLog
 		log.Infow("Ignored grant revoke event")
 		return nil
 	}
+	// expiry reminders don't change the status of the grant
+	if event.DetailType == gevent.GrantExpiringType {
+		log.Infow("Ignored grant expiring event")
+		return nil
+	}
+	// drift is recorded on the request by the reconciler, so only the audit trail needs updating
+	if event.DetailType == gevent.GrantDriftedType {
+		var drifted gevent.GrantDrifted
+		err := json.Unmarshal(event.Detail, &drifted)
+		if err != nil {
+			return err
+		}
+		requestEvent := access.NewGrantDriftedEvent(gq.Result.ID, event.Time, access.GrantDrift{
+			Kind:        access.DriftKind(drifted.Drift),
+			DetectedAt:  event.Time,
+			AutoRevoked: drifted.AutoRevoked,
+		})
+		log.Infow("inserting request event for grant drifted")
+		return n.db.Put(ctx, &requestEvent)
+	}
 	oldStatus := gq.Result.Grant.Status
 	newStatus := grantEvent.Grant.Status
 	gq.Result.Grant.Status = newStatus
@@ -94,3 +119,30 @@ func (n *EventHandler) HandleGrantEvent(ctx context.Context, log *zap.SugaredLog
 	// Updates the grant status
 	return n.db.PutBatch(ctx, items...)
 }
+
+// HandleBreakGlassEvent records the use of break-glass access and the outcome of its post-incident review in the audit trail of the request
+func (n *EventHandler) HandleBreakGlassEvent(ctx context.Context, log *zap.SugaredLogger, event events.CloudWatchEvent) error {
+	var requestEvent access.RequestEvent
+	switch event.DetailType {
+	case gevent.RequestBreakGlassUsedType:
+		var used gevent.RequestBreakGlassUsed
+		err := json.Unmarshal(event.Detail, &used)
+		if err != nil {
+			return err
+		}
+		requestEvent = access.NewBreakGlassUsedEvent(used.Request.ID, event.Time, &used.Request.RequestedBy, used.Reason)
+		log.Infow("inserting request event for break-glass used")
+	case gevent.RequestBreakGlassReviewedType:
+		var reviewed gevent.RequestBreakGlassReviewed
+		err := json.Unmarshal(event.Detail, &reviewed)
+		if err != nil {
+			return err
+		}
+		requestEvent = access.NewBreakGlassReviewedEvent(reviewed.Request.ID, event.Time, &reviewed.ReviewerID, reviewed.Review.Status, reviewed.Review.Comment)
+		log.Infow("inserting request event for break-glass reviewed")
+	default:
+		log.Info("ignoring unhandled break-glass event type")
+		return nil
+	}
+	return n.db.Put(ctx, &requestEvent)
+}
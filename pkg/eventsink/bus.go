@@ -0,0 +1,60 @@
+package eventsink
+
+import (
+	"context"
+	"sync"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"go.uber.org/zap"
+)
+
+// Handler handles events in the same format that EventBridge delivers them to our Lambda functions,
+// such as the eventhandler.EventHandler and the notifiers.
+type Handler interface {
+	HandleEvent(ctx context.Context, event events.CloudWatchEvent) error
+}
+
+// Bus is an in-process event sink which delivers events to the subscribed handlers.
+// It is used by the local development server, where there is no EventBridge rule to invoke the handlers,
+// and in tests, where Events can be used to inspect what was published.
+type Bus struct {
+	mu       sync.Mutex
+	handlers []Handler
+	events   []gevent.Event
+}
+
+// Subscribe registers a handler to be called for every event published to the bus.
+func (b *Bus) Subscribe(h Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers = append(b.handlers, h)
+}
+
+// Put delivers the events to the subscribed handlers in order.
+// Errors returned by handlers are logged rather than returned, as a handler failing
+// doesn't mean that the event wasn't published.
+func (b *Bus) Put(ctx context.Context, evts []gevent.Event) error {
+	b.mu.Lock()
+	b.events = append(b.events, evts...)
+	handlers := append([]Handler{}, b.handlers...)
+	b.mu.Unlock()
+
+	for _, e := range evts {
+		cwe := e.CloudWatchEvent()
+		for _, h := range handlers {
+			err := h.HandleEvent(ctx, cwe)
+			if err != nil {
+				zap.S().Errorw("failed to handle event", "event.id", e.ID, "event.type", e.Type, zap.Error(err))
+			}
+		}
+	}
+	return nil
+}
+
+// Events returns the events which have been published to the bus.
+func (b *Bus) Events() []gevent.Event {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]gevent.Event{}, b.events...)
+}
@@ -0,0 +1,61 @@
+package eventsink
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	"github.com/common-fate/common-fate/pkg/gevent"
+)
+
+// eventBridgeMaxBatch is the maximum number of entries in a PutEvents call.
+const eventBridgeMaxBatch = 10
+
+type EventBridgeAPI interface {
+	PutEvents(ctx context.Context, params *eventbridge.PutEventsInput, optFns ...func(*eventbridge.Options)) (*eventbridge.PutEventsOutput, error)
+}
+
+// EventBridgeSink publishes events to an EventBridge event bus.
+type EventBridgeSink struct {
+	Client      EventBridgeAPI
+	EventBusARN string
+}
+
+func (s *EventBridgeSink) Put(ctx context.Context, events []gevent.Event) error {
+	var pe PutError
+	for _, batch := range chunk(events, eventBridgeMaxBatch) {
+		entries := make([]types.PutEventsRequestEntry, len(batch))
+		for i, e := range batch {
+			entries[i] = types.PutEventsRequestEntry{
+				EventBusName: aws.String(s.EventBusARN),
+				Detail:       aws.String(string(e.Detail)),
+				DetailType:   aws.String(e.Type),
+				Source:       aws.String(gevent.Source),
+				Time:         aws.Time(e.Time),
+				Resources:    e.Resources(),
+			}
+		}
+		res, err := s.Client.PutEvents(ctx, &eventbridge.PutEventsInput{Entries: entries})
+		if err != nil {
+			pe.Failed = append(pe.Failed, batch...)
+			pe.Err = err
+			continue
+		}
+		if res.FailedEntryCount == 0 {
+			continue
+		}
+		// entries in the response are in the same order as the request
+		for i, entry := range res.Entries {
+			if entry.ErrorCode != nil {
+				pe.Failed = append(pe.Failed, batch[i])
+				pe.Err = fmt.Errorf("failed to send event with code: %s, error: %s", aws.ToString(entry.ErrorCode), aws.ToString(entry.ErrorMessage))
+			}
+		}
+	}
+	if len(pe.Failed) > 0 {
+		return &pe
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package eventsink
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/segmentio/kafka-go"
+)
+
+type KafkaWriter interface {
+	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
+}
+
+// KafkaSink writes events to a Kafka topic.
+// Messages are keyed by the event ID, and the event type is set in the 'type' header.
+type KafkaSink struct {
+	Writer KafkaWriter
+}
+
+// NewKafkaSink returns a KafkaSink which writes to the topic on the given brokers.
+func NewKafkaSink(brokers []string, topic string) *KafkaSink {
+	return &KafkaSink{
+		Writer: &kafka.Writer{
+			Addr:     kafka.TCP(brokers...),
+			Topic:    topic,
+			Balancer: &kafka.Hash{},
+			// retries are handled by the Publisher
+			MaxAttempts:  1,
+			RequiredAcks: kafka.RequireAll,
+		},
+	}
+}
+
+func (s *KafkaSink) Put(ctx context.Context, events []gevent.Event) error {
+	msgs := make([]kafka.Message, len(events))
+	for i, e := range events {
+		body, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		msgs[i] = kafka.Message{
+			Key:     []byte(e.ID),
+			Value:   body,
+			Headers: []kafka.Header{{Key: "type", Value: []byte(e.Type)}},
+		}
+	}
+	err := s.Writer.WriteMessages(ctx, msgs...)
+	if errs, ok := err.(kafka.WriteErrors); ok {
+		pe := PutError{Err: err}
+		for i := range msgs {
+			if errs[i] != nil {
+				pe.Failed = append(pe.Failed, events[i])
+			}
+		}
+		return &pe
+	}
+	return err
+}
@@ -0,0 +1,58 @@
+package eventsink
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/common-fate/common-fate/pkg/cfaws"
+)
+
+// Opts configures the sinks returned by New. Each sink is enabled by setting its options.
+type Opts struct {
+	EventBusARN  string
+	SNSTopicARN  string
+	SQSQueueURL  string
+	KafkaBrokers []string
+	KafkaTopic   string
+	// Bus is an in-process bus to publish events to, used when running locally.
+	Bus *Bus
+}
+
+// New returns a sink which publishes to each of the configured backends.
+func New(ctx context.Context, opts Opts) (Sink, error) {
+	var sinks Multi
+	if opts.Bus != nil {
+		sinks = append(sinks, opts.Bus)
+	}
+	if opts.EventBusARN != "" || opts.SNSTopicARN != "" || opts.SQSQueueURL != "" {
+		cfg, err := cfaws.ConfigFromContextOrDefault(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if opts.EventBusARN != "" {
+			sinks = append(sinks, &EventBridgeSink{Client: eventbridge.NewFromConfig(cfg), EventBusARN: opts.EventBusARN})
+		}
+		if opts.SNSTopicARN != "" {
+			sinks = append(sinks, &SNSSink{Client: sns.NewFromConfig(cfg), TopicARN: opts.SNSTopicARN})
+		}
+		if opts.SQSQueueURL != "" {
+			sinks = append(sinks, &SQSSink{Client: sqs.NewFromConfig(cfg), QueueURL: opts.SQSQueueURL})
+		}
+	}
+	if len(opts.KafkaBrokers) > 0 {
+		if opts.KafkaTopic == "" {
+			return nil, errors.New("a Kafka topic must be provided when Kafka brokers are configured")
+		}
+		sinks = append(sinks, NewKafkaSink(opts.KafkaBrokers, opts.KafkaTopic))
+	}
+	if len(sinks) == 0 {
+		return nil, errors.New("no event sinks are configured")
+	}
+	if len(sinks) == 1 {
+		return sinks[0], nil
+	}
+	return sinks, nil
+}
@@ -0,0 +1,189 @@
+package eventsink
+
+import (
+	"context"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultMaxAttempts    = 4
+	defaultInitialBackoff = 100 * time.Millisecond
+)
+
+// Publisher publishes events to a Sink.
+// Failed puts are retried with exponential backoff, and events which still couldn't be
+// published are written to the outbox in DynamoDB, to be published later by Redeliver.
+//
+// Publisher implements the EventPutter interfaces used by the services.
+type Publisher struct {
+	Sink Sink
+	// DB is used to write events to the outbox. If nil, the error is returned to the caller instead.
+	DB ddb.Storage
+	// MaxAttempts is the number of attempts made to publish each event. Defaults to 4.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry, which doubles after each attempt. Defaults to 100ms.
+	InitialBackoff time.Duration
+	// RedeliverAfter is how old an event in the outbox must be before Redeliver publishes it.
+	// Newer events are left to the outbox relay, so that an event isn't published by both.
+	RedeliverAfter time.Duration
+}
+
+// Put publishes a single event.
+func (p *Publisher) Put(ctx context.Context, e gevent.EventTyper) error {
+	// return early if we don't have an event to send.
+	if e == nil {
+		return nil
+	}
+	return p.PutBatch(ctx, e)
+}
+
+// PutBatch publishes several events, batching the calls to the sink.
+func (p *Publisher) PutBatch(ctx context.Context, detail ...gevent.EventTyper) error {
+	now := time.Now()
+	evts := make([]gevent.Event, len(detail))
+	for i, d := range detail {
+		e, err := gevent.NewEvent(d, now)
+		if err != nil {
+			return err
+		}
+		evts[i] = e
+	}
+	return p.Publish(ctx, evts)
+}
+
+// Publish publishes events which have already been serialized.
+func (p *Publisher) Publish(ctx context.Context, evts []gevent.Event) error {
+	if len(evts) == 0 {
+		return nil
+	}
+	attempts, failedEvents, err := p.publish(ctx, evts)
+	if err == nil {
+		return nil
+	}
+	if p.DB == nil {
+		return err
+	}
+	zap.S().Errorw("failed to publish events, writing them to the outbox", "count", len(failedEvents), "attempts", attempts, zap.Error(err))
+	items := make([]ddb.Keyer, len(failedEvents))
+	for i, e := range failedEvents {
+		items[i] = &gevent.OutboxEvent{
+			Event:     e,
+			Attempts:  attempts,
+			LastError: err.Error(),
+			CreatedAt: time.Now(),
+		}
+	}
+	return p.DB.PutBatch(ctx, items...)
+}
+
+// publish puts the events to the sink, retrying any which fail with exponential backoff.
+// It returns the number of attempts made, and the events which couldn't be published.
+func (p *Publisher) publish(ctx context.Context, evts []gevent.Event) (attempts int, failedEvents []gevent.Event, err error) {
+	maxAttempts := p.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultMaxAttempts
+	}
+	backoff := p.InitialBackoff
+	if backoff <= 0 {
+		backoff = defaultInitialBackoff
+	}
+
+	for attempts = 1; ; attempts++ {
+		err = p.Sink.Put(ctx, evts)
+		if err == nil {
+			return attempts, nil, nil
+		}
+		// only retry the events which failed
+		evts = failed(evts, err)
+		if attempts >= maxAttempts {
+			return attempts, evts, err
+		}
+		zap.S().Infow("failed to publish events, retrying", "count", len(evts), "attempt", attempts, "backoff", backoff, zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return attempts, evts, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// Redeliver publishes the events in the outbox which are older than RedeliverAfter, removing them from the outbox once they have been published.
+// Events which still can't be published remain in the outbox with their attempt count updated.
+func (p *Publisher) Redeliver(ctx context.Context) error {
+	hasMore := true
+	var next string
+	for hasMore {
+		q := storage.ListOutboxEvents{}
+		var opts []func(*ddb.QueryOpts)
+		if next != "" {
+			opts = append(opts, ddb.Page(next))
+		}
+		res, err := p.DB.Query(ctx, &q, opts...)
+		if err == ddb.ErrNoItems {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		next = res.NextPage
+		hasMore = next != ""
+
+		var due []gevent.OutboxEvent
+		cutoff := time.Now().Add(-p.RedeliverAfter)
+		for _, o := range q.Result {
+			if !o.CreatedAt.After(cutoff) {
+				due = append(due, o)
+			}
+		}
+		err = p.redeliver(ctx, due)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *Publisher) redeliver(ctx context.Context, outboxed []gevent.OutboxEvent) error {
+	if len(outboxed) == 0 {
+		return nil
+	}
+	evts := make([]gevent.Event, len(outboxed))
+	for i, o := range outboxed {
+		evts[i] = o.Event
+	}
+	attempts, failedEvents, err := p.publish(ctx, evts)
+	stillFailed := map[string]bool{}
+	for _, e := range failedEvents {
+		stillFailed[e.ID] = true
+	}
+
+	var published, updated []ddb.Keyer
+	for i := range outboxed {
+		o := outboxed[i]
+		if !stillFailed[o.Event.ID] {
+			published = append(published, &o)
+			continue
+		}
+		o.Attempts += attempts
+		o.LastError = err.Error()
+		updated = append(updated, &o)
+	}
+	if len(published) > 0 {
+		zap.S().Infow("redelivered outbox events", "count", len(published))
+		derr := p.DB.DeleteBatch(ctx, published...)
+		if derr != nil {
+			return derr
+		}
+	}
+	if len(updated) > 0 {
+		zap.S().Errorw("failed to redeliver outbox events", "count", len(updated), zap.Error(err))
+		return p.DB.PutBatch(ctx, updated...)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package eventsink
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/stretchr/testify/assert"
+)
+
+type testEvent struct {
+	Data string `json:"data"`
+}
+
+func (testEvent) EventType() string {
+	return "event.test"
+}
+
+// flakySink fails to publish the events in failFor until it has been called the given number of times.
+type flakySink struct {
+	failFor  map[string]bool
+	failures int
+	calls    [][]gevent.Event
+}
+
+func (s *flakySink) Put(ctx context.Context, events []gevent.Event) error {
+	s.calls = append(s.calls, events)
+	if len(s.calls) > s.failures {
+		return nil
+	}
+	pe := PutError{Err: errors.New("throttled")}
+	for _, e := range events {
+		if s.failFor == nil || s.failFor[string(e.Detail)] {
+			pe.Failed = append(pe.Failed, e)
+		}
+	}
+	return &pe
+}
+
+// recordingDB records the items written to and deleted from the outbox.
+type recordingDB struct {
+	ddb.Storage
+	put     []ddb.Keyer
+	deleted []ddb.Keyer
+}
+
+func (r *recordingDB) PutBatch(ctx context.Context, items ...ddb.Keyer) error {
+	r.put = append(r.put, items...)
+	return nil
+}
+
+func (r *recordingDB) DeleteBatch(ctx context.Context, items ...ddb.Keyer) error {
+	r.deleted = append(r.deleted, items...)
+	return nil
+}
+
+func TestPublisherRetriesFailedEvents(t *testing.T) {
+	sink := &flakySink{failures: 2, failFor: map[string]bool{`{"data":"b"}`: true}}
+	db := &recordingDB{Storage: ddbmock.New(t)}
+	p := Publisher{Sink: sink, DB: db, InitialBackoff: 1}
+
+	err := p.PutBatch(context.Background(), testEvent{Data: "a"}, testEvent{Data: "b"})
+	assert.NoError(t, err)
+	assert.Len(t, sink.calls, 3)
+	assert.Len(t, sink.calls[0], 2)
+	// only the failed event is retried
+	assert.Len(t, sink.calls[1], 1)
+	assert.Equal(t, `{"data":"b"}`, string(sink.calls[1][0].Detail))
+	assert.Empty(t, db.put)
+}
+
+func TestPublisherWritesToOutbox(t *testing.T) {
+	sink := &flakySink{failures: 10}
+	db := &recordingDB{Storage: ddbmock.New(t)}
+	p := Publisher{Sink: sink, DB: db, MaxAttempts: 2, InitialBackoff: 1}
+
+	err := p.Put(context.Background(), testEvent{Data: "a"})
+	assert.NoError(t, err)
+	assert.Len(t, sink.calls, 2)
+	if assert.Len(t, db.put, 1) {
+		o := db.put[0].(*gevent.OutboxEvent)
+		assert.Equal(t, "event.test", o.Event.Type)
+		assert.Equal(t, 2, o.Attempts)
+		assert.Equal(t, "failed to publish 1 events: throttled", o.LastError)
+	}
+}
+
+func TestPublisherWithoutOutboxReturnsError(t *testing.T) {
+	p := Publisher{Sink: &flakySink{failures: 10}, MaxAttempts: 1}
+	err := p.Put(context.Background(), testEvent{Data: "a"})
+	assert.EqualError(t, err, "failed to publish 1 events: throttled")
+}
+
+func TestRedeliver(t *testing.T) {
+	a := gevent.OutboxEvent{Event: gevent.Event{ID: "a", Type: "event.test", Detail: []byte(`{"data":"a"}`)}, Attempts: 4}
+	b := gevent.OutboxEvent{Event: gevent.Event{ID: "b", Type: "event.test", Detail: []byte(`{"data":"b"}`)}, Attempts: 4}
+
+	sink := &flakySink{failures: 10, failFor: map[string]bool{`{"data":"b"}`: true}}
+	mock := ddbmock.New(t)
+	mock.MockQuery(&storage.ListOutboxEvents{Result: []gevent.OutboxEvent{a, b}})
+	db := &recordingDB{Storage: mock}
+	p := Publisher{Sink: sink, DB: db, MaxAttempts: 1}
+
+	err := p.Redeliver(context.Background())
+	assert.NoError(t, err)
+	if assert.Len(t, db.deleted, 1) {
+		assert.Equal(t, "a", db.deleted[0].(*gevent.OutboxEvent).Event.ID)
+	}
+	if assert.Len(t, db.put, 1) {
+		o := db.put[0].(*gevent.OutboxEvent)
+		assert.Equal(t, "b", o.Event.ID)
+		assert.Equal(t, 5, o.Attempts)
+	}
+}
@@ -0,0 +1,77 @@
+package eventsink
+
+import (
+	"context"
+
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/ddb"
+	"go.uber.org/zap"
+)
+
+// Relay publishes events which were written to the outbox, such as by dbupdate.PutItems,
+// and removes them from the outbox once they have been published.
+// It is called with the keys of new outbox items from the DynamoDB stream of the table.
+//
+// Events which have already been removed from the outbox are skipped, so that a
+// retried stream batch doesn't publish them again. The keys of the events which
+// couldn't be published are returned so that they can be retried.
+//
+// Delivery is at least once: events are removed from the outbox after they are published,
+// so an event which can't be removed is published again by Redeliver.
+// The event ID, which is part of the outbox item's key, is the same for each delivery and
+// should be used by consumers to deduplicate events. FIFO SNS topics and SQS queues use it as
+// the deduplication ID, so they drop a duplicate which is delivered within their deduplication window.
+func (p *Publisher) Relay(ctx context.Context, outboxKeys []ddb.GetKey) []ddb.GetKey {
+	var failedKeys, found []ddb.GetKey
+	var outboxed []gevent.OutboxEvent
+	var evts []gevent.Event
+	for _, k := range outboxKeys {
+		if k.PK != keys.EventOutbox.PK1 {
+			continue
+		}
+		var o gevent.OutboxEvent
+		_, err := p.DB.Get(ctx, k, &o)
+		if err == ddb.ErrNoItems {
+			zap.S().Infow("outbox event has already been published", "outbox.sk", k.SK)
+			continue
+		}
+		if err != nil {
+			zap.S().Errorw("failed to get outbox event", "outbox.sk", k.SK, zap.Error(err))
+			failedKeys = append(failedKeys, k)
+			continue
+		}
+		found = append(found, k)
+		outboxed = append(outboxed, o)
+		evts = append(evts, o.Event)
+	}
+	if len(evts) == 0 {
+		return failedKeys
+	}
+
+	_, failedEvents, err := p.publish(ctx, evts)
+	if err != nil {
+		zap.S().Errorw("failed to relay outbox events", "count", len(failedEvents), zap.Error(err))
+	}
+	stillFailed := map[string]bool{}
+	for _, e := range failedEvents {
+		stillFailed[e.ID] = true
+	}
+	var published []ddb.Keyer
+	for i := range outboxed {
+		if stillFailed[outboxed[i].Event.ID] {
+			failedKeys = append(failedKeys, found[i])
+			continue
+		}
+		published = append(published, &outboxed[i])
+	}
+	if len(published) > 0 {
+		// the events have been published, so they aren't reported as failed if they can't be removed from the outbox.
+		// Retrying the batch would publish them again.
+		derr := p.DB.DeleteBatch(ctx, published...)
+		if derr != nil {
+			zap.S().Errorw("failed to remove published events from the outbox", "count", len(published), zap.Error(derr))
+		}
+	}
+	return failedKeys
+}
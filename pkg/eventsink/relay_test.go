@@ -0,0 +1,56 @@
+package eventsink
+
+import (
+	"context"
+	"testing"
+
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRelay(t *testing.T) {
+	a := gevent.OutboxEvent{Event: gevent.Event{ID: "a", Type: "event.test", Detail: []byte(`{"data":"a"}`)}}
+	b := gevent.OutboxEvent{Event: gevent.Event{ID: "b", Type: "event.test", Detail: []byte(`{"data":"b"}`)}}
+	keyA := ddb.GetKey{PK: keys.EventOutbox.PK1, SK: "a"}
+	keyB := ddb.GetKey{PK: keys.EventOutbox.PK1, SK: "b"}
+	published := ddb.GetKey{PK: keys.EventOutbox.PK1, SK: "published"}
+
+	db := &outboxGetDB{
+		recordingDB: recordingDB{Storage: ddbmock.New(t)},
+		items:       map[ddb.GetKey]gevent.OutboxEvent{keyA: a, keyB: b},
+	}
+
+	sink := &flakySink{failures: 10, failFor: map[string]bool{`{"data":"b"}`: true}}
+	p := Publisher{Sink: sink, DB: db, MaxAttempts: 1}
+
+	// items outside of the outbox are ignored
+	other := ddb.GetKey{PK: "ACCESS_REQUEST#", SK: "req_1"}
+	failedKeys := p.Relay(context.Background(), []ddb.GetKey{keyA, keyB, published, other})
+
+	assert.Len(t, sink.calls, 1)
+	assert.Len(t, sink.calls[0], 2)
+	if assert.Len(t, db.deleted, 1) {
+		assert.Equal(t, "a", db.deleted[0].(*gevent.OutboxEvent).Event.ID)
+	}
+	assert.Equal(t, []ddb.GetKey{keyB}, failedKeys)
+	// the failed event remains in the outbox to be retried
+	assert.Empty(t, db.put)
+}
+
+// outboxGetDB returns the outbox events in items, or ddb.ErrNoItems if they have been published.
+type outboxGetDB struct {
+	recordingDB
+	items map[ddb.GetKey]gevent.OutboxEvent
+}
+
+func (o *outboxGetDB) Get(ctx context.Context, key ddb.GetKey, item ddb.Keyer, opts ...func(*ddb.GetOpts)) (*ddb.GetItemResult, error) {
+	e, ok := o.items[key]
+	if !ok {
+		return nil, ddb.ErrNoItems
+	}
+	*item.(*gevent.OutboxEvent) = e
+	return &ddb.GetItemResult{}, nil
+}
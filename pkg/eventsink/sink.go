@@ -0,0 +1,92 @@
+// Package eventsink publishes Common Fate events to one or more backends,
+// such as EventBridge, SNS, SQS, Kafka, or an in-process bus.
+package eventsink
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/common-fate/common-fate/pkg/gevent"
+)
+
+// Sink is a backend which events are published to.
+type Sink interface {
+	// Put publishes a batch of events. If only some of the events are published,
+	// a *PutError is returned containing the events which failed.
+	Put(ctx context.Context, events []gevent.Event) error
+}
+
+// PutError is returned by a Sink when some of the events in a batch couldn't be published.
+type PutError struct {
+	Failed []gevent.Event
+	Err    error
+}
+
+func (e *PutError) Error() string {
+	return fmt.Sprintf("failed to publish %d events: %s", len(e.Failed), e.Err)
+}
+
+func (e *PutError) Unwrap() error {
+	return e.Err
+}
+
+// failed returns the events which should be retried after a call to Put returned err.
+// If the error doesn't describe which events failed, the whole batch is retried.
+func failed(events []gevent.Event, err error) []gevent.Event {
+	if pe, ok := err.(*PutError); ok {
+		return pe.Failed
+	}
+	return events
+}
+
+// fifoMessageGroupID is the message group which events are published to on FIFO SNS topics and SQS queues.
+// Every event is published to the same group so that they are delivered in order.
+const fifoMessageGroupID = "commonfate"
+
+// isFIFO returns true if the SNS topic ARN or SQS queue URL is for a FIFO topic or queue,
+// which require a message group ID and deduplicate messages by their deduplication ID.
+func isFIFO(arnOrURL string) bool {
+	return strings.HasSuffix(arnOrURL, ".fifo")
+}
+
+// chunk splits the events into batches of at most size events.
+func chunk(events []gevent.Event, size int) [][]gevent.Event {
+	var chunks [][]gevent.Event
+	for size < len(events) {
+		events, chunks = events[size:], append(chunks, events[:size])
+	}
+	if len(events) > 0 {
+		chunks = append(chunks, events)
+	}
+	return chunks
+}
+
+// Multi publishes events to each of the sinks.
+// An event is considered to have failed if it couldn't be published to any one of the sinks,
+// so consumers should deduplicate events by ID.
+type Multi []Sink
+
+func (m Multi) Put(ctx context.Context, events []gevent.Event) error {
+	failedIDs := map[string]bool{}
+	var errs []string
+	for _, s := range m {
+		err := s.Put(ctx, events)
+		if err != nil {
+			errs = append(errs, err.Error())
+			for _, e := range failed(events, err) {
+				failedIDs[e.ID] = true
+			}
+		}
+	}
+	if len(failedIDs) == 0 {
+		return nil
+	}
+	pe := PutError{Err: fmt.Errorf("%s", strings.Join(errs, "; "))}
+	for _, e := range events {
+		if failedIDs[e.ID] {
+			pe.Failed = append(pe.Failed, e)
+		}
+	}
+	return &pe
+}
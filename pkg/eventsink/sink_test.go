@@ -0,0 +1,140 @@
+package eventsink
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/stretchr/testify/assert"
+)
+
+func testEvents(n int) []gevent.Event {
+	evts := make([]gevent.Event, n)
+	for i := range evts {
+		evts[i] = gevent.Event{ID: fmt.Sprintf("evt_%d", i), Type: "event.test", Detail: []byte(fmt.Sprintf(`{"n":%d}`, i))}
+	}
+	return evts
+}
+
+type mockEventBridge struct {
+	calls [][]types.PutEventsRequestEntry
+	// failIndex fails the entry at this index in each call.
+	failIndex int
+}
+
+func (m *mockEventBridge) PutEvents(ctx context.Context, params *eventbridge.PutEventsInput, optFns ...func(*eventbridge.Options)) (*eventbridge.PutEventsOutput, error) {
+	m.calls = append(m.calls, params.Entries)
+	out := eventbridge.PutEventsOutput{Entries: make([]types.PutEventsResultEntry, len(params.Entries))}
+	if m.failIndex < len(params.Entries) {
+		out.FailedEntryCount = 1
+		out.Entries[m.failIndex] = types.PutEventsResultEntry{ErrorCode: aws.String("ThrottlingException"), ErrorMessage: aws.String("rate exceeded")}
+	}
+	return &out, nil
+}
+
+func TestEventBridgeSink(t *testing.T) {
+	client := &mockEventBridge{failIndex: 3}
+	s := EventBridgeSink{Client: client, EventBusARN: "bus"}
+
+	err := s.Put(context.Background(), testEvents(23))
+	var pe *PutError
+	if !errors.As(err, &pe) {
+		t.Fatalf("expected a PutError, got %v", err)
+	}
+	// events are sent in batches of 10
+	assert.Len(t, client.calls, 3)
+	assert.Len(t, client.calls[2], 3)
+	assert.Equal(t, []string{"evt_3", "evt_13"}, []string{pe.Failed[0].ID, pe.Failed[1].ID})
+	assert.Len(t, pe.Failed, 2)
+	assert.EqualError(t, pe.Err, "failed to send event with code: ThrottlingException, error: rate exceeded")
+}
+
+type mockSQS struct {
+	calls [][]sqstypes.SendMessageBatchRequestEntry
+}
+
+func (m *mockSQS) SendMessageBatch(ctx context.Context, params *sqs.SendMessageBatchInput, optFns ...func(*sqs.Options)) (*sqs.SendMessageBatchOutput, error) {
+	m.calls = append(m.calls, params.Entries)
+	return &sqs.SendMessageBatchOutput{}, nil
+}
+
+func TestSQSSinkFIFO(t *testing.T) {
+	type testcase struct {
+		name      string
+		queueURL  string
+		wantDedup *string
+		wantGroup *string
+	}
+	testcases := []testcase{
+		{
+			name:     "standard queue",
+			queueURL: "https://sqs.us-east-1.amazonaws.com/123456789012/events",
+		},
+		{
+			name:      "fifo queue uses the event ID for deduplication",
+			queueURL:  "https://sqs.us-east-1.amazonaws.com/123456789012/events.fifo",
+			wantDedup: aws.String("evt_0"),
+			wantGroup: aws.String(fifoMessageGroupID),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &mockSQS{}
+			s := SQSSink{Client: client, QueueURL: tc.queueURL}
+			err := s.Put(context.Background(), testEvents(1))
+			if err != nil {
+				t.Fatal(err)
+			}
+			entry := client.calls[0][0]
+			assert.Equal(t, tc.wantDedup, entry.MessageDeduplicationId)
+			assert.Equal(t, tc.wantGroup, entry.MessageGroupId)
+		})
+	}
+}
+
+type recordingHandler struct {
+	events []events.CloudWatchEvent
+}
+
+func (h *recordingHandler) HandleEvent(ctx context.Context, event events.CloudWatchEvent) error {
+	h.events = append(h.events, event)
+	return errors.New("handler errors are not returned to the publisher")
+}
+
+func TestBus(t *testing.T) {
+	var b Bus
+	h := &recordingHandler{}
+	b.Subscribe(h)
+
+	err := b.Put(context.Background(), testEvents(2))
+	assert.NoError(t, err)
+	assert.Len(t, b.Events(), 2)
+	if assert.Len(t, h.events, 2) {
+		assert.Equal(t, "evt_1", h.events[1].ID)
+		assert.Equal(t, "event.test", h.events[1].DetailType)
+		assert.Equal(t, gevent.Source, h.events[1].Source)
+	}
+}
+
+func TestMulti(t *testing.T) {
+	ok := &Bus{}
+	failing := &flakySink{failures: 1, failFor: map[string]bool{}}
+	m := Multi{ok, failing}
+
+	evts := testEvents(2)
+	failing.failFor[string(evts[0].Detail)] = true
+	err := m.Put(context.Background(), evts)
+	var pe *PutError
+	if assert.ErrorAs(t, err, &pe) {
+		assert.Equal(t, []gevent.Event{evts[0]}, pe.Failed)
+	}
+	assert.Len(t, ok.Events(), 2)
+}
@@ -0,0 +1,72 @@
+package eventsink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/common-fate/common-fate/pkg/gevent"
+)
+
+// snsMaxBatch is the maximum number of entries in a PublishBatch call.
+const snsMaxBatch = 10
+
+type SNSAPI interface {
+	PublishBatch(ctx context.Context, params *sns.PublishBatchInput, optFns ...func(*sns.Options)) (*sns.PublishBatchOutput, error)
+}
+
+// SNSSink publishes events to an SNS topic.
+// The message body is the JSON encoded event, and the event type is
+// set as the 'type' message attribute so that subscriptions can filter on it.
+// If the topic is a FIFO topic, the event ID is used as the deduplication ID.
+type SNSSink struct {
+	Client   SNSAPI
+	TopicARN string
+}
+
+func (s *SNSSink) Put(ctx context.Context, events []gevent.Event) error {
+	var pe PutError
+	fifo := isFIFO(s.TopicARN)
+	for _, batch := range chunk(events, snsMaxBatch) {
+		byID := map[string]gevent.Event{}
+		entries := make([]types.PublishBatchRequestEntry, len(batch))
+		for i, e := range batch {
+			body, err := json.Marshal(e)
+			if err != nil {
+				return err
+			}
+			byID[e.ID] = e
+			entries[i] = types.PublishBatchRequestEntry{
+				Id:      aws.String(e.ID),
+				Message: aws.String(string(body)),
+				MessageAttributes: map[string]types.MessageAttributeValue{
+					"type": {DataType: aws.String("String"), StringValue: aws.String(e.Type)},
+				},
+			}
+			if fifo {
+				entries[i].MessageDeduplicationId = aws.String(e.ID)
+				entries[i].MessageGroupId = aws.String(fifoMessageGroupID)
+			}
+		}
+		res, err := s.Client.PublishBatch(ctx, &sns.PublishBatchInput{
+			TopicArn:                   aws.String(s.TopicARN),
+			PublishBatchRequestEntries: entries,
+		})
+		if err != nil {
+			pe.Failed = append(pe.Failed, batch...)
+			pe.Err = err
+			continue
+		}
+		for _, f := range res.Failed {
+			pe.Failed = append(pe.Failed, byID[aws.ToString(f.Id)])
+			pe.Err = fmt.Errorf("failed to publish event with code: %s, error: %s", aws.ToString(f.Code), aws.ToString(f.Message))
+		}
+	}
+	if len(pe.Failed) > 0 {
+		return &pe
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package eventsink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/common-fate/common-fate/pkg/gevent"
+)
+
+// sqsMaxBatch is the maximum number of entries in a SendMessageBatch call.
+const sqsMaxBatch = 10
+
+type SQSAPI interface {
+	SendMessageBatch(ctx context.Context, params *sqs.SendMessageBatchInput, optFns ...func(*sqs.Options)) (*sqs.SendMessageBatchOutput, error)
+}
+
+// SQSSink sends events to an SQS queue.
+// The message body is the JSON encoded event, and the event type is
+// set as the 'type' message attribute.
+// If the queue is a FIFO queue, the event ID is used as the deduplication ID.
+type SQSSink struct {
+	Client   SQSAPI
+	QueueURL string
+}
+
+func (s *SQSSink) Put(ctx context.Context, events []gevent.Event) error {
+	var pe PutError
+	fifo := isFIFO(s.QueueURL)
+	for _, batch := range chunk(events, sqsMaxBatch) {
+		byID := map[string]gevent.Event{}
+		entries := make([]types.SendMessageBatchRequestEntry, len(batch))
+		for i, e := range batch {
+			body, err := json.Marshal(e)
+			if err != nil {
+				return err
+			}
+			byID[e.ID] = e
+			entries[i] = types.SendMessageBatchRequestEntry{
+				Id:          aws.String(e.ID),
+				MessageBody: aws.String(string(body)),
+				MessageAttributes: map[string]types.MessageAttributeValue{
+					"type": {DataType: aws.String("String"), StringValue: aws.String(e.Type)},
+				},
+			}
+			if fifo {
+				entries[i].MessageDeduplicationId = aws.String(e.ID)
+				entries[i].MessageGroupId = aws.String(fifoMessageGroupID)
+			}
+		}
+		res, err := s.Client.SendMessageBatch(ctx, &sqs.SendMessageBatchInput{
+			QueueUrl: aws.String(s.QueueURL),
+			Entries:  entries,
+		})
+		if err != nil {
+			pe.Failed = append(pe.Failed, batch...)
+			pe.Err = err
+			continue
+		}
+		for _, f := range res.Failed {
+			pe.Failed = append(pe.Failed, byID[aws.ToString(f.Id)])
+			pe.Err = fmt.Errorf("failed to send event with code: %s, error: %s", aws.ToString(f.Code), aws.ToString(f.Message))
+		}
+	}
+	if len(pe.Failed) > 0 {
+		return &pe
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package gevent
+
+import "github.com/common-fate/common-fate/pkg/configchange"
+
+const (
+	AdminAPICalledType = "admin.api.called"
+
+	AccessRuleCreatedType          = "accessrule.created"
+	AccessRuleUpdatedType          = "accessrule.updated"
+	AccessRuleArchivedType         = "accessrule.archived"
+	TargetGroupCreatedType         = "targetgroup.created"
+	TargetGroupDeletedType         = "targetgroup.deleted"
+	TargetGroupLinkCreatedType     = "targetgrouplink.created"
+	TargetGroupLinkDeletedType     = "targetgrouplink.deleted"
+	HandlerCreatedType             = "handler.created"
+	HandlerDeletedType             = "handler.deleted"
+	ProviderSetupCreatedType       = "providersetup.created"
+	ProviderSetupUpdatedType       = "providersetup.updated"
+	ProviderSetupDeletedType       = "providersetup.deleted"
+	ProviderSetupCompletedType     = "providersetup.completed"
+	GroupCreatedType               = "group.created"
+	GroupUpdatedType               = "group.updated"
+	GroupArchivedType              = "group.archived"
+	UserGroupMembershipUpdatedType = "user.updated"
+)
+
+// AdminAPICalled is emitted when a user calls an administrative
+// API endpoint which can make changes, such as editing an access rule.
+// It is emitted for calls which were denied as well as calls which succeeded.
+type AdminAPICalled struct {
+	ActorID    string `json:"actorId"`
+	ActorEmail string `json:"actorEmail"`
+	Method     string `json:"method"`
+	Path       string `json:"path"`
+	// Status is the HTTP status code of the response.
+	Status    int    `json:"status"`
+	RequestID string `json:"requestId"`
+}
+
+func (AdminAPICalled) EventType() string {
+	return AdminAPICalledType
+}
+
+// ConfigChanged is emitted when an administrator changes an access rule, target group, handler,
+// provider setup or internal group, or the internal groups a user is a member of.
+// The event type is made up of the type of resource and the action, such as 'accessrule.updated'.
+type ConfigChanged struct {
+	configchange.Change
+}
+
+func (e ConfigChanged) EventType() string {
+	return string(e.ResourceType) + "." + string(e.Action)
+}
@@ -0,0 +1,77 @@
+package gevent
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/segmentio/ksuid"
+)
+
+// Source is the source of all events emitted by Common Fate.
+const Source = "commonfate.io/granted"
+
+// eventIDPrefix prefixes the event ID in the resources of the events delivered by EventBridge.
+// EventBridge assigns its own ID to each event it receives, so the ID of the event is passed as a resource.
+const eventIDPrefix = "commonfate:event/"
+
+// Event is an event which has been serialized so that it can be
+// published to an event sink or stored in the outbox.
+type Event struct {
+	// ID is a unique ID for the event, which can be used by consumers to deduplicate deliveries.
+	ID     string          `json:"id" dynamodbav:"id"`
+	Type   string          `json:"type" dynamodbav:"type"`
+	Time   time.Time       `json:"time" dynamodbav:"time"`
+	Detail json.RawMessage `json:"detail" dynamodbav:"detail"`
+}
+
+// NewEvent serializes the event and assigns it a new ID.
+func NewEvent(e EventTyper, now time.Time) (Event, error) {
+	d, err := json.Marshal(e)
+	if err != nil {
+		return Event{}, err
+	}
+	return Event{
+		ID:     ksuid.New().String(),
+		Type:   e.EventType(),
+		Time:   now,
+		Detail: d,
+	}, nil
+}
+
+// CloudWatchEvent returns the event in the format which EventBridge delivers to our event handlers,
+// so that events published to an in-process sink can be handled without EventBridge.
+func (e Event) CloudWatchEvent() events.CloudWatchEvent {
+	return events.CloudWatchEvent{
+		ID:         e.ID,
+		DetailType: e.Type,
+		Source:     Source,
+		Time:       e.Time,
+		Resources:  e.Resources(),
+		Detail:     e.Detail,
+	}
+}
+
+// Resources returns the resources to publish to EventBridge with the event, which include the event ID.
+func (e Event) Resources() []string {
+	return []string{eventIDPrefix + e.ID}
+}
+
+// FromCloudWatchEvent returns the event delivered by EventBridge.
+// The ID is read from the event resources if present, so that the event has the same
+// ID regardless of the sink it was delivered through.
+func FromCloudWatchEvent(ce events.CloudWatchEvent) Event {
+	e := Event{
+		ID:     ce.ID,
+		Type:   ce.DetailType,
+		Time:   ce.Time,
+		Detail: ce.Detail,
+	}
+	for _, r := range ce.Resources {
+		if strings.HasPrefix(r, eventIDPrefix) {
+			e.ID = strings.TrimPrefix(r, eventIDPrefix)
+		}
+	}
+	return e
+}
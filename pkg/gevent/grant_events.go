@@ -8,6 +8,8 @@ const (
 	GrantExpiredType   = "grant.expired"
 	GrantRevokedType   = "grant.revoked"
 	GrantFailedType    = "grant.failed"
+	GrantExpiringType  = "grant.expiring"
+	GrantDriftedType   = "grant.drifted"
 )
 
 // GrantCreated is emitted when a new grant is
@@ -82,6 +84,36 @@ func (GrantFailed) EventType() string {
 	return GrantFailedType
 }
 
+// GrantExpiring is emitted ahead of the end of an
+// active grant, according to the expiry reminders
+// configured for the deployment. It is used to remind
+// the requestor that their access is about to end.
+type GrantExpiring struct {
+	Grant types.Grant `json:"grant"`
+}
+
+func (GrantExpiring) EventType() string {
+	return GrantExpiringType
+}
+
+// GrantDrifted is emitted when the reconciler finds
+// that the access in the provider doesn't match the
+// status of the grant. This happens if the assignment
+// is removed directly in the provider while the grant
+// is active, or if access remains after the grant has
+// ended because revoking it failed.
+type GrantDrifted struct {
+	Grant types.Grant `json:"grant"`
+	// Drift is MISSING_ACCESS or ORPHANED_ACCESS.
+	Drift string `json:"drift"`
+	// AutoRevoked is true if the reconciler removed the orphaned access from the provider.
+	AutoRevoked bool `json:"autoRevoked"`
+}
+
+func (GrantDrifted) EventType() string {
+	return GrantDriftedType
+}
+
 // GrantEventPayload is a payload which is common to
 // all Grant events. It is used to conveniently unmarshal
 // the Grant payloads in our event handler code.
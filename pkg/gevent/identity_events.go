@@ -0,0 +1,32 @@
+package gevent
+
+const (
+	IdentitySyncedType = "identity.synced"
+)
+
+// IdentitySynced is emitted when syncing users and groups from the
+// identity provider changes the users or groups in Common Fate.
+type IdentitySynced struct {
+	IdentityProvider string `json:"identityProvider"`
+	UserCount        int    `json:"userCount"`
+	GroupCount       int    `json:"groupCount"`
+	// UsersAdded are the emails of users which were created or reactivated.
+	UsersAdded []string `json:"usersAdded"`
+	// UsersArchived are the emails of users which were removed from the identity provider.
+	UsersArchived []string `json:"usersArchived"`
+	// GroupsAdded are the names of groups which were created or reactivated.
+	GroupsAdded []string `json:"groupsAdded"`
+	// GroupsArchived are the names of groups which were removed from the identity provider.
+	GroupsArchived []string `json:"groupsArchived"`
+	// GroupMembersChanged are the names of groups which had members added or removed.
+	GroupMembersChanged []string `json:"groupMembersChanged"`
+}
+
+func (IdentitySynced) EventType() string {
+	return IdentitySyncedType
+}
+
+// HasChanges returns whether the sync changed any users or groups.
+func (e IdentitySynced) HasChanges() bool {
+	return len(e.UsersAdded) > 0 || len(e.UsersArchived) > 0 || len(e.GroupsAdded) > 0 || len(e.GroupsArchived) > 0 || len(e.GroupMembersChanged) > 0
+}
@@ -0,0 +1,31 @@
+package gevent
+
+import (
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/ddb"
+)
+
+// OutboxEvent is an event which is waiting to be published to the event sinks.
+// Events are written to the outbox in the same transaction as the change they describe, by dbupdate.PutItems,
+// or by eventsink.Publisher when they couldn't be published, so that they aren't lost.
+// They are published by eventsink.Publisher.Relay as they are added to the outbox,
+// and any which the relay couldn't publish are redelivered by eventsink.Publisher.Redeliver.
+//
+// The event keeps its ID while it is in the outbox, so an event which is published more than once
+// has the same ID each time and can be deduplicated by consumers.
+type OutboxEvent struct {
+	Event     Event     `json:"event" dynamodbav:"event"`
+	Attempts  int       `json:"attempts" dynamodbav:"attempts"`
+	LastError string    `json:"lastError" dynamodbav:"lastError"`
+	CreatedAt time.Time `json:"createdAt" dynamodbav:"createdAt"`
+}
+
+func (o *OutboxEvent) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.EventOutbox.PK1,
+		SK: keys.EventOutbox.SK1(o.CreatedAt.Format(time.RFC3339Nano), o.Event.ID),
+	}
+	return keys, nil
+}
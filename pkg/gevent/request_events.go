@@ -7,6 +7,14 @@ const (
 	RequestApprovedType  = "request.approved"
 	RequestCancelledType = "request.cancelled"
 	RequestDeclinedType  = "request.declined"
+	RequestEscalatedType = "request.escalated"
+
+	RequestBreakGlassUsedType     = "request.breakglass.used"
+	RequestBreakGlassReviewedType = "request.breakglass.reviewed"
+
+	RequestExtensionRequestedType = "request.extension.requested"
+	RequestExtensionApprovedType  = "request.extension.approved"
+	RequestExtensionDeclinedType  = "request.extension.declined"
 )
 
 // RequestCreated is emitted when a user requests access
@@ -50,6 +58,82 @@ func (RequestDeclined) EventType() string {
 	return RequestDeclinedType
 }
 
+// RequestEscalated is emitted when a request has not been
+// reviewed in time and is escalated according to its access rule.
+type RequestEscalated struct {
+	Request access.Request          `json:"request"`
+	Action  access.EscalationAction `json:"action"`
+	// AddedReviewerIDs are the users who were added as reviewers by the escalation.
+	AddedReviewerIDs []string `json:"addedReviewerIds"`
+}
+
+func (RequestEscalated) EventType() string {
+	return RequestEscalatedType
+}
+
+// RequestBreakGlassUsed is emitted when a user approves their own
+// request using break-glass access during an incident.
+type RequestBreakGlassUsed struct {
+	Request        access.Request `json:"request"`
+	RequestorEmail string         `json:"requestorEmail"`
+	Reason         string         `json:"reason"`
+	// ApproverIDs are the users who are asked to review the request after the incident.
+	ApproverIDs []string `json:"approverIds"`
+}
+
+func (RequestBreakGlassUsed) EventType() string {
+	return RequestBreakGlassUsedType
+}
+
+// RequestBreakGlassReviewed is emitted when an approver completes
+// the post-incident review of a break-glass request.
+type RequestBreakGlassReviewed struct {
+	Request       access.Request          `json:"request"`
+	Review        access.BreakGlassReview `json:"review"`
+	ReviewerID    string                  `json:"reviewerId"`
+	ReviewerEmail string                  `json:"reviewerEmail"`
+}
+
+func (RequestBreakGlassReviewed) EventType() string {
+	return RequestBreakGlassReviewedType
+}
+
+// RequestExtensionRequested is emitted when a user requests
+// more time on an active grant and the extension requires review.
+type RequestExtensionRequested struct {
+	Request        access.Request `json:"request"`
+	RequestorEmail string         `json:"requestorEmail"`
+}
+
+func (RequestExtensionRequested) EventType() string {
+	return RequestExtensionRequestedType
+}
+
+// RequestExtensionApproved is emitted when the grant
+// of a request has been extended.
+type RequestExtensionApproved struct {
+	Request access.Request `json:"request"`
+	// ReviewerID is empty if the extension was approved automatically.
+	ReviewerID    string `json:"reviewerId"`
+	ReviewerEmail string `json:"reviewerEmail"`
+}
+
+func (RequestExtensionApproved) EventType() string {
+	return RequestExtensionApprovedType
+}
+
+// RequestExtensionDeclined is emitted when a reviewer
+// declines a request extension.
+type RequestExtensionDeclined struct {
+	Request       access.Request `json:"request"`
+	ReviewerID    string         `json:"reviewerId"`
+	ReviewerEmail string         `json:"reviewerEmail"`
+}
+
+func (RequestExtensionDeclined) EventType() string {
+	return RequestExtensionDeclinedType
+}
+
 // RequestEventPayload is a payload which is common to
 // all Request events. It is used to conveniently unmarshal
 // the Request payloads in our event handler code.
@@ -9,6 +9,12 @@ import (
 	"github.com/common-fate/common-fate/pkg/cfaws"
 )
 
+// EventPutter puts events to the event bus.
+// It is implemented by Sender and by eventsink.Publisher.
+type EventPutter interface {
+	Put(ctx context.Context, e EventTyper) error
+}
+
 // EventSender provides methods to submit events to a Common Fate EventBridge bus.
 type Sender struct {
 	client      *eventbridge.Client
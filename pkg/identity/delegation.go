@@ -0,0 +1,45 @@
+package identity
+
+import (
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// Delegation allows a delegate to review access requests on behalf of an approver
+// for a period of time, such as while the approver is out of office.
+type Delegation struct {
+	ID string `json:"id" dynamodbav:"id"`
+	// UserID is the ID of the approver who has delegated their reviews.
+	UserID string `json:"userId" dynamodbav:"userId"`
+	// DelegateID is the ID of the user who can review on behalf of the approver.
+	DelegateID string    `json:"delegateId" dynamodbav:"delegateId"`
+	StartTime  time.Time `json:"startTime" dynamodbav:"startTime"`
+	EndTime    time.Time `json:"endTime" dynamodbav:"endTime"`
+	CreatedAt  time.Time `json:"createdAt" dynamodbav:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt" dynamodbav:"updatedAt"`
+}
+
+// IsActive returns true if the delegation window includes t.
+func (d Delegation) IsActive(t time.Time) bool {
+	return !t.Before(d.StartTime) && t.Before(d.EndTime)
+}
+
+func (d Delegation) ToAPI() types.Delegation {
+	return types.Delegation{
+		Id:         d.ID,
+		DelegateId: d.DelegateID,
+		StartTime:  d.StartTime,
+		EndTime:    d.EndTime,
+	}
+}
+
+func (d *Delegation) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.Delegation.PK1,
+		SK: keys.Delegation.SK1(d.UserID, d.ID),
+	}
+	return keys, nil
+}
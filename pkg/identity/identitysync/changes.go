@@ -0,0 +1,75 @@
+package identitysync
+
+import (
+	"sort"
+
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/types"
+)
+
+// syncChanges compares the users and groups before and after a sync,
+// returning the changes so that they can be recorded in the audit log.
+func syncChanges(idpType string, users []identity.User, groups []identity.Group, syncedUsers map[string]identity.User, syncedGroups map[string]identity.Group) gevent.IdentitySynced {
+	e := gevent.IdentitySynced{IdentityProvider: idpType}
+
+	existingUsers := make(map[string]identity.User)
+	for _, u := range users {
+		existingUsers[u.ID] = u
+	}
+	for _, u := range syncedUsers {
+		if u.Status == types.IdpStatusACTIVE {
+			e.UserCount++
+		}
+		existing, ok := existingUsers[u.ID]
+		switch {
+		case u.Status == types.IdpStatusACTIVE && (!ok || existing.Status != types.IdpStatusACTIVE):
+			e.UsersAdded = append(e.UsersAdded, u.Email)
+		case u.Status == types.IdpStatusARCHIVED && ok && existing.Status != types.IdpStatusARCHIVED:
+			e.UsersArchived = append(e.UsersArchived, u.Email)
+		}
+	}
+
+	existingGroups := make(map[string]identity.Group)
+	for _, g := range groups {
+		existingGroups[g.ID] = g
+	}
+	for _, g := range syncedGroups {
+		if g.Status == types.IdpStatusACTIVE {
+			e.GroupCount++
+		}
+		existing, ok := existingGroups[g.ID]
+		switch {
+		case g.Status == types.IdpStatusACTIVE && (!ok || existing.Status != types.IdpStatusACTIVE):
+			e.GroupsAdded = append(e.GroupsAdded, g.Name)
+		case g.Status == types.IdpStatusARCHIVED && ok && existing.Status != types.IdpStatusARCHIVED:
+			e.GroupsArchived = append(e.GroupsArchived, g.Name)
+		case ok && !sameMembers(existing.Users, g.Users):
+			e.GroupMembersChanged = append(e.GroupMembersChanged, g.Name)
+		}
+	}
+
+	// the users and groups are synced from maps, so sort them to give a stable order.
+	sort.Strings(e.UsersAdded)
+	sort.Strings(e.UsersArchived)
+	sort.Strings(e.GroupsAdded)
+	sort.Strings(e.GroupsArchived)
+	sort.Strings(e.GroupMembersChanged)
+	return e
+}
+
+// sameMembers returns whether the user IDs contain the same users, regardless of order.
+func sameMembers(a, b []string) bool {
+	set := make(map[string]bool)
+	for _, id := range a {
+		set[id] = true
+	}
+	other := make(map[string]bool)
+	for _, id := range b {
+		if !set[id] {
+			return false
+		}
+		other[id] = true
+	}
+	return len(set) == len(other)
+}
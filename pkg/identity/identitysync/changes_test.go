@@ -0,0 +1,55 @@
+package identitysync
+
+import (
+	"testing"
+
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncChanges(t *testing.T) {
+	active, archived := types.IdpStatusACTIVE, types.IdpStatusARCHIVED
+	users := []identity.User{
+		{ID: "u1", Email: "alice@example.com", Status: active},
+		{ID: "u2", Email: "bob@example.com", Status: active},
+		{ID: "u3", Email: "carol@example.com", Status: archived},
+	}
+	groups := []identity.Group{
+		{ID: "g1", Name: "admins", Status: active, Users: []string{"u1", "u2"}},
+		{ID: "g2", Name: "devs", Status: active, Users: []string{"u2"}},
+		{ID: "g3", Name: "old", Status: active},
+	}
+	syncedUsers := map[string]identity.User{
+		"alice@example.com": {ID: "u1", Email: "alice@example.com", Status: active},
+		"bob@example.com":   {ID: "u2", Email: "bob@example.com", Status: archived},
+		"carol@example.com": {ID: "u3", Email: "carol@example.com", Status: active},
+		"dave@example.com":  {ID: "u4", Email: "dave@example.com", Status: active},
+	}
+	syncedGroups := map[string]identity.Group{
+		"g1": {ID: "g1", Name: "admins", Status: active, Users: []string{"u1"}},
+		"g2": {ID: "g2", Name: "devs", Status: active, Users: []string{"u2"}},
+		"g3": {ID: "g3", Name: "old", Status: archived},
+		"g4": {ID: "g4", Name: "new", Status: active},
+	}
+
+	got := syncChanges("okta", users, groups, syncedUsers, syncedGroups)
+	want := gevent.IdentitySynced{
+		IdentityProvider:    "okta",
+		UserCount:           3,
+		GroupCount:          3,
+		UsersAdded:          []string{"carol@example.com", "dave@example.com"},
+		UsersArchived:       []string{"bob@example.com"},
+		GroupsAdded:         []string{"new"},
+		GroupsArchived:      []string{"old"},
+		GroupMembersChanged: []string{"admins"},
+	}
+	assert.Equal(t, want, got)
+	assert.True(t, got.HasChanges())
+
+	unchanged := syncChanges("okta", users, groups, map[string]identity.User{
+		"alice@example.com": users[0],
+	}, map[string]identity.Group{"g2": groups[1]})
+	assert.False(t, unchanged.HasChanges())
+}
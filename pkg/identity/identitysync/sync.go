@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"sync"
+	"time"
 
 	"github.com/common-fate/analytics-go"
 	"github.com/common-fate/apikit/logger"
@@ -12,6 +13,7 @@ import (
 	"github.com/common-fate/common-fate/pkg/gconfig"
 	"github.com/common-fate/common-fate/pkg/identity"
 	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
 	"github.com/common-fate/common-fate/pkg/types"
 	"github.com/common-fate/ddb"
 	"go.uber.org/zap"
@@ -199,7 +201,19 @@ func (s *IdentitySyncer) Sync(ctx context.Context) error {
 		items = append(items, &vi)
 	}
 
-	return s.db.PutBatch(ctx, items...)
+	// changes to users and groups are recorded in the audit log.
+	// Syncs which don't change anything aren't recorded, as the sync runs every few minutes.
+	synced := syncChanges(s.idpType, uq.Result, gq.Result, usersMap, groupsMap)
+	if synced.HasChanges() {
+		log.Infow("identity sync changed users or groups", "event", synced)
+		events, err := dbupdate.OutboxEvents(time.Now(), synced)
+		if err != nil {
+			return err
+		}
+		items = append(items, events...)
+	}
+
+	return dbupdate.PutItems(ctx, s.db, items...)
 }
 
 // analytics event
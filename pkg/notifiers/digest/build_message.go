@@ -0,0 +1,133 @@
+package digest
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/common-fate/common-fate/pkg/notifiers"
+	"github.com/common-fate/common-fate/pkg/service/digestsvc"
+	"github.com/slack-go/slack"
+)
+
+// maxListItems is the number of items shown in each section of a digest.
+// Slack limits the length of section blocks, so longer lists are truncated.
+const maxListItems = 10
+
+// digestPeriod formats the period covered by the digest.
+func digestPeriod(d digestsvc.Digest) string {
+	return fmt.Sprintf("%s to %s", d.Start.Format("Mon 2 Jan 15:04"), d.End.Format("Mon 2 Jan 15:04 MST"))
+}
+
+// BuildSlackMessage builds the admin digest as a Slack message.
+func BuildSlackMessage(d digestsvc.Digest, frontendURL string) (summary string, msg slack.Message) {
+	summary = fmt.Sprintf("Common Fate digest: %d requests, %d failed grants", d.TotalRequests, len(d.FailedGrants))
+	blocks := []slack.Block{
+		slack.NewHeaderBlock(slack.NewTextBlockObject(slack.PlainTextType, "Common Fate access digest", false, false)),
+		slack.NewContextBlock("", slack.NewTextBlockObject(slack.MarkdownType, digestPeriod(d), false, false)),
+		mrkdwnSection(fmt.Sprintf("*%d* requests were made, and *%d* grants failed.", d.TotalRequests, len(d.FailedGrants))),
+	}
+	for _, section := range sections(d, frontendURL, slackLink) {
+		blocks = append(blocks, slack.NewDividerBlock(), mrkdwnSection(fmt.Sprintf("*%s*\n%s", section.title, section.body)))
+	}
+	return summary, slack.NewBlockMessage(blocks...)
+}
+
+// BuildApproverSlackMessage builds a digest of an approver's pending reviews as a Slack message.
+func BuildApproverSlackMessage(a digestsvc.ApproverDigest, frontendURL string) (summary string, msg slack.Message) {
+	summary = fmt.Sprintf("You have %d requests waiting for your review", len(a.Pending))
+	return summary, slack.NewBlockMessage(
+		mrkdwnSection(fmt.Sprintf("*You have %d requests waiting for your review*", len(a.Pending))),
+		mrkdwnSection(requestList(a.Pending, frontendURL, slackLink)),
+	)
+}
+
+// BuildEmail builds the admin digest as a plain text email.
+func BuildEmail(d digestsvc.Digest, frontendURL string) (subject string, body string) {
+	subject = fmt.Sprintf("Common Fate access digest: %d requests, %d failed grants", d.TotalRequests, len(d.FailedGrants))
+	var b strings.Builder
+	fmt.Fprintf(&b, "Common Fate access digest for %s\n\n", digestPeriod(d))
+	fmt.Fprintf(&b, "%d requests were made, and %d grants failed.\n", d.TotalRequests, len(d.FailedGrants))
+	for _, section := range sections(d, frontendURL, textLink) {
+		fmt.Fprintf(&b, "\n%s\n%s\n", section.title, section.body)
+	}
+	return subject, b.String()
+}
+
+// BuildApproverEmail builds a digest of an approver's pending reviews as a plain text email.
+func BuildApproverEmail(a digestsvc.ApproverDigest, frontendURL string) (subject string, body string) {
+	subject = fmt.Sprintf("You have %d requests waiting for your review", len(a.Pending))
+	body = fmt.Sprintf("The following requests are waiting for your review in Common Fate:\n\n%s\n", requestList(a.Pending, frontendURL, textLink))
+	return subject, body
+}
+
+type section struct {
+	title string
+	body  string
+}
+
+// sections builds the sections of the admin digest. Empty sections are omitted.
+func sections(d digestsvc.Digest, frontendURL string, link linkFunc) []section {
+	var res []section
+	if len(d.PendingReviews) > 0 {
+		var lines []string
+		for _, a := range d.PendingReviews {
+			lines = append(lines, fmt.Sprintf("• %s: %d pending", a.Approver.Email, len(a.Pending)))
+		}
+		res = append(res, section{title: "Pending reviews by approver", body: truncate(lines)})
+	}
+	if len(d.FailedGrants) > 0 {
+		res = append(res, section{title: "Failed grants", body: requestList(d.FailedGrants, frontendURL, link)})
+	}
+	if len(d.TopRules) > 0 {
+		res = append(res, section{title: "Top requested rules", body: countList(d.TopRules)})
+	}
+	if len(d.Requesters) > 0 {
+		res = append(res, section{title: "Requests by requester", body: countList(d.Requesters)})
+	}
+	return res
+}
+
+// linkFunc formats a link for the message type.
+type linkFunc func(url, text string) string
+
+func slackLink(url, text string) string {
+	return fmt.Sprintf("<%s|%s>", url, text)
+}
+
+func textLink(url, text string) string {
+	return fmt.Sprintf("%s (%s)", text, url)
+}
+
+func requestList(requests []digestsvc.RequestSummary, frontendURL string, link linkFunc) string {
+	var lines []string
+	for _, r := range requests {
+		text := fmt.Sprintf("%s requested by %s", r.RuleName, r.RequestorEmail)
+		urls, err := notifiers.ReviewURL(frontendURL, r.Request.ID)
+		if err == nil {
+			text = link(urls.Review, text)
+		}
+		lines = append(lines, "• "+text)
+	}
+	return truncate(lines)
+}
+
+func countList(counts []digestsvc.Count) string {
+	var lines []string
+	for _, c := range counts {
+		lines = append(lines, fmt.Sprintf("• %s: %d", c.Name, c.Count))
+	}
+	return truncate(lines)
+}
+
+// truncate joins the lines, showing at most maxListItems lines.
+func truncate(lines []string) string {
+	if len(lines) > maxListItems {
+		more := len(lines) - maxListItems
+		lines = append(lines[:maxListItems:maxListItems], fmt.Sprintf("…and %d more", more))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func mrkdwnSection(text string) *slack.SectionBlock {
+	return slack.NewSectionBlock(slack.NewTextBlockObject(slack.MarkdownType, text, false, false), nil, nil)
+}
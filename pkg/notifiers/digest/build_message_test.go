@@ -0,0 +1,52 @@
+package digest
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/service/digestsvc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildEmail(t *testing.T) {
+	end := time.Date(2022, 11, 7, 9, 0, 0, 0, time.UTC)
+	d := digestsvc.Digest{
+		Start: end.Add(-24 * time.Hour),
+		End:   end,
+		PendingReviews: []digestsvc.ApproverDigest{
+			{Approver: identity.User{Email: "approver@example.com"}, Pending: []digestsvc.RequestSummary{{Request: access.Request{ID: "req_1"}, RuleName: "prod", RequestorEmail: "user@example.com"}}},
+		},
+		FailedGrants:  []digestsvc.RequestSummary{{Request: access.Request{ID: "req_2"}, RuleName: "prod", RequestorEmail: "user@example.com"}},
+		TotalRequests: 2,
+		TopRules:      []digestsvc.Count{{ID: "rul_1", Name: "prod", Count: 2}},
+	}
+
+	subject, body := BuildEmail(d, "https://commonfate.example.com")
+	assert.Equal(t, "Common Fate access digest: 2 requests, 1 failed grants", subject)
+	want := `Common Fate access digest for Sun 6 Nov 09:00 to Mon 7 Nov 09:00 UTC
+
+2 requests were made, and 1 grants failed.
+
+Pending reviews by approver
+• approver@example.com: 1 pending
+
+Failed grants
+• prod requested by user@example.com (https://commonfate.example.com/requests/req_2)
+
+Top requested rules
+• prod: 2
+`
+	assert.Equal(t, want, body)
+}
+
+func TestTruncate(t *testing.T) {
+	var lines []string
+	for i := 0; i < maxListItems+2; i++ {
+		lines = append(lines, fmt.Sprint(i))
+	}
+	assert.Equal(t, "0\n1\n2\n3\n4\n5\n6\n7\n8\n9\n…and 2 more", truncate(lines))
+	assert.Len(t, lines, maxListItems+2)
+}
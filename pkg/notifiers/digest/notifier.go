@@ -0,0 +1,142 @@
+package digest
+
+import (
+	"context"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/deploy"
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	"github.com/common-fate/common-fate/pkg/notifiers/email"
+	slacknotifier "github.com/common-fate/common-fate/pkg/notifiers/slack"
+	"github.com/common-fate/common-fate/pkg/service/digestsvc"
+	"github.com/common-fate/ddb"
+	"github.com/pkg/errors"
+	"github.com/slack-go/slack"
+	"go.uber.org/zap"
+)
+
+// DigestNotifier sends scheduled digests of access request activity.
+// The admin digest is sent to the configured Slack channels and email recipients,
+// and each approver with pending reviews is sent a digest by Slack DM and/or email.
+type DigestNotifier struct {
+	DB          ddb.Storage
+	Clock       clock.Clock
+	FrontendURL string
+
+	// settings is nil if digests are not configured
+	settings *Settings
+	// slack is set if the Slack notifier is configured
+	slack *slack.Client
+	// email is set if SMTP settings are configured
+	email email.Sender
+}
+
+func (n *DigestNotifier) Init(ctx context.Context, config *deploy.Notifications) error {
+	if config.Digest == nil {
+		return nil
+	}
+	var settings Settings
+	err := settings.Config().Load(ctx, &gconfig.MapLoader{Values: config.Digest})
+	if err != nil {
+		return err
+	}
+	err = settings.Validate()
+	if err != nil {
+		return err
+	}
+	n.settings = &settings
+
+	if config.Slack != nil {
+		sn := slacknotifier.SlackNotifier{DB: n.DB, FrontendURL: n.FrontendURL}
+		err = sn.Init(ctx, &deploy.Notifications{Slack: config.Slack})
+		if err != nil {
+			return errors.Wrap(err, "initialising Slack client")
+		}
+		n.slack = sn.Client()
+	}
+	if config.Email != nil {
+		var smtp email.SMTPSender
+		err = smtp.Config().Load(ctx, &gconfig.MapLoader{Values: config.Email})
+		if err != nil {
+			return errors.Wrap(err, "loading SMTP settings")
+		}
+		n.email = &smtp
+	}
+	return nil
+}
+
+// Run builds and sends a digest if one is due.
+// An error delivering a digest to one destination is logged and does not prevent delivery to the others.
+func (n *DigestNotifier) Run(ctx context.Context) error {
+	log := zap.S()
+	if n.settings == nil {
+		log.Info("digests are not configured, skipping")
+		return nil
+	}
+	period, due := n.settings.Due(n.Clock.Now())
+	if !due {
+		log.Infow("digest is not due today, skipping", "frequency", n.settings.Frequency())
+		return nil
+	}
+
+	svc := digestsvc.Service{Clock: n.Clock, DB: n.DB}
+	d, err := svc.Build(ctx, digestsvc.BuildOpts{Period: period})
+	if err != nil {
+		return errors.Wrap(err, "building digest")
+	}
+	log.Infow("built digest", "requests", d.TotalRequests, "failedGrants", len(d.FailedGrants), "approvers", len(d.PendingReviews))
+
+	n.sendAdminDigest(ctx, *d)
+	if n.settings.ApproverDigests() {
+		for _, a := range d.PendingReviews {
+			n.sendApproverDigest(ctx, a)
+		}
+	}
+	return nil
+}
+
+func (n *DigestNotifier) sendAdminDigest(ctx context.Context, d digestsvc.Digest) {
+	log := zap.S()
+	if channels := n.settings.SlackChannels(); len(channels) > 0 {
+		if n.slack == nil {
+			log.Warnw("digest Slack channels are configured but the Slack notifier is not, skipping", "channels", channels)
+		} else {
+			summary, msg := BuildSlackMessage(d, n.FrontendURL)
+			for _, channel := range channels {
+				_, _, err := n.slack.PostMessageContext(ctx, channel, slack.MsgOptionBlocks(msg.Blocks.BlockSet...), slack.MsgOptionText(summary, false))
+				if err != nil {
+					log.Errorw("failed to send digest to Slack channel", "channel", channel, zap.Error(err))
+				}
+			}
+		}
+	}
+	if recipients := n.settings.EmailRecipients(); len(recipients) > 0 {
+		if n.email == nil {
+			log.Warnw("digest email recipients are configured but email is not, skipping", "recipients", recipients)
+		} else {
+			subject, body := BuildEmail(d, n.FrontendURL)
+			err := n.email.Send(ctx, email.Message{To: recipients, Subject: subject, Body: body})
+			if err != nil {
+				log.Errorw("failed to send digest email", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (n *DigestNotifier) sendApproverDigest(ctx context.Context, a digestsvc.ApproverDigest) {
+	log := zap.S().With("approver", a.Approver.Email)
+	if n.slack != nil {
+		summary, msg := BuildApproverSlackMessage(a, n.FrontendURL)
+		_, err := slacknotifier.SendMessageBlocks(ctx, n.slack, a.Approver.Email, msg, summary)
+		if err != nil {
+			log.Errorw("failed to send approver digest to Slack", zap.Error(err))
+		}
+	}
+	if n.email != nil {
+		subject, body := BuildApproverEmail(a, n.FrontendURL)
+		err := n.email.Send(ctx, email.Message{To: []string{a.Approver.Email}, Subject: subject, Body: body})
+		if err != nil {
+			log.Errorw("failed to send approver digest email", zap.Error(err))
+		}
+	}
+}
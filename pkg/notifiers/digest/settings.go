@@ -0,0 +1,106 @@
+package digest
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/gconfig"
+)
+
+// Digest frequencies.
+const (
+	FrequencyDaily  = "daily"
+	FrequencyWeekly = "weekly"
+)
+
+const defaultWeekday = "monday"
+
+// Settings configure when digests are sent and who they are sent to.
+type Settings struct {
+	frequency       gconfig.OptionalStringValue
+	weekday         gconfig.OptionalStringValue
+	slackChannels   gconfig.OptionalStringValue
+	emailRecipients gconfig.OptionalStringValue
+	approverDigests gconfig.OptionalStringValue
+}
+
+func (s *Settings) Config() gconfig.Config {
+	return gconfig.Config{
+		gconfig.OptionalStringField("frequency", &s.frequency, "how often digests are sent, either 'daily' or 'weekly'", gconfig.WithDefaultFunc(func() string { return FrequencyDaily })),
+		gconfig.OptionalStringField("weekday", &s.weekday, "the day of the week that weekly digests are sent on", gconfig.WithDefaultFunc(func() string { return defaultWeekday })),
+		gconfig.OptionalStringField("slackChannels", &s.slackChannels, "a comma separated list of Slack channel IDs to send the admin digest to"),
+		gconfig.OptionalStringField("emailRecipients", &s.emailRecipients, "a comma separated list of email addresses to send the admin digest to"),
+		gconfig.OptionalStringField("approverDigests", &s.approverDigests, "whether approvers are sent a digest of their pending reviews, either 'true' or 'false'", gconfig.WithDefaultFunc(func() string { return "true" })),
+	}
+}
+
+// Validate checks that the frequency and weekday are valid.
+func (s *Settings) Validate() error {
+	switch s.Frequency() {
+	case FrequencyDaily, FrequencyWeekly:
+	default:
+		return fmt.Errorf("invalid digest frequency '%s', expected '%s' or '%s'", s.Frequency(), FrequencyDaily, FrequencyWeekly)
+	}
+	_, err := s.Weekday()
+	return err
+}
+
+// Frequency returns how often digests are sent, defaulting to daily.
+func (s *Settings) Frequency() string {
+	if s.frequency.IsSet() && s.frequency.Get() != "" {
+		return strings.ToLower(s.frequency.Get())
+	}
+	return FrequencyDaily
+}
+
+// Weekday returns the day of the week that weekly digests are sent on, defaulting to Monday.
+func (s *Settings) Weekday() (time.Weekday, error) {
+	weekday := defaultWeekday
+	if s.weekday.IsSet() && s.weekday.Get() != "" {
+		weekday = strings.TrimSpace(s.weekday.Get())
+	}
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(d.String(), weekday) {
+			return d, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid digest weekday '%s'", weekday)
+}
+
+// Due returns whether a digest should be sent at now, and the period it should cover.
+// Digests are sent by a Lambda which runs once a day, so daily digests are always due
+// and weekly digests are due on the configured weekday.
+func (s *Settings) Due(now time.Time) (period time.Duration, due bool) {
+	if s.Frequency() != FrequencyWeekly {
+		return 24 * time.Hour, true
+	}
+	weekday, err := s.Weekday()
+	if err != nil {
+		return 0, false
+	}
+	return 7 * 24 * time.Hour, now.Weekday() == weekday
+}
+
+func (s *Settings) SlackChannels() []string {
+	return splitList(s.slackChannels.Get())
+}
+
+func (s *Settings) EmailRecipients() []string {
+	return splitList(s.emailRecipients.Get())
+}
+
+// ApproverDigests returns whether approvers are sent a digest of their pending reviews, defaulting to true.
+func (s *Settings) ApproverDigests() bool {
+	return !s.approverDigests.IsSet() || s.approverDigests.Get() != "false"
+}
+
+func splitList(list string) []string {
+	var res []string
+	for _, v := range strings.Split(list, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			res = append(res, v)
+		}
+	}
+	return res
+}
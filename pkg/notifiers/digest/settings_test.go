@@ -0,0 +1,83 @@
+package digest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSettingsDue(t *testing.T) {
+	// 2022-11-07 is a Monday
+	monday := time.Date(2022, 11, 7, 9, 0, 0, 0, time.UTC)
+	tuesday := monday.Add(24 * time.Hour)
+
+	tests := []struct {
+		name       string
+		values     map[string]string
+		now        time.Time
+		wantPeriod time.Duration
+		wantDue    bool
+	}{
+		{name: "defaults to daily", values: map[string]string{}, now: tuesday, wantPeriod: 24 * time.Hour, wantDue: true},
+		{name: "weekly defaults to monday", values: map[string]string{"frequency": "weekly"}, now: monday, wantPeriod: 7 * 24 * time.Hour, wantDue: true},
+		{name: "weekly not due", values: map[string]string{"frequency": "weekly"}, now: tuesday, wantDue: false, wantPeriod: 7 * 24 * time.Hour},
+		{name: "weekly on configured day", values: map[string]string{"frequency": "weekly", "weekday": "Tuesday"}, now: tuesday, wantPeriod: 7 * 24 * time.Hour, wantDue: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var s Settings
+			err := s.Config().Load(context.Background(), &gconfig.MapLoader{Values: tc.values})
+			if err != nil {
+				t.Fatal(err)
+			}
+			period, due := s.Due(tc.now)
+			assert.Equal(t, tc.wantPeriod, period)
+			assert.Equal(t, tc.wantDue, due)
+		})
+	}
+}
+
+func TestSettingsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  map[string]string
+		wantErr string
+	}{
+		{name: "defaults", values: map[string]string{}},
+		{name: "invalid frequency", values: map[string]string{"frequency": "hourly"}, wantErr: "invalid digest frequency 'hourly', expected 'daily' or 'weekly'"},
+		{name: "invalid weekday", values: map[string]string{"weekday": "someday"}, wantErr: "invalid digest weekday 'someday'"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var s Settings
+			err := s.Config().Load(context.Background(), &gconfig.MapLoader{Values: tc.values})
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = s.Validate()
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestSettingsLists(t *testing.T) {
+	var s Settings
+	err := s.Config().Load(context.Background(), &gconfig.MapLoader{Values: map[string]string{
+		"slackChannels":   "C123, C456,",
+		"emailRecipients": "admin@example.com",
+		"approverDigests": "false",
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"C123", "C456"}, s.SlackChannels())
+	assert.Equal(t, []string{"admin@example.com"}, s.EmailRecipients())
+	assert.False(t, s.ApproverDigests())
+}
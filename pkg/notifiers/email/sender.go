@@ -0,0 +1,15 @@
+package email
+
+import "context"
+
+// Message is a plain text email.
+type Message struct {
+	To      []string
+	Subject string
+	Body    string
+}
+
+// Sender sends emails. SMTPSender is the built in implementation.
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
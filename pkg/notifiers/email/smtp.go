@@ -0,0 +1,99 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	"github.com/pkg/errors"
+)
+
+const defaultPort = "587"
+
+// SMTPSender sends emails through an SMTP server.
+// STARTTLS is used if the server supports it.
+type SMTPSender struct {
+	host     gconfig.StringValue
+	port     gconfig.OptionalStringValue
+	username gconfig.StringValue
+	password gconfig.SecretStringValue
+	from     gconfig.StringValue
+
+	// sendMail is used to send messages, defaulting to smtp.SendMail if nil.
+	sendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+func (s *SMTPSender) Config() gconfig.Config {
+	return gconfig.Config{
+		gconfig.StringField("smtpHost", &s.host, "the hostname of the SMTP server"),
+		gconfig.OptionalStringField("smtpPort", &s.port, "the port of the SMTP server", gconfig.WithDefaultFunc(func() string { return defaultPort })),
+		gconfig.StringField("smtpUsername", &s.username, "the username used to authenticate with the SMTP server"),
+		gconfig.SecretStringField("smtpPassword", &s.password, "the password used to authenticate with the SMTP server", gconfig.WithNoArgs("/granted/secrets/notifications/email/smtpPassword")),
+		gconfig.StringField("fromAddress", &s.from, "the address emails are sent from"),
+	}
+}
+
+// TestConfig connects to the SMTP server and authenticates, without sending an email.
+func (s *SMTPSender) TestConfig(ctx context.Context) error {
+	c, err := smtp.Dial(s.addr())
+	if err != nil {
+		return errors.Wrap(err, "connecting to SMTP server")
+	}
+	defer c.Close()
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		err = c.StartTLS(nil)
+		if err != nil {
+			return errors.Wrap(err, "starting TLS")
+		}
+	}
+	err = c.Auth(s.auth())
+	if err != nil {
+		return errors.Wrap(err, "authenticating with SMTP server")
+	}
+	return c.Quit()
+}
+
+func (s *SMTPSender) Send(ctx context.Context, msg Message) error {
+	if len(msg.To) == 0 {
+		return nil
+	}
+	send := s.sendMail
+	if send == nil {
+		send = smtp.SendMail
+	}
+	body := buildMessage(s.from.Get(), msg, time.Now())
+	return send(s.addr(), s.auth(), s.from.Get(), msg.To, body)
+}
+
+// addr returns the address of the SMTP server, using the submission port if no port is configured.
+func (s *SMTPSender) addr() string {
+	port := s.port.Get()
+	if port == "" {
+		port = defaultPort
+	}
+	return net.JoinHostPort(s.host.Get(), port)
+}
+
+func (s *SMTPSender) auth() smtp.Auth {
+	return smtp.PlainAuth("", s.username.Get(), s.password.Get(), s.host.Get())
+}
+
+// buildMessage formats the message as a plain text email.
+func buildMessage(from string, msg Message, date time.Time) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(msg.To, ", "))
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+	return b.Bytes()
+}
@@ -0,0 +1,54 @@
+package email
+
+import (
+	"context"
+	"net/smtp"
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildMessage(t *testing.T) {
+	date := time.Date(2022, 11, 7, 9, 0, 0, 0, time.UTC)
+	msg := Message{To: []string{"a@example.com", "b@example.com"}, Subject: "Digest ✅", Body: "line one\nline two"}
+
+	got := buildMessage("cf@example.com", msg, date)
+	want := "From: cf@example.com\r\n" +
+		"To: a@example.com, b@example.com\r\n" +
+		"Subject: =?utf-8?q?Digest_=E2=9C=85?=\r\n" +
+		"Date: Mon, 07 Nov 2022 09:00:00 +0000\r\n" +
+		"MIME-Version: 1.0\r\n" +
+		"Content-Type: text/plain; charset=\"utf-8\"\r\n" +
+		"\r\n" +
+		"line one\r\nline two"
+	assert.Equal(t, want, string(got))
+}
+
+func TestSMTPSenderSend(t *testing.T) {
+	var s SMTPSender
+	err := s.Config().Load(context.Background(), &gconfig.MapLoader{Values: map[string]string{
+		"smtpHost":     "smtp.example.com",
+		"smtpUsername": "user",
+		"smtpPassword": "password",
+		"fromAddress":  "cf@example.com",
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var gotAddr, gotFrom string
+	var gotTo []string
+	s.sendMail = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		gotAddr, gotFrom, gotTo = addr, from, to
+		return nil
+	}
+
+	err = s.Send(context.Background(), Message{To: []string{"admin@example.com"}, Subject: "hi", Body: "hello"})
+	assert.NoError(t, err)
+	// the submission port is used if no port is configured
+	assert.Equal(t, "smtp.example.com:587", gotAddr)
+	assert.Equal(t, "cf@example.com", gotFrom)
+	assert.Equal(t, []string{"admin@example.com"}, gotTo)
+}
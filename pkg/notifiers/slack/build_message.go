@@ -35,6 +35,9 @@ type RequestMessageOpts struct {
 	WasReviewed      bool
 	RequestReviewer  *identity.User
 	IsWebhook        bool
+	// Interactive messages are reviewed inside Slack through the interactivity endpoint,
+	// rather than linking out to the web app. Messages sent to incoming webhooks are never interactive.
+	Interactive bool
 }
 
 /**
@@ -91,6 +94,13 @@ func BuildRequestReviewMessage(o RequestMessageOpts) (summary string, msg slack.
 		})
 	}
 
+	if o.Request.Data.TicketReference != nil {
+		requestDetails = append(requestDetails, &slack.TextBlockObject{
+			Type: "mrkdwn",
+			Text: fmt.Sprintf("*Ticket:*\n%s", *o.Request.Data.TicketReference),
+		})
+	}
+
 	var richTextSummary string
 
 	if o.IsWebhook && o.WasReviewed && o.Request.Status != access.PENDING {
@@ -132,7 +142,9 @@ func BuildRequestReviewMessage(o RequestMessageOpts) (summary string, msg slack.
 	}
 
 	// If the request has just been sent (PENDING), then append Action Blocks
-	if o.Request.Status == access.PENDING {
+	if o.Request.Status == access.PENDING && o.Interactive && !o.IsWebhook {
+		msg.Blocks.BlockSet = append(msg.Blocks.BlockSet, BuildInteractiveReviewActions(o.Request.ID))
+	} else if o.Request.Status == access.PENDING {
 		msg.Blocks.BlockSet = append(msg.Blocks.BlockSet, slack.NewActionBlock("review_actions",
 			slack.ButtonBlockElement{
 				Type:     slack.METButton,
@@ -206,6 +218,13 @@ func BuildRequestDetailMessage(o RequestDetailMessageOpts) (summary string, msg
 		})
 	}
 
+	if o.Request.Data.TicketReference != nil {
+		requestDetails = append(requestDetails, &slack.TextBlockObject{
+			Type: "mrkdwn",
+			Text: fmt.Sprintf("*Ticket:*\n%s", *o.Request.Data.TicketReference),
+		})
+	}
+
 	msg = slack.NewBlockMessage(
 		slack.SectionBlock{
 			Type: slack.MBTSection,
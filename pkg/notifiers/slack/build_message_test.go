@@ -7,12 +7,15 @@ import (
 	"time"
 
 	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/notifiers"
 	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/slack-go/slack"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestBuildRequestMessage(t *testing.T) {
 	reason := "reason"
+	ticketReference := "OPS-123"
 	start := time.Date(2022, 1, 1, 1, 0, 0, 0, time.UTC)
 
 	tests := []struct {
@@ -79,6 +82,65 @@ func TestBuildRequestMessage(t *testing.T) {
 			]
 		}
 	]
+}`,
+		},
+		{
+			name: "with ticket reference",
+			args: RequestMessageOpts{
+				Request: access.Request{
+					ID:          "123",
+					RequestedBy: "usr_1",
+					Data: access.RequestData{
+						TicketReference: &ticketReference,
+					},
+					RequestedTiming: access.Timing{
+						Duration: time.Hour,
+					},
+				},
+				Rule: rule.AccessRule{
+					Name: "my rule",
+				},
+				RequestorEmail: "testuser@example.com",
+			},
+			wantSummary: "New request for my rule from testuser@example.com",
+			wantMsg: `
+{
+	"replace_original": false,
+	"delete_original": false,
+	"metadata": {
+		"event_type": "",
+		"event_payload": null
+	},
+	"blocks": [
+		{
+			"type": "section",
+			"text": {
+				"type": "mrkdwn",
+				"text": "*\u003c|New request for my rule\u003e from testuser@example.com*"
+			}
+		},
+		{
+			"type": "section",
+			"fields": [
+				{
+					"type": "mrkdwn",
+					"text": "*When:*\nASAP"
+				},
+				{
+					"type": "mrkdwn",
+					"text": "*Duration:*\n1h0m0s"
+				},
+				{
+					"type": "mrkdwn",
+					"text": "*Status:*\n"
+				},
+				{
+					"type": "mrkdwn",
+					"text": "*Ticket:*\nOPS-123"
+				}
+			]
+		}
+	]
 }`,
 		},
 		{
@@ -137,3 +199,28 @@ func TestBuildRequestMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildGrantActivatedMessage(t *testing.T) {
+	urls := notifiers.ReviewURLs{Review: "https://example.com/requests/req_1", AccessInstructions: "https://example.com/requests/req_1#access_instructions"}
+	end := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("embeds instructions", func(t *testing.T) {
+		_, msg := BuildGrantActivatedMessage(GrantMessageOpts{RuleName: "prod", End: end, Instructions: "run `aws sso login`", ReviewURLs: urls})
+		assert.Len(t, msg.Blocks.BlockSet, 3)
+		section := msg.Blocks.BlockSet[2].(*slack.SectionBlock)
+		assert.Equal(t, "run `aws sso login`", section.Text.Text)
+	})
+
+	t.Run("truncates long instructions", func(t *testing.T) {
+		_, msg := BuildGrantActivatedMessage(GrantMessageOpts{RuleName: "prod", End: end, Instructions: strings.Repeat("é", maxInstructionsLength+1), ReviewURLs: urls})
+		section := msg.Blocks.BlockSet[2].(*slack.SectionBlock)
+		assert.Equal(t, strings.Repeat("é", maxInstructionsLength)+"…\n<https://example.com/requests/req_1#access_instructions|View the full access instructions>", section.Text.Text)
+	})
+
+	t.Run("links to instructions when the provider has none", func(t *testing.T) {
+		_, msg := BuildGrantActivatedMessage(GrantMessageOpts{RuleName: "prod", End: end, ReviewURLs: urls})
+		assert.Len(t, msg.Blocks.BlockSet, 2)
+		section := msg.Blocks.BlockSet[1].(*slack.SectionBlock)
+		assert.Equal(t, "<https://example.com/requests/req_1#access_instructions|View the access instructions>", section.Text.Text)
+	})
+}
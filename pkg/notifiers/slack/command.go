@@ -0,0 +1,148 @@
+package slacknotifier
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Actions supported by the /access slash command.
+const (
+	CommandActionRequest = "request"
+	CommandActionList    = "list"
+	CommandActionRevoke  = "revoke"
+	CommandActionHelp    = "help"
+)
+
+const commandUsage = "Usage:\n" +
+	"• `/access request <rule> [argument=value ...] [--duration 1h] [--reason <reason>] [--ticket <reference>]` to request access\n" +
+	"• `/access list` to list your recent requests\n" +
+	"• `/access revoke <request ID>` to revoke access\n" +
+	"Use `/access request` with no rule to see the rules you can request."
+
+// Command is a parsed /access slash command.
+type Command struct {
+	Action string
+	// Rule is the name or ID of the access rule to request.
+	Rule string
+	// With holds the argument values given as key=value pairs.
+	// Multiple values can be given for an argument, separated by commas.
+	With            map[string][]string
+	Duration        *time.Duration
+	Reason          *string
+	TicketReference *string
+	// RequestID is the request to revoke.
+	RequestID string
+}
+
+// ParseCommand parses the text of an /access slash command.
+// Values containing spaces can be wrapped in double quotes, and the --reason flag
+// consumes every word up until the next flag.
+func ParseCommand(text string) (Command, error) {
+	args := splitArgs(text)
+	if len(args) == 0 {
+		return Command{Action: CommandActionHelp}, nil
+	}
+	cmd := Command{Action: strings.ToLower(args[0])}
+	args = args[1:]
+
+	switch cmd.Action {
+	case CommandActionHelp, CommandActionList:
+		return cmd, nil
+	case CommandActionRevoke:
+		if len(args) != 1 {
+			return cmd, fmt.Errorf("revoke expects a request ID, e.g. `/access revoke req_123`")
+		}
+		cmd.RequestID = args[0]
+		return cmd, nil
+	case CommandActionRequest:
+	default:
+		return cmd, fmt.Errorf("unknown command '%s'", cmd.Action)
+	}
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch arg {
+		case "--duration":
+			if i+1 >= len(args) {
+				return cmd, fmt.Errorf("--duration expects a value such as 30m or 2h")
+			}
+			i++
+			d, err := time.ParseDuration(args[i])
+			if err != nil || d <= 0 {
+				return cmd, fmt.Errorf("invalid duration '%s', use a value such as 30m or 2h", args[i])
+			}
+			cmd.Duration = &d
+		case "--ticket":
+			if i+1 >= len(args) {
+				return cmd, fmt.Errorf("--ticket expects a ticket reference")
+			}
+			i++
+			ticket := args[i]
+			cmd.TicketReference = &ticket
+		case "--reason":
+			var words []string
+			for i+1 < len(args) && !strings.HasPrefix(args[i+1], "--") {
+				i++
+				words = append(words, args[i])
+			}
+			if len(words) == 0 {
+				return cmd, fmt.Errorf("--reason expects a reason")
+			}
+			reason := strings.Join(words, " ")
+			cmd.Reason = &reason
+		default:
+			if strings.HasPrefix(arg, "--") {
+				return cmd, fmt.Errorf("unknown flag '%s'", arg)
+			}
+			if key, value, ok := strings.Cut(arg, "="); ok {
+				if key == "" || value == "" {
+					return cmd, fmt.Errorf("invalid argument '%s', use argument=value", arg)
+				}
+				if cmd.With == nil {
+					cmd.With = map[string][]string{}
+				}
+				for _, v := range strings.Split(value, ",") {
+					if v = strings.TrimSpace(v); v != "" {
+						cmd.With[key] = append(cmd.With[key], v)
+					}
+				}
+				continue
+			}
+			if cmd.Rule != "" {
+				return cmd, fmt.Errorf("unexpected argument '%s', arguments should be given as argument=value", arg)
+			}
+			cmd.Rule = arg
+		}
+	}
+	return cmd, nil
+}
+
+// splitArgs splits the command text on whitespace, keeping quoted values together.
+// Slack may send curly quotes depending on the user's client, so these are treated as regular double quotes.
+func splitArgs(text string) []string {
+	text = strings.NewReplacer("“", `"`, "”", `"`).Replace(text)
+	var args []string
+	var current strings.Builder
+	var quoted, inArg bool
+	for _, r := range text {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			inArg = true
+		case !quoted && (r == ' ' || r == '\t' || r == '\n'):
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	return args
+}
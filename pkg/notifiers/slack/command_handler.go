@@ -0,0 +1,406 @@
+package slacknotifier
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/notifiers"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/service/accesssvc"
+	"github.com/common-fate/common-fate/pkg/service/workflowsvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+	"github.com/slack-go/slack"
+	"go.uber.org/zap"
+)
+
+// defaultCommandDuration is used for requests made with the slash command if no duration is given.
+// It is capped at the maximum duration of the access rule.
+const defaultCommandDuration = time.Hour
+
+// maxListedOptions is the number of options shown when an argument is missing or invalid.
+const maxListedOptions = 10
+
+//go:generate go run github.com/golang/mock/mockgen -destination=mocks/command.go -package=mocks . AccessRuleLister,RequestCreator,RequestRevoker
+
+// AccessRuleLister lists the rules a user can request, and the options for their arguments.
+// It is implemented by rulesvc.Service.
+type AccessRuleLister interface {
+	ListUserAccessRules(ctx context.Context, user identity.User) ([]rule.AccessRule, error)
+	RequestArguments(ctx context.Context, accessRuleTarget rule.Target) (map[string]types.RequestArgument, error)
+}
+
+// RequestCreator creates access requests. It is implemented by accesssvc.Service.
+type RequestCreator interface {
+	CreateRequests(ctx context.Context, in accesssvc.CreateRequestsOpts) ([]accesssvc.CreateRequestResult, error)
+}
+
+// RequestRevoker revokes access. It is implemented by workflowsvc.Service.
+type RequestRevoker interface {
+	Revoke(ctx context.Context, request access.Request, revokerID string, revokerEmail string) (*access.Request, error)
+}
+
+// CommandHandler handles the /access slash command, which lets users request, list and revoke
+// access without leaving Slack. The Slack user is matched to a Common Fate user by email,
+// and the result of the command is sent as an ephemeral reply to the command's response URL.
+//
+// Slack only waits 3 seconds for a response, and creating a request can take longer,
+// so the command is acknowledged straight away and handled afterwards if Defer is set.
+type CommandHandler struct {
+	DB            ddb.Storage
+	Rules         AccessRuleLister
+	Access        RequestCreator
+	Workflow      RequestRevoker
+	SigningSecret string
+	FrontendURL   string
+	// AdminGroup is the group whose members can revoke any request, as they can in the web app.
+	AdminGroup string
+	Slack      SlackUserLookup
+	// Defer hands the command off to be handled after it has been acknowledged.
+	// If Defer is nil, the command is handled before responding.
+	Defer DeferFunc
+}
+
+func (h *CommandHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := zap.S()
+
+	_, err := verifyRequest(r, h.SigningSecret)
+	if err != nil {
+		log.Infow("invalid slack command request", zap.Error(err))
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+	sc, err := slack.SlashCommandParse(r)
+	if err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+	log = log.With("slack.user", sc.UserID, "command", sc.Command)
+
+	// an empty response acknowledges the command without replying to it.
+	if deferRequest(ctx, log, h.Defer) {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	reply := h.handle(ctx, log, sc)
+	err = respond(ctx, sc.ResponseURL, reply)
+	if err != nil {
+		log.Errorw("failed to reply to slack command", zap.Error(err))
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// handle runs the command and returns the text to reply with.
+func (h *CommandHandler) handle(ctx context.Context, log *zap.SugaredLogger, sc slack.SlashCommand) string {
+	cmd, err := ParseCommand(sc.Text)
+	if err != nil {
+		return fmt.Sprintf(":warning: %s\n\n%s", err.Error(), commandUsage)
+	}
+	if cmd.Action == CommandActionHelp {
+		return commandUsage
+	}
+
+	user, err := lookupUser(ctx, h.DB, h.Slack, sc.UserID)
+	if err == errUserNotFound {
+		return ":warning: " + err.Error()
+	}
+	if err != nil {
+		log.Errorw("failed to look up user for slack command", zap.Error(err))
+		return ":warning: Something went wrong. Please try again from the web app."
+	}
+	log = log.With("user.id", user.ID)
+
+	switch cmd.Action {
+	case CommandActionRequest:
+		return h.request(ctx, log, *user, cmd)
+	case CommandActionList:
+		return h.list(ctx, log, *user)
+	case CommandActionRevoke:
+		return h.revoke(ctx, log, *user, cmd.RequestID)
+	}
+	return commandUsage
+}
+
+func (h *CommandHandler) request(ctx context.Context, log *zap.SugaredLogger, user identity.User, cmd Command) string {
+	rules, err := h.Rules.ListUserAccessRules(ctx, user)
+	if err != nil {
+		log.Errorw("failed to list access rules", zap.Error(err))
+		return ":warning: Something went wrong listing your access rules. Please try again from the web app."
+	}
+	if cmd.Rule == "" {
+		return availableRules(rules)
+	}
+	accessRule, ok := matchRule(rules, cmd.Rule)
+	if !ok {
+		return fmt.Sprintf(":warning: Couldn't find an access rule matching '%s'.\n\n%s", cmd.Rule, availableRules(rules))
+	}
+
+	requestArguments, err := h.Rules.RequestArguments(ctx, accessRule.Target)
+	if err != nil {
+		log.Errorw("failed to load request arguments", "rule.id", accessRule.ID, zap.Error(err))
+		return ":warning: Something went wrong loading the options for this rule. Please try again from the web app."
+	}
+	with, err := resolveArguments(accessRule, requestArguments, cmd.With)
+	if err != nil {
+		return ":warning: " + err.Error()
+	}
+
+	maxDuration := time.Duration(accessRule.TimeConstraints.MaxDurationSeconds) * time.Second
+	duration := defaultCommandDuration
+	if cmd.Duration != nil {
+		duration = *cmd.Duration
+	} else if maxDuration > 0 && duration > maxDuration {
+		duration = maxDuration
+	}
+
+	create := accesssvc.CreateRequests{
+		AccessRuleId:    accessRule.ID,
+		Reason:          cmd.Reason,
+		Timing:          types.RequestTiming{DurationSeconds: int(duration.Seconds())},
+		TicketReference: cmd.TicketReference,
+	}
+	if len(with) > 0 {
+		create.With = &types.CreateRequestWithSubRequest{{AdditionalProperties: with}}
+	}
+	results, err := h.Access.CreateRequests(ctx, accesssvc.CreateRequestsOpts{User: user, Create: create})
+
+	var lines []string
+	for _, res := range results {
+		lines = append(lines, h.describeRequest(res.Request, accessRule.Name))
+	}
+	if err != nil {
+		log.Infow("failed to create requests from slack command", "rule.id", accessRule.ID, zap.Error(err))
+		lines = append(lines, ":warning: "+createRequestError(err))
+	}
+	if len(results) > 0 {
+		lines = append([]string{fmt.Sprintf("Requested access to *%s* for %s:", accessRule.Name, duration)}, lines...)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (h *CommandHandler) list(ctx context.Context, log *zap.SugaredLogger, user identity.User) string {
+	q := storage.ListRequestsForUser{UserId: user.ID}
+	_, err := h.DB.Query(ctx, &q, ddb.Limit(10))
+	if err != nil && err != ddb.ErrNoItems {
+		log.Errorw("failed to list requests", zap.Error(err))
+		return ":warning: Something went wrong listing your requests. Please try again from the web app."
+	}
+	if len(q.Result) == 0 {
+		return "You haven't made any requests yet. Use `/access request` to request access."
+	}
+
+	ruleNames := map[string]string{}
+	lines := []string{"Your recent requests:"}
+	for _, req := range q.Result {
+		name, ok := ruleNames[req.Rule]
+		if !ok {
+			name = req.Rule
+			rq := storage.GetAccessRuleCurrent{ID: req.Rule}
+			_, err := h.DB.Query(ctx, &rq)
+			if err == nil {
+				name = rq.Result.Name
+			}
+			ruleNames[req.Rule] = name
+		}
+		lines = append(lines, h.describeRequest(req, name))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (h *CommandHandler) revoke(ctx context.Context, log *zap.SugaredLogger, user identity.User, requestID string) string {
+	notFound := fmt.Sprintf(":warning: Request %s wasn't found, or you don't have access to it.", requestID)
+
+	q := storage.GetRequest{ID: requestID}
+	_, err := h.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		return notFound
+	}
+	if err != nil {
+		log.Errorw("failed to get request", "request.id", requestID, zap.Error(err))
+		return ":warning: Something went wrong. Please try again from the web app."
+	}
+	// users can revoke their own requests, reviewers can revoke requests they can review and admins can revoke any request.
+	if q.Result.RequestedBy != user.ID && !user.BelongsToGroup(h.AdminGroup) {
+		qr := storage.GetRequestReviewer{RequestID: requestID, ReviewerID: user.ID}
+		_, err = h.DB.Query(ctx, &qr)
+		if err == ddb.ErrNoItems {
+			return notFound
+		}
+		if err != nil {
+			log.Errorw("failed to get request reviewer", "request.id", requestID, zap.Error(err))
+			return ":warning: Something went wrong. Please try again from the web app."
+		}
+	}
+
+	_, err = h.Workflow.Revoke(ctx, *q.Result, user.ID, user.Email)
+	if err == workflowsvc.ErrGrantInactive || err == workflowsvc.ErrNoGrant {
+		return ":warning: " + err.Error()
+	}
+	if err != nil {
+		log.Errorw("failed to revoke request", "request.id", requestID, zap.Error(err))
+		return ":warning: Something went wrong revoking access. Please try again from the web app."
+	}
+	log.Infow("revoked request from slack command", "request.id", requestID)
+	return fmt.Sprintf("Revoking access for request %s.", requestID)
+}
+
+// describeRequest formats a request as a single line, linking to the request in the web app.
+func (h *CommandHandler) describeRequest(req access.Request, ruleName string) string {
+	status := strings.ToLower(string(req.Status))
+	if req.Grant != nil {
+		status = fmt.Sprintf("%s, access %s", status, strings.ToLower(string(req.Grant.Status)))
+	}
+	name := ruleName
+	var selected []string
+	for _, o := range req.SelectedWith {
+		selected = append(selected, o.Label)
+	}
+	if len(selected) > 0 {
+		sort.Strings(selected)
+		name = fmt.Sprintf("%s (%s)", ruleName, strings.Join(selected, ", "))
+	}
+	urls, err := notifiers.ReviewURL(h.FrontendURL, req.ID)
+	if err != nil {
+		return fmt.Sprintf("• `%s` *%s* %s", req.ID, name, status)
+	}
+	return fmt.Sprintf("• <%s|%s> *%s* %s", urls.Review, req.ID, name, status)
+}
+
+func availableRules(rules []rule.AccessRule) string {
+	if len(rules) == 0 {
+		return "You don't have access to any access rules."
+	}
+	names := make([]string, len(rules))
+	for i, r := range rules {
+		names[i] = fmt.Sprintf("• *%s* (`%s`)", r.Name, r.ID)
+	}
+	sort.Strings(names)
+	return "Access rules you can request:\n" + strings.Join(names, "\n")
+}
+
+// matchRule finds a rule by ID, or by its name ignoring case.
+func matchRule(rules []rule.AccessRule, nameOrID string) (rule.AccessRule, bool) {
+	for _, r := range rules {
+		if r.ID == nameOrID {
+			return r, true
+		}
+	}
+	for _, r := range rules {
+		if strings.EqualFold(r.Name, nameOrID) {
+			return r, true
+		}
+	}
+	return rule.AccessRule{}, false
+}
+
+// resolveArguments matches the argument values given in the command against the options of the access rule.
+// Values can be given as either the value or the label of an option.
+// An error listing the available options is returned if a required argument is missing or a value doesn't match.
+func resolveArguments(accessRule rule.AccessRule, requestArguments map[string]types.RequestArgument, with map[string][]string) (map[string][]string, error) {
+	res := map[string][]string{}
+	for key, values := range with {
+		arg, ok := requestArguments[key]
+		if !ok || !arg.RequiresSelection {
+			return nil, fmt.Errorf("*%s* doesn't have a selectable argument '%s'.%s", accessRule.Name, key, selectableArguments(requestArguments))
+		}
+		for _, v := range values {
+			option, ok := matchOption(arg.Options, v)
+			if !ok {
+				return nil, fmt.Errorf("'%s' isn't a valid option for %s.\n%s", v, key, listOptions(key, arg))
+			}
+			res[key] = append(res[key], option.Value)
+		}
+	}
+
+	var missing []string
+	for key, arg := range requestArguments {
+		if arg.RequiresSelection && len(res[key]) == 0 {
+			missing = append(missing, listOptions(key, arg))
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, fmt.Errorf("select a value for each argument of *%s*, e.g. `/access request %s argument=value`.\n%s", accessRule.Name, accessRule.ID, strings.Join(missing, "\n"))
+	}
+	return res, nil
+}
+
+// matchOption finds a valid option by value, or by its label ignoring case.
+func matchOption(options []types.WithOption, value string) (types.WithOption, bool) {
+	for _, o := range options {
+		if o.Valid && o.Value == value {
+			return o, true
+		}
+	}
+	for _, o := range options {
+		if o.Valid && strings.EqualFold(o.Label, value) {
+			return o, true
+		}
+	}
+	return types.WithOption{}, false
+}
+
+func selectableArguments(requestArguments map[string]types.RequestArgument) string {
+	var keys []string
+	for key, arg := range requestArguments {
+		if arg.RequiresSelection {
+			keys = append(keys, "`"+key+"`")
+		}
+	}
+	if len(keys) == 0 {
+		return " The rule has no selectable arguments."
+	}
+	sort.Strings(keys)
+	return " Selectable arguments are " + strings.Join(keys, ", ") + "."
+}
+
+// listOptions lists the options for an argument, truncated to maxListedOptions.
+func listOptions(key string, arg types.RequestArgument) string {
+	var options []string
+	for _, o := range arg.Options {
+		if !o.Valid {
+			continue
+		}
+		if o.Label == o.Value {
+			options = append(options, "`"+o.Value+"`")
+		} else {
+			options = append(options, fmt.Sprintf("%s (`%s`)", o.Label, o.Value))
+		}
+	}
+	more := ""
+	if len(options) > maxListedOptions {
+		more = fmt.Sprintf(" and %d more", len(options)-maxListedOptions)
+		options = options[:maxListedOptions]
+	}
+	return fmt.Sprintf("• *%s* (`%s`): %s%s", arg.Title, key, strings.Join(options, ", "), more)
+}
+
+// createRequestError returns a message for errors from creating requests.
+// Validation errors are returned to the user, and other errors are replaced with a generic message.
+func createRequestError(err error) string {
+	var me *multierror.Error
+	if errors.As(err, &me) {
+		return err.Error()
+	}
+	var apiErr *apio.APIError
+	if errors.As(err, &apiErr) && apiErr.Status < http.StatusInternalServerError {
+		return apiErr.Err.Error()
+	}
+	switch err {
+	case accesssvc.ErrNoMatchingGroup, accesssvc.ErrRuleNotFound, accesssvc.ErrRequestOverlapsExistingGrant:
+		return err.Error()
+	}
+	return "something went wrong creating your request. Please try again from the web app."
+}
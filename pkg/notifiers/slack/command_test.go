@@ -0,0 +1,270 @@
+package slacknotifier
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/notifiers/slack/mocks"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/service/accesssvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/golang/mock/gomock"
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCommand(t *testing.T) {
+	hour := time.Hour
+	reason := "fixing the prod incident"
+	ticket := "OPS-1"
+
+	tests := []struct {
+		name    string
+		text    string
+		want    Command
+		wantErr string
+	}{
+		{name: "empty", text: "", want: Command{Action: CommandActionHelp}},
+		{name: "list", text: "list", want: Command{Action: CommandActionList}},
+		{name: "revoke", text: "revoke req_1", want: Command{Action: CommandActionRevoke, RequestID: "req_1"}},
+		{name: "revoke without id", text: "revoke", want: Command{Action: CommandActionRevoke}, wantErr: "revoke expects a request ID, e.g. `/access revoke req_123`"},
+		{name: "request without rule", text: "request", want: Command{Action: CommandActionRequest}},
+		{
+			name: "request",
+			text: `request "prod admin" accountId=123,456 --duration 1h --reason fixing the prod incident --ticket OPS-1`,
+			want: Command{
+				Action:          CommandActionRequest,
+				Rule:            "prod admin",
+				With:            map[string][]string{"accountId": {"123", "456"}},
+				Duration:        &hour,
+				Reason:          &reason,
+				TicketReference: &ticket,
+			},
+		},
+		{
+			name: "curly quotes",
+			text: "request “prod admin”",
+			want: Command{Action: CommandActionRequest, Rule: "prod admin"},
+		},
+		{name: "invalid duration", text: "request prod --duration forever", want: Command{Action: CommandActionRequest, Rule: "prod"}, wantErr: "invalid duration 'forever', use a value such as 30m or 2h"},
+		{name: "unknown flag", text: "request prod --start now", want: Command{Action: CommandActionRequest, Rule: "prod"}, wantErr: "unknown flag '--start'"},
+		{name: "unknown command", text: "delete", want: Command{Action: "delete"}, wantErr: "unknown command 'delete'"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseCommand(tc.text)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestResolveArguments(t *testing.T) {
+	accessRule := rule.AccessRule{ID: "rul_1", Name: "prod"}
+	args := map[string]types.RequestArgument{
+		"accountId": {
+			Title:             "Account",
+			RequiresSelection: true,
+			Options: []types.WithOption{
+				{Label: "production", Value: "123", Valid: true},
+				{Label: "staging", Value: "456", Valid: true},
+				{Label: "deleted", Value: "789", Valid: false},
+			},
+		},
+		"permissionSetArn": {
+			Title:   "Permission Set",
+			Options: []types.WithOption{{Label: "Admin", Value: "arn:admin", Valid: true}},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		with    map[string][]string
+		want    map[string][]string
+		wantErr string
+	}{
+		{
+			name: "value and label",
+			with: map[string][]string{"accountId": {"123", "Staging"}},
+			want: map[string][]string{"accountId": {"123", "456"}},
+		},
+		{
+			name:    "missing required argument",
+			wantErr: "select a value for each argument of *prod*, e.g. `/access request rul_1 argument=value`.\n• *Account* (`accountId`): production (`123`), staging (`456`)",
+		},
+		{
+			name:    "invalid option",
+			with:    map[string][]string{"accountId": {"789"}},
+			wantErr: "'789' isn't a valid option for accountId.\n• *Account* (`accountId`): production (`123`), staging (`456`)",
+		},
+		{
+			name:    "argument is not selectable",
+			with:    map[string][]string{"permissionSetArn": {"arn:admin"}},
+			wantErr: "*prod* doesn't have a selectable argument 'permissionSetArn'. Selectable arguments are `accountId`.",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := resolveArguments(accessRule, args, tc.with)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+// signedCommand builds a slash command request signed in the same way as Slack.
+func signedCommand(secret string, text string, responseURL string) *http.Request {
+	body := url.Values{"command": {"/access"}, "text": {text}, "user_id": {"U123"}, "response_url": {responseURL}}.Encode()
+	return signedBody(secret, "/webhook/v1/slack/command", body)
+}
+
+// replyServer records the replies sent to the response URL of a slash command.
+func replyServer(t *testing.T) (*httptest.Server, *[]slack.WebhookMessage) {
+	var replies []slack.WebhookMessage
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var msg slack.WebhookMessage
+		err := json.NewDecoder(r.Body).Decode(&msg)
+		if err != nil {
+			t.Error(err)
+		}
+		replies = append(replies, msg)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &replies
+}
+
+func TestCommandHandlerRequest(t *testing.T) {
+	user := identity.User{ID: "usr_1", Email: "user@example.com", Groups: []string{"developers"}}
+	accessRule := rule.AccessRule{ID: "rul_1", Name: "prod", TimeConstraints: types.TimeConstraints{MaxDurationSeconds: 1800}}
+
+	ctrl := gomock.NewController(t)
+	db := ddbmock.New(t)
+	db.MockQuery(&storage.GetUserByEmail{Result: &user})
+
+	rules := mocks.NewMockAccessRuleLister(ctrl)
+	rules.EXPECT().ListUserAccessRules(gomock.Any(), user).Return([]rule.AccessRule{accessRule}, nil)
+	rules.EXPECT().RequestArguments(gomock.Any(), accessRule.Target).Return(map[string]types.RequestArgument{}, nil)
+
+	creator := mocks.NewMockRequestCreator(ctrl)
+	creator.EXPECT().CreateRequests(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, in accesssvc.CreateRequestsOpts) ([]accesssvc.CreateRequestResult, error) {
+		assert.Equal(t, "rul_1", in.Create.AccessRuleId)
+		// the default duration is capped at the maximum duration of the rule
+		assert.Equal(t, 1800, in.Create.Timing.DurationSeconds)
+		assert.Equal(t, "deploying", *in.Create.Reason)
+		assert.Nil(t, in.Create.With)
+		return []accesssvc.CreateRequestResult{{Request: access.Request{ID: "req_1", Status: access.PENDING}}}, nil
+	})
+
+	h := CommandHandler{
+		DB:            db,
+		Rules:         rules,
+		Access:        creator,
+		SigningSecret: "secret",
+		FrontendURL:   "https://commonfate.example.com",
+		Slack:         &fakeSlack{email: user.Email},
+	}
+	srv, replies := replyServer(t)
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, signedCommand("secret", "request PROD --reason deploying", srv.URL))
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	if assert.Len(t, *replies, 1) {
+		msg := (*replies)[0]
+		assert.Equal(t, slack.ResponseTypeEphemeral, msg.ResponseType)
+		assert.Equal(t, "Requested access to *prod* for 30m0s:\n• <https://commonfate.example.com/requests/req_1|req_1> *prod* pending", msg.Text)
+	}
+}
+
+func TestCommandHandlerDefer(t *testing.T) {
+	var deferred int
+	h := CommandHandler{
+		DB:            ddbmock.New(t),
+		SigningSecret: "secret",
+		Slack:         &fakeSlack{},
+		Defer: func(ctx context.Context) error {
+			deferred++
+			return nil
+		},
+	}
+	srv, replies := replyServer(t)
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, signedCommand("secret", "list", srv.URL))
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Empty(t, rr.Body.Bytes())
+	assert.Equal(t, 1, deferred)
+	// the command is replied to once it has been handled.
+	assert.Empty(t, *replies)
+}
+
+func TestCommandHandlerRejectsInvalidSignature(t *testing.T) {
+	h := CommandHandler{DB: ddbmock.New(t), SigningSecret: "secret", Slack: &fakeSlack{}}
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, signedCommand("other", "list", ""))
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+}
+
+func TestCommandHandlerRevokeRequiresAccess(t *testing.T) {
+	user := identity.User{ID: "usr_1", Email: "user@example.com"}
+	db := ddbmock.New(t)
+	db.MockQuery(&storage.GetUserByEmail{Result: &user})
+	db.MockQuery(&storage.GetRequest{Result: &access.Request{ID: "req_1", RequestedBy: "usr_other"}})
+	db.MockQueryWithErr(&storage.GetRequestReviewer{}, ddb.ErrNoItems)
+
+	h := CommandHandler{
+		DB:            db,
+		Workflow:      mocks.NewMockRequestRevoker(gomock.NewController(t)),
+		SigningSecret: "secret",
+		Slack:         &fakeSlack{email: user.Email},
+	}
+	srv, replies := replyServer(t)
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, signedCommand("secret", "revoke req_1", srv.URL))
+
+	if assert.Len(t, *replies, 1) {
+		assert.Equal(t, ":warning: Request req_1 wasn't found, or you don't have access to it.", (*replies)[0].Text)
+	}
+}
+
+func TestCommandHandlerAdminCanRevoke(t *testing.T) {
+	admin := identity.User{ID: "usr_admin", Email: "admin@example.com", Groups: []string{"admins"}}
+	request := access.Request{ID: "req_1", RequestedBy: "usr_other"}
+	db := ddbmock.New(t)
+	db.MockQuery(&storage.GetUserByEmail{Result: &admin})
+	db.MockQuery(&storage.GetRequest{Result: &request})
+
+	revoker := mocks.NewMockRequestRevoker(gomock.NewController(t))
+	revoker.EXPECT().Revoke(gomock.Any(), request, admin.ID, admin.Email).Return(&request, nil)
+
+	h := CommandHandler{
+		DB:            db,
+		Workflow:      revoker,
+		SigningSecret: "secret",
+		AdminGroup:    "admins",
+		Slack:         &fakeSlack{email: admin.Email},
+	}
+	srv, replies := replyServer(t)
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, signedCommand("secret", "revoke req_1", srv.URL))
+
+	if assert.Len(t, *replies, 1) {
+		assert.Equal(t, "Revoking access for request req_1.", (*replies)[0].Text)
+	}
+}
@@ -0,0 +1,33 @@
+package slacknotifier
+
+import (
+	"context"
+
+	"github.com/slack-go/slack"
+	"go.uber.org/zap"
+)
+
+// DeferFunc hands the Slack request being served off to be handled after Slack has been sent an acknowledgement.
+// In the webhook Lambda it invokes the Lambda again asynchronously with the request.
+type DeferFunc func(ctx context.Context) error
+
+// deferRequest calls d, and returns false if the request wasn't deferred and should be handled now.
+func deferRequest(ctx context.Context, log *zap.SugaredLogger, d DeferFunc) bool {
+	if d == nil {
+		return false
+	}
+	err := d(ctx)
+	if err != nil {
+		log.Errorw("failed to defer slack request, handling it now", zap.Error(err))
+		return false
+	}
+	return true
+}
+
+// respond sends an ephemeral reply to the response URL of a slash command or a message action.
+func respond(ctx context.Context, responseURL string, text string) error {
+	return slack.PostWebhookContext(ctx, responseURL, &slack.WebhookMessage{
+		ResponseType: slack.ResponseTypeEphemeral,
+		Text:         text,
+	})
+}
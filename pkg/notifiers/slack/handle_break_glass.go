@@ -0,0 +1,70 @@
+package slacknotifier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/notifiers"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/slack-go/slack"
+	"go.uber.org/zap"
+)
+
+// handleBreakGlassUsed alerts the approvers of the rule and any webhook channels that break-glass access has been used,
+// and asks the approvers to review the request.
+func (n *SlackNotifier) handleBreakGlassUsed(ctx context.Context, log *zap.SugaredLogger, used gevent.RequestBreakGlassUsed, requestedRule rule.AccessRule, requestingUser identity.User) error {
+	reviewURL, err := notifiers.ReviewURL(n.FrontendURL, used.Request.ID)
+	if err != nil {
+		return err
+	}
+	msg := fmt.Sprintf(":rotating_light: *%s* used break-glass access to *%s*.\n*Reason:* %s\nThe access was granted without approval. <%s|Review the request> to acknowledge or flag it.", requestingUser.Email, requestedRule.Name, used.Reason, reviewURL.Review)
+	fallback := fmt.Sprintf("%s used break-glass access to %s", requestingUser.Email, requestedRule.Name)
+
+	log.Infow("break-glass access used, messaging approvers", "approvers", used.ApproverIDs)
+	for _, id := range used.ApproverIDs {
+		if id == used.Request.RequestedBy {
+			continue
+		}
+		n.SendDMWithLogOnError(ctx, log, id, msg, fallback)
+	}
+	n.sendWebhookText(ctx, log, msg, fallback)
+	return nil
+}
+
+// handleBreakGlassReviewed lets the requestor and any webhook channels know the outcome of the post-incident review.
+func (n *SlackNotifier) handleBreakGlassReviewed(ctx context.Context, log *zap.SugaredLogger, reviewed gevent.RequestBreakGlassReviewed, requestedRule rule.AccessRule, requestingUser identity.User) error {
+	var msg, fallback string
+	switch reviewed.Review.Status {
+	case access.BreakGlassReviewFlagged:
+		msg = fmt.Sprintf(":warning: %s flagged the break-glass access to *%s* used by %s.", reviewed.ReviewerEmail, requestedRule.Name, requestingUser.Email)
+		fallback = fmt.Sprintf("%s flagged the break-glass access to %s", reviewed.ReviewerEmail, requestedRule.Name)
+	default:
+		msg = fmt.Sprintf(":white_check_mark: %s acknowledged the break-glass access to *%s* used by %s.", reviewed.ReviewerEmail, requestedRule.Name, requestingUser.Email)
+		fallback = fmt.Sprintf("%s acknowledged the break-glass access to %s", reviewed.ReviewerEmail, requestedRule.Name)
+	}
+	if reviewed.Review.Comment != nil && *reviewed.Review.Comment != "" {
+		msg += fmt.Sprintf("\n*Comment:* %s", *reviewed.Review.Comment)
+	}
+
+	n.SendDMWithLogOnError(ctx, log, reviewed.Request.RequestedBy, msg, fallback)
+	n.sendWebhookText(ctx, log, msg, fallback)
+	return nil
+}
+
+// sendWebhookText sends a markdown text message to any configured webhook channels.
+func (n *SlackNotifier) sendWebhookText(ctx context.Context, log *zap.SugaredLogger, msg, fallback string) {
+	blocks := slack.Blocks{
+		BlockSet: []slack.Block{
+			slack.NewSectionBlock(slack.NewTextBlockObject(slack.MarkdownType, msg, false, false), nil, nil),
+		},
+	}
+	for _, webhook := range n.webhooks {
+		err := webhook.SendWebhookMessage(ctx, blocks, fallback)
+		if err != nil {
+			log.Errorw("failed to send message to incomingWebhook channel", "error", err)
+		}
+	}
+}
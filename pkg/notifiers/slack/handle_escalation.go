@@ -0,0 +1,73 @@
+package slacknotifier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/notifiers"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+	"go.uber.org/zap"
+)
+
+// handleRequestEscalated notifies the reviewers added to a request when it is escalated,
+// or lets the requestor and reviewers know that the request has been automatically declined.
+func (n *SlackNotifier) handleRequestEscalated(ctx context.Context, log *zap.SugaredLogger, escalation gevent.RequestEscalated, requestedRule rule.AccessRule, requestingUser identity.User) error {
+	request := escalation.Request
+	switch escalation.Action {
+	case access.ESCALATED:
+		added := make(map[string]bool)
+		for _, id := range escalation.AddedReviewerIDs {
+			added[id] = true
+		}
+		log.Infow("request escalated, messaging added reviewers", "reviewers", escalation.AddedReviewerIDs)
+		return n.notifyReviewers(ctx, log, request, requestedRule, requestingUser, func(r access.Reviewer) bool { return added[r.ReviewerID] })
+
+	case access.AUTO_DECLINED:
+		msg := fmt.Sprintf("Your request to access *%s* has been automatically declined because it was not reviewed in time.", requestedRule.Name)
+		fallback := fmt.Sprintf("Your request to access %s has been automatically declined.", requestedRule.Name)
+		n.SendDMWithLogOnError(ctx, log, request.RequestedBy, msg, fallback)
+
+		reviewURL, err := notifiers.ReviewURL(n.FrontendURL, request.ID)
+		if err != nil {
+			return err
+		}
+		requestArguments, err := n.RenderRequestArguments(ctx, log, request, requestedRule)
+		if err != nil {
+			log.Errorw("failed to generate request arguments, skipping including them in the slack message", "error", err)
+		}
+
+		// the request status is now DECLINED, so the updated message no longer includes the review actions.
+		_, reviewerMsg := BuildRequestReviewMessage(RequestMessageOpts{
+			Request:          request,
+			RequestArguments: requestArguments,
+			Rule:             requestedRule,
+			RequestorEmail:   requestingUser.Email,
+			ReviewURLs:       reviewURL,
+		})
+		reviewers := storage.ListRequestReviewers{RequestID: request.ID}
+		_, err = n.DB.Query(ctx, &reviewers)
+		if err != nil && err != ddb.ErrNoItems {
+			return err
+		}
+		for _, usr := range reviewers.Result {
+			err = n.UpdateMessageBlockForReviewer(ctx, usr, reviewerMsg)
+			if err != nil {
+				log.Errorw("failed to update slack message", "user", usr, zap.Error(err))
+			}
+		}
+
+		summary := fmt.Sprintf("%s's request to access %s was automatically declined because it was not reviewed in time.", requestingUser.Email, requestedRule.Name)
+		for _, webhook := range n.webhooks {
+			err = webhook.SendWebhookMessage(ctx, reviewerMsg.Blocks, summary)
+			if err != nil {
+				log.Errorw("failed to send auto-decline message to incomingWebhook channel", "error", err)
+			}
+		}
+	}
+	return nil
+}
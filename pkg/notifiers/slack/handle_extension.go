@@ -0,0 +1,63 @@
+package slacknotifier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/notifiers"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+	"go.uber.org/zap"
+)
+
+// handleExtensionRequested asks the reviewers of a request to review an extension of its grant.
+func (n *SlackNotifier) handleExtensionRequested(ctx context.Context, log *zap.SugaredLogger, request access.Request, requestedRule rule.AccessRule, requestingUser identity.User) error {
+	if request.Extension == nil {
+		return nil
+	}
+	reviewURL, err := notifiers.ReviewURL(n.FrontendURL, request.ID)
+	if err != nil {
+		return err
+	}
+	msg := fmt.Sprintf(":hourglass_flowing_sand: %s has requested to extend their access to *%s* by %s. <%s|Review the extension>.", requestingUser.Email, requestedRule.Name, request.Extension.ExtendBy, reviewURL.Review)
+	if request.Extension.Reason != nil && *request.Extension.Reason != "" {
+		msg += fmt.Sprintf("\n*Reason:* %s", *request.Extension.Reason)
+	}
+	fallback := fmt.Sprintf("%s has requested to extend their access to %s", requestingUser.Email, requestedRule.Name)
+
+	reviewers := storage.ListRequestReviewers{RequestID: request.ID}
+	_, err = n.DB.Query(ctx, &reviewers)
+	if err != nil && err != ddb.ErrNoItems {
+		return err
+	}
+	log.Infow("extension requested, messaging reviewers", "reviewers", reviewers.Result)
+	for _, r := range reviewers.Result {
+		if r.ReviewerID == request.RequestedBy {
+			continue
+		}
+		n.SendDMWithLogOnError(ctx, log, r.ReviewerID, msg, fallback)
+	}
+	n.sendWebhookText(ctx, log, msg, fallback)
+	return nil
+}
+
+// handleExtensionReviewed lets the requestor know whether their extension was approved.
+func (n *SlackNotifier) handleExtensionReviewed(ctx context.Context, log *zap.SugaredLogger, request access.Request, requestedRule rule.AccessRule, approved bool) {
+	if !approved {
+		msg := fmt.Sprintf("Your request to extend your access to *%s* has been declined.", requestedRule.Name)
+		fallback := fmt.Sprintf("Your request to extend your access to %s has been declined.", requestedRule.Name)
+		n.SendDMWithLogOnError(ctx, log, request.RequestedBy, msg, fallback)
+		return
+	}
+	var until string
+	if request.Grant != nil {
+		until = " until " + types.ExpiryString(request.Grant.End)
+	}
+	msg := fmt.Sprintf(":white_check_mark: Your access to *%s* has been extended%s.", requestedRule.Name, until)
+	fallback := fmt.Sprintf("Your access to %s has been extended.", requestedRule.Name)
+	n.SendDMWithLogOnError(ctx, log, request.RequestedBy, msg, fallback)
+}
@@ -4,14 +4,22 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-lambda-go/events"
 	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/notifiers"
 	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
 	"github.com/slack-go/slack"
 	"go.uber.org/zap"
 )
 
+// maxInstructionsLength is the maximum length of access instructions embedded in a message.
+// Slack rejects section blocks with more than 3000 characters of text.
+const maxInstructionsLength = 2900
+
 func (n *SlackNotifier) HandleGrantEvent(ctx context.Context, log *zap.SugaredLogger, event events.CloudWatchEvent) error {
 
 	var grantEvent gevent.GrantEventPayload
@@ -30,41 +38,118 @@ func (n *SlackNotifier) HandleGrantEvent(ctx context.Context, log *zap.SugaredLo
 	if err != nil {
 		return err
 	}
-	var msg string
-	var fallback string
-	var accessory *slack.Accessory
+	if n.directMessageClient == nil {
+		log.Infow("slack direct messages are not configured, skipping grant event", "detailType", event.DetailType)
+		return nil
+	}
 
-	// reviewURL, err := notifiers.ReviewURL(n.FrontendURL, gq.Result.ID)
-	// if err != nil {
-	// 	return err
-	// }
+	reviewURL, err := notifiers.ReviewURL(n.FrontendURL, gq.Result.ID)
+	if err != nil {
+		return err
+	}
 
 	// get the message text based on the event type
 	switch event.DetailType {
-	// NOTE: Commenting out slack notification for grant activated type to make notification less noisy.
-	// case gevent.GrantActivatedType:
-	// 	msg = fmt.Sprintf("Your access to *%s* is now active.", rq.Result.Name)
-	// 	accessory = &slack.Accessory{
-	// 		ButtonElement: &slack.ButtonBlockElement{
-	// 			Type: slack.METButton,
-	// 			Text: slack.NewTextBlockObject(slack.PlainTextType, "Access Instructions", true, false),
-	// 			URL:  reviewURL.AccessInstructions,
-	// 		},
-	// 	}
-	// 	fallback = fmt.Sprintf("Your access to %s is now active.", rq.Result.Name)
+	case gevent.GrantActivatedType:
+		iq := storage.GetRequestInstructions{ID: gq.Result.ID}
+		_, err = n.DB.Query(ctx, &iq)
+		if err != nil && err != ddb.ErrNoItems {
+			return err
+		}
+		var instructions string
+		if iq.Result != nil {
+			instructions = iq.Result.Instructions
+		}
+		summary, msg := BuildGrantActivatedMessage(GrantMessageOpts{
+			RuleName:     rq.Result.Name,
+			End:          grantEvent.Grant.End.Time,
+			Instructions: instructions,
+			ReviewURLs:   reviewURL,
+		})
+		_, err = SendMessageBlocks(ctx, n.directMessageClient.client, string(grantEvent.Grant.Subject), msg, summary)
+		return err
+	case gevent.GrantExpiringType:
+		summary, msg := BuildGrantExpiringMessage(GrantMessageOpts{
+			RuleName:   rq.Result.Name,
+			End:        grantEvent.Grant.End.Time,
+			ReviewURLs: reviewURL,
+		})
+		_, err = SendMessageBlocks(ctx, n.directMessageClient.client, string(grantEvent.Grant.Subject), msg, summary)
+		return err
 	case gevent.GrantFailedType:
-		msg = fmt.Sprintf("We've had an issue trying to provision or clean up your access to *%s*. We'll keep trying, but if you urgently need access to the role please contact your cloud administrator.", rq.Result.Name)
-		fallback = fmt.Sprintf("We've had an issue with your access to %s", rq.Result.Name)
+		msg := fmt.Sprintf("We've had an issue trying to provision or clean up your access to *%s*. We'll keep trying, but if you urgently need access to the role please contact your cloud administrator.", rq.Result.Name)
+		fallback := fmt.Sprintf("We've had an issue with your access to %s", rq.Result.Name)
+		_, err = SendMessage(ctx, n.directMessageClient.client, gq.Result.Grant.Subject, msg, fallback, nil)
+		return err
 	case gevent.GrantRevokedType:
-		msg = fmt.Sprintf("Your access to *%s* has been cancelled by your administrator. Please contact your cloud administrator for more information.", rq.Result.Name)
-		fallback = fmt.Sprintf("Your access to %s has been cancelled by your administrator", rq.Result.Name)
+		msg := fmt.Sprintf("Your access to *%s* has been cancelled by your administrator. Please contact your cloud administrator for more information.", rq.Result.Name)
+		fallback := fmt.Sprintf("Your access to %s has been cancelled by your administrator", rq.Result.Name)
+		_, err = SendMessage(ctx, n.directMessageClient.client, gq.Result.Grant.Subject, msg, fallback, nil)
+		return err
 	default:
 		zap.S().Infow("unhandled grant event", "detailType", event.DetailType)
 	}
-	if msg != "" {
+	return nil
+}
 
-		_, err = SendMessage(ctx, n.directMessageClient.client, gq.Result.Grant.Subject, msg, fallback, accessory)
-		return err
+type GrantMessageOpts struct {
+	RuleName string
+	// End is the time the grant expires.
+	End time.Time
+	// Instructions are the access instructions rendered by the provider. They are optional.
+	Instructions string
+	ReviewURLs   notifiers.ReviewURLs
+}
+
+// BuildGrantActivatedMessage builds the message sent to the requestor when their grant is activated.
+// The access instructions are embedded in the message if the provider rendered them, otherwise the message links to the instructions in the web app.
+func BuildGrantActivatedMessage(o GrantMessageOpts) (summary string, msg slack.Message) {
+	summary = fmt.Sprintf("Your access to %s is now active.", o.RuleName)
+	blocks := []slack.Block{
+		slack.NewSectionBlock(
+			slack.NewTextBlockObject(slack.MarkdownType, fmt.Sprintf(":white_check_mark: Your access to *%s* is now active until %s.", o.RuleName, types.ExpiryString(o.End)), false, false),
+			nil,
+			linkButton("View Request", o.ReviewURLs.Review),
+		),
+	}
+	if o.Instructions != "" {
+		instructions := o.Instructions
+		if r := []rune(instructions); len(r) > maxInstructionsLength {
+			instructions = fmt.Sprintf("%s…\n<%s|View the full access instructions>", string(r[:maxInstructionsLength]), o.ReviewURLs.AccessInstructions)
+		}
+		blocks = append(blocks,
+			slack.NewSectionBlock(slack.NewTextBlockObject(slack.MarkdownType, "*Access Instructions*", false, false), nil, nil),
+			slack.NewSectionBlock(slack.NewTextBlockObject(slack.MarkdownType, instructions, false, false), nil, nil),
+		)
+	} else {
+		blocks = append(blocks, slack.NewSectionBlock(
+			slack.NewTextBlockObject(slack.MarkdownType, fmt.Sprintf("<%s|View the access instructions>", o.ReviewURLs.AccessInstructions), false, false),
+			nil,
+			nil,
+		))
+	}
+	return summary, slack.NewBlockMessage(blocks...)
+}
+
+// BuildGrantExpiringMessage builds the reminder sent to the requestor before their grant expires, with a link to extend their access.
+func BuildGrantExpiringMessage(o GrantMessageOpts) (summary string, msg slack.Message) {
+	summary = fmt.Sprintf("Your access to %s is about to expire.", o.RuleName)
+	return summary, slack.NewBlockMessage(
+		slack.NewSectionBlock(
+			slack.NewTextBlockObject(slack.MarkdownType, fmt.Sprintf(":hourglass_flowing_sand: Your access to *%s* expires at %s. If you need more time, you can request an extension.", o.RuleName, types.ExpiryString(o.End)), false, false),
+			nil,
+			linkButton("Extend Access", o.ReviewURLs.Review),
+		),
+	)
+}
+
+// linkButton is a button accessory which opens the URL.
+func linkButton(text string, url string) *slack.Accessory {
+	return &slack.Accessory{
+		ButtonElement: &slack.ButtonBlockElement{
+			Type: slack.METButton,
+			Text: slack.NewTextBlockObject(slack.PlainTextType, text, true, false),
+			URL:  url,
+		},
 	}
-	return nil
 }
@@ -46,7 +46,8 @@ func (n *SlackNotifier) HandleRequestEvent(ctx context.Context, log *zap.Sugared
 		// only send slack notification if access request requires approval.
 		// if access request was automatically approved then no slack notification is sent.
 		// this is done to reduce slack notification noise. More here: CF-831
-		if requestedRule.Approval.IsRequired() {
+		// break-glass requests are granted immediately, and approvers are alerted by the break-glass used event instead.
+		if requestedRule.Approval.IsRequired() && !request.BreakGlass {
 			msg := fmt.Sprintf("Your request to access *%s* requires approval. We've notified the approvers and will let you know once your request has been reviewed.", requestedRule.Name)
 			fallback := fmt.Sprintf("Your request to access %s requires approval.", requestedRule.Name)
 			if n.directMessageClient != nil {
@@ -56,114 +57,154 @@ func (n *SlackNotifier) HandleRequestEvent(ctx context.Context, log *zap.Sugared
 				}
 			}
 
-			// Notify approvers
-			reviewURL, err := notifiers.ReviewURL(n.FrontendURL, request.ID)
+			err = n.notifyReviewers(ctx, log, request, requestedRule, *requestingUserQuery.Result, func(access.Reviewer) bool { return true })
 			if err != nil {
-				return errors.Wrap(err, "building review URL")
+				return err
 			}
+		}
+	case gevent.RequestApprovedType:
+		msg := fmt.Sprintf(":white_check_mark: Your request to access *%s* has been approved.", requestedRule.Name)
+		fallback := fmt.Sprintf("Your request to access %s has been approved.", requestedRule.Name)
+		n.sendRequestDetailsMessage(ctx, log, request, requestedRule, *requestingUserQuery.Result, msg, fallback)
+		n.SendUpdatesForRequest(ctx, log, request, requestEvent, requestedRule, requestingUserQuery.Result)
+	case gevent.RequestCancelledType:
+		n.SendUpdatesForRequest(ctx, log, request, requestEvent, requestedRule, requestingUserQuery.Result)
+	case gevent.RequestEscalatedType:
+		var escalation gevent.RequestEscalated
+		err = json.Unmarshal(event.Detail, &escalation)
+		if err != nil {
+			return err
+		}
+		return n.handleRequestEscalated(ctx, log, escalation, requestedRule, *requestingUserQuery.Result)
+	case gevent.RequestBreakGlassUsedType:
+		var used gevent.RequestBreakGlassUsed
+		err = json.Unmarshal(event.Detail, &used)
+		if err != nil {
+			return err
+		}
+		return n.handleBreakGlassUsed(ctx, log, used, requestedRule, *requestingUserQuery.Result)
+	case gevent.RequestBreakGlassReviewedType:
+		var reviewed gevent.RequestBreakGlassReviewed
+		err = json.Unmarshal(event.Detail, &reviewed)
+		if err != nil {
+			return err
+		}
+		return n.handleBreakGlassReviewed(ctx, log, reviewed, requestedRule, *requestingUserQuery.Result)
+	case gevent.RequestExtensionRequestedType:
+		return n.handleExtensionRequested(ctx, log, request, requestedRule, *requestingUserQuery.Result)
+	case gevent.RequestExtensionApprovedType:
+		n.handleExtensionReviewed(ctx, log, request, requestedRule, true)
+	case gevent.RequestExtensionDeclinedType:
+		n.handleExtensionReviewed(ctx, log, request, requestedRule, false)
+	case gevent.RequestDeclinedType:
+		msg := fmt.Sprintf("Your request to access *%s* has been declined.", requestedRule.Name)
+		fallback := fmt.Sprintf("Your request to access %s has been declined.", requestedRule.Name)
+		n.SendDMWithLogOnError(ctx, log, request.RequestedBy, msg, fallback)
+		n.SendUpdatesForRequest(ctx, log, request, requestEvent, requestedRule, requestingUserQuery.Result)
+	}
+	return nil
+}
 
-			reviewers := storage.ListRequestReviewers{RequestID: request.ID}
-			_, err = n.DB.Query(ctx, &reviewers)
-			if err != nil && err != ddb.ErrNoItems {
-				return errors.Wrap(err, "getting reviewers")
-			}
+// notifyReviewers sends the review message for a request to any configured webhook channels, and to each reviewer of the request
+// for which include returns true.
+func (n *SlackNotifier) notifyReviewers(ctx context.Context, log *zap.SugaredLogger, request access.Request, requestedRule rule.AccessRule, requestingUser identity.User, include func(access.Reviewer) bool) error {
+	reviewURL, err := notifiers.ReviewURL(n.FrontendURL, request.ID)
+	if err != nil {
+		return errors.Wrap(err, "building review URL")
+	}
 
-			log.Infow("messaging reviewers", "reviewers", reviewers)
+	reviewers := storage.ListRequestReviewers{RequestID: request.ID}
+	_, err = n.DB.Query(ctx, &reviewers)
+	if err != nil && err != ddb.ErrNoItems {
+		return errors.Wrap(err, "getting reviewers")
+	}
 
-			requestArguments, err := n.RenderRequestArguments(ctx, log, request, requestedRule)
-			if err != nil {
-				log.Errorw("failed to generate request arguments, skipping including them in the slack message", "error", err)
-			}
-			// for webhooks
-			reviewerSummary, reviewerMsg := BuildRequestReviewMessage(RequestMessageOpts{
-				Request:          request,
-				RequestArguments: requestArguments,
-				Rule:             requestedRule,
-				RequestorEmail:   requestingUserQuery.Result.Email,
-				ReviewURLs:       reviewURL,
-				IsWebhook:        true,
-			})
+	log.Infow("messaging reviewers", "reviewers", reviewers)
 
-			// log for testing purposes
-			if len(n.webhooks) > 0 {
-				log.Infow("webhooks found", "webhooks", n.webhooks)
-			}
+	requestArguments, err := n.RenderRequestArguments(ctx, log, request, requestedRule)
+	if err != nil {
+		log.Errorw("failed to generate request arguments, skipping including them in the slack message", "error", err)
+	}
+	// for webhooks
+	reviewerSummary, reviewerMsg := BuildRequestReviewMessage(RequestMessageOpts{
+		Request:          request,
+		RequestArguments: requestArguments,
+		Rule:             requestedRule,
+		RequestorEmail:   requestingUser.Email,
+		ReviewURLs:       reviewURL,
+		IsWebhook:        true,
+	})
 
-			// send the review message to any configured webhook channels channels
-			for _, webhook := range n.webhooks {
-				err = webhook.SendWebhookMessage(ctx, reviewerMsg.Blocks, reviewerSummary)
-				if err != nil {
-					log.Errorw("failed to send review message to incomingWebhook channel", "error", err)
-				}
+	// log for testing purposes
+	if len(n.webhooks) > 0 {
+		log.Infow("webhooks found", "webhooks", n.webhooks)
+	}
+
+	// send the review message to any configured webhook channels channels
+	for _, webhook := range n.webhooks {
+		err = webhook.SendWebhookMessage(ctx, reviewerMsg.Blocks, reviewerSummary)
+		if err != nil {
+			log.Errorw("failed to send review message to incomingWebhook channel", "error", err)
+		}
+	}
+	if n.directMessageClient != nil {
+		// get the requestor's Slack user ID if it exists to render it nicely in the message to approvers.
+		var slackUserID string
+		requestor, err := n.directMessageClient.client.GetUserByEmailContext(ctx, requestingUser.Email)
+		if err != nil {
+			zap.S().Infow("couldn't get slack user from requestor - falling back to email address", "requestor.id", requestingUser.ID, zap.Error(err))
+		}
+		if requestor != nil {
+			slackUserID = requestor.ID
+		}
+		reviewerSummary, reviewerMsg := BuildRequestReviewMessage(RequestMessageOpts{
+			Request:          request,
+			RequestArguments: requestArguments,
+			Rule:             requestedRule,
+			RequestorSlackID: slackUserID,
+			RequestorEmail:   requestingUser.Email,
+			ReviewURLs:       reviewURL,
+			IsWebhook:        false,
+			Interactive:      n.interactivity != nil,
+		})
+
+		var wg sync.WaitGroup
+		for _, usr := range reviewers.Result {
+			if !include(usr) {
+				continue
 			}
-			if n.directMessageClient != nil {
-				// get the requestor's Slack user ID if it exists to render it nicely in the message to approvers.
-				var slackUserID string
-				requestor, err := n.directMessageClient.client.GetUserByEmailContext(ctx, requestingUserQuery.Result.Email)
+			if usr.ReviewerID == request.RequestedBy {
+				log.Infow("skipping sending approval message to requestor", "user.id", usr)
+				continue
+			}
+			wg.Add(1)
+			go func(usr access.Reviewer) {
+				defer wg.Done()
+				approver := storage.GetUser{ID: usr.ReviewerID}
+				_, err := n.DB.Query(ctx, &approver)
 				if err != nil {
-					zap.S().Infow("couldn't get slack user from requestor - falling back to email address", "requestor.id", requestingUserQuery.Result.ID, zap.Error(err))
+					log.Errorw("failed to fetch user by id while trying to send message in slack", "user.id", usr, zap.Error(err))
+					return
 				}
-				if requestor != nil {
-					slackUserID = requestor.ID
+				ts, err := SendMessageBlocks(ctx, n.directMessageClient.client, approver.Result.Email, reviewerMsg, reviewerSummary)
+				if err != nil {
+					log.Errorw("failed to send request approval message", "user", usr, zap.Error(err))
 				}
-				reviewerSummary, reviewerMsg := BuildRequestReviewMessage(RequestMessageOpts{
-					Request:          request,
-					RequestArguments: requestArguments,
-					Rule:             requestedRule,
-					RequestorSlackID: slackUserID,
-					RequestorEmail:   requestingUserQuery.Result.Email,
-					ReviewURLs:       reviewURL,
-					IsWebhook:        false,
-				})
-
-				var wg sync.WaitGroup
-				for _, usr := range reviewers.Result {
-					if usr.ReviewerID == request.RequestedBy {
-						log.Infow("skipping sending approval message to requestor", "user.id", usr)
-						continue
-					}
-					wg.Add(1)
-					go func(usr access.Reviewer) {
-						defer wg.Done()
-						approver := storage.GetUser{ID: usr.ReviewerID}
-						_, err := n.DB.Query(ctx, &approver)
-						if err != nil {
-							log.Errorw("failed to fetch user by id while trying to send message in slack", "user.id", usr, zap.Error(err))
-							return
-						}
-						ts, err := SendMessageBlocks(ctx, n.directMessageClient.client, approver.Result.Email, reviewerMsg, reviewerSummary)
-						if err != nil {
-							log.Errorw("failed to send request approval message", "user", usr, "msg", msg, zap.Error(err))
-						}
 
-						updatedUsr := usr
-						updatedUsr.Notifications = access.Notifications{
-							SlackMessageID: &ts,
-						}
-						log.Infow("updating reviewer with slack msg id", "updatedUsr.SlackMessageID", ts)
+				updatedUsr := usr
+				updatedUsr.Notifications = access.Notifications{
+					SlackMessageID: &ts,
+				}
+				log.Infow("updating reviewer with slack msg id", "updatedUsr.SlackMessageID", ts)
 
-						err = n.DB.Put(ctx, &updatedUsr)
+				err = n.DB.Put(ctx, &updatedUsr)
 
-						if err != nil {
-							log.Errorw("failed to update reviewer", "user", usr, zap.Error(err))
-						}
-					}(usr)
+				if err != nil {
+					log.Errorw("failed to update reviewer", "user", usr, zap.Error(err))
 				}
-				wg.Wait()
-			}
+			}(usr)
 		}
-	case gevent.RequestApprovedType:
-		msg := fmt.Sprintf(":white_check_mark: Your request to access *%s* has been approved.", requestedRule.Name)
-		fallback := fmt.Sprintf("Your request to access %s has been approved.", requestedRule.Name)
-		n.sendRequestDetailsMessage(ctx, log, request, requestedRule, *requestingUserQuery.Result, msg, fallback)
-		n.SendUpdatesForRequest(ctx, log, request, requestEvent, requestedRule, requestingUserQuery.Result)
-	case gevent.RequestCancelledType:
-		n.SendUpdatesForRequest(ctx, log, request, requestEvent, requestedRule, requestingUserQuery.Result)
-	case gevent.RequestDeclinedType:
-		msg := fmt.Sprintf("Your request to access *%s* has been declined.", requestedRule.Name)
-		fallback := fmt.Sprintf("Your request to access %s has been declined.", requestedRule.Name)
-		n.SendDMWithLogOnError(ctx, log, request.RequestedBy, msg, fallback)
-		n.SendUpdatesForRequest(ctx, log, request, requestEvent, requestedRule, requestingUserQuery.Result)
+		wg.Wait()
 	}
 	return nil
 }
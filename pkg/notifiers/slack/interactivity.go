@@ -0,0 +1,379 @@
+package slacknotifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/notifiers"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/service/accesssvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+	"github.com/pkg/errors"
+	"github.com/slack-go/slack"
+	"go.uber.org/zap"
+)
+
+//go:generate go run github.com/golang/mock/mockgen -destination=mocks/request_reviewer.go -package=mocks . RequestReviewer
+
+// RequestReviewer adds reviews to access requests. It is implemented by accesssvc.Service.
+type RequestReviewer interface {
+	AddReviewAndGrantAccess(ctx context.Context, opts accesssvc.AddReviewOpts) (*accesssvc.AddReviewResult, error)
+}
+
+// SlackUserLookup looks up Slack users by ID. It is implemented by slack.Client.
+type SlackUserLookup interface {
+	GetUserInfoContext(ctx context.Context, user string) (*slack.User, error)
+	OpenViewContext(ctx context.Context, triggerID string, view slack.ModalViewRequest) (*slack.ViewResponse, error)
+	PostEphemeralContext(ctx context.Context, channelID, userID string, options ...slack.MsgOption) (string, error)
+	PostMessageContext(ctx context.Context, channelID string, options ...slack.MsgOption) (string, string, error)
+}
+
+var errNotReviewer = errors.New("you are not a reviewer of this request")
+
+// InteractivityHandler handles requests from Slack when a reviewer clicks the review buttons
+// in a request message, or submits the review modal.
+//
+// Requests are verified using the signing secret of the Slack app. The Slack user is matched to
+// a reviewer of the request by email, and the review is added as that reviewer.
+//
+// Slack only waits 3 seconds for a response, and adding a review can take longer as it may provision access,
+// so reviews are acknowledged straight away and added afterwards if Defer is set.
+type InteractivityHandler struct {
+	DB            ddb.Storage
+	Access        RequestReviewer
+	Notifier      *SlackNotifier
+	SigningSecret string
+	// Slack defaults to the direct message client of the Notifier if nil.
+	Slack SlackUserLookup
+	// Defer hands the review off to be added after it has been acknowledged.
+	// If Defer is nil, the review is added before responding.
+	Defer DeferFunc
+}
+
+func (h *InteractivityHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := zap.S()
+
+	body, err := verifyRequest(r, h.SigningSecret)
+	if err != nil {
+		log.Infow("invalid slack interactivity request", zap.Error(err))
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+	var cb slack.InteractionCallback
+	err = json.Unmarshal([]byte(form.Get("payload")), &cb)
+	if err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	switch cb.Type {
+	case slack.InteractionTypeBlockActions:
+		// the trigger ID used to open the review modal expires after 3 seconds, so opening it isn't deferred.
+		if !opensReviewModal(cb) && deferRequest(ctx, log, h.Defer) {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		h.handleBlockActions(ctx, log, cb)
+		w.WriteHeader(http.StatusOK)
+	case slack.InteractionTypeViewSubmission:
+		if cb.View.CallbackID != ReviewModalCallbackID {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		submission, errs := ParseReviewModal(cb.View)
+		if errs != nil {
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(slack.NewErrorsViewSubmissionResponse(errs))
+			return
+		}
+		if !deferRequest(ctx, log, h.Defer) {
+			h.handleReviewModal(ctx, log, cb, submission)
+		}
+		// an empty response closes the modal.
+		w.WriteHeader(http.StatusOK)
+	default:
+		log.Infow("ignoring unhandled slack interaction", "type", cb.Type)
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+func (h *InteractivityHandler) slackClient() SlackUserLookup {
+	if h.Slack != nil {
+		return h.Slack
+	}
+	if h.Notifier != nil && h.Notifier.directMessageClient != nil {
+		return h.Notifier.directMessageClient.client
+	}
+	return nil
+}
+
+// opensReviewModal returns true if the interaction is a click on the button which opens the review modal.
+func opensReviewModal(cb slack.InteractionCallback) bool {
+	for _, action := range cb.ActionCallback.BlockActions {
+		if action.ActionID == ActionReview {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *InteractivityHandler) handleBlockActions(ctx context.Context, log *zap.SugaredLogger, cb slack.InteractionCallback) {
+	for _, action := range cb.ActionCallback.BlockActions {
+		requestID := action.Value
+		var err error
+		switch action.ActionID {
+		case ActionApprove:
+			err = h.review(ctx, log, cb.User.ID, ReviewModalSubmission{RequestID: requestID, Decision: access.DecisionApproved})
+		case ActionDeny:
+			err = h.review(ctx, log, cb.User.ID, ReviewModalSubmission{RequestID: requestID, Decision: access.DecisionDECLINED})
+		case ActionReview:
+			err = h.openReviewModal(ctx, cb.TriggerID, requestID)
+		default:
+			continue
+		}
+		if err != nil {
+			log.Errorw("failed to handle slack review action", "action", action.ActionID, "request.id", requestID, zap.Error(err))
+			h.sendError(ctx, log, cb, err)
+		}
+	}
+}
+
+func (h *InteractivityHandler) openReviewModal(ctx context.Context, triggerID string, requestID string) error {
+	q := storage.GetRequest{ID: requestID}
+	_, err := h.DB.Query(ctx, &q)
+	if err != nil {
+		return errors.Wrap(err, "getting request")
+	}
+	rq := storage.GetAccessRuleCurrent{ID: q.Result.Rule}
+	_, err = h.DB.Query(ctx, &rq)
+	if err != nil {
+		return errors.Wrap(err, "getting access rule")
+	}
+	client := h.slackClient()
+	if client == nil {
+		return errors.New("slack is not configured")
+	}
+	_, err = client.OpenViewContext(ctx, triggerID, BuildReviewModal(*q.Result, rq.Result.Name))
+	return err
+}
+
+// handleReviewModal reviews the request using the submitted modal.
+// The modal is closed once it has been submitted, so an error is sent to the reviewer in a message.
+func (h *InteractivityHandler) handleReviewModal(ctx context.Context, log *zap.SugaredLogger, cb slack.InteractionCallback, submission ReviewModalSubmission) {
+	err := h.review(ctx, log, cb.User.ID, submission)
+	if err != nil {
+		log.Errorw("failed to review request from slack modal", "request.id", submission.RequestID, zap.Error(err))
+		h.sendError(ctx, log, cb, err)
+	}
+}
+
+var errDurationTooLong = errors.New("the duration is longer than the maximum duration of the access rule")
+
+// review adds the review as the reviewer matching the Slack user, and then updates
+// the request message of every reviewer.
+func (h *InteractivityHandler) review(ctx context.Context, log *zap.SugaredLogger, slackUserID string, submission ReviewModalSubmission) error {
+	client := h.slackClient()
+	if client == nil {
+		return errors.New("slack is not configured")
+	}
+	user, err := lookupUser(ctx, h.DB, client, slackUserID)
+	if err == errUserNotFound {
+		return errNotReviewer
+	}
+	if err != nil {
+		return err
+	}
+
+	q := storage.GetRequest{ID: submission.RequestID}
+	_, err = h.DB.Query(ctx, &q)
+	if err != nil {
+		return errors.Wrap(err, "getting request")
+	}
+	request := *q.Result
+	rq := storage.GetAccessRuleCurrent{ID: request.Rule}
+	_, err = h.DB.Query(ctx, &rq)
+	if err != nil {
+		return errors.Wrap(err, "getting access rule")
+	}
+	accessRule := *rq.Result
+	reviewers := storage.ListRequestReviewers{RequestID: request.ID}
+	_, err = h.DB.Query(ctx, &reviewers)
+	if err != nil && err != ddb.ErrNoItems {
+		return errors.Wrap(err, "getting reviewers")
+	}
+
+	var isReviewer bool
+	for _, r := range reviewers.Result {
+		if r.ReviewerID == user.ID {
+			isReviewer = true
+			break
+		}
+	}
+	if !isReviewer {
+		return errNotReviewer
+	}
+
+	var overrideTiming *access.Timing
+	if submission.Duration != nil && *submission.Duration != request.RequestedTiming.Duration {
+		maxDuration := time.Duration(accessRule.TimeConstraints.MaxDurationSeconds) * time.Second
+		if *submission.Duration > maxDuration {
+			return errDurationTooLong
+		}
+		// only the duration is changed by the reviewer, the start time and any recurrence are kept.
+		timing := request.RequestedTiming
+		timing.Duration = *submission.Duration
+		overrideTiming = &timing
+	}
+
+	result, err := h.Access.AddReviewAndGrantAccess(ctx, accesssvc.AddReviewOpts{
+		ReviewerID:     user.ID,
+		ReviewerEmail:  user.Email,
+		Reviewers:      reviewers.Result,
+		Decision:       submission.Decision,
+		Comment:        submission.Comment,
+		OverrideTiming: overrideTiming,
+		Request:        request,
+		AccessRule:     accessRule,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Infow("reviewed request from slack", "request.id", request.ID, "reviewer.id", user.ID, "decision", submission.Decision)
+	h.updateReviewerMessages(ctx, log, result.Request, accessRule, reviewers.Result, user)
+	return nil
+}
+
+// updateReviewerMessages replaces the review actions in each reviewer's message with the outcome of the review.
+func (h *InteractivityHandler) updateReviewerMessages(ctx context.Context, log *zap.SugaredLogger, request access.Request, accessRule rule.AccessRule, reviewers []access.Reviewer, reviewer *identity.User) {
+	if h.Notifier == nil {
+		return
+	}
+	requestor := storage.GetUser{ID: request.RequestedBy}
+	_, err := h.DB.Query(ctx, &requestor)
+	if err != nil {
+		log.Errorw("failed to get requestor, skipping updating slack messages", zap.Error(err))
+		return
+	}
+	reviewURL, err := notifiers.ReviewURL(h.Notifier.FrontendURL, request.ID)
+	if err != nil {
+		log.Errorw("failed to build review URL, skipping updating slack messages", zap.Error(err))
+		return
+	}
+	requestArguments, err := h.Notifier.RenderRequestArguments(ctx, log, request, accessRule)
+	if err != nil {
+		log.Errorw("failed to generate request arguments, skipping including them in the slack message", "error", err)
+	}
+	_, msg := BuildRequestReviewMessage(RequestMessageOpts{
+		Request:          request,
+		RequestArguments: requestArguments,
+		Rule:             accessRule,
+		RequestorEmail:   requestor.Result.Email,
+		ReviewURLs:       reviewURL,
+		WasReviewed:      request.Status != access.PENDING,
+		RequestReviewer:  reviewer,
+		// the request may still need a review from another reviewer, who can review it inside Slack.
+		Interactive: true,
+	})
+	for _, r := range reviewers {
+		err = h.Notifier.UpdateMessageBlockForReviewer(ctx, r, msg)
+		if err != nil {
+			log.Errorw("failed to update slack message", "user", r, zap.Error(err))
+		}
+	}
+}
+
+// sendError lets the Slack user know that their review wasn't applied.
+func (h *InteractivityHandler) sendError(ctx context.Context, log *zap.SugaredLogger, cb slack.InteractionCallback, err error) {
+	client := h.slackClient()
+	if client == nil {
+		return
+	}
+	text := fmt.Sprintf(":warning: Your review couldn't be completed: %s", userFacingError(err))
+	// interactions with the review modal aren't in a channel, so the error is sent as a direct message.
+	if cb.Channel.ID == "" {
+		_, _, err = client.PostMessageContext(ctx, cb.User.ID, slack.MsgOptionText(text, false))
+	} else {
+		_, err = client.PostEphemeralContext(ctx, cb.Channel.ID, cb.User.ID, slack.MsgOptionText(text, false))
+	}
+	if err != nil {
+		log.Errorw("failed to send slack error message", zap.Error(err))
+	}
+}
+
+var errUserNotFound = errors.New("your Slack account doesn't match a Common Fate user")
+
+// verifyRequest checks that the request was signed by Slack using the signing secret, and returns the body of the request.
+// The body of the request is replaced, so that it can be read again by the caller.
+func verifyRequest(r *http.Request, signingSecret string) ([]byte, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	sv, err := slack.NewSecretsVerifier(r.Header, signingSecret)
+	if err != nil {
+		return nil, err
+	}
+	_, _ = sv.Write(body)
+	err = sv.Ensure()
+	if err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// lookupUser finds the Common Fate user matching the email address of the Slack user.
+func lookupUser(ctx context.Context, db ddb.Storage, client SlackUserLookup, slackUserID string) (*identity.User, error) {
+	slackUser, err := client.GetUserInfoContext(ctx, slackUserID)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting slack user")
+	}
+	uq := storage.GetUserByEmail{Email: slackUser.Profile.Email}
+	_, err = db.Query(ctx, &uq)
+	if err == ddb.ErrNoItems {
+		return nil, errUserNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "getting user")
+	}
+	return uq.Result, nil
+}
+
+// userFacingError returns a message for errors which are safe to show to the reviewer.
+func userFacingError(err error) string {
+	switch err {
+	case errNotReviewer, errDurationTooLong,
+		accesssvc.ErrUserNotAuthorized,
+		accesssvc.ErrReviewerAlreadyApproved,
+		accesssvc.ErrNotApproverForStep,
+		accesssvc.ErrRequestOverlapsExistingGrant:
+		return err.Error()
+	}
+	if _, ok := err.(accesssvc.InvalidStatusError); ok {
+		return err.Error()
+	}
+	// validation errors, such as an invalid duration for the access rule, explain what to change.
+	var apiErr *apio.APIError
+	if errors.As(err, &apiErr) && len(apiErr.Fields) > 0 {
+		return apiErr.Fields[0].Error
+	}
+	return "something went wrong. Please try again from the web app."
+}
@@ -0,0 +1,362 @@
+package slacknotifier
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/notifiers/slack/mocks"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/service/accesssvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/golang/mock/gomock"
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeSlack struct {
+	email     string
+	ephemeral []string
+	messages  []string
+	views     []slack.ModalViewRequest
+}
+
+func (f *fakeSlack) GetUserInfoContext(ctx context.Context, user string) (*slack.User, error) {
+	return &slack.User{ID: user, Profile: slack.UserProfile{Email: f.email}}, nil
+}
+
+func (f *fakeSlack) OpenViewContext(ctx context.Context, triggerID string, view slack.ModalViewRequest) (*slack.ViewResponse, error) {
+	f.views = append(f.views, view)
+	return &slack.ViewResponse{}, nil
+}
+
+func (f *fakeSlack) PostEphemeralContext(ctx context.Context, channelID, userID string, options ...slack.MsgOption) (string, error) {
+	f.ephemeral = append(f.ephemeral, userID)
+	return "", nil
+}
+
+func (f *fakeSlack) PostMessageContext(ctx context.Context, channelID string, options ...slack.MsgOption) (string, string, error) {
+	f.messages = append(f.messages, channelID)
+	return "", "", nil
+}
+
+// signedRequest builds an interactivity request signed in the same way as Slack.
+func signedRequest(t *testing.T, secret string, cb slack.InteractionCallback) *http.Request {
+	payload, err := json.Marshal(cb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := url.Values{"payload": {string(payload)}}.Encode()
+	return signedBody(secret, "/webhook/v1/slack/interactivity", body)
+}
+
+// signedBody builds a form request signed in the same way as Slack.
+func signedBody(secret string, path string, body string) *http.Request {
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "v0:%s:%s", ts, body)
+
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("X-Slack-Request-Timestamp", ts)
+	req.Header.Set("X-Slack-Signature", "v0="+hex.EncodeToString(mac.Sum(nil)))
+	return req
+}
+
+func approveCallback(requestID string) slack.InteractionCallback {
+	return slack.InteractionCallback{
+		Type:    slack.InteractionTypeBlockActions,
+		User:    slack.User{ID: "U123"},
+		Channel: slack.Channel{GroupConversation: slack.GroupConversation{Conversation: slack.Conversation{ID: "D123"}}},
+		ActionCallback: slack.ActionCallbacks{
+			BlockActions: []*slack.BlockAction{{ActionID: ActionApprove, Value: requestID}},
+		},
+	}
+}
+
+func TestInteractivityHandlerRejectsInvalidSignature(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	h := InteractivityHandler{
+		DB:            ddbmock.New(t),
+		Access:        mocks.NewMockRequestReviewer(ctrl),
+		SigningSecret: "secret",
+		Slack:         &fakeSlack{},
+	}
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, signedRequest(t, "other", approveCallback("req_1")))
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+}
+
+func TestInteractivityHandlerApprove(t *testing.T) {
+	request := access.Request{ID: "req_1", Rule: "rul_1", RequestedBy: "usr_requestor", Status: access.PENDING}
+	accessRule := rule.AccessRule{ID: "rul_1", Name: "prod"}
+	reviewer := identity.User{ID: "usr_reviewer", Email: "reviewer@example.com"}
+
+	tests := []struct {
+		name          string
+		email         string
+		reviewers     []access.Reviewer
+		wantReview    bool
+		wantEphemeral bool
+	}{
+		{
+			name:       "reviewer approves",
+			email:      reviewer.Email,
+			reviewers:  []access.Reviewer{{ReviewerID: reviewer.ID, Request: request}},
+			wantReview: true,
+		},
+		{
+			name:          "user is not a reviewer",
+			email:         reviewer.Email,
+			reviewers:     []access.Reviewer{{ReviewerID: "usr_other", Request: request}},
+			wantEphemeral: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.GetUserByEmail{Result: &reviewer})
+			db.MockQuery(&storage.GetRequest{Result: &request})
+			db.MockQuery(&storage.GetAccessRuleCurrent{Result: &accessRule})
+			db.MockQuery(&storage.ListRequestReviewers{Result: tc.reviewers})
+
+			reviewerSvc := mocks.NewMockRequestReviewer(ctrl)
+			if tc.wantReview {
+				reviewerSvc.EXPECT().AddReviewAndGrantAccess(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, opts accesssvc.AddReviewOpts) (*accesssvc.AddReviewResult, error) {
+					assert.Equal(t, reviewer.ID, opts.ReviewerID)
+					assert.Equal(t, access.DecisionApproved, opts.Decision)
+					assert.False(t, opts.ReviewerIsAdmin)
+					assert.Nil(t, opts.OverrideTiming)
+					return &accesssvc.AddReviewResult{Request: request}, nil
+				})
+			}
+
+			fs := &fakeSlack{email: tc.email}
+			h := InteractivityHandler{
+				DB:            db,
+				Access:        reviewerSvc,
+				SigningSecret: "secret",
+				Slack:         fs,
+			}
+			rr := httptest.NewRecorder()
+			h.ServeHTTP(rr, signedRequest(t, "secret", approveCallback(request.ID)))
+			assert.Equal(t, http.StatusOK, rr.Code)
+			assert.Equal(t, tc.wantEphemeral, len(fs.ephemeral) > 0)
+		})
+	}
+}
+
+func TestInteractivityHandlerReviewModalDurationTooLong(t *testing.T) {
+	request := access.Request{ID: "req_1", Rule: "rul_1", Status: access.PENDING, RequestedTiming: access.Timing{Duration: time.Hour}}
+	accessRule := rule.AccessRule{ID: "rul_1", TimeConstraints: types.TimeConstraints{MaxDurationSeconds: 3600}}
+	reviewer := identity.User{ID: "usr_reviewer", Email: "reviewer@example.com"}
+
+	db := ddbmock.New(t)
+	db.MockQuery(&storage.GetUserByEmail{Result: &reviewer})
+	db.MockQuery(&storage.GetRequest{Result: &request})
+	db.MockQuery(&storage.GetAccessRuleCurrent{Result: &accessRule})
+	db.MockQuery(&storage.ListRequestReviewers{Result: []access.Reviewer{{ReviewerID: reviewer.ID}}})
+
+	fs := &fakeSlack{email: reviewer.Email}
+	h := InteractivityHandler{
+		DB:            db,
+		Access:        mocks.NewMockRequestReviewer(gomock.NewController(t)),
+		SigningSecret: "secret",
+		Slack:         fs,
+	}
+	cb := slack.InteractionCallback{
+		Type: slack.InteractionTypeViewSubmission,
+		User: slack.User{ID: "U123"},
+		View: slack.View{
+			CallbackID:      ReviewModalCallbackID,
+			PrivateMetadata: request.ID,
+			State: &slack.ViewState{Values: map[string]map[string]slack.BlockAction{
+				reviewModalDurationBlock: {reviewModalDurationBlock: {Value: "2h"}},
+			}},
+		},
+	}
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, signedRequest(t, "secret", cb))
+	assert.Equal(t, http.StatusOK, rr.Code)
+	// the modal is closed, and the error is sent to the reviewer in a direct message.
+	assert.Empty(t, rr.Body.Bytes())
+	assert.Equal(t, []string{"U123"}, fs.messages)
+}
+
+func TestInteractivityHandlerDefer(t *testing.T) {
+	request := access.Request{ID: "req_1", Rule: "rul_1", Status: access.PENDING}
+	accessRule := rule.AccessRule{ID: "rul_1", Name: "prod"}
+
+	tests := []struct {
+		name      string
+		cb        slack.InteractionCallback
+		wantDefer bool
+		wantViews int
+	}{
+		{
+			name:      "approve is deferred",
+			cb:        approveCallback(request.ID),
+			wantDefer: true,
+		},
+		{
+			name: "opening the review modal isn't deferred",
+			cb: slack.InteractionCallback{
+				Type:      slack.InteractionTypeBlockActions,
+				User:      slack.User{ID: "U123"},
+				TriggerID: "trigger",
+				ActionCallback: slack.ActionCallbacks{
+					BlockActions: []*slack.BlockAction{{ActionID: ActionReview, Value: request.ID}},
+				},
+			},
+			wantViews: 1,
+		},
+		{
+			name: "review modal is deferred",
+			cb: slack.InteractionCallback{
+				Type: slack.InteractionTypeViewSubmission,
+				User: slack.User{ID: "U123"},
+				View: slack.View{
+					CallbackID:      ReviewModalCallbackID,
+					PrivateMetadata: request.ID,
+					State:           &slack.ViewState{},
+				},
+			},
+			wantDefer: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.GetRequest{Result: &request})
+			db.MockQuery(&storage.GetAccessRuleCurrent{Result: &accessRule})
+
+			var deferred int
+			fs := &fakeSlack{}
+			h := InteractivityHandler{
+				DB:            db,
+				Access:        mocks.NewMockRequestReviewer(gomock.NewController(t)),
+				SigningSecret: "secret",
+				Slack:         fs,
+				Defer: func(ctx context.Context) error {
+					deferred++
+					return nil
+				},
+			}
+			rr := httptest.NewRecorder()
+			h.ServeHTTP(rr, signedRequest(t, "secret", tc.cb))
+			assert.Equal(t, http.StatusOK, rr.Code)
+			assert.Empty(t, rr.Body.Bytes())
+			assert.Equal(t, tc.wantDefer, deferred == 1)
+			assert.Len(t, fs.views, tc.wantViews)
+		})
+	}
+}
+
+func TestInteractivityHandlerReviewModalKeepsRecurrence(t *testing.T) {
+	start := time.Date(2022, 1, 3, 9, 0, 0, 0, time.UTC)
+	recurrence := access.Recurrence{Frequency: access.FrequencyWeekly, Interval: 1, Until: start.AddDate(0, 1, 0)}
+	request := access.Request{ID: "req_1", Rule: "rul_1", Status: access.PENDING, RequestedTiming: access.Timing{Duration: 2 * time.Hour, StartTime: &start, Recurrence: &recurrence}}
+	accessRule := rule.AccessRule{ID: "rul_1", TimeConstraints: types.TimeConstraints{MaxDurationSeconds: 7200}}
+	reviewer := identity.User{ID: "usr_reviewer", Email: "reviewer@example.com"}
+
+	db := ddbmock.New(t)
+	db.MockQuery(&storage.GetUserByEmail{Result: &reviewer})
+	db.MockQuery(&storage.GetRequest{Result: &request})
+	db.MockQuery(&storage.GetAccessRuleCurrent{Result: &accessRule})
+	db.MockQuery(&storage.ListRequestReviewers{Result: []access.Reviewer{{ReviewerID: reviewer.ID}}})
+
+	reviewerSvc := mocks.NewMockRequestReviewer(gomock.NewController(t))
+	reviewerSvc.EXPECT().AddReviewAndGrantAccess(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, opts accesssvc.AddReviewOpts) (*accesssvc.AddReviewResult, error) {
+		// only the duration is overridden by the reviewer.
+		want := access.Timing{Duration: time.Hour, StartTime: &start, Recurrence: &recurrence}
+		assert.Equal(t, &want, opts.OverrideTiming)
+		return &accesssvc.AddReviewResult{Request: request}, nil
+	})
+
+	h := InteractivityHandler{
+		DB:            db,
+		Access:        reviewerSvc,
+		SigningSecret: "secret",
+		Slack:         &fakeSlack{email: reviewer.Email},
+	}
+	cb := slack.InteractionCallback{
+		Type: slack.InteractionTypeViewSubmission,
+		User: slack.User{ID: "U123"},
+		View: slack.View{
+			CallbackID:      ReviewModalCallbackID,
+			PrivateMetadata: request.ID,
+			State: &slack.ViewState{Values: map[string]map[string]slack.BlockAction{
+				reviewModalDurationBlock: {reviewModalDurationBlock: {Value: "1h"}},
+			}},
+		},
+	}
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, signedRequest(t, "secret", cb))
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestParseReviewModal(t *testing.T) {
+	state := func(values map[string]string) *slack.ViewState {
+		s := slack.ViewState{Values: map[string]map[string]slack.BlockAction{}}
+		for block, v := range values {
+			if block == reviewModalDecisionBlock {
+				s.Values[block] = map[string]slack.BlockAction{block: {SelectedOption: slack.OptionBlockObject{Value: v}}}
+				continue
+			}
+			s.Values[block] = map[string]slack.BlockAction{block: {Value: v}}
+		}
+		return &s
+	}
+	comment := "looks good"
+	duration := 30 * time.Minute
+
+	tests := []struct {
+		name     string
+		state    *slack.ViewState
+		want     ReviewModalSubmission
+		wantErrs map[string]string
+	}{
+		{
+			name:  "defaults to approve",
+			state: state(map[string]string{}),
+			want:  ReviewModalSubmission{RequestID: "req_1", Decision: access.DecisionApproved},
+		},
+		{
+			name: "deny with comment and duration",
+			state: state(map[string]string{
+				reviewModalDecisionBlock: string(access.DecisionDECLINED),
+				reviewModalCommentBlock:  " looks good ",
+				reviewModalDurationBlock: "30m",
+			}),
+			want: ReviewModalSubmission{RequestID: "req_1", Decision: access.DecisionDECLINED, Comment: &comment, Duration: &duration},
+		},
+		{
+			name:     "invalid duration",
+			state:    state(map[string]string{reviewModalDurationBlock: "forever"}),
+			want:     ReviewModalSubmission{RequestID: "req_1", Decision: access.DecisionApproved},
+			wantErrs: map[string]string{reviewModalDurationBlock: "Enter a duration such as 30m or 2h."},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, errs := ParseReviewModal(slack.View{PrivateMetadata: "req_1", State: tc.state})
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.wantErrs, errs)
+		})
+	}
+}
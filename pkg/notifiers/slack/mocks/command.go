@@ -0,0 +1,146 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/common-fate/common-fate/pkg/notifiers/slack (interfaces: AccessRuleLister,RequestCreator,RequestRevoker)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	access "github.com/common-fate/common-fate/pkg/access"
+	identity "github.com/common-fate/common-fate/pkg/identity"
+	rule "github.com/common-fate/common-fate/pkg/rule"
+	accesssvc "github.com/common-fate/common-fate/pkg/service/accesssvc"
+	types "github.com/common-fate/common-fate/pkg/types"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAccessRuleLister is a mock of AccessRuleLister interface.
+type MockAccessRuleLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessRuleListerMockRecorder
+}
+
+// MockAccessRuleListerMockRecorder is the mock recorder for MockAccessRuleLister.
+type MockAccessRuleListerMockRecorder struct {
+	mock *MockAccessRuleLister
+}
+
+// NewMockAccessRuleLister creates a new mock instance.
+func NewMockAccessRuleLister(ctrl *gomock.Controller) *MockAccessRuleLister {
+	mock := &MockAccessRuleLister{ctrl: ctrl}
+	mock.recorder = &MockAccessRuleListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccessRuleLister) EXPECT() *MockAccessRuleListerMockRecorder {
+	return m.recorder
+}
+
+// ListUserAccessRules mocks base method.
+func (m *MockAccessRuleLister) ListUserAccessRules(arg0 context.Context, arg1 identity.User) ([]rule.AccessRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserAccessRules", arg0, arg1)
+	ret0, _ := ret[0].([]rule.AccessRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserAccessRules indicates an expected call of ListUserAccessRules.
+func (mr *MockAccessRuleListerMockRecorder) ListUserAccessRules(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserAccessRules", reflect.TypeOf((*MockAccessRuleLister)(nil).ListUserAccessRules), arg0, arg1)
+}
+
+// RequestArguments mocks base method.
+func (m *MockAccessRuleLister) RequestArguments(arg0 context.Context, arg1 rule.Target) (map[string]types.RequestArgument, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestArguments", arg0, arg1)
+	ret0, _ := ret[0].(map[string]types.RequestArgument)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestArguments indicates an expected call of RequestArguments.
+func (mr *MockAccessRuleListerMockRecorder) RequestArguments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestArguments", reflect.TypeOf((*MockAccessRuleLister)(nil).RequestArguments), arg0, arg1)
+}
+
+// MockRequestCreator is a mock of RequestCreator interface.
+type MockRequestCreator struct {
+	ctrl     *gomock.Controller
+	recorder *MockRequestCreatorMockRecorder
+}
+
+// MockRequestCreatorMockRecorder is the mock recorder for MockRequestCreator.
+type MockRequestCreatorMockRecorder struct {
+	mock *MockRequestCreator
+}
+
+// NewMockRequestCreator creates a new mock instance.
+func NewMockRequestCreator(ctrl *gomock.Controller) *MockRequestCreator {
+	mock := &MockRequestCreator{ctrl: ctrl}
+	mock.recorder = &MockRequestCreatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRequestCreator) EXPECT() *MockRequestCreatorMockRecorder {
+	return m.recorder
+}
+
+// CreateRequests mocks base method.
+func (m *MockRequestCreator) CreateRequests(arg0 context.Context, arg1 accesssvc.CreateRequestsOpts) ([]accesssvc.CreateRequestResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRequests", arg0, arg1)
+	ret0, _ := ret[0].([]accesssvc.CreateRequestResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRequests indicates an expected call of CreateRequests.
+func (mr *MockRequestCreatorMockRecorder) CreateRequests(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRequests", reflect.TypeOf((*MockRequestCreator)(nil).CreateRequests), arg0, arg1)
+}
+
+// MockRequestRevoker is a mock of RequestRevoker interface.
+type MockRequestRevoker struct {
+	ctrl     *gomock.Controller
+	recorder *MockRequestRevokerMockRecorder
+}
+
+// MockRequestRevokerMockRecorder is the mock recorder for MockRequestRevoker.
+type MockRequestRevokerMockRecorder struct {
+	mock *MockRequestRevoker
+}
+
+// NewMockRequestRevoker creates a new mock instance.
+func NewMockRequestRevoker(ctrl *gomock.Controller) *MockRequestRevoker {
+	mock := &MockRequestRevoker{ctrl: ctrl}
+	mock.recorder = &MockRequestRevokerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRequestRevoker) EXPECT() *MockRequestRevokerMockRecorder {
+	return m.recorder
+}
+
+// Revoke mocks base method.
+func (m *MockRequestRevoker) Revoke(arg0 context.Context, arg1 access.Request, arg2, arg3 string) (*access.Request, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*access.Request)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockRequestRevokerMockRecorder) Revoke(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockRequestRevoker)(nil).Revoke), arg0, arg1, arg2, arg3)
+}
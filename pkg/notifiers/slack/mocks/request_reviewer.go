@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/common-fate/common-fate/pkg/notifiers/slack (interfaces: RequestReviewer)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	accesssvc "github.com/common-fate/common-fate/pkg/service/accesssvc"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRequestReviewer is a mock of RequestReviewer interface.
+type MockRequestReviewer struct {
+	ctrl     *gomock.Controller
+	recorder *MockRequestReviewerMockRecorder
+}
+
+// MockRequestReviewerMockRecorder is the mock recorder for MockRequestReviewer.
+type MockRequestReviewerMockRecorder struct {
+	mock *MockRequestReviewer
+}
+
+// NewMockRequestReviewer creates a new mock instance.
+func NewMockRequestReviewer(ctrl *gomock.Controller) *MockRequestReviewer {
+	mock := &MockRequestReviewer{ctrl: ctrl}
+	mock.recorder = &MockRequestReviewerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRequestReviewer) EXPECT() *MockRequestReviewerMockRecorder {
+	return m.recorder
+}
+
+// AddReviewAndGrantAccess mocks base method.
+func (m *MockRequestReviewer) AddReviewAndGrantAccess(arg0 context.Context, arg1 accesssvc.AddReviewOpts) (*accesssvc.AddReviewResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReviewAndGrantAccess", arg0, arg1)
+	ret0, _ := ret[0].(*accesssvc.AddReviewResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddReviewAndGrantAccess indicates an expected call of AddReviewAndGrantAccess.
+func (mr *MockRequestReviewerMockRecorder) AddReviewAndGrantAccess(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReviewAndGrantAccess", reflect.TypeOf((*MockRequestReviewer)(nil).AddReviewAndGrantAccess), arg0, arg1)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/common-fate/common-fate/pkg/deploy"
 	"github.com/common-fate/common-fate/pkg/gconfig"
 	"github.com/common-fate/ddb"
+	"github.com/slack-go/slack"
 	"go.uber.org/zap"
 )
 
@@ -20,6 +21,9 @@ type SlackNotifier struct {
 	webhooks []*SlackIncomingWebhook
 	// directMessageClient is client that uses the OAuth token to send direct messages to users
 	directMessageClient *SlackDirectMessage
+	// interactivity is set if a signing secret is configured, in which case messages to reviewers
+	// can be reviewed directly in Slack
+	interactivity *SlackInteractivity
 }
 
 func (n *SlackNotifier) Init(ctx context.Context, config *deploy.Notifications) error {
@@ -34,6 +38,15 @@ func (n *SlackNotifier) Init(ctx context.Context, config *deploy.Notifications)
 			return err
 		}
 		n.directMessageClient = slackDMClient
+
+		if _, ok := config.Slack["signingSecret"]; ok {
+			interactivity := &SlackInteractivity{}
+			err = interactivity.Config().Load(ctx, &gconfig.MapLoader{Values: config.Slack})
+			if err != nil {
+				return err
+			}
+			n.interactivity = interactivity
+		}
 	}
 	if config.SlackIncomingWebhooks != nil {
 		log := zap.S()
@@ -73,3 +86,18 @@ func (n *SlackNotifier) HandleEvent(ctx context.Context, event events.CloudWatch
 	}
 	return nil
 }
+
+// Interactivity returns the interactivity config of the notifier, or nil if
+// interactive messages are not enabled.
+func (n *SlackNotifier) Interactivity() *SlackInteractivity {
+	return n.interactivity
+}
+
+// Client returns the Slack API client used to send direct messages, or nil if
+// direct messages are not enabled.
+func (n *SlackNotifier) Client() *slack.Client {
+	if n.directMessageClient == nil {
+		return nil
+	}
+	return n.directMessageClient.client
+}
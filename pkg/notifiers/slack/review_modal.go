@@ -0,0 +1,130 @@
+package slacknotifier
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/slack-go/slack"
+)
+
+// Action and block IDs used by interactive review messages and the review modal.
+const (
+	ActionApprove = "approve"
+	ActionDeny    = "deny"
+	// ActionReview opens the review modal, where a comment and a timing override can be given.
+	ActionReview = "review"
+
+	ReviewModalCallbackID = "review_request"
+
+	reviewModalDecisionBlock = "decision"
+	reviewModalCommentBlock  = "comment"
+	reviewModalDurationBlock = "duration"
+)
+
+// BuildInteractiveReviewActions builds the review buttons for a request.
+// Each button carries the request ID as its value, and is handled by the interactivity endpoint.
+func BuildInteractiveReviewActions(requestID string) *slack.ActionBlock {
+	return slack.NewActionBlock("review_actions",
+		slack.ButtonBlockElement{
+			Type:     slack.METButton,
+			Text:     &slack.TextBlockObject{Type: slack.PlainTextType, Text: "Approve"},
+			Style:    slack.StylePrimary,
+			ActionID: ActionApprove,
+			Value:    requestID,
+		},
+		slack.ButtonBlockElement{
+			Type:     slack.METButton,
+			Text:     &slack.TextBlockObject{Type: slack.PlainTextType, Text: "Deny"},
+			Style:    slack.StyleDanger,
+			ActionID: ActionDeny,
+			Value:    requestID,
+		},
+		slack.ButtonBlockElement{
+			Type:     slack.METButton,
+			Text:     &slack.TextBlockObject{Type: slack.PlainTextType, Text: "Review with comment"},
+			ActionID: ActionReview,
+			Value:    requestID,
+		},
+	)
+}
+
+// BuildReviewModal builds the modal used to review a request with a comment and an optional duration override.
+// The request ID is stored in the private metadata of the modal.
+func BuildReviewModal(request access.Request, ruleName string) slack.ModalViewRequest {
+	approve := slack.NewOptionBlockObject(string(access.DecisionApproved), slack.NewTextBlockObject(slack.PlainTextType, "Approve", false, false), nil)
+	deny := slack.NewOptionBlockObject(string(access.DecisionDECLINED), slack.NewTextBlockObject(slack.PlainTextType, "Deny", false, false), nil)
+	decision := slack.NewRadioButtonsBlockElement(reviewModalDecisionBlock, approve, deny)
+	decision.InitialOption = approve
+
+	comment := slack.NewPlainTextInputBlockElement(slack.NewTextBlockObject(slack.PlainTextType, "Add a comment for the requestor", false, false), reviewModalCommentBlock)
+	comment.Multiline = true
+
+	duration := slack.NewPlainTextInputBlockElement(slack.NewTextBlockObject(slack.PlainTextType, "e.g. 30m or 2h", false, false), reviewModalDurationBlock)
+	duration.InitialValue = request.RequestedTiming.Duration.String()
+
+	commentInput := slack.NewInputBlock(reviewModalCommentBlock, slack.NewTextBlockObject(slack.PlainTextType, "Comment", false, false), nil, comment)
+	commentInput.Optional = true
+	durationInput := slack.NewInputBlock(reviewModalDurationBlock, slack.NewTextBlockObject(slack.PlainTextType, "Duration", false, false), slack.NewTextBlockObject(slack.PlainTextType, "Change this to override the requested duration.", false, false), duration)
+	durationInput.Optional = true
+
+	return slack.ModalViewRequest{
+		Type:            slack.VTModal,
+		CallbackID:      ReviewModalCallbackID,
+		PrivateMetadata: request.ID,
+		Title:           slack.NewTextBlockObject(slack.PlainTextType, "Review request", false, false),
+		Submit:          slack.NewTextBlockObject(slack.PlainTextType, "Submit", false, false),
+		Close:           slack.NewTextBlockObject(slack.PlainTextType, "Cancel", false, false),
+		Blocks: slack.Blocks{
+			BlockSet: []slack.Block{
+				slack.NewSectionBlock(slack.NewTextBlockObject(slack.MarkdownType, fmt.Sprintf("Review the request for *%s*.", ruleName), false, false), nil, nil),
+				slack.NewInputBlock(reviewModalDecisionBlock, slack.NewTextBlockObject(slack.PlainTextType, "Decision", false, false), nil, decision),
+				commentInput,
+				durationInput,
+			},
+		},
+	}
+}
+
+// ReviewModalSubmission is the parsed state of a submitted review modal.
+type ReviewModalSubmission struct {
+	RequestID string
+	Decision  access.Decision
+	Comment   *string
+	// Duration is nil if no duration was given.
+	Duration *time.Duration
+}
+
+// ParseReviewModal parses the state of a submitted review modal.
+// Validation errors are returned keyed by block ID, so that they can be displayed in the modal.
+func ParseReviewModal(view slack.View) (ReviewModalSubmission, map[string]string) {
+	s := ReviewModalSubmission{
+		RequestID: view.PrivateMetadata,
+		Decision:  access.DecisionApproved,
+	}
+	if view.State == nil {
+		return s, nil
+	}
+	errs := map[string]string{}
+
+	if v, ok := view.State.Values[reviewModalDecisionBlock][reviewModalDecisionBlock]; ok && v.SelectedOption.Value != "" {
+		s.Decision = access.Decision(v.SelectedOption.Value)
+	}
+	if v, ok := view.State.Values[reviewModalCommentBlock][reviewModalCommentBlock]; ok && strings.TrimSpace(v.Value) != "" {
+		comment := strings.TrimSpace(v.Value)
+		s.Comment = &comment
+	}
+	if v, ok := view.State.Values[reviewModalDurationBlock][reviewModalDurationBlock]; ok && strings.TrimSpace(v.Value) != "" {
+		d, err := time.ParseDuration(strings.TrimSpace(v.Value))
+		if err != nil || d <= 0 {
+			errs[reviewModalDurationBlock] = "Enter a duration such as 30m or 2h."
+		} else {
+			s.Duration = &d
+		}
+	}
+	if len(errs) > 0 {
+		return s, errs
+	}
+	return s, nil
+}
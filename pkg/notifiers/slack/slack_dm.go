@@ -33,3 +33,20 @@ func (s *SlackDirectMessage) TestConfig(ctx context.Context) error {
 	}
 	return nil
 }
+
+// SlackInteractivity holds the signing secret of the Slack app, which is used to verify
+// requests to the interactivity endpoint. It is stored alongside the API token in the
+// Slack notifications config, and interactive messages are only sent if it is set.
+type SlackInteractivity struct {
+	signingSecret gconfig.SecretStringValue
+}
+
+func (s *SlackInteractivity) Config() gconfig.Config {
+	return gconfig.Config{
+		gconfig.SecretStringField("signingSecret", &s.signingSecret, "the Slack app signing secret", gconfig.WithNoArgs("/granted/secrets/notifications/slack/signingSecret")),
+	}
+}
+
+func (s *SlackInteractivity) SigningSecret() string {
+	return s.signingSecret.Get()
+}
@@ -0,0 +1,98 @@
+package teamsnotifier
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/notifiers"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/types"
+)
+
+type RequestCardOpts struct {
+	Request        access.Request
+	Rule           rule.AccessRule
+	ReviewURLs     notifiers.ReviewURLs
+	RequestorEmail string
+	// RequestReviewer is set when the card is built for a request which has been reviewed.
+	RequestReviewer *identity.User
+}
+
+// BuildRequestReviewCard builds a Teams message for a request review.
+// Pending requests include Approve and Deny actions, which link to the review page in the web app.
+func BuildRequestReviewCard(o RequestCardOpts) (summary string, msg Message) {
+	var title string
+	switch {
+	case o.Request.Status == access.CANCELLED:
+		title = fmt.Sprintf("%s cancelled their request for %s", o.RequestorEmail, o.Rule.Name)
+	case o.Request.Status != access.PENDING && o.RequestReviewer != nil:
+		title = fmt.Sprintf("%s %s %s's request for %s", o.RequestReviewer.Email, strings.ToLower(string(o.Request.Status)), o.RequestorEmail, o.Rule.Name)
+	default:
+		title = fmt.Sprintf("New request for %s from %s", o.Rule.Name, o.RequestorEmail)
+	}
+
+	when := "ASAP"
+	if o.Request.RequestedTiming.StartTime != nil {
+		when = types.ExpiryString(*o.Request.RequestedTiming.StartTime)
+	}
+
+	facts := []Fact{
+		{Title: "When", Value: when},
+		{Title: "Duration", Value: o.Request.RequestedTiming.Duration.String()},
+		{Title: "Status", Value: titleCase(string(o.Request.Status))},
+	}
+	facts = append(facts, requestFacts(o.Request)...)
+
+	actions := []Action{openURL("View Request", o.ReviewURLs.Review, "")}
+	if o.Request.Status == access.PENDING {
+		actions = []Action{
+			openURL("Approve", o.ReviewURLs.Approve, "positive"),
+			openURL("Deny", o.ReviewURLs.Deny, "destructive"),
+			openURL("View Request", o.ReviewURLs.Review, ""),
+		}
+	}
+
+	return title, NewMessage(title, newCard([]Element{heading(title), factSet(facts)}, actions...))
+}
+
+// BuildNotificationCard builds a Teams message with a heading and an optional body,
+// linking to the request in the web app.
+func BuildNotificationCard(title, text string, reviewURLs notifiers.ReviewURLs) Message {
+	body := []Element{heading(title)}
+	if text != "" {
+		body = append(body, textBlock(text))
+	}
+	return NewMessage(title, newCard(body, openURL("View Request", reviewURLs.Review, "")))
+}
+
+// requestFacts returns the selected arguments, reason and ticket of a request as card facts.
+func requestFacts(request access.Request) []Fact {
+	var facts []Fact
+	keys := make([]string, 0, len(request.SelectedWith))
+	for k := range request.SelectedWith {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		facts = append(facts, Fact{Title: k, Value: request.SelectedWith[k].Label})
+	}
+	if request.Data.Reason != nil && *request.Data.Reason != "" {
+		facts = append(facts, Fact{Title: "Request Reason", Value: *request.Data.Reason})
+	}
+	if request.Data.TicketReference != nil {
+		facts = append(facts, Fact{Title: "Ticket", Value: *request.Data.TicketReference})
+	}
+	return facts
+}
+
+// titleCase turns STRING into String
+func titleCase(s string) string {
+	if s == "" {
+		return ""
+	}
+	lower := strings.ToLower(s)
+	return strings.ToUpper(string(lower[0])) + lower[1:]
+}
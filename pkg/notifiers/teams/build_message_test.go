@@ -0,0 +1,151 @@
+package teamsnotifier
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/notifiers"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildRequestReviewCard(t *testing.T) {
+	reason := "reason"
+	ticketReference := "OPS-123"
+	reviewURLs, err := notifiers.ReviewURL("https://commonfate.example.com", "req_123")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		args        RequestCardOpts
+		wantSummary string
+		wantCard    string
+	}{
+		{
+			name: "pending request has review actions",
+			args: RequestCardOpts{
+				Request: access.Request{
+					ID:     "req_123",
+					Status: access.PENDING,
+					Data: access.RequestData{
+						Reason:          &reason,
+						TicketReference: &ticketReference,
+					},
+					SelectedWith: map[string]access.Option{
+						"accountId": {Label: "prod", Value: "123456789012"},
+					},
+					RequestedTiming: access.Timing{Duration: time.Hour},
+				},
+				Rule:           rule.AccessRule{Name: "my rule"},
+				ReviewURLs:     reviewURLs,
+				RequestorEmail: "testuser@example.com",
+			},
+			wantSummary: "New request for my rule from testuser@example.com",
+			wantCard: `
+{
+	"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+	"type": "AdaptiveCard",
+	"version": "1.4",
+	"body": [
+		{"type": "TextBlock", "text": "New request for my rule from testuser@example.com", "size": "Medium", "weight": "Bolder", "wrap": true},
+		{"type": "FactSet", "facts": [
+			{"title": "When", "value": "ASAP"},
+			{"title": "Duration", "value": "1h0m0s"},
+			{"title": "Status", "value": "Pending"},
+			{"title": "accountId", "value": "prod"},
+			{"title": "Request Reason", "value": "reason"},
+			{"title": "Ticket", "value": "OPS-123"}
+		]}
+	],
+	"actions": [
+		{"type": "Action.OpenUrl", "title": "Approve", "url": "https://commonfate.example.com/requests/req_123?action=approve", "style": "positive"},
+		{"type": "Action.OpenUrl", "title": "Deny", "url": "https://commonfate.example.com/requests/req_123?action=deny", "style": "destructive"},
+		{"type": "Action.OpenUrl", "title": "View Request", "url": "https://commonfate.example.com/requests/req_123"}
+	]
+}`,
+		},
+		{
+			name: "reviewed request links to the request",
+			args: RequestCardOpts{
+				Request: access.Request{
+					ID:              "req_123",
+					Status:          access.APPROVED,
+					RequestedTiming: access.Timing{Duration: time.Hour},
+				},
+				Rule:            rule.AccessRule{Name: "my rule"},
+				ReviewURLs:      reviewURLs,
+				RequestorEmail:  "testuser@example.com",
+				RequestReviewer: &identity.User{Email: "reviewer@example.com"},
+			},
+			wantSummary: "reviewer@example.com approved testuser@example.com's request for my rule",
+			wantCard: `
+{
+	"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+	"type": "AdaptiveCard",
+	"version": "1.4",
+	"body": [
+		{"type": "TextBlock", "text": "reviewer@example.com approved testuser@example.com's request for my rule", "size": "Medium", "weight": "Bolder", "wrap": true},
+		{"type": "FactSet", "facts": [
+			{"title": "When", "value": "ASAP"},
+			{"title": "Duration", "value": "1h0m0s"},
+			{"title": "Status", "value": "Approved"}
+		]}
+	],
+	"actions": [
+		{"type": "Action.OpenUrl", "title": "View Request", "url": "https://commonfate.example.com/requests/req_123"}
+	]
+}`,
+		},
+		{
+			name: "cancelled request",
+			args: RequestCardOpts{
+				Request: access.Request{
+					ID:              "req_123",
+					Status:          access.CANCELLED,
+					RequestedTiming: access.Timing{Duration: time.Hour},
+				},
+				Rule:           rule.AccessRule{Name: "my rule"},
+				ReviewURLs:     reviewURLs,
+				RequestorEmail: "testuser@example.com",
+			},
+			wantSummary: "testuser@example.com cancelled their request for my rule",
+			wantCard: `
+{
+	"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+	"type": "AdaptiveCard",
+	"version": "1.4",
+	"body": [
+		{"type": "TextBlock", "text": "testuser@example.com cancelled their request for my rule", "size": "Medium", "weight": "Bolder", "wrap": true},
+		{"type": "FactSet", "facts": [
+			{"title": "When", "value": "ASAP"},
+			{"title": "Duration", "value": "1h0m0s"},
+			{"title": "Status", "value": "Cancelled"}
+		]}
+	],
+	"actions": [
+		{"type": "Action.OpenUrl", "title": "View Request", "url": "https://commonfate.example.com/requests/req_123"}
+	]
+}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotSummary, gotMsg := BuildRequestReviewCard(tt.args)
+			assert.Equal(t, tt.wantSummary, gotSummary)
+			assert.Equal(t, "message", gotMsg.Type)
+			assert.Len(t, gotMsg.Attachments, 1)
+			assert.Equal(t, "application/vnd.microsoft.card.adaptive", gotMsg.Attachments[0].ContentType)
+
+			card, err := json.Marshal(gotMsg.Attachments[0].Content)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.JSONEq(t, tt.wantCard, string(card))
+		})
+	}
+}
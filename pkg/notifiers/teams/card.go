@@ -0,0 +1,95 @@
+package teamsnotifier
+
+// The types in this file model the subset of the Adaptive Card schema
+// which is used when posting messages to Microsoft Teams.
+// See: https://adaptivecards.io/explorer/
+
+const (
+	adaptiveCardSchema      = "http://adaptivecards.io/schemas/adaptive-card.json"
+	adaptiveCardVersion     = "1.4"
+	adaptiveCardContentType = "application/vnd.microsoft.card.adaptive"
+)
+
+// Message is the payload accepted by a Teams incoming webhook.
+type Message struct {
+	Type        string       `json:"type"`
+	Summary     string       `json:"summary,omitempty"`
+	Attachments []Attachment `json:"attachments"`
+}
+
+type Attachment struct {
+	ContentType string       `json:"contentType"`
+	Content     AdaptiveCard `json:"content"`
+}
+
+type AdaptiveCard struct {
+	Schema  string    `json:"$schema"`
+	Type    string    `json:"type"`
+	Version string    `json:"version"`
+	Body    []Element `json:"body"`
+	Actions []Action  `json:"actions,omitempty"`
+}
+
+// Element is a card body element, either a TextBlock or a FactSet.
+type Element struct {
+	Type   string `json:"type"`
+	Text   string `json:"text,omitempty"`
+	Size   string `json:"size,omitempty"`
+	Weight string `json:"weight,omitempty"`
+	Wrap   bool   `json:"wrap,omitempty"`
+	Facts  []Fact `json:"facts,omitempty"`
+}
+
+type Fact struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+}
+
+// Action is an Action.OpenUrl button. Incoming webhooks don't support
+// Action.Submit, so approve and deny link to the review page in the web app.
+type Action struct {
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+	Style string `json:"style,omitempty"`
+}
+
+// NewMessage wraps an Adaptive Card in the message envelope expected by Teams.
+func NewMessage(summary string, card AdaptiveCard) Message {
+	return Message{
+		Type:    "message",
+		Summary: summary,
+		Attachments: []Attachment{
+			{
+				ContentType: adaptiveCardContentType,
+				Content:     card,
+			},
+		},
+	}
+}
+
+func newCard(body []Element, actions ...Action) AdaptiveCard {
+	return AdaptiveCard{
+		Schema:  adaptiveCardSchema,
+		Type:    "AdaptiveCard",
+		Version: adaptiveCardVersion,
+		Body:    body,
+		Actions: actions,
+	}
+}
+
+func heading(text string) Element {
+	return Element{Type: "TextBlock", Text: text, Size: "Medium", Weight: "Bolder", Wrap: true}
+}
+
+func textBlock(text string) Element {
+	return Element{Type: "TextBlock", Text: text, Wrap: true}
+}
+
+func factSet(facts []Fact) Element {
+	return Element{Type: "FactSet", Facts: facts}
+}
+
+func openURL(title, url, style string) Action {
+	return Action{Type: "Action.OpenUrl", Title: title, URL: url, Style: style}
+}
@@ -0,0 +1,65 @@
+package teamsnotifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/notifiers"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"go.uber.org/zap"
+)
+
+func (n *TeamsNotifier) HandleGrantEvent(ctx context.Context, log *zap.SugaredLogger, event events.CloudWatchEvent) error {
+	var grantEvent gevent.GrantEventPayload
+	err := json.Unmarshal(event.Detail, &grantEvent)
+	if err != nil {
+		return err
+	}
+
+	gq := storage.GetRequest{ID: grantEvent.Grant.ID}
+	_, err = n.DB.Query(ctx, &gq)
+	if err != nil {
+		return err
+	}
+	rq := storage.GetAccessRuleVersion{ID: gq.Result.Rule, VersionID: gq.Result.RuleVersion}
+	_, err = n.DB.Query(ctx, &rq)
+	if err != nil {
+		return err
+	}
+	reviewURL, err := notifiers.ReviewURL(n.FrontendURL, gq.Result.ID)
+	if err != nil {
+		return err
+	}
+
+	var title string
+	switch event.DetailType {
+	case gevent.GrantFailedType:
+		title = fmt.Sprintf("There was an issue provisioning or cleaning up access to %s for %s", rq.Result.Name, grantEvent.Grant.Subject)
+	case gevent.GrantRevokedType:
+		title = fmt.Sprintf("Access to %s for %s has been revoked by an administrator", rq.Result.Name, grantEvent.Grant.Subject)
+	case gevent.GrantDriftedType:
+		var drifted gevent.GrantDrifted
+		err = json.Unmarshal(event.Detail, &drifted)
+		if err != nil {
+			return err
+		}
+		switch {
+		case drifted.AutoRevoked:
+			title = fmt.Sprintf("Access to %s for %s remained after the grant ended and has been removed", rq.Result.Name, grantEvent.Grant.Subject)
+		case drifted.Drift == string(access.ORPHANED_ACCESS):
+			title = fmt.Sprintf("Access to %s for %s remains in the provider after the grant ended", rq.Result.Name, grantEvent.Grant.Subject)
+		default:
+			title = fmt.Sprintf("Access to %s for %s was removed from the provider while the grant is active", rq.Result.Name, grantEvent.Grant.Subject)
+		}
+	default:
+		log.Infow("unhandled grant event", "detailType", event.DetailType)
+	}
+	if title != "" {
+		n.send(ctx, log, BuildNotificationCard(title, "", reviewURL))
+	}
+	return nil
+}
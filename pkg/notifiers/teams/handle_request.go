@@ -0,0 +1,126 @@
+package teamsnotifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/notifiers"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+func (n *TeamsNotifier) HandleRequestEvent(ctx context.Context, log *zap.SugaredLogger, event events.CloudWatchEvent) error {
+	var requestEvent gevent.RequestEventPayload
+	err := json.Unmarshal(event.Detail, &requestEvent)
+	if err != nil {
+		return err
+	}
+	request := requestEvent.Request
+	requestedRuleQuery := storage.GetAccessRuleVersion{ID: request.Rule, VersionID: request.RuleVersion}
+	_, err = n.DB.Query(ctx, &requestedRuleQuery)
+	if err != nil {
+		return errors.Wrap(err, "getting access rule")
+	}
+	requestedRule := *requestedRuleQuery.Result
+	requestingUserQuery := storage.GetUser{ID: request.RequestedBy}
+	_, err = n.DB.Query(ctx, &requestingUserQuery)
+	if err != nil {
+		return errors.Wrap(err, "getting requestor")
+	}
+	requestor := requestingUserQuery.Result.Email
+
+	reviewURL, err := notifiers.ReviewURL(n.FrontendURL, request.ID)
+	if err != nil {
+		return errors.Wrap(err, "building review URL")
+	}
+	opts := RequestCardOpts{
+		Request:        request,
+		Rule:           requestedRule,
+		ReviewURLs:     reviewURL,
+		RequestorEmail: requestor,
+	}
+
+	switch event.DetailType {
+	case gevent.RequestCreatedType:
+		// break-glass requests are granted immediately, and approvers are alerted by the break-glass used event instead.
+		if request.BreakGlass {
+			return nil
+		}
+		if requestedRule.Approval.IsRequired() {
+			_, msg := BuildRequestReviewCard(opts)
+			n.send(ctx, log, msg)
+		} else {
+			title := fmt.Sprintf("%s's request to access %s has been automatically approved.", requestor, requestedRule.Name)
+			n.send(ctx, log, BuildNotificationCard(title, "", reviewURL))
+		}
+	case gevent.RequestApprovedType, gevent.RequestDeclinedType, gevent.RequestCancelledType:
+		if requestEvent.ReviewerID == "" && request.Status != access.CANCELLED {
+			// automatic approvals are announced when the request is created.
+			return nil
+		}
+		if requestEvent.ReviewerID != "" {
+			reviewer := storage.GetUser{ID: requestEvent.ReviewerID}
+			_, err = n.DB.Query(ctx, &reviewer)
+			if err != nil {
+				return errors.Wrap(err, "getting reviewer")
+			}
+			opts.RequestReviewer = reviewer.Result
+		}
+		_, msg := BuildRequestReviewCard(opts)
+		n.send(ctx, log, msg)
+	case gevent.RequestEscalatedType:
+		var escalation gevent.RequestEscalated
+		err = json.Unmarshal(event.Detail, &escalation)
+		if err != nil {
+			return err
+		}
+		if escalation.Action == access.AUTO_DECLINED {
+			title := fmt.Sprintf("%s's request to access %s has been automatically declined because it was not reviewed in time.", requestor, requestedRule.Name)
+			n.send(ctx, log, BuildNotificationCard(title, "", reviewURL))
+			return nil
+		}
+		_, msg := BuildRequestReviewCard(opts)
+		n.send(ctx, log, msg)
+	case gevent.RequestBreakGlassUsedType:
+		var used gevent.RequestBreakGlassUsed
+		err = json.Unmarshal(event.Detail, &used)
+		if err != nil {
+			return err
+		}
+		title := fmt.Sprintf("%s used break-glass access to %s", requestor, requestedRule.Name)
+		text := fmt.Sprintf("Reason: %s\n\nThe access was granted without approval. Review the request to acknowledge or flag it.", used.Reason)
+		n.send(ctx, log, BuildNotificationCard(title, text, reviewURL))
+	case gevent.RequestBreakGlassReviewedType:
+		var reviewed gevent.RequestBreakGlassReviewed
+		err = json.Unmarshal(event.Detail, &reviewed)
+		if err != nil {
+			return err
+		}
+		action := "acknowledged"
+		if reviewed.Review.Status == access.BreakGlassReviewFlagged {
+			action = "flagged"
+		}
+		title := fmt.Sprintf("%s %s the break-glass access to %s used by %s", reviewed.ReviewerEmail, action, requestedRule.Name, requestor)
+		var text string
+		if reviewed.Review.Comment != nil {
+			text = *reviewed.Review.Comment
+		}
+		n.send(ctx, log, BuildNotificationCard(title, text, reviewURL))
+	case gevent.RequestExtensionRequestedType:
+		if request.Extension == nil {
+			return nil
+		}
+		title := fmt.Sprintf("%s has requested to extend their access to %s by %s", requestor, requestedRule.Name, request.Extension.ExtendBy)
+		var text string
+		if request.Extension.Reason != nil {
+			text = fmt.Sprintf("Reason: %s", *request.Extension.Reason)
+		}
+		n.send(ctx, log, BuildNotificationCard(title, text, reviewURL))
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package teamsnotifier
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/common-fate/common-fate/pkg/deploy"
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	"github.com/common-fate/ddb"
+	"go.uber.org/zap"
+)
+
+// TeamsNotifier provides handler methods for sending notifications to Microsoft Teams based on events.
+// Messages are sent as Adaptive Cards to the channels configured with incoming webhooks.
+type TeamsNotifier struct {
+	DB          ddb.Storage
+	FrontendURL string
+	// webhooks is a list of Teams incoming webhooks to send messages to
+	webhooks []*TeamsIncomingWebhook
+}
+
+func (n *TeamsNotifier) Init(ctx context.Context, config *deploy.Notifications) error {
+	if config.Teams != nil {
+		log := zap.S()
+		log.Infow("initialising teams incoming webhooks", "webhooks", config.Teams)
+
+		for _, webhook := range config.Teams {
+			tw := TeamsIncomingWebhook{}
+			err := tw.Config().Load(ctx, &gconfig.MapLoader{Values: webhook})
+			if err != nil {
+				return err
+			}
+			n.webhooks = append(n.webhooks, &tw)
+		}
+	}
+	return nil
+}
+
+func (n *TeamsNotifier) HandleEvent(ctx context.Context, event events.CloudWatchEvent) (err error) {
+	log := zap.S()
+
+	log.Infow("received event", "event", event)
+
+	if len(n.webhooks) == 0 {
+		log.Info("no teams webhooks configured, ignoring event")
+		return nil
+	}
+
+	if strings.HasPrefix(event.DetailType, "grant") {
+		err = n.HandleGrantEvent(ctx, log, event)
+		if err != nil {
+			return err
+		}
+	} else if strings.HasPrefix(event.DetailType, "request") {
+		err = n.HandleRequestEvent(ctx, log, event)
+		if err != nil {
+			return err
+		}
+	} else {
+		log.Info("ignoring unhandled event type")
+	}
+	return nil
+}
+
+// send posts the message to each configured channel, logging any failures.
+func (n *TeamsNotifier) send(ctx context.Context, log *zap.SugaredLogger, msg Message) {
+	for _, webhook := range n.webhooks {
+		err := webhook.SendWebhookMessage(ctx, msg)
+		if err != nil {
+			log.Errorw("failed to send message to teams webhook channel", "error", err)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package teamsnotifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// TeamsIncomingWebhook posts Adaptive Card messages to a Microsoft Teams channel.
+type TeamsIncomingWebhook struct {
+	webhookURL gconfig.SecretStringValue
+	// client is used to send requests, defaulting to http.DefaultClient if nil.
+	client *http.Client
+}
+
+func (t *TeamsIncomingWebhook) Config() gconfig.Config {
+	return gconfig.Config{
+		gconfig.SecretStringField("webhookUrl", &t.webhookURL, "the Microsoft Teams incoming webhook url", gconfig.WithArgs("/granted/secrets/notifications/teams/%s/webhookUrl", 1)),
+	}
+}
+
+// TestConfig sends a test message to the channel to verify the webhook url.
+func (t *TeamsIncomingWebhook) TestConfig(ctx context.Context) error {
+	msg := NewMessage("Common Fate integration test", newCard([]Element{textBlock("Common Fate has been connected to this channel.")}))
+	return t.SendWebhookMessage(ctx, msg)
+}
+
+func (t *TeamsIncomingWebhook) SendWebhookMessage(ctx context.Context, msg Message) error {
+	log := zap.S()
+
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	// the card includes the details of the request, so it's only logged when debugging.
+	log.Debugw("sending teams webhook message", "requestBody", string(payload))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.webhookURL.Get(), bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := t.client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	// Teams webhooks return 200 for the legacy connectors and 202 for workflow based webhooks.
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusAccepted {
+		body, err := io.ReadAll(res.Body)
+		if err != nil {
+			return errors.Wrap(err, "failed to decode body of failed post request to teams webhook")
+		}
+		log.Errorw("failed to post teams webhook message", "statusCode", res.StatusCode, "responseBody", string(body))
+		return errors.New("failed to post teams webhook message")
+	}
+	log.Infow("sent teams webhook message", "statusCode", res.StatusCode)
+	return nil
+}
@@ -0,0 +1,48 @@
+package teamsnotifier
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSendWebhookMessage(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		wantErr    bool
+	}{
+		{name: "ok", statusCode: http.StatusOK},
+		{name: "accepted", statusCode: http.StatusAccepted},
+		{name: "rejected", statusCode: http.StatusBadRequest, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Message
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				err := json.NewDecoder(r.Body).Decode(&got)
+				if err != nil {
+					t.Fatal(err)
+				}
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer ts.Close()
+
+			webhook := TeamsIncomingWebhook{webhookURL: gconfig.SecretStringValue{Value: ts.URL}, client: ts.Client()}
+			msg := NewMessage("summary", newCard([]Element{textBlock("hello")}))
+			err := webhook.SendWebhookMessage(context.Background(), msg)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, msg, got)
+		})
+	}
+}
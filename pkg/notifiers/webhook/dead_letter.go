@@ -0,0 +1,29 @@
+package webhooknotifier
+
+import (
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/ddb"
+)
+
+// DeadLetter records an event which couldn't be delivered to a webhook endpoint
+// after all retries were exhausted, so that failed deliveries can be inspected.
+type DeadLetter struct {
+	EndpointID string `json:"endpointId" dynamodbav:"endpointId"`
+	EventID    string `json:"eventId" dynamodbav:"eventId"`
+	EventType  string `json:"eventType" dynamodbav:"eventType"`
+	// Payload is the signed request body which was sent to the endpoint.
+	Payload   string    `json:"payload" dynamodbav:"payload"`
+	Attempts  int       `json:"attempts" dynamodbav:"attempts"`
+	Error     string    `json:"error" dynamodbav:"error"`
+	CreatedAt time.Time `json:"createdAt" dynamodbav:"createdAt"`
+}
+
+func (d *DeadLetter) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.WebhookDeadLetter.PK1,
+		SK: keys.WebhookDeadLetter.SK1(d.EndpointID, d.CreatedAt.Format(time.RFC3339), d.EventID),
+	}
+	return keys, nil
+}
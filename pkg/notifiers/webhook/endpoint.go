@@ -0,0 +1,89 @@
+package webhooknotifier
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	"github.com/pkg/errors"
+)
+
+// TestEventType is the event type sent by Endpoint.TestConfig.
+const TestEventType = "webhook.test"
+
+// Endpoint is an HTTP endpoint which receives signed event payloads.
+type Endpoint struct {
+	// ID is the key of the endpoint in the notifications configuration.
+	ID         string
+	url        gconfig.StringValue
+	eventTypes gconfig.OptionalStringValue
+	signingKey gconfig.SecretStringValue
+}
+
+func (e *Endpoint) Config() gconfig.Config {
+	return gconfig.Config{
+		gconfig.StringField("url", &e.url, "the URL to send events to"),
+		gconfig.OptionalStringField("eventTypes", &e.eventTypes, "a comma separated list of event types to send, such as 'request.created,grant.*'. Leave empty to send all events"),
+		gconfig.SecretStringField("signingKey", &e.signingKey, "the key used to sign payloads", gconfig.WithArgs("/granted/secrets/notifications/webhooks/%s/signingKey", 1)),
+	}
+}
+
+func (e *Endpoint) URL() string {
+	return e.url.Get()
+}
+
+// EventTypes returns the event type filters of the endpoint.
+// An empty slice means that all events are sent.
+func (e *Endpoint) EventTypes() []string {
+	var res []string
+	for _, t := range strings.Split(e.eventTypes.Get(), ",") {
+		t = strings.TrimSpace(t)
+		if t != "" {
+			res = append(res, t)
+		}
+	}
+	return res
+}
+
+// Matches returns true if the endpoint should receive events of eventType.
+// Filters ending in ".*" match any event type with that prefix, and "*" matches all events.
+func (e *Endpoint) Matches(eventType string) bool {
+	filters := e.EventTypes()
+	if len(filters) == 0 {
+		return true
+	}
+	for _, f := range filters {
+		if f == "*" || f == eventType {
+			return true
+		}
+		if strings.HasSuffix(f, ".*") && strings.HasPrefix(eventType, strings.TrimSuffix(f, "*")) {
+			return true
+		}
+	}
+	return false
+}
+
+// TestConfig sends a test event to the endpoint without retries.
+func (e *Endpoint) TestConfig(ctx context.Context) error {
+	detail, err := json.Marshal(map[string]string{"message": "Common Fate webhook test"})
+	if err != nil {
+		return err
+	}
+	payload := Payload{
+		ID:     "test",
+		Type:   TestEventType,
+		Time:   time.Now(),
+		Detail: detail,
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	_, err = e.send(ctx, nil, payload, body)
+	if err != nil {
+		return errors.Wrap(err, "sending test event")
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+package webhooknotifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/common-fate/common-fate/pkg/deploy"
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	"github.com/common-fate/ddb"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultMaxAttempts    = 4
+	defaultInitialBackoff = time.Second
+	requestTimeout        = 10 * time.Second
+	// deadLetterReserve is the time left before the deadline of the context to write dead letters,
+	// once retries have stopped.
+	deadLetterReserve = 5 * time.Second
+)
+
+// Payload is the JSON body posted to webhook endpoints.
+type Payload struct {
+	// ID is the ID of the event, which can be used by receivers to deduplicate deliveries.
+	ID     string          `json:"id"`
+	Type   string          `json:"type"`
+	Time   time.Time       `json:"time"`
+	Detail json.RawMessage `json:"detail"`
+}
+
+// WebhookNotifier sends request and grant events to HTTP endpoints.
+// Each payload is signed with the signing key of the endpoint.
+// Events are delivered to each endpoint in parallel. Failed deliveries are retried with exponential backoff
+// until the attempts run out or the context is close to its deadline, and a DeadLetter is
+// written to DynamoDB if every attempt fails.
+type WebhookNotifier struct {
+	DB ddb.Storage
+	// Client is used to send requests, defaulting to http.DefaultClient if nil.
+	Client *http.Client
+	// MaxAttempts is the number of delivery attempts made for each endpoint. Defaults to 4.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry, which doubles after each attempt. Defaults to 1s.
+	InitialBackoff time.Duration
+
+	endpoints []*Endpoint
+}
+
+func (n *WebhookNotifier) Init(ctx context.Context, config *deploy.Notifications) error {
+	for id, values := range config.Webhooks {
+		e := Endpoint{ID: id}
+		err := e.Config().Load(ctx, &gconfig.MapLoader{Values: values})
+		if err != nil {
+			return err
+		}
+		n.endpoints = append(n.endpoints, &e)
+	}
+	return nil
+}
+
+func (n *WebhookNotifier) HandleEvent(ctx context.Context, event events.CloudWatchEvent) error {
+	log := zap.S().With("event.id", event.ID, "event.type", event.DetailType)
+
+	if !strings.HasPrefix(event.DetailType, "request") && !strings.HasPrefix(event.DetailType, "grant") {
+		log.Info("ignoring unhandled event type")
+		return nil
+	}
+
+	payload := Payload{
+		ID:     event.ID,
+		Type:   event.DetailType,
+		Time:   event.Time,
+		Detail: event.Detail,
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	// retries stop in time to write dead letters before the deadline, such as the timeout of the Lambda function.
+	deliverCtx := ctx
+	if deadline, ok := ctx.Deadline(); ok {
+		var cancel context.CancelFunc
+		deliverCtx, cancel = context.WithDeadline(ctx, deadline.Add(-deadLetterReserve))
+		defer cancel()
+	}
+
+	// endpoints are delivered to in parallel, so that retries for a failing endpoint don't delay the others.
+	var wg sync.WaitGroup
+	for _, e := range n.endpoints {
+		if !e.Matches(event.DetailType) {
+			continue
+		}
+		wg.Add(1)
+		go func(e *Endpoint) {
+			defer wg.Done()
+			attempts, err := n.deliver(deliverCtx, log, e, payload, body)
+			if err != nil {
+				n.writeDeadLetter(ctx, log, e, event, body, attempts, err)
+			}
+		}(e)
+	}
+	wg.Wait()
+	return nil
+}
+
+// writeDeadLetter records an event which couldn't be delivered to the endpoint.
+// An error writing the dead letter is logged, so that it doesn't affect delivery to the other endpoints.
+func (n *WebhookNotifier) writeDeadLetter(ctx context.Context, log *zap.SugaredLogger, e *Endpoint, event events.CloudWatchEvent, body []byte, attempts int, deliveryErr error) {
+	log.Errorw("failed to deliver webhook event, writing dead letter", "endpoint.id", e.ID, "attempts", attempts, zap.Error(deliveryErr))
+	dl := DeadLetter{
+		EndpointID: e.ID,
+		EventID:    event.ID,
+		EventType:  event.DetailType,
+		Payload:    string(body),
+		Attempts:   attempts,
+		Error:      deliveryErr.Error(),
+		CreatedAt:  time.Now(),
+	}
+	err := n.DB.Put(ctx, &dl)
+	if err != nil {
+		log.Errorw("failed to write webhook dead letter", "endpoint.id", e.ID, zap.Error(err))
+	}
+}
+
+// deliver sends the payload to the endpoint, retrying with exponential backoff.
+// It returns the number of attempts made.
+func (n *WebhookNotifier) deliver(ctx context.Context, log *zap.SugaredLogger, e *Endpoint, payload Payload, body []byte) (attempts int, err error) {
+	maxAttempts := n.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultMaxAttempts
+	}
+	backoff := n.InitialBackoff
+	if backoff <= 0 {
+		backoff = defaultInitialBackoff
+	}
+
+	for attempts = 1; ; attempts++ {
+		retryable, err := e.send(ctx, n.Client, payload, body)
+		if err == nil {
+			return attempts, nil
+		}
+		if !retryable || attempts >= maxAttempts {
+			return attempts, err
+		}
+		// don't wait for a retry which can't be made before the deadline.
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(backoff).After(deadline) {
+			return attempts, err
+		}
+		log.Infow("webhook delivery failed, retrying", "endpoint.id", e.ID, "attempt", attempts, "backoff", backoff, zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return attempts, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// send makes a single delivery attempt. Network errors, 429 and 5xx responses are retryable.
+func (e *Endpoint) send(ctx context.Context, client *http.Client, payload Payload, body []byte) (retryable bool, err error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url.Get(), bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventIDHeader, payload.ID)
+	req.Header.Set(EventTypeHeader, payload.Type)
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, Sign(e.signingKey.Get(), timestamp, body))
+
+	res, err := client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return false, nil
+	}
+	resBody, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+	err = fmt.Errorf("webhook endpoint returned status %d: %s", res.StatusCode, string(resBody))
+	retryable = res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= 500
+	return retryable, err
+}
@@ -0,0 +1,193 @@
+package webhooknotifier
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// recordingDB records the items written with Put. Endpoints are delivered to in parallel, so it is safe for concurrent use.
+type recordingDB struct {
+	ddb.Storage
+	mu   sync.Mutex
+	puts []ddb.Keyer
+	// err is returned by Put if set.
+	err error
+}
+
+func (r *recordingDB) Put(ctx context.Context, item ddb.Keyer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.puts = append(r.puts, item)
+	return r.err
+}
+
+func TestEndpointMatches(t *testing.T) {
+	tests := []struct {
+		name       string
+		eventTypes string
+		eventType  string
+		want       bool
+	}{
+		{name: "no filter", eventType: "request.created", want: true},
+		{name: "wildcard", eventTypes: "*", eventType: "grant.revoked", want: true},
+		{name: "exact", eventTypes: "request.created, grant.revoked", eventType: "grant.revoked", want: true},
+		{name: "prefix", eventTypes: "request.*", eventType: "request.breakglass.used", want: true},
+		{name: "no match", eventTypes: "request.*", eventType: "grant.activated", want: false},
+		{name: "prefix requires separator", eventTypes: "grant.*", eventType: "grants", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := Endpoint{eventTypes: gconfig.OptionalStringValue{Value: &tt.eventTypes}}
+			assert.Equal(t, tt.want, e.Matches(tt.eventType))
+		})
+	}
+}
+
+func TestHandleEvent(t *testing.T) {
+	tests := []struct {
+		name           string
+		responses      []int
+		wantRequests   int
+		wantDeadLetter bool
+	}{
+		{name: "delivered", responses: []int{http.StatusOK}, wantRequests: 1},
+		{name: "retried until delivered", responses: []int{http.StatusBadGateway, http.StatusTooManyRequests, http.StatusNoContent}, wantRequests: 3},
+		{name: "retries exhausted", responses: []int{http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError}, wantRequests: 3, wantDeadLetter: true},
+		{name: "client errors are not retried", responses: []int{http.StatusBadRequest}, wantRequests: 1, wantDeadLetter: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				assert.True(t, VerifySignature("secret", r.Header.Get(TimestampHeader), body, r.Header.Get(SignatureHeader)))
+				assert.Equal(t, "request.created", r.Header.Get(EventTypeHeader))
+				assert.Equal(t, "evt_1", r.Header.Get(EventIDHeader))
+
+				var p Payload
+				err = json.Unmarshal(body, &p)
+				if err != nil {
+					t.Fatal(err)
+				}
+				assert.JSONEq(t, `{"request":{"id":"req_1"}}`, string(p.Detail))
+
+				w.WriteHeader(tt.responses[requests])
+				requests++
+			}))
+			defer ts.Close()
+
+			db := &recordingDB{Storage: ddbmock.New(t)}
+			n := WebhookNotifier{
+				DB:             db,
+				Client:         ts.Client(),
+				MaxAttempts:    3,
+				InitialBackoff: time.Millisecond,
+				endpoints: []*Endpoint{
+					{
+						ID:         "siem",
+						url:        gconfig.StringValue{Value: ts.URL},
+						signingKey: gconfig.SecretStringValue{Value: "secret"},
+					},
+				},
+			}
+			err := n.HandleEvent(context.Background(), events.CloudWatchEvent{
+				ID:         "evt_1",
+				DetailType: "request.created",
+				Detail:     json.RawMessage(`{"request":{"id":"req_1"}}`),
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantRequests, requests)
+
+			if !tt.wantDeadLetter {
+				assert.Empty(t, db.puts)
+				return
+			}
+			if assert.Len(t, db.puts, 1) {
+				dl := db.puts[0].(*DeadLetter)
+				assert.Equal(t, "siem", dl.EndpointID)
+				assert.Equal(t, "evt_1", dl.EventID)
+				assert.Equal(t, tt.wantRequests, dl.Attempts)
+			}
+		})
+	}
+}
+
+func TestHandleEventSkipsFilteredEndpoints(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("unexpected request to filtered endpoint")
+	}))
+	defer ts.Close()
+
+	filter := "grant.*"
+	n := WebhookNotifier{
+		DB: ddbmock.New(t),
+		endpoints: []*Endpoint{
+			{ID: "grants", url: gconfig.StringValue{Value: ts.URL}, eventTypes: gconfig.OptionalStringValue{Value: &filter}},
+		},
+	}
+	err := n.HandleEvent(context.Background(), events.CloudWatchEvent{ID: "evt_1", DetailType: "request.created", Detail: json.RawMessage(`{}`)})
+	assert.NoError(t, err)
+}
+
+func TestHandleEventStopsRetryingBeforeDeadline(t *testing.T) {
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+	var delivered int32
+	ok := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&delivered, 1)
+	}))
+	defer ok.Close()
+
+	// writing the dead letter fails, which doesn't prevent delivery to the other endpoint.
+	db := &recordingDB{Storage: ddbmock.New(t), err: errors.New("throttled")}
+	n := WebhookNotifier{
+		DB:             db,
+		MaxAttempts:    4,
+		InitialBackoff: time.Minute,
+		endpoints: []*Endpoint{
+			{ID: "failing", url: gconfig.StringValue{Value: failing.URL}, signingKey: gconfig.SecretStringValue{Value: "secret"}},
+			{ID: "ok", url: gconfig.StringValue{Value: ok.URL}, signingKey: gconfig.SecretStringValue{Value: "secret"}},
+		},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), deadLetterReserve+time.Second)
+	defer cancel()
+	err := n.HandleEvent(ctx, events.CloudWatchEvent{ID: "evt_1", DetailType: "request.created", Detail: json.RawMessage(`{}`)})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&delivered))
+
+	// the retry after a minute can't be made before the deadline, so the delivery error is recorded without waiting.
+	if assert.Len(t, db.puts, 1) {
+		dl := db.puts[0].(*DeadLetter)
+		assert.Equal(t, "failing", dl.EndpointID)
+		assert.Equal(t, 1, dl.Attempts)
+		assert.Contains(t, dl.Error, "status 500")
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	body := []byte(`{"id":"evt_1"}`)
+	sig := Sign("secret", "1700000000", body)
+	assert.True(t, VerifySignature("secret", "1700000000", body, sig))
+	assert.False(t, VerifySignature("other", "1700000000", body, sig))
+	assert.False(t, VerifySignature("secret", "1700000001", body, sig))
+	assert.False(t, VerifySignature("secret", "1700000000", body, "invalid"))
+}
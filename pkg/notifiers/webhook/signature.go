@@ -0,0 +1,36 @@
+package webhooknotifier
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+const (
+	// SignatureHeader holds the HMAC-SHA256 signature of the payload, prefixed with "sha256=".
+	SignatureHeader = "X-CommonFate-Signature"
+	// TimestampHeader holds the unix timestamp the payload was signed at.
+	// It's included in the signature so that receivers can reject replayed payloads.
+	TimestampHeader = "X-CommonFate-Timestamp"
+	EventTypeHeader = "X-CommonFate-Event-Type"
+	EventIDHeader   = "X-CommonFate-Event-ID"
+)
+
+// Sign returns the signature for a payload, computed over "<timestamp>.<body>".
+func Sign(key, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature reports whether signature is a valid signature of the payload.
+// It's provided for receivers written in Go.
+func VerifySignature(key, timestamp string, body []byte, signature string) bool {
+	if !strings.HasPrefix(signature, "sha256=") {
+		return false
+	}
+	return hmac.Equal([]byte(Sign(key, timestamp, body)), []byte(signature))
+}
@@ -1,10 +1,14 @@
 package rule
 
 import (
+	"errors"
+	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/common-fate/common-fate/accesshandler/pkg/providerregistry"
 	"github.com/common-fate/common-fate/accesshandler/pkg/providers"
+	"github.com/common-fate/common-fate/pkg/identity"
 	"github.com/common-fate/common-fate/pkg/storage/keys"
 	"github.com/common-fate/common-fate/pkg/target"
 	"github.com/common-fate/common-fate/pkg/types"
@@ -22,10 +26,14 @@ type AccessRule struct {
 	// When a new version is added, the previous version should be updated to set Current to false
 	Current bool `json:"current" dynamodbav:"current"`
 	// Approver config for access rules
-	Approval    Approval `json:"approval" dynamodbav:"approval"`
-	Version     string   `json:"version" dynamodbav:"version"`
-	Status      Status   `json:"status" dynamodbav:"status"`
-	Description string   `json:"description" dynamodbav:"description"`
+	Approval Approval `json:"approval" dynamodbav:"approval"`
+	// BreakGlass is set if users are permitted to approve their own requests for this rule during an incident
+	BreakGlass *BreakGlass `json:"breakGlass,omitempty" dynamodbav:"breakGlass,omitempty"`
+	// TicketReference is set if requests for this rule must include a ticket reference
+	TicketReference *TicketReference `json:"ticketReference,omitempty" dynamodbav:"ticketReference,omitempty"`
+	Version         string           `json:"version" dynamodbav:"version"`
+	Status          Status           `json:"status" dynamodbav:"status"`
+	Description     string           `json:"description" dynamodbav:"description"`
 
 	// Array of group names that the access rule applies to
 	Groups          []string              `json:"groups" dynamodbav:"groups"`
@@ -41,6 +49,8 @@ type AccessRule struct {
 type GetAccessRuleResponse struct {
 	Rule       *AccessRule
 	CanRequest bool
+	// CanBreakGlass is true if the user can request the rule and is permitted to use break-glass access
+	CanBreakGlass bool
 }
 
 // ised for admin apis, this contains the access rule target in a format for updating the access rule provider target
@@ -59,6 +69,22 @@ func (a AccessRule) ToAPIDetail() types.AccessRuleDetail {
 	if a.Approval.Users != nil {
 		approval.Users = &a.Approval.Users
 	}
+	if len(a.Approval.Steps) > 0 {
+		steps := make([]types.ApprovalStep, len(a.Approval.Steps))
+		for i, step := range a.Approval.Steps {
+			steps[i] = step.ToAPI()
+		}
+		approval.Steps = &steps
+	}
+	if a.Approval.Escalation != nil {
+		esc := a.Approval.Escalation.ToAPI()
+		approval.Escalation = &esc
+	}
+	var breakGlass *types.BreakGlassConfig
+	if a.BreakGlass != nil {
+		bg := a.BreakGlass.ToAPI()
+		breakGlass = &bg
+	}
 	return types.AccessRuleDetail{
 		ID:          a.ID,
 		Description: a.Description,
@@ -70,11 +96,11 @@ func (a AccessRule) ToAPIDetail() types.AccessRuleDetail {
 			CreatedBy:     a.Metadata.CreatedBy,
 			UpdatedBy:     a.Metadata.UpdatedBy,
 		},
-		Groups: a.Groups,
-		TimeConstraints: types.TimeConstraints{
-			MaxDurationSeconds: a.TimeConstraints.MaxDurationSeconds,
-		},
-		Approval: approval,
+		Groups:          a.Groups,
+		TimeConstraints: a.TimeConstraints,
+		Approval:        approval,
+		BreakGlass:      breakGlass,
+		TicketReference: a.TicketReference.ToAPI(),
 
 		Target: a.Target.ToAPIDetail(),
 
@@ -88,13 +114,11 @@ func (a AccessRule) ToAPIDetail() types.AccessRuleDetail {
 func (a AccessRule) ToAPI() types.AccessRule {
 
 	return types.AccessRule{
-		ID:          a.ID,
-		Version:     a.Version,
-		Description: a.Description,
-		Name:        a.Name,
-		TimeConstraints: types.TimeConstraints{
-			MaxDurationSeconds: a.TimeConstraints.MaxDurationSeconds,
-		},
+		ID:              a.ID,
+		Version:         a.Version,
+		Description:     a.Description,
+		Name:            a.Name,
+		TimeConstraints: a.TimeConstraints,
 
 		Target:    a.Target.ToAPI(),
 		IsCurrent: a.Current,
@@ -104,7 +128,7 @@ func (a AccessRule) ToAPI() types.AccessRule {
 }
 
 // This is used to serve a user making a request, it contains all the available arguments and options with title, description and labels
-func (a AccessRule) ToRequestAccessRuleAPI(requestArguments map[string]types.RequestArgument, canRequest bool) types.RequestAccessRule {
+func (a AccessRule) ToRequestAccessRuleAPI(requestArguments map[string]types.RequestArgument, canRequest bool, canBreakGlass bool) types.RequestAccessRule {
 	return types.RequestAccessRule{
 		Version:     a.Version,
 		Description: a.Description,
@@ -119,6 +143,8 @@ func (a AccessRule) ToRequestAccessRuleAPI(requestArguments map[string]types.Req
 		},
 		TimeConstraints: a.TimeConstraints,
 		CanRequest:      canRequest,
+		CanBreakGlass:   canBreakGlass,
+		TicketReference: a.TicketReference.ToAPI(),
 	}
 }
 
@@ -141,10 +167,239 @@ type Approval struct {
 	//List of users ids represents the individual users who may approve requests for this rule.
 	// This does not represent members of the approval groups
 	Users []string `json:"users" dynamodbav:"users"`
+	// Steps is an ordered list of approval stages which must each be satisfied before a request is approved.
+	// When Steps is set, Groups and Users are ignored.
+	Steps []ApprovalStep `json:"steps,omitempty" dynamodbav:"steps,omitempty"`
+	// Escalation configures what happens to requests which are not reviewed in time.
+	Escalation *Escalation `json:"escalation,omitempty" dynamodbav:"escalation,omitempty"`
 }
 
 func (a *Approval) IsRequired() bool {
-	return len(a.Users) > 0 || len(a.Groups) > 0
+	return len(a.Users) > 0 || len(a.Groups) > 0 || len(a.Steps) > 0
+}
+
+// GetSteps returns the ordered approval steps for the rule.
+// Rules which only define flat Groups and Users are treated as a single step requiring one approval.
+func (a *Approval) GetSteps() []ApprovalStep {
+	if len(a.Steps) > 0 {
+		return a.Steps
+	}
+	if !a.IsRequired() {
+		return nil
+	}
+	return []ApprovalStep{{Groups: a.Groups, Users: a.Users, RequiredApprovals: 1}}
+}
+
+// ApprovalStep is a single stage of a multi-stage approval workflow,
+// such as "team lead" followed by "security".
+type ApprovalStep struct {
+	Name string `json:"name" dynamodbav:"name"`
+	// List of group ids whos members may approve this step
+	Groups []string `json:"groups" dynamodbav:"groups"`
+	// List of user ids who may approve this step
+	Users []string `json:"users" dynamodbav:"users"`
+	// RequiredApprovals is the number of distinct approvals needed to complete the step.
+	RequiredApprovals int `json:"requiredApprovals" dynamodbav:"requiredApprovals"`
+}
+
+// Required returns the number of approvals needed to complete the step, which is always at least 1.
+func (s ApprovalStep) Required() int {
+	if s.RequiredApprovals < 1 {
+		return 1
+	}
+	return s.RequiredApprovals
+}
+
+func (s ApprovalStep) ToAPI() types.ApprovalStep {
+	step := types.ApprovalStep{
+		Name:              s.Name,
+		Groups:            []string{},
+		Users:             []string{},
+		RequiredApprovals: s.Required(),
+	}
+	if s.Groups != nil {
+		step.Groups = s.Groups
+	}
+	if s.Users != nil {
+		step.Users = s.Users
+	}
+	return step
+}
+
+// ApprovalStepsFromAPI converts the api approval steps to the internal type
+func ApprovalStepsFromAPI(in []types.ApprovalStep) []ApprovalStep {
+	var steps []ApprovalStep
+	for _, step := range in {
+		steps = append(steps, ApprovalStep{
+			Name:              step.Name,
+			Groups:            step.Groups,
+			Users:             step.Users,
+			RequiredApprovals: step.RequiredApprovals,
+		})
+	}
+	return steps
+}
+
+// Escalation is applied to requests which are still pending after a period of time.
+// After AfterMinutes, the escalation Users and Groups are added as reviewers for the request.
+// If AutoDeclineAfterMinutes is set, the request is declined once it has been pending for that long.
+type Escalation struct {
+	AfterMinutes int `json:"afterMinutes" dynamodbav:"afterMinutes"`
+	// List of group ids whos members are added as reviewers when the request is escalated
+	Groups []string `json:"groups" dynamodbav:"groups"`
+	// List of user ids who are added as reviewers when the request is escalated
+	Users                   []string `json:"users" dynamodbav:"users"`
+	AutoDeclineAfterMinutes *int     `json:"autoDeclineAfterMinutes,omitempty" dynamodbav:"autoDeclineAfterMinutes,omitempty"`
+}
+
+// EscalateAt returns the time at which a request created at createdAt should be escalated.
+func (e Escalation) EscalateAt(createdAt time.Time) time.Time {
+	return createdAt.Add(time.Duration(e.AfterMinutes) * time.Minute)
+}
+
+// AutoDeclineAt returns the time at which a request created at createdAt should be automatically declined.
+// ok is false if the escalation does not auto-decline requests.
+func (e Escalation) AutoDeclineAt(createdAt time.Time) (t time.Time, ok bool) {
+	if e.AutoDeclineAfterMinutes == nil {
+		return time.Time{}, false
+	}
+	return createdAt.Add(time.Duration(*e.AutoDeclineAfterMinutes) * time.Minute), true
+}
+
+func (e Escalation) ToAPI() types.Escalation {
+	esc := types.Escalation{
+		AfterMinutes:            e.AfterMinutes,
+		Groups:                  []string{},
+		Users:                   []string{},
+		AutoDeclineAfterMinutes: e.AutoDeclineAfterMinutes,
+	}
+	if e.Groups != nil {
+		esc.Groups = e.Groups
+	}
+	if e.Users != nil {
+		esc.Users = e.Users
+	}
+	return esc
+}
+
+// EscalationFromAPI converts the api escalation to the internal type
+func EscalationFromAPI(in types.Escalation) *Escalation {
+	return &Escalation{
+		AfterMinutes:            in.AfterMinutes,
+		Groups:                  in.Groups,
+		Users:                   in.Users,
+		AutoDeclineAfterMinutes: in.AutoDeclineAfterMinutes,
+	}
+}
+
+// BreakGlass configures emergency access for an access rule.
+// Permitted users can approve their own requests immediately, and the request must then be reviewed by the rule's approvers.
+type BreakGlass struct {
+	// List of group ids whos members may use break-glass access
+	Groups []string `json:"groups" dynamodbav:"groups"`
+	// List of user ids who may use break-glass access
+	Users []string `json:"users" dynamodbav:"users"`
+}
+
+// Permits returns true if the user is permitted to use break-glass access, either directly or through one of their groups.
+func (b BreakGlass) Permits(user identity.User) bool {
+	for _, u := range b.Users {
+		if u == user.ID {
+			return true
+		}
+	}
+	for _, g := range b.Groups {
+		for _, ug := range user.Groups {
+			if g == ug {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (b BreakGlass) ToAPI() types.BreakGlassConfig {
+	bg := types.BreakGlassConfig{
+		Groups: []string{},
+		Users:  []string{},
+	}
+	if b.Groups != nil {
+		bg.Groups = b.Groups
+	}
+	if b.Users != nil {
+		bg.Users = b.Users
+	}
+	return bg
+}
+
+// BreakGlassFromAPI converts the api break-glass config to the internal type
+func BreakGlassFromAPI(in types.BreakGlassConfig) *BreakGlass {
+	return &BreakGlass{
+		Groups: in.Groups,
+		Users:  in.Users,
+	}
+}
+
+// TicketReference requires requests for an access rule to include a ticket reference, such as a Jira issue key or incident ID.
+type TicketReference struct {
+	// Pattern is an optional regular expression which the ticket reference must match
+	Pattern string `json:"pattern,omitempty" dynamodbav:"pattern,omitempty"`
+	// Description is shown to users making a request
+	Description string `json:"description,omitempty" dynamodbav:"description,omitempty"`
+	// Validate is true if the ticket reference should be checked with the ticket validator configured for the deployment
+	Validate bool `json:"validate" dynamodbav:"validate"`
+}
+
+// Check returns an error if the ticket reference is empty or doesn't match the pattern.
+func (t TicketReference) Check(ref string) error {
+	if ref == "" {
+		return errors.New("a ticket reference is required for this access rule")
+	}
+	if t.Pattern == "" {
+		return nil
+	}
+	re, err := regexp.Compile(t.Pattern)
+	if err != nil {
+		return err
+	}
+	if !re.MatchString(ref) {
+		return fmt.Errorf("ticket reference %s does not match the pattern %s", ref, t.Pattern)
+	}
+	return nil
+}
+
+// ToAPI returns nil if the ticket reference config is nil, so it can be used directly for the optional api field.
+func (t *TicketReference) ToAPI() *types.TicketReferenceConfig {
+	if t == nil {
+		return nil
+	}
+	res := types.TicketReferenceConfig{Validate: &t.Validate}
+	if t.Pattern != "" {
+		res.Pattern = &t.Pattern
+	}
+	if t.Description != "" {
+		res.Description = &t.Description
+	}
+	return &res
+}
+
+// TicketReferenceFromAPI converts the api ticket reference config to the internal type
+func TicketReferenceFromAPI(in types.TicketReferenceConfig) (*TicketReference, error) {
+	var t TicketReference
+	if in.Pattern != nil && *in.Pattern != "" {
+		_, err := regexp.Compile(*in.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("ticket reference pattern is not a valid regular expression: %w", err)
+		}
+		t.Pattern = *in.Pattern
+	}
+	if in.Description != nil {
+		t.Description = *in.Description
+	}
+	if in.Validate != nil {
+		t.Validate = *in.Validate
+	}
+	return &t, nil
 }
 
 // Provider defines model for Provider.
@@ -0,0 +1,167 @@
+package rule
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/types"
+)
+
+// weekdays maps the API weekday codes to weekdays.
+var weekdays = map[types.Weekday]time.Weekday{
+	types.MO: time.Monday,
+	types.TU: time.Tuesday,
+	types.WE: time.Wednesday,
+	types.TH: time.Thursday,
+	types.FR: time.Friday,
+	types.SA: time.Saturday,
+	types.SU: time.Sunday,
+}
+
+// ValidateTimeConstraints returns an error if the time constraints of an access rule are misconfigured.
+func ValidateTimeConstraints(tc types.TimeConstraints) error {
+	if tc.ActiveWindow != nil {
+		w := tc.ActiveWindow
+		if _, err := time.LoadLocation(w.Timezone); err != nil || w.Timezone == "" {
+			return fmt.Errorf("active window timezone %q is not a valid IANA timezone", w.Timezone)
+		}
+		start, err := parseTimeOfDay(w.StartTime)
+		if err != nil {
+			return fmt.Errorf("active window startTime: %w", err)
+		}
+		end, err := parseTimeOfDay(w.EndTime)
+		if err != nil {
+			return fmt.Errorf("active window endTime: %w", err)
+		}
+		if end <= start {
+			return errors.New("active window endTime must be after startTime")
+		}
+		if w.Weekdays != nil {
+			for _, d := range *w.Weekdays {
+				if _, ok := weekdays[d]; !ok {
+					return fmt.Errorf("active window weekday %q is not valid", d)
+				}
+			}
+		}
+	}
+	if tc.Blackouts != nil {
+		for i, b := range *tc.Blackouts {
+			if !b.End.After(b.Start) {
+				return fmt.Errorf("blackout %d must end after it starts", i)
+			}
+		}
+	}
+	if tc.MinimumNoticeSeconds != nil && *tc.MinimumNoticeSeconds < 0 {
+		return errors.New("minimumNoticeSeconds can't be negative")
+	}
+	return nil
+}
+
+// CheckActiveWindow returns an error if access between start and end would fall outside of the active window of the rule.
+// Access must start and end within the window on a single day.
+func (a AccessRule) CheckActiveWindow(start time.Time, end time.Time) error {
+	w := a.TimeConstraints.ActiveWindow
+	if w == nil {
+		return nil
+	}
+	loc, err := time.LoadLocation(w.Timezone)
+	if err != nil {
+		return err
+	}
+	startOfDay, err := parseTimeOfDay(w.StartTime)
+	if err != nil {
+		return err
+	}
+	endOfDay, err := parseTimeOfDay(w.EndTime)
+	if err != nil {
+		return err
+	}
+	local := start.In(loc)
+	windowStart := time.Date(local.Year(), local.Month(), local.Day(), 0, startOfDay, 0, 0, loc)
+	windowEnd := time.Date(local.Year(), local.Month(), local.Day(), 0, endOfDay, 0, 0, loc)
+
+	if !windowAllowsDay(*w, local.Weekday()) || local.Before(windowStart) || end.After(windowEnd) {
+		return fmt.Errorf("access can only be active %s", describeActiveWindow(*w))
+	}
+	return nil
+}
+
+// Blackout returns the blackout which access between start and end overlaps with, if any.
+func (a AccessRule) Blackout(start time.Time, end time.Time) (*types.Blackout, bool) {
+	if a.TimeConstraints.Blackouts == nil {
+		return nil, false
+	}
+	for _, b := range *a.TimeConstraints.Blackouts {
+		if start.Before(b.End) && end.After(b.Start) {
+			return &b, true
+		}
+	}
+	return nil, false
+}
+
+// InBlackout returns the blackout which t falls inside of, if any.
+func (a AccessRule) InBlackout(t time.Time) (*types.Blackout, bool) {
+	if a.TimeConstraints.Blackouts == nil {
+		return nil, false
+	}
+	for _, b := range *a.TimeConstraints.Blackouts {
+		if !t.Before(b.Start) && t.Before(b.End) {
+			return &b, true
+		}
+	}
+	return nil, false
+}
+
+// MinimumNotice is how long before scheduled access starts it must be requested.
+func (a AccessRule) MinimumNotice() time.Duration {
+	if a.TimeConstraints.MinimumNoticeSeconds == nil {
+		return 0
+	}
+	return time.Duration(*a.TimeConstraints.MinimumNoticeSeconds) * time.Second
+}
+
+// DescribeBlackout returns a human readable description of a blackout, for use in error messages.
+func DescribeBlackout(b types.Blackout) string {
+	desc := fmt.Sprintf("%s to %s", b.Start.UTC().Format(time.RFC3339), b.End.UTC().Format(time.RFC3339))
+	if b.Reason != nil && *b.Reason != "" {
+		desc += " (" + *b.Reason + ")"
+	}
+	return desc
+}
+
+// parseTimeOfDay parses a time of day in HH:MM format, returning the number of minutes since midnight.
+// 24:00 is accepted as the end of the day.
+func parseTimeOfDay(s string) (int, error) {
+	var h, m int
+	_, err := fmt.Sscanf(s, "%d:%d", &h, &m)
+	if err != nil || len(s) != 5 || h < 0 || m < 0 || m > 59 || h > 24 || (h == 24 && m != 0) {
+		return 0, fmt.Errorf("%q is not a valid time of day in HH:MM format", s)
+	}
+	return h*60 + m, nil
+}
+
+func windowAllowsDay(w types.ActiveWindow, day time.Weekday) bool {
+	if w.Weekdays == nil || len(*w.Weekdays) == 0 {
+		return true
+	}
+	for _, d := range *w.Weekdays {
+		if weekdays[d] == day {
+			return true
+		}
+	}
+	return false
+}
+
+func describeActiveWindow(w types.ActiveWindow) string {
+	desc := fmt.Sprintf("between %s and %s", w.StartTime, w.EndTime)
+	if w.Weekdays != nil && len(*w.Weekdays) > 0 {
+		var days []string
+		for _, d := range *w.Weekdays {
+			days = append(days, weekdays[d].String())
+		}
+		desc += " on " + strings.Join(days, ", ")
+	}
+	return desc + " (" + w.Timezone + ")"
+}
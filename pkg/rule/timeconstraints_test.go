@@ -0,0 +1,166 @@
+package rule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckActiveWindow(t *testing.T) {
+	sydney, err := time.LoadLocation("Australia/Sydney")
+	if err != nil {
+		t.Fatal(err)
+	}
+	businessHours := &types.ActiveWindow{
+		Timezone:  "Australia/Sydney",
+		Weekdays:  &[]types.Weekday{types.MO, types.TU, types.WE, types.TH, types.FR},
+		StartTime: "09:00",
+		EndTime:   "17:00",
+	}
+	// 2022-01-04 is a Tuesday
+	tuesday := func(hour int, min int) time.Time { return time.Date(2022, 1, 4, hour, min, 0, 0, sydney) }
+
+	type testcase struct {
+		name      string
+		give      *types.ActiveWindow
+		giveStart time.Time
+		giveEnd   time.Time
+		wantErr   string
+	}
+
+	testcases := []testcase{
+		{
+			name:      "no active window",
+			giveStart: tuesday(2, 0),
+			giveEnd:   tuesday(4, 0),
+		},
+		{
+			name:      "inside window",
+			give:      businessHours,
+			giveStart: tuesday(9, 0),
+			giveEnd:   tuesday(17, 0),
+		},
+		{
+			name:      "inside window in a different timezone",
+			give:      businessHours,
+			giveStart: tuesday(10, 0).UTC(),
+			giveEnd:   tuesday(11, 0).UTC(),
+		},
+		{
+			name:      "starts before window",
+			give:      businessHours,
+			giveStart: tuesday(8, 59),
+			giveEnd:   tuesday(10, 0),
+			wantErr:   "access can only be active between 09:00 and 17:00 on Monday, Tuesday, Wednesday, Thursday, Friday (Australia/Sydney)",
+		},
+		{
+			name:      "ends after window",
+			give:      businessHours,
+			giveStart: tuesday(16, 0),
+			giveEnd:   tuesday(17, 1),
+			wantErr:   "access can only be active between 09:00 and 17:00 on Monday, Tuesday, Wednesday, Thursday, Friday (Australia/Sydney)",
+		},
+		{
+			name:      "weekend",
+			give:      businessHours,
+			giveStart: tuesday(10, 0).AddDate(0, 0, 4),
+			giveEnd:   tuesday(11, 0).AddDate(0, 0, 4),
+			wantErr:   "access can only be active between 09:00 and 17:00 on Monday, Tuesday, Wednesday, Thursday, Friday (Australia/Sydney)",
+		},
+		{
+			name:      "end of day",
+			give:      &types.ActiveWindow{Timezone: "UTC", StartTime: "22:00", EndTime: "24:00"},
+			giveStart: time.Date(2022, 1, 4, 22, 0, 0, 0, time.UTC),
+			giveEnd:   time.Date(2022, 1, 5, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := AccessRule{TimeConstraints: types.TimeConstraints{ActiveWindow: tc.give}}
+			err := r.CheckActiveWindow(tc.giveStart, tc.giveEnd)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestBlackout(t *testing.T) {
+	freezeStart := time.Date(2022, 12, 20, 0, 0, 0, 0, time.UTC)
+	freezeEnd := time.Date(2023, 1, 3, 0, 0, 0, 0, time.UTC)
+	reason := "End of year change freeze"
+	r := AccessRule{TimeConstraints: types.TimeConstraints{Blackouts: &[]types.Blackout{{Start: freezeStart, End: freezeEnd, Reason: &reason}}}}
+
+	_, ok := r.Blackout(freezeStart.Add(-2*time.Hour), freezeStart)
+	assert.False(t, ok, "access ending as the blackout starts")
+	_, ok = r.Blackout(freezeStart.Add(-time.Hour), freezeStart.Add(time.Hour))
+	assert.True(t, ok, "access running into the blackout")
+	_, ok = r.InBlackout(freezeStart.Add(-time.Hour))
+	assert.False(t, ok, "start before the blackout")
+	b, ok := r.InBlackout(freezeStart)
+	assert.True(t, ok, "start at the beginning of the blackout")
+	assert.Equal(t, "2022-12-20T00:00:00Z to 2023-01-03T00:00:00Z (End of year change freeze)", DescribeBlackout(*b))
+	_, ok = r.InBlackout(freezeEnd)
+	assert.False(t, ok, "start at the end of the blackout")
+}
+
+func TestValidateTimeConstraints(t *testing.T) {
+	negative := -1
+	type testcase struct {
+		name    string
+		give    types.TimeConstraints
+		wantErr string
+	}
+
+	testcases := []testcase{
+		{
+			name: "ok",
+			give: types.TimeConstraints{
+				MaxDurationSeconds: 3600,
+				ActiveWindow:       &types.ActiveWindow{Timezone: "Europe/London", StartTime: "09:00", EndTime: "24:00"},
+				Blackouts:          &[]types.Blackout{{Start: time.Date(2022, 12, 20, 0, 0, 0, 0, time.UTC), End: time.Date(2023, 1, 3, 0, 0, 0, 0, time.UTC)}},
+			},
+		},
+		{
+			name:    "invalid timezone",
+			give:    types.TimeConstraints{ActiveWindow: &types.ActiveWindow{Timezone: "Mars/Olympus", StartTime: "09:00", EndTime: "17:00"}},
+			wantErr: `active window timezone "Mars/Olympus" is not a valid IANA timezone`,
+		},
+		{
+			name:    "invalid time of day",
+			give:    types.TimeConstraints{ActiveWindow: &types.ActiveWindow{Timezone: "UTC", StartTime: "9am", EndTime: "17:00"}},
+			wantErr: `active window startTime: "9am" is not a valid time of day in HH:MM format`,
+		},
+		{
+			name:    "end before start",
+			give:    types.TimeConstraints{ActiveWindow: &types.ActiveWindow{Timezone: "UTC", StartTime: "17:00", EndTime: "09:00"}},
+			wantErr: "active window endTime must be after startTime",
+		},
+		{
+			name:    "blackout ends before it starts",
+			give:    types.TimeConstraints{Blackouts: &[]types.Blackout{{Start: time.Date(2023, 1, 3, 0, 0, 0, 0, time.UTC), End: time.Date(2022, 12, 20, 0, 0, 0, 0, time.UTC)}}},
+			wantErr: "blackout 0 must end after it starts",
+		},
+		{
+			name:    "negative notice",
+			give:    types.TimeConstraints{MinimumNoticeSeconds: &negative},
+			wantErr: "minimumNoticeSeconds can't be negative",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateTimeConstraints(tc.give)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,29 @@
+// Package schedule runs jobs on an interval in the local development server,
+// where there are no scheduled Lambdas to run them.
+package schedule
+
+import (
+	"context"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"go.uber.org/zap"
+)
+
+// RunEvery calls fn on the given interval until the context is cancelled.
+// Errors returned by fn are logged with msg rather than stopping the schedule, so that the job is retried on the next tick.
+func RunEvery(ctx context.Context, clk clock.Clock, interval time.Duration, msg string, fn func(ctx context.Context) error) {
+	t := clk.Ticker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			err := fn(ctx)
+			if err != nil {
+				zap.S().Errorw(msg, zap.Error(err))
+			}
+		}
+	}
+}
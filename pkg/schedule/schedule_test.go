@@ -0,0 +1,37 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunEvery(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	runs := 0
+	done := make(chan struct{})
+	go func() {
+		RunEvery(ctx, clock.New(), time.Millisecond, "failed to run job", func(ctx context.Context) error {
+			runs++
+			if runs == 3 {
+				cancel()
+			}
+			// an error doesn't stop the job from running on the next tick
+			return errors.New("job failed")
+		})
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("RunEvery did not return after the context was cancelled")
+	}
+	assert.Equal(t, 3, runs)
+}
@@ -0,0 +1,68 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/auth"
+	"github.com/common-fate/common-fate/pkg/configchange"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
+	"github.com/common-fate/ddb"
+	chiMiddleware "github.com/go-chi/chi/v5/middleware"
+	"go.uber.org/zap"
+)
+
+// changeReasonHeader is the header which administrators can use to give a reason for the changes made by a request.
+const changeReasonHeader = "X-Change-Reason"
+
+// changeReasonMiddleware adds the reason given in the X-Change-Reason header to the context,
+// so that it is recorded with any configuration changes made by the request.
+func changeReasonMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if reason := r.Header.Get(changeReasonHeader); reason != "" {
+			r = r.WithContext(configchange.WithReason(r.Context(), reason))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// auditMiddleware records calls to administrative endpoints which can make changes in the audit log.
+// It must run after auth.Middleware, and runs before auth.AdminAuthorizer so that denied calls are recorded too.
+func auditMiddleware(db ddb.Storage, log *zap.SugaredLogger) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !strings.HasPrefix(r.URL.Path, "/api/v1/admin") || r.Method == http.MethodGet || r.Method == http.MethodHead || r.Method == http.MethodOptions {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ww := chiMiddleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			next.ServeHTTP(ww, r)
+
+			ctx := r.Context()
+			usr := auth.UserFromContext(ctx)
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+			evt := gevent.AdminAPICalled{
+				ActorID:    usr.ID,
+				ActorEmail: usr.Email,
+				Method:     r.Method,
+				Path:       r.URL.Path,
+				Status:     status,
+				RequestID:  chiMiddleware.GetReqID(ctx),
+			}
+			// the response has already been written, so a failure to record the call can only be logged.
+			items, err := dbupdate.OutboxEvents(time.Now(), evt)
+			if err == nil {
+				err = db.PutBatch(ctx, items...)
+			}
+			if err != nil {
+				log.Errorw("failed to record admin API call in audit log", "event", evt, zap.Error(err))
+			}
+		})
+	}
+}
@@ -23,11 +23,13 @@ func (c *Server) Handler() http.Handler {
 	r.Use(cors.Handler(cors.Options{
 		AllowedOrigins:   []string{c.cfg.FrontendURL},
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", changeReasonHeader},
 		AllowCredentials: true,
 		MaxAge:           300,
 	}))
 	r.Use(auth.Middleware(c.authenticator, c.db, c.identitySyncer))
+	r.Use(auditMiddleware(c.db, c.log))
+	r.Use(changeReasonMiddleware)
 	r.Use(auth.AdminAuthorizer(c.cfg.AdminGroup))
 	r.Use(openapi.Validator(c.swagger))
 
@@ -7,6 +7,7 @@ import (
 	"github.com/common-fate/common-fate/pkg/access"
 	"github.com/common-fate/common-fate/pkg/gevent"
 	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/service/workflowsvc"
 	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
 	"github.com/common-fate/common-fate/pkg/types"
 )
@@ -44,6 +45,12 @@ func (s *Service) AddReviewAndGrantAccess(ctx context.Context, opts AddReviewOpt
 	if !isAllowed {
 		return nil, ErrUserNotAuthorized
 	}
+	if opts.OverrideTiming != nil && opts.Decision == access.DecisionApproved {
+		err := validateOverrideTiming(ctx, *opts.OverrideTiming, opts.AccessRule, s.Clock.Now())
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	r := access.Review{
 		ID:              types.NewRequestReviewID(),
@@ -52,11 +59,25 @@ func (s *Service) AddReviewAndGrantAccess(ctx context.Context, opts AddReviewOpt
 		Decision:        opts.Decision,
 		Comment:         opts.Comment,
 		OverrideTimings: opts.OverrideTiming,
+		OnBehalfOf:      onBehalfOf(opts),
 	}
 
+	now := s.Clock.Now()
+	var approval addApprovalResult
+	var occurrences []access.Request
+
 	// update the request status, based on the review decision
 	switch r.Decision {
 	case access.DecisionApproved:
+		var err error
+		approval, err = addApproval(&request, opts, now)
+		if err != nil {
+			return nil, err
+		}
+		if !approval.Complete {
+			// the request stays pending until every approval step has been satisfied
+			break
+		}
 		request.Status = access.APPROVED
 		request.OverrideTiming = opts.OverrideTiming
 		// This will check against the requests which do have grants already
@@ -67,51 +88,85 @@ func (s *Service) AddReviewAndGrantAccess(ctx context.Context, opts AddReviewOpt
 		if overlaps {
 			return nil, ErrRequestOverlapsExistingGrant
 		}
-		grant, err := s.Workflow.Grant(ctx, request, opts.AccessRule)
-		if err != nil {
-			return nil, err
+		// recurring requests are granted once for each occurrence, rather than once for the request.
+		if request.IsRecurring() {
+			occurrences, err = s.grantOccurrences(ctx, request, opts.AccessRule)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			grant, err := s.Workflow.Grant(ctx, request, opts.AccessRule)
+			if err != nil {
+				return nil, err
+			}
+			request.Grant = grant
 		}
-		request.Grant = grant
 		reviewed := types.REVIEWED
 		request.ApprovalMethod = &reviewed
 
 	case access.DecisionDECLINED:
 		request.Status = access.DECLINED
 	}
-	request.UpdatedAt = s.Clock.Now()
+	request.UpdatedAt = now
+
+	// record the decision against the reviewer so that the progress of the review is visible on the Reviewer records.
+	reviewers := make([]access.Reviewer, len(opts.Reviewers))
+	copy(reviewers, opts.Reviewers)
+	for i := range reviewers {
+		if reviewers[i].ReviewerID == opts.ReviewerID {
+			decision := r.Decision
+			reviewers[i].Decision = &decision
+		}
+	}
+
+	var evt gevent.EventTyper
+	switch request.Status {
+	case access.APPROVED:
+		evt = gevent.RequestApproved{Request: request, ReviewerEmail: opts.ReviewerEmail, ReviewerID: r.ReviewerID}
+	case access.DECLINED:
+		evt = gevent.RequestDeclined{Request: request, ReviewerEmail: opts.ReviewerEmail, ReviewerID: r.ReviewerID}
+	}
+	updateOpts := []func(*dbupdate.UpdateRequestOpts){dbupdate.WithReviewers(reviewers)}
+	if evt != nil {
+		updateOpts = append(updateOpts, dbupdate.WithEvents(evt))
+	}
+	if request.Status == access.APPROVED {
+		updateOpts = append(updateOpts, dbupdate.WithEvents(workflowsvc.GrantEvents(request, opts.AccessRule)...))
+	}
 
 	// we need to save the Review, the updated Request in the database.
-	items, err := dbupdate.GetUpdateRequestItems(ctx, s.DB, request, dbupdate.WithReviewers(opts.Reviewers))
+	items, err := dbupdate.GetUpdateRequestItems(ctx, s.DB, request, updateOpts...)
 	if err != nil {
 		return nil, err
 	}
 	items = append(items, &r)
 
+	// audit log events. If the reviewer is a delegate, the events record the approvers they acted on behalf of.
+	if len(opts.AccessRule.Approval.Steps) > 0 && r.Decision == access.DecisionApproved {
+		progressEvent := access.NewApprovalProgressEvent(request.ID, request.UpdatedAt, &opts.ReviewerID, approval.Progress)
+		progressEvent.OnBehalfOf = r.OnBehalfOf
+		items = append(items, &progressEvent)
+	}
+
 	if request.OverrideTiming != nil {
-		// audit log event
 		reqEvent := access.NewTimingChangeEvent(request.ID, request.UpdatedAt, &opts.ReviewerID, request.RequestedTiming, *request.OverrideTiming)
+		reqEvent.OnBehalfOf = r.OnBehalfOf
 		items = append(items, &reqEvent)
 	}
-	// audit log event
-	reqEvent := access.NewStatusChangeEvent(request.ID, request.UpdatedAt, &opts.ReviewerID, originalStatus, request.Status)
-
-	items = append(items, &reqEvent)
-	// store the updated items in the database
-	err = s.DB.PutBatch(ctx, items...)
-	if err != nil {
-		return nil, err
+	if request.Status != originalStatus {
+		reqEvent := access.NewStatusChangeEvent(request.ID, request.UpdatedAt, &opts.ReviewerID, originalStatus, request.Status)
+		reqEvent.OnBehalfOf = r.OnBehalfOf
+		items = append(items, &reqEvent)
 	}
 
-	switch r.Decision {
-	case access.DecisionApproved:
-		err = s.EventPutter.Put(ctx, gevent.RequestApproved{Request: request, ReviewerEmail: opts.ReviewerEmail, ReviewerID: r.ReviewerID})
-	case access.DecisionDECLINED:
-		err = s.EventPutter.Put(ctx, gevent.RequestDeclined{Request: request, ReviewerEmail: opts.ReviewerEmail, ReviewerID: r.ReviewerID})
+	// store the updated items in the database. The occurrences of a recurring request are saved first,
+	// and their grants are revoked if the request can't be saved so that access isn't left provisioned for an unapproved request.
+	err = s.saveOccurrences(ctx, occurrences, opts.AccessRule)
+	if err == nil {
+		err = dbupdate.PutItems(ctx, s.DB, items...)
 	}
-
-	// In a future PR we will shift these events out to be triggered by dynamo db streams
-	// This will currently put the app in a strange state if this fails
 	if err != nil {
+		s.revokeOccurrences(ctx, occurrences, opts.AccessRule)
 		return nil, err
 	}
 
@@ -162,3 +217,13 @@ func canReview(opts AddReviewOpts) bool {
 	// the user isn't allowed to review the request.
 	return false
 }
+
+// onBehalfOf returns the approvers who delegated their review to the reviewer, if the reviewer is a delegate.
+func onBehalfOf(opts AddReviewOpts) []string {
+	for _, r := range opts.Reviewers {
+		if r.ReviewerID == opts.ReviewerID {
+			return r.OnBehalfOf
+		}
+	}
+	return nil
+}
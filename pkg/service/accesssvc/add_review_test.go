@@ -2,12 +2,13 @@ package accesssvc
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/benbjohnson/clock"
 	"github.com/common-fate/common-fate/pkg/access"
-	"github.com/common-fate/common-fate/pkg/service/accesssvc/mocks"
+	"github.com/common-fate/common-fate/pkg/rule"
 	accessMocks "github.com/common-fate/common-fate/pkg/service/accesssvc/mocks"
 	"github.com/common-fate/common-fate/pkg/storage"
 	"github.com/common-fate/common-fate/pkg/types"
@@ -109,6 +110,7 @@ func TestAddReview(t *testing.T) {
 					Status: access.PENDING,
 				},
 				OverrideTiming: overrideTiming,
+				AccessRule:     rule.AccessRule{TimeConstraints: types.TimeConstraints{MaxDurationSeconds: 3600}},
 			},
 
 			withCreateGrantResponse: createGrantResponse{
@@ -119,6 +121,31 @@ func TestAddReview(t *testing.T) {
 				Request: requestWithOverride,
 			},
 		},
+		{
+			name: "override longer than the maximum duration",
+			give: AddReviewOpts{
+				ReviewerID:     "a",
+				Decision:       access.DecisionApproved,
+				Reviewers:      []access.Reviewer{{ReviewerID: "a"}},
+				Request:        access.Request{Status: access.PENDING},
+				OverrideTiming: &access.Timing{Duration: 2 * time.Hour, StartTime: &now},
+				AccessRule:     rule.AccessRule{TimeConstraints: types.TimeConstraints{MaxDurationSeconds: 3600}},
+			},
+			wantErr: errors.New("review validation failed"),
+		},
+		{
+			name: "override with an invalid recurrence",
+			give: AddReviewOpts{
+				ReviewerID: "a",
+				Decision:   access.DecisionApproved,
+				Reviewers:  []access.Reviewer{{ReviewerID: "a"}},
+				Request:    access.Request{Status: access.PENDING},
+				// the recurrence must end after it starts
+				OverrideTiming: &access.Timing{Duration: time.Minute, StartTime: &now, Recurrence: &access.Recurrence{Frequency: access.FrequencyDaily, Interval: 1, Until: now.Add(-time.Hour)}},
+				AccessRule:     rule.AccessRule{TimeConstraints: types.TimeConstraints{MaxDurationSeconds: 3600}},
+			},
+			wantErr: errors.New("request validation failed"),
+		},
 		{
 			name: "cannot review own request",
 			give: AddReviewOpts{
@@ -194,10 +221,6 @@ func TestAddReview(t *testing.T) {
 				workflowMock.EXPECT().Grant(gomock.Any(), gomock.Any(), gomock.Any()).Return(tc.withCreateGrantResponse.request.Grant, tc.withCreateGrantResponse.err).AnyTimes()
 			}
 
-			ctrl2 := gomock.NewController(t)
-			ep := mocks.NewMockEventPutter(ctrl2)
-			ep.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-
 			c := ddbmock.New(t)
 			c.MockQuery(&storage.ListRequestsForUserAndRequestend{})
 
@@ -205,10 +228,9 @@ func TestAddReview(t *testing.T) {
 			c.MockQuery(&storage.ListRequestReviewers{})
 
 			s := Service{
-				Clock:       clk,
-				DB:          c,
-				EventPutter: ep,
-				Workflow:    workflowMock,
+				Clock:    clk,
+				DB:       c,
+				Workflow: workflowMock,
 			}
 			got, err := s.AddReviewAndGrantAccess(context.Background(), tc.give)
 			if tc.wantErr == nil {
@@ -222,3 +244,112 @@ func TestAddReview(t *testing.T) {
 	}
 
 }
+
+func TestAddReviewMultiStep(t *testing.T) {
+	type testcase struct {
+		name            string
+		giveReviewerID  string
+		giveIsAdmin     bool
+		giveApprovals   []access.StepApproval
+		wantStatus      access.Status
+		wantApprovals   int
+		wantGrantCalled bool
+		wantErr         error
+	}
+
+	clk := clock.NewMock()
+	accessRule := rule.AccessRule{
+		Approval: rule.Approval{
+			Steps: []rule.ApprovalStep{
+				{Name: "team lead", Users: []string{"lead"}, RequiredApprovals: 1},
+				{Name: "security", Users: []string{"sec1", "sec2"}, RequiredApprovals: 2},
+			},
+		},
+	}
+	reviewers := []access.Reviewer{
+		{ReviewerID: "lead", Steps: []int{0}},
+		{ReviewerID: "sec1", Steps: []int{1}},
+		{ReviewerID: "sec2", Steps: []int{1}},
+	}
+
+	testcases := []testcase{
+		{
+			name:           "first step approval keeps request pending",
+			giveReviewerID: "lead",
+			wantStatus:     access.PENDING,
+			wantApprovals:  1,
+		},
+		{
+			name:           "later step cannot approve before earlier step",
+			giveReviewerID: "sec1",
+			wantErr:        ErrNotApproverForStep,
+		},
+		{
+			name:           "quorum not yet met keeps request pending",
+			giveReviewerID: "sec1",
+			giveApprovals:  []access.StepApproval{{Step: 0, ReviewerID: "lead"}},
+			wantStatus:     access.PENDING,
+			wantApprovals:  2,
+		},
+		{
+			name:           "reviewer cannot approve twice",
+			giveReviewerID: "sec1",
+			giveApprovals:  []access.StepApproval{{Step: 0, ReviewerID: "lead"}, {Step: 1, ReviewerID: "sec1"}},
+			wantErr:        ErrReviewerAlreadyApproved,
+		},
+		{
+			name:            "final approval approves the request",
+			giveReviewerID:  "sec2",
+			giveApprovals:   []access.StepApproval{{Step: 0, ReviewerID: "lead"}, {Step: 1, ReviewerID: "sec1"}},
+			wantStatus:      access.APPROVED,
+			wantApprovals:   3,
+			wantGrantCalled: true,
+		},
+		{
+			name:           "admin approval counts towards the current step",
+			giveReviewerID: "admin",
+			giveIsAdmin:    true,
+			wantStatus:     access.PENDING,
+			wantApprovals:  1,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			workflowMock := accessMocks.NewMockWorkflow(ctrl)
+			if tc.wantGrantCalled {
+				workflowMock.EXPECT().Grant(gomock.Any(), gomock.Any(), gomock.Any()).Return(&access.Grant{}, nil).Times(1)
+			}
+
+			c := ddbmock.New(t)
+			c.MockQuery(&storage.ListRequestsForUserAndRequestend{})
+
+			s := Service{
+				Clock:    clk,
+				DB:       c,
+				Workflow: workflowMock,
+			}
+			got, err := s.AddReviewAndGrantAccess(context.Background(), AddReviewOpts{
+				ReviewerID:      tc.giveReviewerID,
+				ReviewerIsAdmin: tc.giveIsAdmin,
+				Decision:        access.DecisionApproved,
+				Reviewers:       reviewers,
+				Request: access.Request{
+					RequestedBy: "requestor",
+					Status:      access.PENDING,
+					Approvals:   tc.giveApprovals,
+				},
+				AccessRule: accessRule,
+			})
+			if tc.wantErr != nil {
+				assert.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantStatus, got.Request.Status)
+			assert.Len(t, got.Request.Approvals, tc.wantApprovals)
+		})
+	}
+}
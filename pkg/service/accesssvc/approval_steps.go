@@ -0,0 +1,83 @@
+package accesssvc
+
+import (
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/rule"
+)
+
+// approvalCounter is implemented by requests and request extensions, which both record approvals towards the approval steps of an access rule.
+type approvalCounter interface {
+	ApprovalsForStep(step int) int
+}
+
+// currentApprovalStep returns the index of the first approval step which has not yet received enough approvals.
+// If every step is complete, len(steps) is returned.
+func currentApprovalStep(steps []rule.ApprovalStep, approvals approvalCounter) int {
+	for i, step := range steps {
+		if approvals.ApprovalsForStep(i) < step.Required() {
+			return i
+		}
+	}
+	return len(steps)
+}
+
+// reviewerCanApproveStep returns true if the user is a Reviewer on the request who is eligible to approve the step.
+func reviewerCanApproveStep(reviewers []access.Reviewer, reviewerID string, step int) bool {
+	for _, r := range reviewers {
+		if r.ReviewerID == reviewerID {
+			return r.CanApproveStep(step)
+		}
+	}
+	return false
+}
+
+type addApprovalResult struct {
+	// Progress is the progress of the step which the approval was counted towards.
+	Progress access.ApprovalProgress
+	// Complete is true if every approval step has now been satisfied.
+	Complete bool
+}
+
+// addApproval records an approval against the current approval step of the request.
+// Administrators may approve any step, other reviewers may only approve steps they are eligible for.
+// A reviewer can only contribute a single approval to a request, so that each step is approved by distinct people.
+func addApproval(request *access.Request, opts AddReviewOpts, now time.Time) (addApprovalResult, error) {
+	steps := opts.AccessRule.Approval.GetSteps()
+	step := currentApprovalStep(steps, request)
+	if step == len(steps) {
+		return addApprovalResult{Complete: true}, nil
+	}
+	if request.HasApprovalFrom(opts.ReviewerID) {
+		return addApprovalResult{}, ErrReviewerAlreadyApproved
+	}
+	if !opts.ReviewerIsAdmin && !reviewerCanApproveStep(opts.Reviewers, opts.ReviewerID, step) {
+		return addApprovalResult{}, ErrNotApproverForStep
+	}
+
+	request.Approvals = append(request.Approvals, access.StepApproval{
+		Step:       step,
+		ReviewerID: opts.ReviewerID,
+		ApprovedAt: now,
+	})
+
+	return addApprovalResult{
+		Progress: access.ApprovalProgress{
+			Step:              step,
+			StepName:          steps[step].Name,
+			Approvals:         request.ApprovalsForStep(step),
+			RequiredApprovals: steps[step].Required(),
+		},
+		Complete: currentApprovalStep(steps, request) == len(steps),
+	}, nil
+}
+
+func containsStep(steps []int, step int) bool {
+	for _, s := range steps {
+		if s == step {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+package accesssvc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
+	"github.com/common-fate/ddb"
+)
+
+// validateBreakGlass checks that the user is permitted to use break-glass access for the rule and has given a reason.
+func validateBreakGlass(accessRule rule.AccessRule, in CreateRequestsOpts) error {
+	if accessRule.BreakGlass == nil || !accessRule.BreakGlass.Permits(in.User) {
+		return apio.NewRequestError(ErrBreakGlassNotPermitted, http.StatusForbidden)
+	}
+	if in.Create.Reason == nil || *in.Create.Reason == "" {
+		return apio.NewRequestError(ErrBreakGlassReasonRequired, http.StatusBadRequest)
+	}
+	if in.Create.Timing.Recurrence != nil {
+		return apio.NewRequestError(ErrBreakGlassRecurring, http.StatusBadRequest)
+	}
+	return nil
+}
+
+// newBreakGlassReview creates the post-incident review task for a break-glass request.
+// The review can be completed by any of the request's reviewers.
+func newBreakGlassReview(req access.Request, reviewers []access.Reviewer) *access.BreakGlassReview {
+	approvers := []string{}
+	for _, r := range reviewers {
+		approvers = append(approvers, r.ReviewerID)
+	}
+	var reason string
+	if req.Data.Reason != nil {
+		reason = *req.Data.Reason
+	}
+	return &access.BreakGlassReview{
+		RequestID:   req.ID,
+		Rule:        req.Rule,
+		RequestedBy: req.RequestedBy,
+		Reason:      reason,
+		Approvers:   approvers,
+		Status:      access.BreakGlassReviewOpen,
+		CreatedAt:   req.CreatedAt,
+		UpdatedAt:   req.CreatedAt,
+	}
+}
+
+type ReviewBreakGlassOpts struct {
+	RequestID     string
+	ReviewerID    string
+	ReviewerEmail string
+	Status        access.BreakGlassReviewStatus
+	Comment       *string
+}
+
+// ReviewBreakGlass completes the post-incident review of a break-glass request.
+// Approvers either acknowledge the use of break-glass access, or flag it for follow up.
+func (s *Service) ReviewBreakGlass(ctx context.Context, opts ReviewBreakGlassOpts) (*access.BreakGlassReview, error) {
+	if opts.Status != access.BreakGlassReviewAcknowledged && opts.Status != access.BreakGlassReviewFlagged {
+		return nil, apio.NewRequestError(fmt.Errorf("invalid break-glass review status: %s", opts.Status), http.StatusBadRequest)
+	}
+
+	q := storage.GetBreakGlassReview{RequestID: opts.RequestID}
+	_, err := s.DB.Query(ctx, &q)
+	if err != nil {
+		return nil, err
+	}
+	review := *q.Result
+	if !review.IsApprover(opts.ReviewerID) {
+		return nil, ErrUserNotAuthorized
+	}
+	if review.Status != access.BreakGlassReviewOpen {
+		return nil, ErrBreakGlassReviewClosed
+	}
+
+	rq := storage.GetRequest{ID: opts.RequestID}
+	_, err = s.DB.Query(ctx, &rq)
+	if err != nil {
+		return nil, err
+	}
+
+	review.Status = opts.Status
+	review.ReviewedBy = &opts.ReviewerID
+	review.Comment = opts.Comment
+	review.UpdatedAt = s.Clock.Now()
+
+	outbox, err := dbupdate.OutboxEvents(review.UpdatedAt, gevent.RequestBreakGlassReviewed{
+		Request:       *rq.Result,
+		Review:        review,
+		ReviewerID:    opts.ReviewerID,
+		ReviewerEmail: opts.ReviewerEmail,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = dbupdate.PutItems(ctx, s.DB, append([]ddb.Keyer{&review}, outbox...)...)
+	if err != nil {
+		return nil, err
+	}
+	return &review, nil
+}
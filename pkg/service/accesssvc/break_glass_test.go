@@ -0,0 +1,99 @@
+package accesssvc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReviewBreakGlass(t *testing.T) {
+	type testcase struct {
+		name          string
+		give          ReviewBreakGlassOpts
+		review        *access.BreakGlassReview
+		getReviewErr  error
+		wantErr       error
+		wantStatus    access.BreakGlassReviewStatus
+		wantEventSent bool
+	}
+
+	open := &access.BreakGlassReview{
+		RequestID:   "req_1",
+		RequestedBy: "usr_requestor",
+		Approvers:   []string{"usr_approver"},
+		Status:      access.BreakGlassReviewOpen,
+	}
+	closed := &access.BreakGlassReview{
+		RequestID:   "req_1",
+		RequestedBy: "usr_requestor",
+		Approvers:   []string{"usr_approver"},
+		Status:      access.BreakGlassReviewAcknowledged,
+	}
+
+	testcases := []testcase{
+		{
+			name:          "acknowledged",
+			give:          ReviewBreakGlassOpts{RequestID: "req_1", ReviewerID: "usr_approver", Status: access.BreakGlassReviewAcknowledged},
+			review:        open,
+			wantStatus:    access.BreakGlassReviewAcknowledged,
+			wantEventSent: true,
+		},
+		{
+			name:          "flagged",
+			give:          ReviewBreakGlassOpts{RequestID: "req_1", ReviewerID: "usr_approver", Status: access.BreakGlassReviewFlagged},
+			review:        open,
+			wantStatus:    access.BreakGlassReviewFlagged,
+			wantEventSent: true,
+		},
+		{
+			name:    "not an approver",
+			give:    ReviewBreakGlassOpts{RequestID: "req_1", ReviewerID: "usr_requestor", Status: access.BreakGlassReviewAcknowledged},
+			review:  open,
+			wantErr: ErrUserNotAuthorized,
+		},
+		{
+			name:    "already reviewed",
+			give:    ReviewBreakGlassOpts{RequestID: "req_1", ReviewerID: "usr_approver", Status: access.BreakGlassReviewFlagged},
+			review:  closed,
+			wantErr: ErrBreakGlassReviewClosed,
+		},
+		{
+			name:         "review not found",
+			give:         ReviewBreakGlassOpts{RequestID: "req_1", ReviewerID: "usr_approver", Status: access.BreakGlassReviewAcknowledged},
+			getReviewErr: ddb.ErrNoItems,
+			wantErr:      ddb.ErrNoItems,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock := ddbmock.New(t)
+			mock.MockQueryWithErr(&storage.GetBreakGlassReview{Result: tc.review}, tc.getReviewErr)
+			mock.MockQuery(&storage.GetRequest{Result: &access.Request{ID: "req_1", RequestedBy: "usr_requestor", BreakGlass: true}})
+			db := &outboxDB{Storage: mock}
+
+			s := Service{
+				Clock: clock.NewMock(),
+				DB:    db,
+			}
+			got, err := s.ReviewBreakGlass(context.Background(), tc.give)
+			assert.Equal(t, tc.wantErr, err)
+			if tc.wantErr == nil {
+				assert.Equal(t, tc.wantStatus, got.Status)
+				assert.Equal(t, &tc.give.ReviewerID, got.ReviewedBy)
+			}
+			if tc.wantEventSent {
+				assert.Equal(t, []string{gevent.RequestBreakGlassReviewedType}, db.eventTypes())
+			} else {
+				assert.Empty(t, db.events)
+			}
+		})
+	}
+}
@@ -36,22 +36,15 @@ func (s *Service) CancelRequest(ctx context.Context, opts CancelRequestOpts) err
 	req.Status = access.CANCELLED
 	req.UpdatedAt = s.Clock.Now()
 	// we need to save the Review, the updated Request in the database.
-	items, err := dbupdate.GetUpdateRequestItems(ctx, s.DB, *req)
+	items, err := dbupdate.GetUpdateRequestItems(ctx, s.DB, *req, dbupdate.WithEvents(gevent.RequestCancelled{Request: *req}))
 	if err != nil {
 		return err
 	}
 	// audit log event
 	reqEvent := access.NewStatusChangeEvent(req.ID, req.UpdatedAt, &opts.CancellerID, originalStatus, req.Status)
 
-	err = s.EventPutter.Put(ctx, gevent.RequestCancelled{Request: *req})
-	// In a future PR we will shift these events out to be triggered by dynamo db streams
-	// This will currently put the app in a strange state if this fails
-	if err != nil {
-		return err
-	}
-
 	items = append(items, &reqEvent)
-	return s.DB.PutBatch(ctx, items...)
+	return dbupdate.PutItems(ctx, s.DB, items...)
 }
 
 // users can cancel their own requests.
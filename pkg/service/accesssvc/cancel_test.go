@@ -7,10 +7,8 @@ import (
 	"github.com/benbjohnson/clock"
 	"github.com/common-fate/common-fate/accesshandler/pkg/types"
 	"github.com/common-fate/common-fate/pkg/access"
-	accessMocks "github.com/common-fate/common-fate/pkg/service/accesssvc/mocks"
 	"github.com/common-fate/common-fate/pkg/storage"
 	"github.com/common-fate/ddb/ddbmock"
-	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -120,14 +118,9 @@ func TestCancelRequest(t *testing.T) {
 			db.MockQueryWithErr(&storage.GetRequest{Result: tc.getRequestResponse}, tc.getRequestErr)
 			db.MockQuery(&storage.ListRequestReviewers{Result: []access.Reviewer{}})
 
-			ctrl2 := gomock.NewController(t)
-			ep := accessMocks.NewMockEventPutter(ctrl2)
-			ep.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-
 			s := Service{
-				Clock:       clk,
-				DB:          db,
-				EventPutter: ep,
+				Clock: clk,
+				DB:    db,
 			}
 			err := s.CancelRequest(context.Background(), tc.givenCancelRequest)
 			assert.Equal(t, tc.wantErr, err)
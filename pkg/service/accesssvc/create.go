@@ -3,23 +3,26 @@ package accesssvc
 import (
 	"context"
 	"errors"
-	"github.com/common-fate/common-fate/pkg/autoapproval"
 	"net/http"
-	"os"
+	"sort"
 	"sync"
 
 	"github.com/common-fate/analytics-go"
 	"github.com/common-fate/apikit/apio"
 	"github.com/common-fate/apikit/logger"
 	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/autoapproval"
 	"github.com/common-fate/common-fate/pkg/gevent"
 	"github.com/common-fate/common-fate/pkg/identity"
 	"github.com/common-fate/common-fate/pkg/rule"
 	"github.com/common-fate/common-fate/pkg/service/rulesvc"
+	"github.com/common-fate/common-fate/pkg/service/workflowsvc"
+	"github.com/common-fate/common-fate/pkg/storage"
 	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
 	"github.com/common-fate/common-fate/pkg/types"
 	"github.com/common-fate/ddb"
 	"github.com/hashicorp/go-multierror"
+	"go.uber.org/zap"
 )
 
 type CreateRequestResult struct {
@@ -32,6 +35,10 @@ type CreateRequests struct {
 	Reason       *string
 	Timing       types.RequestTiming
 	With         *types.CreateRequestWithSubRequest
+	// BreakGlass requests are approved immediately and reviewed by the rule's approvers afterwards.
+	BreakGlass bool
+	// TicketReference is required if the access rule has a ticket reference configured.
+	TicketReference *string
 }
 
 type CreateRequestsOpts struct {
@@ -58,10 +65,12 @@ func (s *Service) CreateRequests(ctx context.Context, in CreateRequestsOpts) ([]
 			res, err := s.createRequest(ctx, createRequestOpts{
 				User: in.User,
 				Request: CreateRequest{
-					AccessRuleId: in.Create.AccessRuleId,
-					Reason:       in.Create.Reason,
-					Timing:       in.Create.Timing,
-					With:         c,
+					AccessRuleId:    in.Create.AccessRuleId,
+					Reason:          in.Create.Reason,
+					Timing:          in.Create.Timing,
+					With:            c,
+					BreakGlass:      in.Create.BreakGlass,
+					TicketReference: validated.ticketReference,
 				},
 				Rule:             validated.rule,
 				RequestArguments: validated.requestArguments,
@@ -87,6 +96,9 @@ type CreateRequest struct {
 	Reason       *string
 	Timing       types.RequestTiming
 	With         map[string]string
+	BreakGlass   bool
+	// TicketReference has already been validated against the access rule.
+	TicketReference *string
 }
 type createRequestOpts struct {
 	User             identity.User
@@ -104,7 +116,8 @@ func (s *Service) createRequest(ctx context.Context, in createRequestOpts) (Crea
 		ID:          types.NewRequestID(),
 		RequestedBy: in.User.ID,
 		Data: access.RequestData{
-			Reason: in.Request.Reason,
+			Reason:          in.Request.Reason,
+			TicketReference: in.Request.TicketReference,
 		},
 		CreatedAt:       now,
 		UpdatedAt:       now,
@@ -113,6 +126,7 @@ func (s *Service) createRequest(ctx context.Context, in createRequestOpts) (Crea
 		Rule:            in.Rule.ID,
 		RuleVersion:     in.Rule.Version,
 		SelectedWith:    make(map[string]access.Option),
+		BreakGlass:      in.Request.BreakGlass,
 	}
 	if in.Request.With != nil {
 		for k, v := range in.Request.With {
@@ -138,18 +152,44 @@ func (s *Service) createRequest(ctx context.Context, in createRequestOpts) (Crea
 		}
 	}
 
+	var autoApproval *access.RequestAutoApproval
+	if !req.BreakGlass {
+		var err error
+		autoApproval, err = s.autoapprove(ctx, in, req)
+		if err != nil {
+			return CreateRequestResult{}, err
+		}
+	}
+
 	// If the approval is not required, auto-approve the request
 	auto := types.AUTOMATIC
 	revd := types.REVIEWED
+	breakGlass := types.BREAKGLASS
 
-	if !in.Rule.Approval.IsRequired() {
+	// approvedOnCreate is true if the request is granted immediately rather than waiting for a review
+	approvedOnCreate := !in.Rule.Approval.IsRequired() || autoApproval != nil || req.BreakGlass
+
+	switch {
+	case req.BreakGlass:
+		req.Status = access.APPROVED
+		req.ApprovalMethod = &breakGlass
+	case approvedOnCreate:
 		req.Status = access.APPROVED
 		req.ApprovalMethod = &auto
-	} else {
+	default:
 		req.ApprovalMethod = &revd
 	}
 
-	approvers, err := rulesvc.GetApprovers(ctx, s.DB, in.Rule)
+	stepApprovers, err := rulesvc.GetStepApprovers(ctx, s.DB, in.Rule)
+	if err != nil {
+		return CreateRequestResult{}, err
+	}
+
+	var allApprovers []string
+	for _, approvers := range stepApprovers {
+		allApprovers = append(allApprovers, approvers...)
+	}
+	delegates, err := rulesvc.GetDelegates(ctx, s.DB, allApprovers, now)
 	if err != nil {
 		return CreateRequestResult{}, err
 	}
@@ -158,30 +198,91 @@ func (s *Service) createRequest(ctx context.Context, in createRequestOpts) (Crea
 	items := []ddb.Keyer{&req}
 
 	// create Reviewers for each approver in the Access Rule. Reviewers will see the request in the End User portal.
+	// For rules with multi-step approvals, each reviewer records the approval steps they are eligible to approve.
+	// Approvers who have an active delegation have the review fanned out to their delegates as well.
+	usesSteps := len(in.Rule.Approval.Steps) > 0
 	var reviewers []access.Reviewer
-	for _, u := range approvers {
-		// users cannot approve their own requests.
-		// We don't create a Reviewer for them, even if they are an approver on the Access Rule.
-		if u == req.RequestedBy {
+	reviewerIndex := make(map[string]int)
+	addReviewer := func(u string, step int) int {
+		i, ok := reviewerIndex[u]
+		if !ok {
+			i = len(reviewers)
+			reviewerIndex[u] = i
+			reviewers = append(reviewers, access.Reviewer{
+				ReviewerID: u,
+				Request:    req,
+			})
+		}
+		if usesSteps && !containsStep(reviewers[i].Steps, step) {
+			reviewers[i].Steps = append(reviewers[i].Steps, step)
+		}
+		return i
+	}
+	for step, approvers := range stepApprovers {
+		for _, u := range approvers {
+			// users cannot approve their own requests.
+			// We don't create a Reviewer for them, even if they are an approver on the Access Rule.
+			if u == req.RequestedBy {
+				continue
+			}
+			addReviewer(u, step)
+		}
+	}
+	// iterate over delegates in a stable order so that reviewers are created deterministically.
+	delegateIDs := make([]string, 0, len(delegates))
+	for delegate := range delegates {
+		delegateIDs = append(delegateIDs, delegate)
+	}
+	sort.Strings(delegateIDs)
+	for _, delegate := range delegateIDs {
+		// delegates cannot approve their own requests either.
+		if delegate == req.RequestedBy {
 			continue
 		}
-
-		r := access.Reviewer{
-			ReviewerID: u,
-			Request:    req,
+		onBehalfOf := delegates[delegate]
+		for step, approvers := range stepApprovers {
+			for _, u := range approvers {
+				// the requestor's own delegation doesn't let their delegate review the request for them.
+				if u == req.RequestedBy || !contains(onBehalfOf, u) {
+					continue
+				}
+				i := addReviewer(delegate, step)
+				if !contains(reviewers[i].OnBehalfOf, u) {
+					reviewers[i].OnBehalfOf = append(reviewers[i].OnBehalfOf, u)
+				}
+			}
 		}
+	}
+	for i := range reviewers {
+		items = append(items, &reviewers[i])
+	}
 
-		reviewers = append(reviewers, r)
-		items = append(items, &r)
+	// break-glass requests must be reviewed by the approvers after the fact.
+	var breakGlassReview *access.BreakGlassReview
+	if req.BreakGlass {
+		breakGlassReview = newBreakGlassReview(req, reviewers)
+		if len(breakGlassReview.Approvers) == 0 {
+			log.Warnw("break-glass request has no approvers to review it", "request.id", req.ID)
+		}
+		items = append(items, breakGlassReview)
 	}
 
 	log.Debugw("saving request", "request", req, "reviewers", reviewers)
 
+	// index the request by its ticket reference so that admins can find the requests made for a ticket.
+	if req.Data.TicketReference != nil {
+		items = append(items, &access.RequestTicket{
+			Reference: *req.Data.TicketReference,
+			RequestID: req.ID,
+			CreatedAt: req.CreatedAt,
+		})
+	}
+
 	// audit log event
 	reqEvent := access.NewRequestCreatedEvent(req.ID, req.CreatedAt, &req.RequestedBy)
 
 	//before saving the request check to see if there already is a active approved rule
-	if !in.Rule.Approval.IsRequired() {
+	if approvedOnCreate {
 
 		// This will check against the requests which do have grants already
 		overlaps, err := s.overlapsExistingGrant(ctx, req)
@@ -195,42 +296,61 @@ func (s *Service) createRequest(ctx context.Context, in createRequestOpts) (Crea
 	}
 
 	items = append(items, &reqEvent)
-	// save the request.
-	err = s.DB.PutBatch(ctx, items...)
+	// record the policy statement which approved the request, so that the approval can be audited.
+	if autoApproval != nil {
+		autoApprovedEvent := access.NewAutoApprovedEvent(req.ID, req.CreatedAt, *autoApproval)
+		items = append(items, &autoApprovedEvent)
+	}
+
+	// the events are written to the outbox with the request, and published once it has been saved.
+	events := []gevent.EventTyper{gevent.RequestCreated{Request: req, RequestorEmail: in.User.Email}}
+	if breakGlassReview != nil {
+		events = append(events, gevent.RequestBreakGlassUsed{
+			Request:        req,
+			RequestorEmail: in.User.Email,
+			Reason:         breakGlassReview.Reason,
+			ApproverIDs:    breakGlassReview.Approvers,
+		})
+	}
+	outbox, err := dbupdate.OutboxEvents(req.CreatedAt, events...)
 	if err != nil {
 		return CreateRequestResult{}, err
 	}
+	items = append(items, outbox...)
 
-	err = s.EventPutter.Put(ctx, gevent.RequestCreated{Request: req, RequestorEmail: in.User.Email})
-	// in a future PR we will shift these events out to be triggered by dynamo db streams
-	// This will currently put the app in a strange state if this fails
+	// save the request.
+	err = dbupdate.PutItems(ctx, s.DB, items...)
 	if err != nil {
 		return CreateRequestResult{}, err
 	}
-	// TODO(IvanVan) Get it from config
-	arn := os.Getenv("COMMONFATE_AUTO_APPROVAL_LAMBDA_ARN")
-	var autoapproved bool
-	if arn != "" {
-		autoapproved, err = autoapproval.Service{}.Autoapprove(in.User, in.Rule, "123")
-		if err != nil {
-			log.Errorw("error happened when calling auto-approval lambda", "err", err)
-		}
-	}
-
 	// check to see if it valid for instant approval
-	if !in.Rule.Approval.IsRequired() || autoapproved {
+	if approvedOnCreate {
 		log.Debugw("auto-approving", "request", req, "reviewers", reviewers)
-		grant, err := s.Workflow.Grant(ctx, req, in.Rule)
-		if err != nil {
-			return CreateRequestResult{}, err
+		var occurrences []access.Request
+		// recurring requests are granted once for each occurrence, rather than once for the request.
+		if req.IsRecurring() {
+			occurrences, err = s.grantOccurrences(ctx, req, in.Rule)
+			if err != nil {
+				return CreateRequestResult{}, err
+			}
+		} else {
+			grant, err := s.Workflow.Grant(ctx, req, in.Rule)
+			if err != nil {
+				return CreateRequestResult{}, err
+			}
+			req.Grant = grant
 		}
-		req.Grant = grant
-		items, err := dbupdate.GetUpdateRequestItems(ctx, s.DB, req, dbupdate.WithReviewers(reviewers))
+		items, err := dbupdate.GetUpdateRequestItems(ctx, s.DB, req, dbupdate.WithReviewers(reviewers), dbupdate.WithEvents(workflowsvc.GrantEvents(req, in.Rule)...))
 		if err != nil {
 			return CreateRequestResult{}, err
 		}
-		err = s.DB.PutBatch(ctx, items...)
+		// the grants for the occurrences of a recurring request are revoked if they can't be saved.
+		err = s.saveOccurrences(ctx, occurrences, in.Rule)
+		if err == nil {
+			err = dbupdate.PutItems(ctx, s.DB, items...)
+		}
 		if err != nil {
+			s.revokeOccurrences(ctx, occurrences, in.Rule)
 			return CreateRequestResult{}, err
 		}
 	}
@@ -244,7 +364,7 @@ func (s *Service) createRequest(ctx context.Context, in createRequestOpts) (Crea
 		RuleID:           req.Rule,
 		Timing:           req.RequestedTiming.ToAnalytics(),
 		HasReason:        req.HasReason(),
-		RequiresApproval: in.Rule.Approval.IsRequired() && !autoapproved,
+		RequiresApproval: !approvedOnCreate,
 	})
 
 	return CreateRequestResult{
@@ -263,3 +383,77 @@ func groupMatches(ruleGroups []string, userGroups []string) error {
 	}
 	return apio.NewRequestError(ErrNoMatchingGroup, http.StatusBadRequest)
 }
+
+// autoapprove evaluates the auto-approval policy for a request which requires approval.
+// It returns the policy statement which approved the request, or nil if the request must be reviewed.
+// Errors from the auto-approval service are logged rather than returned, so that the request falls back to manual review.
+func (s *Service) autoapprove(ctx context.Context, in createRequestOpts, req access.Request) (*access.RequestAutoApproval, error) {
+	if s.AutoApproval == nil || !in.Rule.Approval.IsRequired() {
+		return nil, nil
+	}
+	log := logger.Get(ctx).With("user.id", in.User.ID, "request.id", req.ID)
+	return s.evaluateAutoApproval(ctx, log, autoapproval.Input{
+		User:   in.User,
+		Rule:   in.Rule,
+		With:   in.Request.With,
+		Timing: req.RequestedTiming,
+		Now:    req.CreatedAt,
+	})
+}
+
+// evaluateAutoApproval looks up the user's request history which the policy needs, and evaluates the auto-approval policy.
+// It returns the policy statement which approved the request, or nil if the request must be reviewed.
+// Errors from the auto-approval service are logged rather than returned, so that the request falls back to manual review.
+func (s *Service) evaluateAutoApproval(ctx context.Context, log *zap.SugaredLogger, in autoapproval.Input) (*access.RequestAutoApproval, error) {
+	hq := s.AutoApproval.HistoryQuery()
+	history := map[string]bool{}
+	addHistory := func(reqs []access.Request) {
+		// the same request may be returned by both queries.
+		for _, r := range reqs {
+			if !history[r.ID] {
+				history[r.ID] = true
+				in.History = append(in.History, r)
+			}
+		}
+	}
+	if hq.Within > 0 {
+		// a request always ends after it was created, so this includes every request created within the period.
+		q := storage.ListRequestsForUserAndRequestend{
+			UserID:               in.User.ID,
+			RequestEndComparator: storage.GreaterThanEqual,
+			CompareTo:            in.Now.Add(-hq.Within),
+		}
+		err := storage.ForEachPage(ctx, s.DB, &q, func() error {
+			addHistory(q.Result)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if hq.ForRule {
+		q := storage.ListRequestsForUserAndRuleAndRequestend{
+			UserID:               in.User.ID,
+			RuleID:               in.Rule.ID,
+			RequestEndComparator: storage.GreaterThanEqual,
+		}
+		err := storage.ForEachPage(ctx, s.DB, &q, func() error {
+			addHistory(q.Result)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := s.AutoApproval.Autoapprove(ctx, in)
+	if err != nil {
+		log.Errorw("error evaluating auto-approval", "error", err)
+		return nil, nil
+	}
+	log.Infow("evaluated auto-approval", "decision", res.Decision, "statement", res.Statement, "justification", res.Justification)
+	if !res.AutoApproved() {
+		return nil, nil
+	}
+	return &access.RequestAutoApproval{Statement: res.Statement, Justification: res.Justification}, nil
+}
@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/benbjohnson/clock"
 	"github.com/common-fate/apikit/apio"
 	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/autoapproval"
 	"github.com/common-fate/common-fate/pkg/identity"
 	"github.com/common-fate/common-fate/pkg/rule"
 	accessMocks "github.com/common-fate/common-fate/pkg/service/accesssvc/mocks"
@@ -19,6 +21,7 @@ import (
 	"github.com/common-fate/ddb/ddbmock"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
 )
 
 func TestNewRequest(t *testing.T) {
@@ -37,11 +40,15 @@ func TestNewRequest(t *testing.T) {
 		withGetGroupResponse         *storage.GetGroup
 		withRequestArgumentsResponse map[string]types.RequestArgument
 		currentRequestsForGrant      []access.Request
+		withAutoApprovalResult       *autoapproval.Result
+		withDelegations              []identity.Delegation
 	}
 
 	clk := clock.NewMock()
 	autoApproval := types.AUTOMATIC
 	reviewed := types.REVIEWED
+	breakGlass := types.BREAKGLASS
+	incident := "incident INC-1"
 	testcases := []testcase{
 		{
 			name: "ok, no approvers so should auto approve",
@@ -236,6 +243,217 @@ func TestNewRequest(t *testing.T) {
 			withRequestArgumentsResponse: map[string]types.RequestArgument{},
 			currentRequestsForGrant:      []access.Request{},
 		},
+		{
+			name: "with reviewers and auto-approved by policy",
+			in:   CreateRequestsOpts{User: identity.User{Groups: []string{"a"}}},
+			rule: &rule.AccessRule{
+				Groups: []string{"a"},
+				Approval: rule.Approval{
+					Users: []string{"b"},
+				},
+			},
+			withAutoApprovalResult: &autoapproval.Result{Decision: autoapproval.AUTO_APPROVED, Statement: "on-call"},
+			want: []CreateRequestResult{
+				{Request: access.Request{
+					ID:             "-",
+					Status:         access.APPROVED,
+					CreatedAt:      clk.Now(),
+					UpdatedAt:      clk.Now(),
+					Grant:          &access.Grant{},
+					ApprovalMethod: &autoApproval,
+					SelectedWith:   make(map[string]access.Option),
+				},
+					Reviewers: []access.Reviewer{
+						{
+							ReviewerID: "b",
+							Request: access.Request{
+								ID:             "-",
+								Status:         access.APPROVED,
+								CreatedAt:      clk.Now(),
+								UpdatedAt:      clk.Now(),
+								ApprovalMethod: &autoApproval,
+								SelectedWith:   make(map[string]access.Option),
+							},
+						},
+					}},
+			},
+			withCreateGrantResponse: createGrantResponse{
+				request: &access.Request{
+					Grant: &access.Grant{},
+				},
+			},
+			withRequestArgumentsResponse: map[string]types.RequestArgument{},
+			currentRequestsForGrant:      []access.Request{},
+		},
+		{
+			name: "with reviewers and not auto-approved by policy",
+			in:   CreateRequestsOpts{User: identity.User{Groups: []string{"a"}}},
+			rule: &rule.AccessRule{
+				Groups: []string{"a"},
+				Approval: rule.Approval{
+					Users: []string{"b"},
+				},
+			},
+			withAutoApprovalResult: &autoapproval.Result{Decision: autoapproval.REQUIRES_APPROVAL},
+			want: []CreateRequestResult{
+				{Request: access.Request{
+					ID:             "-",
+					Status:         access.PENDING,
+					CreatedAt:      clk.Now(),
+					UpdatedAt:      clk.Now(),
+					ApprovalMethod: &reviewed,
+					SelectedWith:   make(map[string]access.Option),
+				},
+					Reviewers: []access.Reviewer{
+						{
+							ReviewerID: "b",
+							Request: access.Request{
+								ID:             "-",
+								Status:         access.PENDING,
+								CreatedAt:      clk.Now(),
+								UpdatedAt:      clk.Now(),
+								ApprovalMethod: &reviewed,
+								SelectedWith:   make(map[string]access.Option),
+							},
+						},
+					}},
+			},
+			withRequestArgumentsResponse: map[string]types.RequestArgument{},
+			currentRequestsForGrant:      []access.Request{},
+		},
+		{
+			name: "approver has delegated their reviews",
+			in:   CreateRequestsOpts{User: identity.User{ID: "a", Groups: []string{"a"}}},
+			rule: &rule.AccessRule{
+				Groups: []string{"a"},
+				Approval: rule.Approval{
+					Users: []string{"b"},
+				},
+			},
+			withDelegations: []identity.Delegation{
+				{UserID: "b", DelegateID: "d", StartTime: clk.Now().Add(-time.Hour), EndTime: clk.Now().Add(time.Hour)},
+				// expired delegations are ignored
+				{UserID: "b", DelegateID: "e", StartTime: clk.Now().Add(-2 * time.Hour), EndTime: clk.Now().Add(-time.Hour)},
+				// the requestor can't review their own request as a delegate
+				{UserID: "b", DelegateID: "a", StartTime: clk.Now().Add(-time.Hour), EndTime: clk.Now().Add(time.Hour)},
+			},
+			want: []CreateRequestResult{
+				{Request: access.Request{
+					ID:             "-",
+					RequestedBy:    "a",
+					Status:         access.PENDING,
+					CreatedAt:      clk.Now(),
+					UpdatedAt:      clk.Now(),
+					ApprovalMethod: &reviewed,
+					SelectedWith:   make(map[string]access.Option),
+				},
+					Reviewers: []access.Reviewer{
+						{
+							ReviewerID: "b",
+							Request: access.Request{
+								ID:             "-",
+								RequestedBy:    "a",
+								Status:         access.PENDING,
+								CreatedAt:      clk.Now(),
+								UpdatedAt:      clk.Now(),
+								ApprovalMethod: &reviewed,
+								SelectedWith:   make(map[string]access.Option),
+							},
+						},
+						{
+							ReviewerID: "d",
+							Request: access.Request{
+								ID:             "-",
+								RequestedBy:    "a",
+								Status:         access.PENDING,
+								CreatedAt:      clk.Now(),
+								UpdatedAt:      clk.Now(),
+								ApprovalMethod: &reviewed,
+								SelectedWith:   make(map[string]access.Option),
+							},
+							OnBehalfOf: []string{"b"},
+						},
+					}},
+			},
+			withRequestArgumentsResponse: map[string]types.RequestArgument{},
+			currentRequestsForGrant:      []access.Request{},
+		},
+		{
+			name: "break-glass grants access immediately",
+			in: CreateRequestsOpts{User: identity.User{ID: "a", Groups: []string{"a"}}, Create: CreateRequests{
+				Reason:     &incident,
+				BreakGlass: true,
+			}},
+			rule: &rule.AccessRule{
+				Groups:     []string{"a"},
+				Approval:   rule.Approval{Users: []string{"b"}},
+				BreakGlass: &rule.BreakGlass{Users: []string{"a"}},
+			},
+			want: []CreateRequestResult{
+				{Request: access.Request{
+					ID:             "-",
+					RequestedBy:    "a",
+					Status:         access.APPROVED,
+					Data:           access.RequestData{Reason: &incident},
+					CreatedAt:      clk.Now(),
+					UpdatedAt:      clk.Now(),
+					Grant:          &access.Grant{},
+					ApprovalMethod: &breakGlass,
+					BreakGlass:     true,
+					SelectedWith:   make(map[string]access.Option),
+				},
+					Reviewers: []access.Reviewer{
+						{
+							ReviewerID: "b",
+							Request: access.Request{
+								ID:             "-",
+								RequestedBy:    "a",
+								Status:         access.APPROVED,
+								Data:           access.RequestData{Reason: &incident},
+								CreatedAt:      clk.Now(),
+								UpdatedAt:      clk.Now(),
+								ApprovalMethod: &breakGlass,
+								BreakGlass:     true,
+								SelectedWith:   make(map[string]access.Option),
+							},
+						},
+					}},
+			},
+			withCreateGrantResponse: createGrantResponse{
+				request: &access.Request{
+					Grant: &access.Grant{},
+				},
+			},
+			withRequestArgumentsResponse: map[string]types.RequestArgument{},
+			currentRequestsForGrant:      []access.Request{},
+		},
+		{
+			name: "break-glass not permitted for user",
+			in: CreateRequestsOpts{User: identity.User{ID: "c", Groups: []string{"a"}}, Create: CreateRequests{
+				Reason:     &incident,
+				BreakGlass: true,
+			}},
+			rule: &rule.AccessRule{
+				Groups:     []string{"a"},
+				Approval:   rule.Approval{Users: []string{"b"}},
+				BreakGlass: &rule.BreakGlass{Users: []string{"a"}},
+			},
+			wantErr:                 apio.NewRequestError(ErrBreakGlassNotPermitted, http.StatusForbidden),
+			currentRequestsForGrant: []access.Request{},
+		},
+		{
+			name: "break-glass requires a reason",
+			in: CreateRequestsOpts{User: identity.User{ID: "a", Groups: []string{"a"}}, Create: CreateRequests{
+				BreakGlass: true,
+			}},
+			rule: &rule.AccessRule{
+				Groups:     []string{"a"},
+				Approval:   rule.Approval{Users: []string{"b"}},
+				BreakGlass: &rule.BreakGlass{Users: []string{"a"}},
+			},
+			wantErr:                 apio.NewRequestError(ErrBreakGlassReasonRequired, http.StatusBadRequest),
+			currentRequestsForGrant: []access.Request{},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -245,14 +463,12 @@ func TestNewRequest(t *testing.T) {
 			db.MockQuery(tc.withGetGroupResponse)
 			db.MockQuery(&storage.ListRequestReviewers{})
 			db.MockQuery(&storage.ListRequestsForUserAndRequestend{Result: tc.currentRequestsForGrant})
+			db.MockQuery(&storage.ListRequestsForUser{})
+			db.MockQuery(&storage.ListDelegationsForUser{Result: tc.withDelegations})
 			ctrl := gomock.NewController(t)
 
 			defer ctrl.Finish()
 
-			ctrl2 := gomock.NewController(t)
-			ep := accessMocks.NewMockEventPutter(ctrl2)
-			ep.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-
 			workflowMock := accessMocks.NewMockWorkflow(ctrl)
 			if tc.withCreateGrantResponse.request != nil {
 				workflowMock.EXPECT().Grant(gomock.Any(), gomock.Any(), gomock.Any()).Return(tc.withCreateGrantResponse.request.Grant, tc.withCreateGrantResponse.err).AnyTimes()
@@ -265,12 +481,17 @@ func TestNewRequest(t *testing.T) {
 				rs.EXPECT().RequestArguments(gomock.Any(), tc.rule.Target).Return(tc.withRequestArgumentsResponse, nil)
 			}
 			s := Service{
-				Clock:       clk,
-				DB:          db,
-				EventPutter: ep,
-				Cache:       ca,
-				Rules:       rs,
-				Workflow:    workflowMock,
+				Clock:    clk,
+				DB:       db,
+				Cache:    ca,
+				Rules:    rs,
+				Workflow: workflowMock,
+			}
+			if tc.withAutoApprovalResult != nil {
+				aa := accessMocks.NewMockAutoApprovalService(ctrl)
+				aa.EXPECT().Autoapprove(gomock.Any(), gomock.Any()).Return(*tc.withAutoApprovalResult, nil)
+				aa.EXPECT().HistoryQuery().Return(autoapproval.HistoryQuery{}).AnyTimes()
+				s.AutoApproval = aa
 			}
 			got, err := s.CreateRequests(context.Background(), tc.in)
 			var gotWithoutIDs []CreateRequestResult
@@ -291,3 +512,102 @@ func TestNewRequest(t *testing.T) {
 	}
 
 }
+
+// pagedHistoryDB returns the request history for a user in two pages for each query.
+type pagedHistoryDB struct {
+	ddb.Storage
+	pages     [][]access.Request
+	rulePages [][]access.Request
+	queries   []ddb.QueryBuilder
+}
+
+func (db *pagedHistoryDB) Query(ctx context.Context, qb ddb.QueryBuilder, opts ...func(*ddb.QueryOpts)) (*ddb.QueryResult, error) {
+	var o ddb.QueryOpts
+	for _, opt := range opts {
+		opt(&o)
+	}
+	page := 0
+	if o.PageToken != "" {
+		page = 1
+	}
+	switch q := qb.(type) {
+	case *storage.ListRequestsForUserAndRequestend:
+		q.Result = db.pages[page]
+	case *storage.ListRequestsForUserAndRuleAndRequestend:
+		q.Result = db.rulePages[page]
+	default:
+		return nil, fmt.Errorf("unexpected query %T", qb)
+	}
+	db.queries = append(db.queries, qb)
+	res := ddb.QueryResult{}
+	if page == 0 {
+		res.NextPage = "page2"
+	}
+	return &res, nil
+}
+
+func TestEvaluateAutoApproval(t *testing.T) {
+	now := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+	type testcase struct {
+		name         string
+		giveQuery    autoapproval.HistoryQuery
+		wantHistory  []string
+		wantQueryNum int
+	}
+	testcases := []testcase{
+		{
+			name:         "no history condition",
+			giveQuery:    autoapproval.HistoryQuery{},
+			wantQueryNum: 0,
+		},
+		{
+			name:         "requests within a period",
+			giveQuery:    autoapproval.HistoryQuery{Within: time.Hour},
+			wantHistory:  []string{"req_1", "req_2"},
+			wantQueryNum: 2,
+		},
+		{
+			name:      "requests within a period and for the rule",
+			giveQuery: autoapproval.HistoryQuery{Within: time.Hour, ForRule: true},
+			// req_2 is returned by both queries, and is only included once.
+			wantHistory:  []string{"req_1", "req_2", "req_3"},
+			wantQueryNum: 4,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := &pagedHistoryDB{
+				pages:     [][]access.Request{{{ID: "req_1"}}, {{ID: "req_2"}}},
+				rulePages: [][]access.Request{{{ID: "req_2"}}, {{ID: "req_3"}}},
+			}
+
+			ctrl := gomock.NewController(t)
+			aa := accessMocks.NewMockAutoApprovalService(ctrl)
+			aa.EXPECT().HistoryQuery().Return(tc.giveQuery)
+			aa.EXPECT().Autoapprove(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, in autoapproval.Input) (autoapproval.Result, error) {
+				var got []string
+				for _, r := range in.History {
+					got = append(got, r.ID)
+				}
+				assert.Equal(t, tc.wantHistory, got)
+				return autoapproval.Result{Decision: autoapproval.AUTO_APPROVED, Statement: "on-call", Justification: "the user is on call"}, nil
+			})
+
+			s := Service{DB: db, AutoApproval: aa}
+			got, err := s.evaluateAutoApproval(context.Background(), zap.S(), autoapproval.Input{User: identity.User{ID: "usr_1"}, Rule: rule.AccessRule{ID: "rul_1"}, Now: now})
+			assert.NoError(t, err)
+			assert.Equal(t, &access.RequestAutoApproval{Statement: "on-call", Justification: "the user is on call"}, got)
+			assert.Len(t, db.queries, tc.wantQueryNum)
+			for _, q := range db.queries {
+				switch q := q.(type) {
+				case *storage.ListRequestsForUserAndRequestend:
+					// only requests which end within the period are loaded.
+					assert.Equal(t, now.Add(-time.Hour), q.CompareTo)
+				case *storage.ListRequestsForUserAndRuleAndRequestend:
+					assert.Equal(t, "rul_1", q.RuleID)
+				}
+			}
+		})
+	}
+}
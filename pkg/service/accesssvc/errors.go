@@ -24,6 +24,33 @@ var (
 
 	// ErrRequestOverlapsExistingGrant is returned if the request overlaps an existing grant
 	ErrRequestOverlapsExistingGrant = errors.New("this request overlaps an existing grant")
+
+	// ErrReviewerAlreadyApproved is returned if a reviewer tries to approve a request which they have already approved
+	ErrReviewerAlreadyApproved = errors.New("reviewer has already approved this request")
+
+	// ErrNotApproverForStep is returned if a reviewer tries to approve a request but is not an approver for its current approval step
+	ErrNotApproverForStep = errors.New("reviewer is not an approver for the current approval step")
+
+	// ErrBreakGlassNotPermitted is returned if a user tries to use break-glass access for a rule which does not allow them to
+	ErrBreakGlassNotPermitted = errors.New("user is not permitted to use break-glass access for the access rule")
+
+	// ErrBreakGlassReasonRequired is returned if a user tries to use break-glass access without giving a reason
+	ErrBreakGlassReasonRequired = errors.New("a reason is required when using break-glass access")
+
+	// ErrBreakGlassRecurring is returned if a user tries to use break-glass access for a recurring request
+	ErrBreakGlassRecurring = errors.New("break-glass access can't be requested on a recurring schedule")
+
+	// ErrBreakGlassReviewClosed is returned if an approver tries to review a break-glass request which has already been reviewed
+	ErrBreakGlassReviewClosed = errors.New("the break-glass request has already been reviewed")
+
+	// ErrGrantNotActive is returned if a user requests an extension for a request which does not have an active grant
+	ErrGrantNotActive = errors.New("only requests with an active grant can be extended")
+
+	// ErrExtensionPending is returned if a user requests an extension while a previous extension is still pending review
+	ErrExtensionPending = errors.New("the request already has a pending extension")
+
+	// ErrNoPendingExtension is returned if a reviewer tries to review an extension for a request which has no pending extension
+	ErrNoPendingExtension = errors.New("the request has no pending extension")
 )
 
 // InvalidStatusError is returned if a user tries to review a request which wasn't PENDING.
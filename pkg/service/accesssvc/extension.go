@@ -0,0 +1,267 @@
+package accesssvc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/apikit/logger"
+	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/autoapproval"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
+	"github.com/common-fate/ddb"
+)
+
+type RequestExtensionOpts struct {
+	User       identity.User
+	Request    access.Request
+	AccessRule rule.AccessRule
+	ExtendBy   time.Duration
+	// Reason is optional on an extension
+	Reason *string
+}
+
+// RequestExtension requests more time on the active grant of a request.
+// Extensions go through the same approval path as new requests: the extension is approved immediately if the
+// access rule doesn't require approval or it is auto-approved, otherwise it is sent to the reviewers of the request.
+// The extended grant can't be longer than the maximum duration of the access rule, run past the end of its active window or into a blackout.
+func (s *Service) RequestExtension(ctx context.Context, opts RequestExtensionOpts) (*access.Request, error) {
+	request := opts.Request
+	if request.RequestedBy != opts.User.ID {
+		return nil, ErrUserNotAuthorized
+	}
+	if request.Status != access.APPROVED || request.Grant == nil || request.Grant.Status != ahTypes.GrantStatusACTIVE {
+		return nil, ErrGrantNotActive
+	}
+	if request.Extension != nil && request.Extension.Status == access.ExtensionPending {
+		return nil, ErrExtensionPending
+	}
+
+	maxDuration := time.Duration(opts.AccessRule.TimeConstraints.MaxDurationSeconds) * time.Second
+	end := request.Grant.End.Add(opts.ExtendBy)
+	if end.Sub(request.Grant.Start) > maxDuration {
+		return nil, apio.NewRequestError(fmt.Errorf("extending the request by %s would exceed the maximum duration of %s for the access rule", opts.ExtendBy, maxDuration), http.StatusBadRequest)
+	}
+	if !request.BreakGlass {
+		err := opts.AccessRule.CheckActiveWindow(request.Grant.Start, end)
+		if err != nil {
+			return nil, apio.NewRequestError(err, http.StatusBadRequest)
+		}
+		if b, ok := opts.AccessRule.Blackout(request.Grant.End, end); ok {
+			return nil, apio.NewRequestError(fmt.Errorf("the extended access can't be active during the blackout from %s", rule.DescribeBlackout(*b)), http.StatusBadRequest)
+		}
+	}
+	// reject extensions which the runtime can't apply before they are saved and sent to reviewers
+	err := s.Workflow.ValidateExtension(request, end, opts.AccessRule)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.Clock.Now()
+	request.Extension = &access.Extension{
+		Status:      access.ExtensionPending,
+		ExtendBy:    opts.ExtendBy,
+		Reason:      opts.Reason,
+		RequestedAt: now,
+		UpdatedAt:   now,
+	}
+	request.UpdatedAt = now
+
+	approved := !opts.AccessRule.Approval.IsRequired()
+	var autoApproval *access.RequestAutoApproval
+	if !approved && s.AutoApproval != nil {
+		with := make(map[string]string)
+		for k, v := range request.SelectedWith {
+			with[k] = v.Value
+		}
+		log := logger.Get(ctx).With("user.id", opts.User.ID, "request.id", request.ID)
+		autoApproval, err = s.evaluateAutoApproval(ctx, log, autoapproval.Input{
+			User:   opts.User,
+			Rule:   opts.AccessRule,
+			With:   with,
+			Timing: extendedTiming(request),
+			Now:    now,
+		})
+		if err != nil {
+			return nil, err
+		}
+		approved = autoApproval != nil
+	}
+	if approved {
+		var items []ddb.Keyer
+		// record the policy statement which approved the extension, so that the approval can be audited.
+		if autoApproval != nil {
+			autoApprovedEvent := access.NewAutoApprovedEvent(request.ID, now, *autoApproval)
+			items = append(items, &autoApprovedEvent)
+		}
+		return s.extendGrant(ctx, request, opts.AccessRule, nil, "", items...)
+	}
+
+	items, err := dbupdate.GetUpdateRequestItems(ctx, s.DB, request, dbupdate.WithEvents(gevent.RequestExtensionRequested{Request: request, RequestorEmail: opts.User.Email}))
+	if err != nil {
+		return nil, err
+	}
+	err = dbupdate.PutItems(ctx, s.DB, items...)
+	if err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+type ReviewExtensionOpts struct {
+	ReviewerID      string
+	ReviewerEmail   string
+	ReviewerIsAdmin bool
+	Reviewers       []access.Reviewer
+	Decision        access.Decision
+	Request         access.Request
+	AccessRule      rule.AccessRule
+}
+
+// ReviewExtension reviews the pending extension of a request.
+// Approvals are counted towards the approval steps of the access rule in the same way as reviews of the original request,
+// and the grant is extended once every step has been satisfied.
+func (s *Service) ReviewExtension(ctx context.Context, opts ReviewExtensionOpts) (*access.Request, error) {
+	request := opts.Request
+	if request.Extension == nil || request.Extension.Status != access.ExtensionPending {
+		return nil, ErrNoPendingExtension
+	}
+	isAllowed := canReview(AddReviewOpts{
+		ReviewerID:      opts.ReviewerID,
+		ReviewerIsAdmin: opts.ReviewerIsAdmin,
+		Reviewers:       opts.Reviewers,
+		Request:         request,
+	})
+	if !isAllowed {
+		return nil, ErrUserNotAuthorized
+	}
+
+	// copy the extension so that the caller's request is not modified.
+	ext := *request.Extension
+	request.Extension = &ext
+	now := s.Clock.Now()
+
+	if opts.Decision == access.DecisionDECLINED {
+		ext.Status = access.ExtensionDeclined
+		ext.UpdatedAt = now
+		request.UpdatedAt = now
+		evt := gevent.RequestExtensionDeclined{Request: request, ReviewerID: opts.ReviewerID, ReviewerEmail: opts.ReviewerEmail}
+		items, err := dbupdate.GetUpdateRequestItems(ctx, s.DB, request, dbupdate.WithReviewers(opts.Reviewers), dbupdate.WithEvents(evt))
+		if err != nil {
+			return nil, err
+		}
+		err = dbupdate.PutItems(ctx, s.DB, items...)
+		if err != nil {
+			return nil, err
+		}
+		return &request, nil
+	}
+
+	steps := opts.AccessRule.Approval.GetSteps()
+	step := currentApprovalStep(steps, &ext)
+	if step < len(steps) {
+		if ext.HasApprovalFrom(opts.ReviewerID) {
+			return nil, ErrReviewerAlreadyApproved
+		}
+		if !opts.ReviewerIsAdmin && !reviewerCanApproveStep(opts.Reviewers, opts.ReviewerID, step) {
+			return nil, ErrNotApproverForStep
+		}
+		ext.Approvals = append(ext.Approvals, access.StepApproval{
+			Step:       step,
+			ReviewerID: opts.ReviewerID,
+			ApprovedAt: now,
+		})
+	}
+	if currentApprovalStep(steps, &ext) == len(steps) {
+		return s.extendGrant(ctx, request, opts.AccessRule, &opts.ReviewerID, opts.ReviewerEmail)
+	}
+
+	// the extension stays pending until every approval step has been satisfied
+	ext.UpdatedAt = now
+	request.UpdatedAt = now
+	items, err := dbupdate.GetUpdateRequestItems(ctx, s.DB, request, dbupdate.WithReviewers(opts.Reviewers))
+	if err != nil {
+		return nil, err
+	}
+	progressEvent := access.NewApprovalProgressEvent(request.ID, now, &opts.ReviewerID, access.ApprovalProgress{
+		Step:              step,
+		StepName:          steps[step].Name,
+		Approvals:         ext.ApprovalsForStep(step),
+		RequiredApprovals: steps[step].Required(),
+	})
+	items = append(items, &progressEvent)
+	err = dbupdate.PutItems(ctx, s.DB, items...)
+	if err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+// extendGrant reschedules the end of the grant for an approved extension and records the timing change.
+// reviewerID is nil if the extension was approved automatically. Any extra items are saved along with the request.
+func (s *Service) extendGrant(ctx context.Context, request access.Request, accessRule rule.AccessRule, reviewerID *string, reviewerEmail string, extra ...ddb.Keyer) (*access.Request, error) {
+	end := request.Grant.End.Add(request.Extension.ExtendBy)
+	err := s.Workflow.Extend(ctx, request, end, accessRule)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.Clock.Now()
+	from := currentTiming(request)
+	to := extendedTiming(request)
+
+	grant := *request.Grant
+	grant.End = end
+	grant.UpdatedAt = now
+	request.Grant = &grant
+	request.OverrideTiming = &to
+	ext := *request.Extension
+	ext.Status = access.ExtensionApproved
+	ext.UpdatedAt = now
+	request.Extension = &ext
+	request.UpdatedAt = now
+
+	evt := gevent.RequestExtensionApproved{Request: request, ReviewerEmail: reviewerEmail}
+	if reviewerID != nil {
+		evt.ReviewerID = *reviewerID
+	}
+	items, err := dbupdate.GetUpdateRequestItems(ctx, s.DB, request, dbupdate.WithEvents(evt))
+	if err != nil {
+		return nil, err
+	}
+	timingEvent := access.NewTimingChangeEvent(request.ID, now, reviewerID, from, to)
+	items = append(items, &timingEvent)
+	items = append(items, extra...)
+	err = dbupdate.PutItems(ctx, s.DB, items...)
+	if err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+// currentTiming returns the timing of the request, taking into account any override by an approver.
+func currentTiming(request access.Request) access.Timing {
+	if request.OverrideTiming != nil {
+		return *request.OverrideTiming
+	}
+	return request.RequestedTiming
+}
+
+// extendedTiming returns the timing of the request once its pending extension has been applied.
+// The start time of an ASAP request is pinned to the start of its grant, as otherwise the extended
+// timing would be measured from whenever it is evaluated rather than from when access began.
+func extendedTiming(request access.Request) access.Timing {
+	t := currentTiming(request)
+	if t.StartTime == nil && request.Grant != nil {
+		start := request.Grant.Start
+		t.StartTime = &start
+	}
+	t.Duration += request.Extension.ExtendBy
+	return t
+}
@@ -0,0 +1,311 @@
+package accesssvc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/rule"
+	accessMocks "github.com/common-fate/common-fate/pkg/service/accesssvc/mocks"
+	"github.com/common-fate/common-fate/pkg/service/workflowsvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestExtension(t *testing.T) {
+	clk := clock.NewMock()
+	start := clk.Now().Add(-30 * time.Minute)
+	activeRequest := access.Request{
+		ID:              "req_1",
+		RequestedBy:     "usr_requestor",
+		Status:          access.APPROVED,
+		RequestedTiming: access.Timing{Duration: time.Hour},
+		Grant:           &access.Grant{Status: ahTypes.GrantStatusACTIVE, Start: start, End: start.Add(time.Hour)},
+	}
+	pending := activeRequest
+	pending.Extension = &access.Extension{Status: access.ExtensionPending, ExtendBy: time.Hour}
+	expired := activeRequest
+	expired.Grant = &access.Grant{Status: ahTypes.GrantStatusEXPIRED, Start: start, End: start.Add(time.Hour)}
+
+	type testcase struct {
+		name        string
+		giveUser    string
+		giveRequest access.Request
+		giveRule    rule.AccessRule
+		giveExtend  time.Duration
+		// giveValidateErr is returned by the workflow when validating the extension
+		giveValidateErr error
+		wantErr         error
+		// wantExtended is true if the grant should be extended immediately
+		wantExtended bool
+		wantStatus   access.ExtensionStatus
+	}
+
+	maxFourHours := types.TimeConstraints{MaxDurationSeconds: 4 * 60 * 60}
+	testcases := []testcase{
+		{
+			name:         "no approval required extends immediately",
+			giveUser:     "usr_requestor",
+			giveRequest:  activeRequest,
+			giveRule:     rule.AccessRule{TimeConstraints: maxFourHours},
+			giveExtend:   time.Hour,
+			wantExtended: true,
+			wantStatus:   access.ExtensionApproved,
+		},
+		{
+			name:        "approval required",
+			giveUser:    "usr_requestor",
+			giveRequest: activeRequest,
+			giveRule:    rule.AccessRule{TimeConstraints: maxFourHours, Approval: rule.Approval{Users: []string{"usr_reviewer"}}},
+			giveExtend:  time.Hour,
+			wantStatus:  access.ExtensionPending,
+		},
+		{
+			name:        "exceeds max duration",
+			giveUser:    "usr_requestor",
+			giveRequest: activeRequest,
+			giveRule:    rule.AccessRule{TimeConstraints: maxFourHours},
+			giveExtend:  4 * time.Hour,
+			wantErr:     errors.New("extending the request by 4h0m0s would exceed the maximum duration of 4h0m0s for the access rule"),
+		},
+		{
+			name:        "not the requestor",
+			giveUser:    "usr_other",
+			giveRequest: activeRequest,
+			giveRule:    rule.AccessRule{TimeConstraints: maxFourHours},
+			giveExtend:  time.Hour,
+			wantErr:     ErrUserNotAuthorized,
+		},
+		{
+			name:        "grant not active",
+			giveUser:    "usr_requestor",
+			giveRequest: expired,
+			giveRule:    rule.AccessRule{TimeConstraints: maxFourHours},
+			giveExtend:  time.Hour,
+			wantErr:     ErrGrantNotActive,
+		},
+		{
+			name:        "extension already pending",
+			giveUser:    "usr_requestor",
+			giveRequest: pending,
+			giveRule:    rule.AccessRule{TimeConstraints: maxFourHours},
+			giveExtend:  time.Hour,
+			wantErr:     ErrExtensionPending,
+		},
+		{
+			name:            "runtime can't extend the grant",
+			giveUser:        "usr_requestor",
+			giveRequest:     activeRequest,
+			giveRule:        rule.AccessRule{TimeConstraints: maxFourHours, Approval: rule.Approval{Users: []string{"usr_reviewer"}}},
+			giveExtend:      time.Hour,
+			giveValidateErr: workflowsvc.ErrExtendNotSupported,
+			wantErr:         workflowsvc.ErrExtendNotSupported,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.ListRequestReviewers{})
+
+			ctrl := gomock.NewController(t)
+			workflow := accessMocks.NewMockWorkflow(ctrl)
+			workflow.EXPECT().ValidateExtension(gomock.Any(), gomock.Any(), gomock.Any()).Return(tc.giveValidateErr).AnyTimes()
+			if tc.wantExtended {
+				workflow.EXPECT().Extend(gomock.Any(), gomock.Any(), tc.giveRequest.Grant.End.Add(tc.giveExtend), gomock.Any()).Return(nil)
+			}
+
+			s := Service{
+				Clock:    clk,
+				DB:       db,
+				Workflow: workflow,
+			}
+			got, err := s.RequestExtension(context.Background(), RequestExtensionOpts{
+				User:       identity.User{ID: tc.giveUser},
+				Request:    tc.giveRequest,
+				AccessRule: tc.giveRule,
+				ExtendBy:   tc.giveExtend,
+			})
+			if tc.wantErr != nil {
+				assert.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantStatus, got.Extension.Status)
+			if tc.wantExtended {
+				assert.Equal(t, tc.giveRequest.Grant.End.Add(tc.giveExtend), got.Grant.End)
+				assert.Equal(t, 2*time.Hour, got.OverrideTiming.Duration)
+				assert.Equal(t, &start, got.OverrideTiming.StartTime)
+			} else {
+				assert.Equal(t, tc.giveRequest.Grant.End, got.Grant.End)
+			}
+		})
+	}
+}
+
+func TestExtendedTimingASAP(t *testing.T) {
+	clk := clock.NewMock()
+	// the request was approved and started an hour ago, and is extended by an hour.
+	start := clk.Now().Add(-time.Hour)
+	request := access.Request{
+		RequestedTiming: access.Timing{Duration: 2 * time.Hour},
+		Grant:           &access.Grant{Status: ahTypes.GrantStatusACTIVE, Start: start, End: start.Add(2 * time.Hour)},
+		Extension:       &access.Extension{Status: access.ExtensionPending, ExtendBy: time.Hour},
+	}
+	got := extendedTiming(request)
+	assert.Equal(t, access.Timing{Duration: 3 * time.Hour, StartTime: &start}, got)
+
+	// the extended timing ends an hour after the grant, rather than three hours from now.
+	gotStart, gotEnd := got.GetInterval(access.WithNow(clk.Now()))
+	assert.Equal(t, start, gotStart)
+	assert.Equal(t, request.Grant.End.Add(time.Hour), gotEnd)
+}
+
+func TestReviewExtension(t *testing.T) {
+	clk := clock.NewMock()
+	start := clk.Now().Add(-30 * time.Minute)
+	request := access.Request{
+		ID:              "req_1",
+		RequestedBy:     "usr_requestor",
+		Status:          access.APPROVED,
+		RequestedTiming: access.Timing{Duration: time.Hour},
+		Grant:           &access.Grant{Status: ahTypes.GrantStatusACTIVE, Start: start, End: start.Add(time.Hour)},
+		Extension:       &access.Extension{Status: access.ExtensionPending, ExtendBy: time.Hour},
+	}
+	noExtension := request
+	noExtension.Extension = nil
+
+	quorum := rule.AccessRule{Approval: rule.Approval{Steps: []rule.ApprovalStep{
+		{Users: []string{"usr_reviewer", "usr_reviewer_2"}, RequiredApprovals: 2},
+	}}}
+
+	type testcase struct {
+		name         string
+		giveReviewer string
+		giveDecision access.Decision
+		giveRequest  access.Request
+		giveRule     rule.AccessRule
+		wantErr      error
+		wantStatus   access.ExtensionStatus
+		wantEvent    string
+	}
+
+	testcases := []testcase{
+		{
+			name:         "approved",
+			giveReviewer: "usr_reviewer",
+			giveDecision: access.DecisionApproved,
+			giveRequest:  request,
+			giveRule:     rule.AccessRule{Approval: rule.Approval{Users: []string{"usr_reviewer"}}},
+			wantStatus:   access.ExtensionApproved,
+			wantEvent:    gevent.RequestExtensionApprovedType,
+		},
+		{
+			name:         "declined",
+			giveReviewer: "usr_reviewer",
+			giveDecision: access.DecisionDECLINED,
+			giveRequest:  request,
+			giveRule:     rule.AccessRule{Approval: rule.Approval{Users: []string{"usr_reviewer"}}},
+			wantStatus:   access.ExtensionDeclined,
+			wantEvent:    gevent.RequestExtensionDeclinedType,
+		},
+		{
+			name:         "waiting for quorum",
+			giveReviewer: "usr_reviewer",
+			giveDecision: access.DecisionApproved,
+			giveRequest:  request,
+			giveRule:     quorum,
+			wantStatus:   access.ExtensionPending,
+		},
+		{
+			name:         "not a reviewer",
+			giveReviewer: "usr_other",
+			giveDecision: access.DecisionApproved,
+			giveRequest:  request,
+			giveRule:     rule.AccessRule{Approval: rule.Approval{Users: []string{"usr_reviewer"}}},
+			wantErr:      ErrUserNotAuthorized,
+		},
+		{
+			name:         "no pending extension",
+			giveReviewer: "usr_reviewer",
+			giveDecision: access.DecisionApproved,
+			giveRequest:  noExtension,
+			giveRule:     rule.AccessRule{Approval: rule.Approval{Users: []string{"usr_reviewer"}}},
+			wantErr:      ErrNoPendingExtension,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock := ddbmock.New(t)
+			mock.MockQuery(&storage.ListRequestReviewers{})
+			db := &outboxDB{Storage: mock}
+
+			ctrl := gomock.NewController(t)
+			workflow := accessMocks.NewMockWorkflow(ctrl)
+			workflow.EXPECT().Extend(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			s := Service{
+				Clock:    clk,
+				DB:       db,
+				Workflow: workflow,
+			}
+			reviewers := []access.Reviewer{
+				{ReviewerID: "usr_reviewer", Steps: []int{0}},
+				{ReviewerID: "usr_reviewer_2", Steps: []int{0}},
+			}
+			got, err := s.ReviewExtension(context.Background(), ReviewExtensionOpts{
+				ReviewerID: tc.giveReviewer,
+				Reviewers:  reviewers,
+				Decision:   tc.giveDecision,
+				Request:    tc.giveRequest,
+				AccessRule: tc.giveRule,
+			})
+			assert.Equal(t, tc.wantErr, err)
+			if tc.wantErr == nil {
+				assert.Equal(t, tc.wantStatus, got.Extension.Status)
+				// the request passed in should not be modified
+				assert.Equal(t, access.ExtensionPending, tc.giveRequest.Extension.Status)
+			}
+			var wantEvents []string
+			if tc.wantEvent != "" {
+				wantEvents = []string{tc.wantEvent}
+			}
+			assert.Equal(t, wantEvents, db.eventTypes())
+		})
+	}
+}
+
+// outboxDB records the events which are written to the outbox.
+type outboxDB struct {
+	ddb.Storage
+	events []gevent.Event
+}
+
+func (o *outboxDB) TransactWriteItems(ctx context.Context, tx []ddb.TransactWriteItem) error {
+	for _, item := range tx {
+		if e, ok := item.Put.(*gevent.OutboxEvent); ok {
+			o.events = append(o.events, e.Event)
+		}
+	}
+	return o.Storage.TransactWriteItems(ctx, tx)
+}
+
+// eventTypes returns the types of the events written to the outbox.
+func (o *outboxDB) eventTypes() []string {
+	var types []string
+	for _, e := range o.events {
+		types = append(types, e.Type)
+	}
+	return types
+}
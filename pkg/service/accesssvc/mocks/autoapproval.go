@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/common-fate/common-fate/pkg/service/accesssvc (interfaces: AutoApprovalService)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	autoapproval "github.com/common-fate/common-fate/pkg/autoapproval"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAutoApprovalService is a mock of AutoApprovalService interface.
+type MockAutoApprovalService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAutoApprovalServiceMockRecorder
+}
+
+// MockAutoApprovalServiceMockRecorder is the mock recorder for MockAutoApprovalService.
+type MockAutoApprovalServiceMockRecorder struct {
+	mock *MockAutoApprovalService
+}
+
+// NewMockAutoApprovalService creates a new mock instance.
+func NewMockAutoApprovalService(ctrl *gomock.Controller) *MockAutoApprovalService {
+	mock := &MockAutoApprovalService{ctrl: ctrl}
+	mock.recorder = &MockAutoApprovalServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAutoApprovalService) EXPECT() *MockAutoApprovalServiceMockRecorder {
+	return m.recorder
+}
+
+// Autoapprove mocks base method.
+func (m *MockAutoApprovalService) Autoapprove(arg0 context.Context, arg1 autoapproval.Input) (autoapproval.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Autoapprove", arg0, arg1)
+	ret0, _ := ret[0].(autoapproval.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Autoapprove indicates an expected call of Autoapprove.
+func (mr *MockAutoApprovalServiceMockRecorder) Autoapprove(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Autoapprove", reflect.TypeOf((*MockAutoApprovalService)(nil).Autoapprove), arg0, arg1)
+}
+
+// HistoryQuery mocks base method.
+func (m *MockAutoApprovalService) HistoryQuery() autoapproval.HistoryQuery {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HistoryQuery")
+	ret0, _ := ret[0].(autoapproval.HistoryQuery)
+	return ret0
+}
+
+// HistoryQuery indicates an expected call of HistoryQuery.
+func (mr *MockAutoApprovalServiceMockRecorder) HistoryQuery() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HistoryQuery", reflect.TypeOf((*MockAutoApprovalService)(nil).HistoryQuery))
+}
@@ -7,6 +7,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	access "github.com/common-fate/common-fate/pkg/access"
 	rule "github.com/common-fate/common-fate/pkg/rule"
@@ -36,6 +37,34 @@ func (m *MockWorkflow) EXPECT() *MockWorkflowMockRecorder {
 	return m.recorder
 }
 
+// Cancel mocks base method.
+func (m *MockWorkflow) Cancel(arg0 context.Context, arg1 access.Request, arg2 rule.AccessRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockWorkflowMockRecorder) Cancel(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockWorkflow)(nil).Cancel), arg0, arg1, arg2)
+}
+
+// Extend mocks base method.
+func (m *MockWorkflow) Extend(arg0 context.Context, arg1 access.Request, arg2 time.Time, arg3 rule.AccessRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Extend", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Extend indicates an expected call of Extend.
+func (mr *MockWorkflowMockRecorder) Extend(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Extend", reflect.TypeOf((*MockWorkflow)(nil).Extend), arg0, arg1, arg2, arg3)
+}
+
 // Grant mocks base method.
 func (m *MockWorkflow) Grant(arg0 context.Context, arg1 access.Request, arg2 rule.AccessRule) (*access.Grant, error) {
 	m.ctrl.T.Helper()
@@ -50,3 +79,17 @@ func (mr *MockWorkflowMockRecorder) Grant(arg0, arg1, arg2 interface{}) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Grant", reflect.TypeOf((*MockWorkflow)(nil).Grant), arg0, arg1, arg2)
 }
+
+// ValidateExtension mocks base method.
+func (m *MockWorkflow) ValidateExtension(arg0 access.Request, arg1 time.Time, arg2 rule.AccessRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateExtension", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateExtension indicates an expected call of ValidateExtension.
+func (mr *MockWorkflowMockRecorder) ValidateExtension(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateExtension", reflect.TypeOf((*MockWorkflow)(nil).ValidateExtension), arg0, arg1, arg2)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/benbjohnson/clock"
 	"github.com/common-fate/common-fate/pkg/access"
@@ -17,8 +18,10 @@ type requestAndRule struct {
 	rule    rule.AccessRule
 }
 
+// overlapsExistingGrantCheck returns true if any window of access for the request overlaps with an active or pending grant
+// for the same target. For recurring requests every future occurrence is checked.
 func overlapsExistingGrantCheck(req access.Request, upcomingRequests []access.Request, currentRequestRule rule.AccessRule, allRules []rule.AccessRule, clock clock.Clock) (bool, error) {
-	start, end := req.GetInterval(access.WithNow(clock.Now()))
+	intervals := req.Intervals(access.WithNow(clock.Now()))
 	var upcomingRequestAndRules []requestAndRule
 
 	ruleMap := make(map[string]rule.AccessRule)
@@ -54,7 +57,7 @@ func overlapsExistingGrantCheck(req access.Request, upcomingRequests []access.Re
 		}
 
 		upcomingStart, upcomingEnd := r.request.GetInterval(access.WithNow(clock.Now()))
-		if (start.Before(upcomingEnd) || start.Equal(upcomingEnd)) && (end.After(upcomingStart) || end.Equal(upcomingStart)) {
+		if overlapsAny(intervals, upcomingStart, upcomingEnd) {
 
 			//check the arguments overlap
 			upcomingRequestArguments := make(map[string]string)
@@ -80,6 +83,16 @@ func overlapsExistingGrantCheck(req access.Request, upcomingRequests []access.Re
 	return false, nil
 }
 
+// overlapsAny returns true if any of the intervals overlaps with the window between start and end.
+func overlapsAny(intervals []access.Interval, start time.Time, end time.Time) bool {
+	for _, i := range intervals {
+		if (i.Start.Before(end) || i.Start.Equal(end)) && (i.End.After(start) || i.End.Equal(start)) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *Service) overlapsExistingGrant(ctx context.Context, req access.Request) (bool, error) {
 	start, _ := req.GetInterval(access.WithNow(s.Clock.Now()))
 
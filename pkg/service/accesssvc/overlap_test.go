@@ -39,6 +39,12 @@ func TestOverlapsExistingGrant(t *testing.T) {
 	args2["a"] = "argA"
 	args2["b"] = "argB"
 
+	// a grant which starts in a week, overlapping with the second occurrence of a weekly request starting now
+	inOneWeek := now.AddDate(0, 0, 7)
+	upcomingRequest := access.Request{Status: access.Status(types.RequestStatusAPPROVED), Grant: &access.Grant{Status: "PENDING"}, Rule: "rule_a", RequestedTiming: access.Timing{StartTime: &inOneWeek, Duration: time.Minute * 2}}
+	weekly := &access.Recurrence{Frequency: access.FrequencyWeekly, Interval: 1, Until: now.AddDate(0, 0, 14)}
+	daily := &access.Recurrence{Frequency: access.FrequencyDaily, Interval: 2, Until: now.AddDate(0, 0, 14)}
+
 	testcases := []testcase{
 		{
 			name:               "no existing grants",
@@ -77,6 +83,24 @@ func TestOverlapsExistingGrant(t *testing.T) {
 			want:               true,
 		},
 
+		{
+			name:               "future occurrence of recurring request overlaps upcoming grant fails",
+			accessRequest:      access.Request{Rule: "rule_a", RequestedTiming: access.Timing{StartTime: &inOneMinute, Duration: time.Minute * 5, Recurrence: weekly}},
+			upcomingRequests:   []access.Request{upcomingRequest},
+			currentRequestRule: rule.AccessRule{ID: "rule_a", Target: rule.Target{ProviderID: "prov_a"}},
+			allRules:           []rule.AccessRule{{ID: "rule_a", Target: rule.Target{ProviderID: "prov_a"}}},
+			clock:              clk,
+			want:               true,
+		},
+		{
+			name:               "recurring request with no occurrence during upcoming grant passes",
+			accessRequest:      access.Request{Rule: "rule_a", RequestedTiming: access.Timing{StartTime: &inOneMinute, Duration: time.Minute * 5, Recurrence: daily}},
+			upcomingRequests:   []access.Request{upcomingRequest},
+			currentRequestRule: rule.AccessRule{ID: "rule_a", Target: rule.Target{ProviderID: "prov_a"}},
+			allRules:           []rule.AccessRule{{ID: "rule_a", Target: rule.Target{ProviderID: "prov_a"}}},
+			clock:              clk,
+			want:               false,
+		},
 		{
 			name:               "same rule different arguments should succeed",
 			accessRequest:      access.Request{Rule: "rule_a", RequestedTiming: access.Timing{StartTime: &inOneMinute, Duration: time.Minute * 5}},
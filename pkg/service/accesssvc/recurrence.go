@@ -0,0 +1,124 @@
+package accesssvc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/service/workflowsvc"
+	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+	"go.uber.org/zap"
+)
+
+// validateRecurrence checks that a recurring request has a start time and a valid recurrence.
+func validateRecurrence(timing types.RequestTiming) error {
+	if timing.Recurrence == nil {
+		return nil
+	}
+	fieldErr := func(msg string) error {
+		return &apio.APIError{
+			Err:    errors.New("request validation failed"),
+			Status: http.StatusBadRequest,
+			Fields: []apio.FieldError{
+				{
+					Field: "timing.recurrence",
+					Error: msg,
+				},
+			},
+		}
+	}
+	if timing.StartTime == nil {
+		return fieldErr("a recurring request must have a start time")
+	}
+	r := access.RecurrenceFromAPI(*timing.Recurrence)
+	err := r.Validate(*timing.StartTime, time.Duration(timing.DurationSeconds)*time.Second)
+	if err != nil {
+		return fieldErr(err.Error())
+	}
+	return nil
+}
+
+// grantOccurrences creates a request for each occurrence of an approved recurring request and grants it.
+// The occurrences are approved along with the recurring request, so they are created with an APPROVED status
+// and refer to the recurring request through RecurrenceOf. The recurring request itself is never granted.
+//
+// Occurrences which have already ended are skipped. If any occurrence can't be granted, the grants which have already been
+// created are revoked. The returned occurrences should be saved with saveOccurrences before the recurring request is saved.
+func (s *Service) grantOccurrences(ctx context.Context, parent access.Request, accessRule rule.AccessRule) ([]access.Request, error) {
+	now := s.Clock.Now()
+	var occurrences []access.Request
+	for _, interval := range parent.Intervals(access.WithNow(now)) {
+		if !interval.End.After(now) {
+			continue
+		}
+		// an occurrence which is already underway is granted from now until the end of the occurrence.
+		start := interval.Start
+		if start.Before(now) {
+			start = now
+		}
+		occurrence := access.Request{
+			ID:             types.NewRequestID(),
+			RequestedBy:    parent.RequestedBy,
+			Data:           parent.Data,
+			Status:         access.APPROVED,
+			ApprovalMethod: parent.ApprovalMethod,
+			RequestedTiming: access.Timing{
+				Duration:  interval.End.Sub(start),
+				StartTime: &start,
+			},
+			Rule:         parent.Rule,
+			RuleVersion:  parent.RuleVersion,
+			SelectedWith: parent.SelectedWith,
+			RecurrenceOf: &parent.ID,
+			CreatedAt:    now,
+			UpdatedAt:    now,
+		}
+		grant, err := s.Workflow.Grant(ctx, occurrence, accessRule)
+		if err != nil {
+			s.revokeOccurrences(ctx, occurrences, accessRule)
+			return nil, err
+		}
+		occurrence.Grant = grant
+		occurrences = append(occurrences, occurrence)
+	}
+	return occurrences, nil
+}
+
+// saveOccurrences saves each granted occurrence along with its created event and grant events.
+// The occurrences are saved separately so that the outbox transaction for a recurring request with many occurrences
+// doesn't exceed the DynamoDB limit on the number of items in a transaction.
+func (s *Service) saveOccurrences(ctx context.Context, occurrences []access.Request, accessRule rule.AccessRule) error {
+	for i := range occurrences {
+		occurrence := occurrences[i]
+		reqEvent := access.NewRequestCreatedEvent(occurrence.ID, occurrence.CreatedAt, nil)
+		items := []ddb.Keyer{&occurrence, &reqEvent}
+		events, err := dbupdate.OutboxEvents(occurrence.CreatedAt, workflowsvc.GrantEvents(occurrence, accessRule)...)
+		if err != nil {
+			return err
+		}
+		items = append(items, events...)
+		err = dbupdate.PutItems(ctx, s.DB, items...)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// revokeOccurrences revokes the grants of occurrences which couldn't be saved along with their recurring request.
+// Errors are logged rather than returned, so that the error which caused the occurrences to be revoked is returned to the caller.
+func (s *Service) revokeOccurrences(ctx context.Context, occurrences []access.Request, accessRule rule.AccessRule) {
+	for _, occurrence := range occurrences {
+		err := s.Workflow.Cancel(ctx, occurrence, accessRule)
+		if err != nil {
+			logger.Get(ctx).Errorw("failed to revoke grant for occurrence of recurring request", "request.id", occurrence.ID, "recurrenceOf", occurrence.RecurrenceOf, zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package accesssvc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/rule"
+	accessMocks "github.com/common-fate/common-fate/pkg/service/accesssvc/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrantOccurrences(t *testing.T) {
+	clk := clock.NewMock()
+	now := clk.Now()
+	// the first occurrence started an hour ago and is still underway
+	start := now.Add(-time.Hour)
+
+	parent := access.Request{
+		ID:          "req_parent",
+		RequestedBy: "user1",
+		Rule:        "rule_a",
+		Status:      access.APPROVED,
+		RequestedTiming: access.Timing{
+			StartTime:  &start,
+			Duration:   2 * time.Hour,
+			Recurrence: &access.Recurrence{Frequency: access.FrequencyWeekly, Interval: 1, Until: start.AddDate(0, 0, 14)},
+		},
+	}
+	accessRule := rule.AccessRule{ID: "rule_a"}
+
+	ctrl := gomock.NewController(t)
+	workflow := accessMocks.NewMockWorkflow(ctrl)
+	var granted []access.Request
+	workflow.EXPECT().Grant(gomock.Any(), gomock.Any(), accessRule).DoAndReturn(func(ctx context.Context, req access.Request, accessRule rule.AccessRule) (*access.Grant, error) {
+		granted = append(granted, req)
+		s, e := req.GetInterval()
+		return &access.Grant{Start: s, End: e}, nil
+	}).Times(2)
+
+	s := Service{Clock: clk, Workflow: workflow}
+	occurrences, err := s.grantOccurrences(context.Background(), parent, accessRule)
+	assert.NoError(t, err)
+	assert.Len(t, occurrences, 2)
+
+	nextWeek := start.AddDate(0, 0, 7)
+	want := []struct {
+		start time.Time
+		end   time.Time
+	}{
+		{start: now, end: start.Add(2 * time.Hour)},
+		{start: nextWeek, end: nextWeek.Add(2 * time.Hour)},
+	}
+	for i, w := range want {
+		gotStart, gotEnd := granted[i].GetInterval()
+		assert.Equal(t, w.start, gotStart)
+		assert.Equal(t, w.end, gotEnd)
+		assert.Equal(t, "req_parent", *granted[i].RecurrenceOf)
+		assert.Equal(t, access.APPROVED, granted[i].Status)
+	}
+}
+
+func TestGrantOccurrencesRevokesOnError(t *testing.T) {
+	clk := clock.NewMock()
+	start := clk.Now().Add(time.Hour)
+
+	parent := access.Request{
+		ID:          "req_parent",
+		RequestedBy: "user1",
+		Rule:        "rule_a",
+		Status:      access.APPROVED,
+		RequestedTiming: access.Timing{
+			StartTime:  &start,
+			Duration:   2 * time.Hour,
+			Recurrence: &access.Recurrence{Frequency: access.FrequencyDaily, Interval: 1, Until: start.AddDate(0, 0, 3)},
+		},
+	}
+	accessRule := rule.AccessRule{ID: "rule_a"}
+
+	ctrl := gomock.NewController(t)
+	workflow := accessMocks.NewMockWorkflow(ctrl)
+	var granted []string
+	workflow.EXPECT().Grant(gomock.Any(), gomock.Any(), accessRule).DoAndReturn(func(ctx context.Context, req access.Request, accessRule rule.AccessRule) (*access.Grant, error) {
+		// the third occurrence fails to be granted
+		if len(granted) == 2 {
+			return nil, errors.New("granting failed")
+		}
+		granted = append(granted, req.ID)
+		return &access.Grant{}, nil
+	}).Times(3)
+	var revoked []string
+	workflow.EXPECT().Cancel(gomock.Any(), gomock.Any(), accessRule).DoAndReturn(func(ctx context.Context, req access.Request, accessRule rule.AccessRule) error {
+		revoked = append(revoked, req.ID)
+		return nil
+	}).Times(2)
+
+	s := Service{Clock: clk, Workflow: workflow}
+	_, err := s.grantOccurrences(context.Background(), parent, accessRule)
+	assert.EqualError(t, err, "granting failed")
+	// the grants which were created before the error are revoked
+	assert.Equal(t, granted, revoked)
+}
@@ -2,37 +2,49 @@ package accesssvc
 
 import (
 	"context"
+	"time"
 
 	"github.com/benbjohnson/clock"
 
 	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
 	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/autoapproval"
 	"github.com/common-fate/common-fate/pkg/cache"
-	"github.com/common-fate/common-fate/pkg/gevent"
 	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/ticket"
 	"github.com/common-fate/common-fate/pkg/types"
 	"github.com/common-fate/ddb"
 )
 
 // Service holds business logic relating to Access Requests.
 type Service struct {
-	Clock       clock.Clock
-	DB          ddb.Storage
-	EventPutter EventPutter
-	Cache       CacheService
-	AHClient    AHClient
-	Rules       AccessRuleService
-	Workflow    Workflow
+	Clock    clock.Clock
+	DB       ddb.Storage
+	Cache    CacheService
+	AHClient AHClient
+	Rules    AccessRuleService
+	Workflow Workflow
+	// AutoApproval is optional. If it is nil, requests are never auto-approved.
+	AutoApproval AutoApprovalService
+	// TicketValidator is optional. If it is nil, ticket references are only checked against the pattern of the access rule.
+	TicketValidator ticket.Validator
 }
 
 //go:generate go run github.com/golang/mock/mockgen -destination=mocks/workflow.go -package=mocks . Workflow
 type Workflow interface {
 	Grant(ctx context.Context, request access.Request, accessRule rule.AccessRule) (*access.Grant, error)
+	Cancel(ctx context.Context, request access.Request, accessRule rule.AccessRule) error
+	ValidateExtension(request access.Request, end time.Time, accessRule rule.AccessRule) error
+	Extend(ctx context.Context, request access.Request, end time.Time, accessRule rule.AccessRule) error
 }
 
-//go:generate go run github.com/golang/mock/mockgen -destination=mocks/eventputter.go -package=mocks . EventPutter
-type EventPutter interface {
-	Put(ctx context.Context, detail gevent.EventTyper) error
+//go:generate go run github.com/golang/mock/mockgen -destination=mocks/autoapproval.go -package=mocks . AutoApprovalService
+
+// AutoApprovalService decides whether a request can be approved without review
+type AutoApprovalService interface {
+	Autoapprove(ctx context.Context, in autoapproval.Input) (autoapproval.Result, error)
+	// HistoryQuery returns the user's request history which must be loaded to evaluate a request.
+	HistoryQuery() autoapproval.HistoryQuery
 }
 
 //go:generate go run github.com/golang/mock/mockgen -destination=mocks/cache.go -package=mocks . CacheService
@@ -5,11 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/common-fate/apikit/apio"
 	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/pkg/access"
 	"github.com/common-fate/common-fate/pkg/rule"
 	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/ticket"
 	"github.com/common-fate/common-fate/pkg/types"
 	"github.com/common-fate/ddb"
 	"go.uber.org/zap"
@@ -19,6 +23,7 @@ type validateCreateRequestsResponse struct {
 	argumentCombinations types.RequestArgumentCombinations
 	rule                 rule.AccessRule
 	requestArguments     map[string]types.RequestArgument
+	ticketReference      *string
 }
 
 // validateCreateRequests returns APIO errors for bad request errors relating to the whole request
@@ -45,6 +50,26 @@ func (s *Service) validateCreateRequests(ctx context.Context, in CreateRequestsO
 		return nil, err
 	}
 
+	if in.Create.BreakGlass {
+		err = validateBreakGlass(rule, in)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// break-glass access is used during incidents, so it isn't held to the active window, blackouts or notice period of the rule.
+	if !in.Create.BreakGlass {
+		err = validateTimeConstraints(ctx, in.Create.Timing, rule, s.Clock.Now())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ticketReference, err := s.validateTicketReference(ctx, rule, in)
+	if err != nil {
+		return nil, err
+	}
+
 	requestArguments, err := s.Rules.RequestArguments(ctx, rule.Target)
 	if err != nil {
 		return nil, err
@@ -66,6 +91,7 @@ func (s *Service) validateCreateRequests(ctx context.Context, in CreateRequestsO
 		argumentCombinations: combinationsToCreate,
 		rule:                 rule,
 		requestArguments:     requestArguments,
+		ticketReference:      ticketReference,
 	}, nil
 }
 
@@ -126,6 +152,12 @@ func validateCreateRequest(ctx context.Context, request CreateRequest, rule rule
 		}
 	}
 
+	err := validateRecurrence(request.Timing)
+	if err != nil {
+		logger.Get(ctx).Errorw("error validating request", zap.Error(err))
+		return err
+	}
+
 	given := make(map[string]string)
 	expected := make(map[string][]string)
 	if request.With != nil {
@@ -180,6 +212,131 @@ func validateCreateRequest(ctx context.Context, request CreateRequest, rule rule
 	return nil
 }
 
+// validateTimeConstraints checks that every window of access for the request falls inside the active window of the rule,
+// doesn't overlap with a blackout, and that scheduled requests are made with the minimum notice required by the rule.
+func validateTimeConstraints(ctx context.Context, timing types.RequestTiming, accessRule rule.AccessRule, now time.Time) error {
+	fieldErr := func(msg string) error {
+		logger.Get(ctx).Errorw("error validating request", zap.Error(errors.New(msg)))
+		return &apio.APIError{
+			Err:    errors.New("request validation failed"),
+			Status: http.StatusBadRequest,
+			Fields: []apio.FieldError{
+				{
+					Field: "timing",
+					Error: msg,
+				},
+			},
+		}
+	}
+	if timing.StartTime != nil {
+		notice := accessRule.MinimumNotice()
+		if timing.StartTime.Sub(now) < notice {
+			return fieldErr(fmt.Sprintf("scheduled access must be requested at least %s before it starts", notice))
+		}
+	}
+	msg, ok := checkAccessWindows(access.TimingFromRequestTiming(timing), accessRule, now)
+	if !ok {
+		return fieldErr(msg)
+	}
+	return nil
+}
+
+// checkAccessWindows checks that every window of access for the timing falls inside the active window of the rule
+// and doesn't overlap with a blackout. If it doesn't, the reason is returned.
+func checkAccessWindows(t access.Timing, accessRule rule.AccessRule, now time.Time) (string, bool) {
+	for _, interval := range t.Intervals(access.WithNow(now)) {
+		err := accessRule.CheckActiveWindow(interval.Start, interval.End)
+		if err != nil {
+			return err.Error(), false
+		}
+		if b, ok := accessRule.Blackout(interval.Start, interval.End); ok {
+			return "access can't be active during the blackout from " + rule.DescribeBlackout(*b), false
+		}
+	}
+	return "", true
+}
+
+// validateOverrideTiming checks the timing which a reviewer approves a request with against the time constraints of the rule,
+// in the same way as the timing of a new request. The minimum notice isn't checked, as it applies when the request is made.
+func validateOverrideTiming(ctx context.Context, timing access.Timing, accessRule rule.AccessRule, now time.Time) error {
+	fieldErr := func(field string, msg string) error {
+		logger.Get(ctx).Errorw("error validating override timing", zap.Error(errors.New(msg)))
+		return &apio.APIError{
+			Err:    errors.New("review validation failed"),
+			Status: http.StatusBadRequest,
+			Fields: []apio.FieldError{
+				{
+					Field: field,
+					Error: msg,
+				},
+			},
+		}
+	}
+	if timing.Duration <= 0 {
+		return fieldErr("overrideTiming.durationSeconds", "the duration must be greater than zero")
+	}
+	maxDuration := time.Duration(accessRule.TimeConstraints.MaxDurationSeconds) * time.Second
+	if timing.Duration > maxDuration {
+		return fieldErr("overrideTiming.durationSeconds", fmt.Sprintf("durationSeconds: %d exceeds the maximum duration seconds: %d", int(timing.Duration.Seconds()), accessRule.TimeConstraints.MaxDurationSeconds))
+	}
+	err := validateRecurrence(timing.ToAPI())
+	if err != nil {
+		return err
+	}
+	msg, ok := checkAccessWindows(timing, accessRule, now)
+	if !ok {
+		return fieldErr("overrideTiming", msg)
+	}
+	return nil
+}
+
+// validateTicketReference checks the ticket reference against the access rule, and with the ticket validator if the rule requires it.
+// It returns the ticket reference with surrounding whitespace removed, or nil if no ticket reference was given.
+func (s *Service) validateTicketReference(ctx context.Context, accessRule rule.AccessRule, in CreateRequestsOpts) (*string, error) {
+	var ref string
+	if in.Create.TicketReference != nil {
+		ref = strings.TrimSpace(*in.Create.TicketReference)
+	}
+	fieldErr := func(msg string) error {
+		logger.Get(ctx).Errorw("error validating request", zap.Error(errors.New(msg)))
+		return &apio.APIError{
+			Err:    errors.New("request validation failed"),
+			Status: http.StatusBadRequest,
+			Fields: []apio.FieldError{
+				{
+					Field: "ticketReference",
+					Error: msg,
+				},
+			},
+		}
+	}
+
+	if accessRule.TicketReference != nil {
+		err := accessRule.TicketReference.Check(ref)
+		if err != nil {
+			return nil, fieldErr(err.Error())
+		}
+		if accessRule.TicketReference.Validate {
+			// requests are rejected rather than accepted without validation, so that a missing validator can't be used to skip the check.
+			if s.TicketValidator == nil {
+				return nil, fieldErr("ticket references for this access rule can't be validated as no ticket validator is configured, contact your administrator")
+			}
+			err = s.TicketValidator.Validate(ctx, ticket.Input{Reference: ref, RuleID: accessRule.ID, RequesterEmail: in.User.Email})
+			var invalid ticket.InvalidError
+			if errors.As(err, &invalid) {
+				return nil, fieldErr(invalid.Error())
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	if ref == "" {
+		return nil, nil
+	}
+	return &ref, nil
+}
+
 func contains(set []string, str string) bool {
 	for _, s := range set {
 		if s == str {
@@ -7,11 +7,13 @@ import (
 	"time"
 
 	"github.com/benbjohnson/clock"
+	"github.com/common-fate/apikit/apio"
 	"github.com/common-fate/common-fate/pkg/access"
 	"github.com/common-fate/common-fate/pkg/identity"
 	"github.com/common-fate/common-fate/pkg/rule"
 	accessMocks "github.com/common-fate/common-fate/pkg/service/accesssvc/mocks"
 	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/ticket"
 	"github.com/common-fate/common-fate/pkg/types"
 	"github.com/common-fate/ddb/ddbmock"
 	"github.com/golang/mock/gomock"
@@ -460,3 +462,159 @@ func TestValidateCreate(t *testing.T) {
 	}
 
 }
+
+func TestValidateTimeConstraints(t *testing.T) {
+	clk := clock.NewMock()
+	now := clk.Now()
+	inOneHour := now.Add(time.Hour)
+	inOneWeek := now.AddDate(0, 0, 7)
+	oneDay := 86400
+	reason := "change freeze"
+
+	type testcase struct {
+		name      string
+		giveTimeC types.TimeConstraints
+		giveTime  types.RequestTiming
+		wantErr   string
+	}
+
+	testcases := []testcase{
+		{
+			name:     "no constraints",
+			giveTime: types.RequestTiming{DurationSeconds: 3600},
+		},
+		{
+			name:      "scheduled without enough notice",
+			giveTimeC: types.TimeConstraints{MinimumNoticeSeconds: &oneDay},
+			giveTime:  types.RequestTiming{DurationSeconds: 3600, StartTime: &inOneHour},
+			wantErr:   "scheduled access must be requested at least 24h0m0s before it starts",
+		},
+		{
+			name:      "asap requests don't need notice",
+			giveTimeC: types.TimeConstraints{MinimumNoticeSeconds: &oneDay},
+			giveTime:  types.RequestTiming{DurationSeconds: 3600},
+		},
+		{
+			name:      "outside active window",
+			giveTimeC: types.TimeConstraints{ActiveWindow: &types.ActiveWindow{Timezone: "UTC", StartTime: "02:00", EndTime: "04:00"}},
+			giveTime:  types.RequestTiming{DurationSeconds: 3600},
+			wantErr:   "access can only be active between 02:00 and 04:00 (UTC)",
+		},
+		{
+			name:      "overlaps blackout",
+			giveTimeC: types.TimeConstraints{Blackouts: &[]types.Blackout{{Start: now.Add(30 * time.Minute), End: now.Add(2 * time.Hour), Reason: &reason}}},
+			giveTime:  types.RequestTiming{DurationSeconds: 3600},
+			wantErr:   "access can't be active during the blackout from 1970-01-01T00:30:00Z to 1970-01-01T02:00:00Z (change freeze)",
+		},
+		{
+			name:      "later occurrence of recurring request overlaps blackout",
+			giveTimeC: types.TimeConstraints{Blackouts: &[]types.Blackout{{Start: inOneWeek, End: inOneWeek.Add(2 * time.Hour)}}},
+			giveTime: types.RequestTiming{DurationSeconds: 3600, StartTime: &inOneHour, Recurrence: &types.RequestRecurrence{
+				Frequency: types.DAILY,
+				Until:     now.AddDate(0, 0, 14),
+			}},
+			wantErr: "access can't be active during the blackout from 1970-01-08T00:00:00Z to 1970-01-08T02:00:00Z",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateTimeConstraints(context.Background(), tc.giveTime, rule.AccessRule{TimeConstraints: tc.giveTimeC}, now)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var apiErr *apio.APIError
+			assert.ErrorAs(t, err, &apiErr)
+			assert.Equal(t, []apio.FieldError{{Field: "timing", Error: tc.wantErr}}, apiErr.Fields)
+		})
+	}
+}
+
+// testTicketValidator returns err for every ticket reference.
+type testTicketValidator struct {
+	err error
+}
+
+func (v testTicketValidator) Validate(ctx context.Context, in ticket.Input) error {
+	return v.err
+}
+
+func TestValidateTicketReference(t *testing.T) {
+	type testcase struct {
+		name          string
+		giveRef       *string
+		giveConfig    *rule.TicketReference
+		withValidator ticket.Validator
+		want          *string
+		wantErr       string
+	}
+	ref := "OPS-123"
+	padded := " OPS-123 "
+	incident := "INC-1"
+
+	testcases := []testcase{
+		{
+			name: "not required or given",
+		},
+		{
+			name:    "given without config",
+			giveRef: &padded,
+			want:    &ref,
+		},
+		{
+			name:       "required",
+			giveConfig: &rule.TicketReference{},
+			wantErr:    "a ticket reference is required for this access rule",
+		},
+		{
+			name:       "matches pattern",
+			giveRef:    &ref,
+			giveConfig: &rule.TicketReference{Pattern: "^OPS-[0-9]+$"},
+			want:       &ref,
+		},
+		{
+			name:       "doesn't match pattern",
+			giveRef:    &incident,
+			giveConfig: &rule.TicketReference{Pattern: "^OPS-[0-9]+$"},
+			wantErr:    "ticket reference INC-1 does not match the pattern ^OPS-[0-9]+$",
+		},
+		{
+			name:          "rejected by validator",
+			giveRef:       &ref,
+			giveConfig:    &rule.TicketReference{Validate: true},
+			withValidator: testTicketValidator{err: ticket.InvalidError{Reference: ref, Reason: "issue is closed"}},
+			wantErr:       "ticket reference OPS-123 is not valid: issue is closed",
+		},
+		{
+			name:       "validation required without a validator",
+			giveRef:    &ref,
+			giveConfig: &rule.TicketReference{Validate: true},
+			wantErr:    "ticket references for this access rule can't be validated as no ticket validator is configured, contact your administrator",
+		},
+		{
+			name:          "validator not used unless the rule requires it",
+			giveRef:       &ref,
+			giveConfig:    &rule.TicketReference{},
+			withValidator: testTicketValidator{err: ticket.InvalidError{Reference: ref}},
+			want:          &ref,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := Service{TicketValidator: tc.withValidator}
+			got, err := s.validateTicketReference(context.Background(), rule.AccessRule{TicketReference: tc.giveConfig}, CreateRequestsOpts{
+				Create: CreateRequests{TicketReference: tc.giveRef},
+			})
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.want, got)
+				return
+			}
+			var apiErr *apio.APIError
+			assert.ErrorAs(t, err, &apiErr)
+			assert.Equal(t, []apio.FieldError{{Field: "ticketReference", Error: tc.wantErr}}, apiErr.Fields)
+		})
+	}
+}
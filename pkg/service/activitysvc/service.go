@@ -0,0 +1,142 @@
+package activitysvc
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/activity"
+	"github.com/common-fate/common-fate/pkg/schedule"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultLookback is how far back the audit log is read the first time a reader is run.
+	DefaultLookback = time.Hour
+	// DefaultDelay accounts for the delay between an action occurring and it appearing in the audit log.
+	// CloudTrail typically delivers events within 15 minutes.
+	DefaultDelay = 15 * time.Minute
+)
+
+// Service ingests provider audit logs and attributes the records to the grants of access requests.
+type Service struct {
+	Clock clock.Clock
+	DB    ddb.Storage
+	// Readers are keyed by the name of the audit log, which is used to track how far through
+	// the audit log has been read.
+	Readers map[string]activity.Reader
+	// Lookback is how far back the audit log is read the first time a reader is run.
+	// If zero, DefaultLookback is used.
+	Lookback time.Duration
+	// Delay is how far behind the current time records are read up to.
+	// If zero, DefaultDelay is used.
+	Delay time.Duration
+}
+
+// Run reads new records from each audit log and stores the records which can be attributed to a grant.
+// An audit log which can't be read is retried from the same cursor on the next run.
+func (s *Service) Run(ctx context.Context) error {
+	log := zap.S()
+
+	// read the audit logs in a consistent order.
+	var sources []string
+	for source := range s.Readers {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+
+	for _, source := range sources {
+		err := s.ingest(ctx, source, s.Readers[source])
+		if err != nil {
+			log.Errorw("failed to ingest activity", "source", source, zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// RunEvery ingests activity on the given interval until the context is cancelled.
+// The deployed stack runs the activity Lambda on a schedule instead.
+func (s *Service) RunEvery(ctx context.Context, interval time.Duration) {
+	schedule.RunEvery(ctx, s.Clock, interval, "failed to ingest activity", s.Run)
+}
+
+// ingest reads the records since the last run from the audit log, attributes them to grants
+// and moves the cursor for the audit log forward.
+func (s *Service) ingest(ctx context.Context, source string, reader activity.Reader) error {
+	log := zap.S().With("source", source)
+	now := s.Clock.Now()
+	to := now.Add(-s.delay())
+
+	from := to.Add(-s.lookback())
+	q := storage.GetActivityCursor{Source: source}
+	_, err := s.DB.Query(ctx, &q)
+	if err != nil && err != ddb.ErrNoItems {
+		return err
+	}
+	if err == nil {
+		from = q.Result.ReadUntil
+	}
+	if !from.Before(to) {
+		return nil
+	}
+
+	records, err := reader.Read(ctx, from, to)
+	if err != nil {
+		return err
+	}
+	requests, err := s.listRequestsWithGrantsBetween(ctx, from, to)
+	if err != nil {
+		return err
+	}
+	events := activity.Attribute(records, requests, now)
+	log.Infow("ingested activity", "from", from, "to", to, "records", len(records), "attributed", len(events))
+
+	items := make([]ddb.Keyer, 0, len(events))
+	for i := range events {
+		items = append(items, &events[i])
+	}
+	if len(items) > 0 {
+		err = s.DB.PutBatch(ctx, items...)
+		if err != nil {
+			return err
+		}
+	}
+	// the cursor is only moved forward once every record has been saved, so activity is delivered at least once.
+	// If saving the records or the cursor fails, the same window is read again on the next run.
+	// Records are keyed by their ID, so reading them again does not duplicate them.
+	return s.DB.Put(ctx, &activity.Cursor{Source: source, ReadUntil: to})
+}
+
+// listRequestsWithGrantsBetween returns the approved requests which had a grant that overlapped with the time window.
+func (s *Service) listRequestsWithGrantsBetween(ctx context.Context, from time.Time, to time.Time) ([]access.Request, error) {
+	var requests []access.Request
+	// requests are filtered by their end time so that requests which ended before the window are not returned.
+	q := storage.ListApprovedRequestsEndingAfter{EndingAfter: from}
+	err := storage.ForEachPage(ctx, s.DB, &q, func() error {
+		for _, req := range q.Result {
+			if req.Grant != nil && req.Grant.Start.Before(to) && req.Grant.End.After(from) {
+				requests = append(requests, req)
+			}
+		}
+		return nil
+	})
+	return requests, err
+}
+
+func (s *Service) lookback() time.Duration {
+	if s.Lookback == 0 {
+		return DefaultLookback
+	}
+	return s.Lookback
+}
+
+func (s *Service) delay() time.Duration {
+	if s.Delay == 0 {
+		return DefaultDelay
+	}
+	return s.Delay
+}
@@ -0,0 +1,159 @@
+package activitysvc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/activity"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// testReader records the time window it was asked to read.
+type testReader struct {
+	from    time.Time
+	to      time.Time
+	records []activity.Record
+	err     error
+}
+
+func (r *testReader) Read(ctx context.Context, from time.Time, to time.Time) ([]activity.Record, error) {
+	r.from = from
+	r.to = to
+	return r.records, r.err
+}
+
+func TestRun(t *testing.T) {
+	clk := clock.NewMock()
+	now := clk.Now()
+
+	type testcase struct {
+		name          string
+		giveCursor    *activity.Cursor
+		giveReaderErr error
+		// wantRead is false if the reader should not be called
+		wantRead bool
+		wantFrom time.Time
+		wantTo   time.Time
+	}
+
+	testcases := []testcase{
+		{
+			name:     "first run reads the lookback window",
+			wantRead: true,
+			wantFrom: now.Add(-DefaultDelay - DefaultLookback),
+			wantTo:   now.Add(-DefaultDelay),
+		},
+		{
+			name:       "reads from the cursor",
+			giveCursor: &activity.Cursor{Source: "file", ReadUntil: now.Add(-20 * time.Minute)},
+			wantRead:   true,
+			wantFrom:   now.Add(-20 * time.Minute),
+			wantTo:     now.Add(-DefaultDelay),
+		},
+		{
+			name:       "already up to date",
+			giveCursor: &activity.Cursor{Source: "file", ReadUntil: now.Add(-DefaultDelay)},
+		},
+		{
+			name:          "reader errors are not returned",
+			giveReaderErr: errors.New("audit log unavailable"),
+			wantRead:      true,
+			wantFrom:      now.Add(-DefaultDelay - DefaultLookback),
+			wantTo:        now.Add(-DefaultDelay),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			if tc.giveCursor != nil {
+				db.MockQuery(&storage.GetActivityCursor{Result: tc.giveCursor})
+			} else {
+				db.MockQueryWithErr(&storage.GetActivityCursor{}, ddb.ErrNoItems)
+			}
+			db.MockQuery(&storage.ListApprovedRequestsEndingAfter{})
+
+			reader := &testReader{err: tc.giveReaderErr}
+			s := Service{Clock: clk, DB: db, Readers: map[string]activity.Reader{"file": reader}}
+			err := s.Run(context.Background())
+			assert.NoError(t, err)
+			if !tc.wantRead {
+				assert.True(t, reader.from.IsZero())
+				return
+			}
+			assert.Equal(t, tc.wantFrom, reader.from)
+			assert.Equal(t, tc.wantTo, reader.to)
+		})
+	}
+}
+
+// cursorDB fails to save activity events and records whether the cursor was saved.
+type cursorDB struct {
+	ddb.Storage
+	putBatchErr error
+	cursor      *activity.Cursor
+}
+
+func (db *cursorDB) PutBatch(ctx context.Context, items ...ddb.Keyer) error {
+	return db.putBatchErr
+}
+
+func (db *cursorDB) Put(ctx context.Context, item ddb.Keyer) error {
+	if c, ok := item.(*activity.Cursor); ok {
+		db.cursor = c
+	}
+	return nil
+}
+
+func TestRunDoesNotMoveCursorWhenSavingFails(t *testing.T) {
+	clk := clock.NewMock()
+	now := clk.Now()
+	grantStart := now.Add(-2 * time.Hour)
+
+	mock := ddbmock.New(t)
+	mock.MockQueryWithErr(&storage.GetActivityCursor{}, ddb.ErrNoItems)
+	mock.MockQuery(&storage.ListApprovedRequestsEndingAfter{Result: []access.Request{
+		{
+			ID:     "req_1",
+			Status: access.APPROVED,
+			Grant: &access.Grant{
+				Subject: "user@example.com",
+				Status:  ahTypes.GrantStatusACTIVE,
+				Start:   grantStart,
+				End:     now,
+			},
+		},
+	}})
+	reader := &testReader{records: []activity.Record{
+		{ID: "rec_1", Source: "file", Subject: "user@example.com", Timestamp: now.Add(-DefaultDelay - time.Minute)},
+	}}
+
+	for _, tc := range []struct {
+		name        string
+		putBatchErr error
+		wantCursor  bool
+	}{
+		{name: "records saved", wantCursor: true},
+		{name: "records not saved", putBatchErr: errors.New("throttled")},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			db := &cursorDB{Storage: mock, putBatchErr: tc.putBatchErr}
+			s := Service{Clock: clk, DB: db, Readers: map[string]activity.Reader{"file": reader}}
+			err := s.Run(context.Background())
+			assert.NoError(t, err)
+			if !tc.wantCursor {
+				assert.Nil(t, db.cursor)
+				return
+			}
+			assert.Equal(t, &activity.Cursor{Source: "file", ReadUntil: now.Add(-DefaultDelay)}, db.cursor)
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package auditsvc
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/common-fate/common-fate/pkg/audit"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+	"go.uber.org/zap"
+)
+
+// MaxEntriesPerObject is the maximum number of entries written to a single S3 object.
+const MaxEntriesPerObject = 10000
+
+// Export writes the entries appended since the last export to S3 as JSON Lines.
+// Objects are written with a compliance mode object lock, so they can't be changed or deleted
+// until the retention period ends.
+//
+// The entries are verified against the last exported entry before they are written, so an entry which
+// has been changed in DynamoDB is never exported.
+func (s *Service) Export(ctx context.Context) error {
+	q := storage.GetAuditLogExported{}
+	_, err := s.DB.Query(ctx, &q)
+	if err != nil && err != ddb.ErrNoItems {
+		return err
+	}
+	var exported audit.Exported
+	if err == nil {
+		exported = *q.Result
+	}
+
+	for {
+		entries, err := s.listEntries(ctx, exported.Sequence)
+		if err != nil {
+			return err
+		}
+		if len(entries) == 0 {
+			return nil
+		}
+
+		problems := audit.VerifyFrom(audit.Head{Sequence: exported.Sequence, Hash: exported.Hash}, entries)
+		if len(problems) > 0 {
+			for _, p := range problems {
+				zap.S().Errorw("audit log entry failed verification", "sequence", p.Sequence, "reason", p.Reason)
+			}
+			return fmt.Errorf("audit log failed verification after entry %d: %s", exported.Sequence, problems[0])
+		}
+
+		first, last := entries[0], entries[len(entries)-1]
+		key := audit.ObjectKey(first.Sequence, last.Sequence)
+		err = s.putObject(ctx, key, entries)
+		if err != nil {
+			return err
+		}
+		zap.S().Infow("exported audit log", "bucket", s.Bucket, "key", key, "entries", len(entries))
+
+		exported = audit.Exported{Sequence: last.Sequence, Hash: last.Hash, ExportedAt: s.Clock.Now()}
+		err = s.DB.Put(ctx, &exported)
+		if err != nil {
+			return err
+		}
+		if len(entries) < MaxEntriesPerObject {
+			return nil
+		}
+	}
+}
+
+// listEntries lists up to MaxEntriesPerObject entries after the sequence number.
+func (s *Service) listEntries(ctx context.Context, after int64) ([]audit.Entry, error) {
+	var entries []audit.Entry
+	var page string
+	for len(entries) < MaxEntriesPerObject {
+		q := storage.ListAuditLogEntries{AfterSequence: after}
+		opts := []func(*ddb.QueryOpts){ddb.ConsistentRead(), ddb.Limit(int32(MaxEntriesPerObject - len(entries)))}
+		if page != "" {
+			opts = append(opts, ddb.Page(page))
+		}
+		res, err := s.DB.Query(ctx, &q, opts...)
+		if err != nil && err != ddb.ErrNoItems {
+			return nil, err
+		}
+		entries = append(entries, q.Result...)
+		if res == nil || res.NextPage == "" {
+			break
+		}
+		page = res.NextPage
+	}
+	return entries, nil
+}
+
+func (s *Service) putObject(ctx context.Context, key string, entries []audit.Entry) error {
+	if s.Bucket == "" {
+		return errors.New("audit log bucket must be set to export the audit log")
+	}
+	var buf bytes.Buffer
+	err := audit.WriteJSONL(&buf, entries)
+	if err != nil {
+		return err
+	}
+	sum := sha256.Sum256(buf.Bytes())
+
+	in := s3.PutObjectInput{
+		Bucket:            aws.String(s.Bucket),
+		Key:               aws.String(key),
+		Body:              bytes.NewReader(buf.Bytes()),
+		ContentType:       aws.String("application/x-ndjson"),
+		ChecksumAlgorithm: types.ChecksumAlgorithmSha256,
+		ChecksumSHA256:    aws.String(base64.StdEncoding.EncodeToString(sum[:])),
+	}
+	if s.Retention > 0 {
+		in.ObjectLockMode = types.ObjectLockModeCompliance
+		in.ObjectLockRetainUntilDate = aws.Time(s.Clock.Now().Add(s.Retention))
+	}
+	_, err = s.S3.PutObject(ctx, &in)
+	return err
+}
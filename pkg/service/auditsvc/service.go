@@ -0,0 +1,180 @@
+package auditsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/audit"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+	"go.uber.org/zap"
+)
+
+// DefaultMaxAttempts is the number of times an entry is appended
+// before giving up, if another entry was appended at the same time.
+const DefaultMaxAttempts = 5
+
+// ErrEventAlreadyAppended is returned if an event which has already been recorded in the audit log is appended again.
+var ErrEventAlreadyAppended = errors.New("event has already been appended to the audit log")
+
+// TransactWriter writes DynamoDB transactions. It is implemented by *dynamodb.Client.
+type TransactWriter interface {
+	TransactWriteItems(ctx context.Context, params *dynamodb.TransactWriteItemsInput, optFns ...func(*dynamodb.Options)) (*dynamodb.TransactWriteItemsOutput, error)
+}
+
+// S3Putter uploads objects to S3. It is implemented by *s3.Client.
+type S3Putter interface {
+	PutObject(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options)) (*s3.PutObjectOutput, error)
+}
+
+// Service appends events to the audit log and exports the log to S3.
+type Service struct {
+	Clock clock.Clock
+	DB    ddb.Storage
+	// Writer appends entries using conditional writes, which ddb.Storage doesn't support.
+	// If nil, the DynamoDB client of DB is used.
+	Writer TransactWriter
+	// MaxAttempts defaults to DefaultMaxAttempts if zero.
+	MaxAttempts int
+
+	S3     S3Putter
+	Bucket string
+	// Retention is how long exported objects are locked for.
+	// If zero, the default retention of the bucket is used.
+	Retention time.Duration
+}
+
+// HandleEvent appends an event delivered by EventBridge to the audit log.
+// Events which have already been appended are ignored, so that retried deliveries aren't recorded twice.
+func (s *Service) HandleEvent(ctx context.Context, ce events.CloudWatchEvent) error {
+	evt := gevent.FromCloudWatchEvent(ce)
+	entry, err := s.Append(ctx, evt)
+	if err == ErrEventAlreadyAppended {
+		zap.S().Infow("event has already been appended to the audit log", "event.id", evt.ID, "event.type", evt.Type)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	zap.S().Infow("appended event to audit log", "event.id", evt.ID, "event.type", evt.Type, "sequence", entry.Sequence)
+	return nil
+}
+
+// Append adds the event to the end of the audit log.
+//
+// The entry, the new head of the log, and a marker recording that the event has been appended are written
+// in a single transaction. The transaction fails if the head has moved since it was read, in which case
+// the entry is chained to the new head and appended again.
+func (s *Service) Append(ctx context.Context, evt gevent.Event) (*audit.Entry, error) {
+	maxAttempts := s.MaxAttempts
+	if maxAttempts == 0 {
+		maxAttempts = DefaultMaxAttempts
+	}
+
+	var err error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		var head audit.Head
+		head, err = s.head(ctx)
+		if err != nil {
+			return nil, err
+		}
+		entry := audit.NewEntry(head, evt, s.Clock.Now())
+		err = s.write(ctx, head, entry)
+		if err == nil {
+			return &entry, nil
+		}
+		var tce *types.TransactionCanceledException
+		if !errors.As(err, &tce) {
+			return nil, err
+		}
+		// the cancellation reasons are in the same order as the items in the transaction.
+		if len(tce.CancellationReasons) == 3 && aws.ToString(tce.CancellationReasons[2].Code) == "ConditionalCheckFailed" {
+			return nil, ErrEventAlreadyAppended
+		}
+		zap.S().Infow("audit log head moved while appending, retrying", "attempt", attempt, zap.Error(err))
+	}
+	return nil, fmt.Errorf("appending event %s to audit log after %d attempts: %w", evt.ID, maxAttempts, err)
+}
+
+// head returns the latest entry in the log, or an empty head if the log is empty.
+func (s *Service) head(ctx context.Context) (audit.Head, error) {
+	q := storage.GetAuditLogHead{}
+	_, err := s.DB.Query(ctx, &q, ddb.ConsistentRead())
+	if err == ddb.ErrNoItems {
+		return audit.Head{}, nil
+	}
+	if err != nil {
+		return audit.Head{}, err
+	}
+	return *q.Result, nil
+}
+
+func (s *Service) write(ctx context.Context, prev audit.Head, entry audit.Entry) error {
+	entryItem, err := marshal(&entry)
+	if err != nil {
+		return err
+	}
+	headItem, err := marshal(&audit.Head{Sequence: entry.Sequence, Hash: entry.Hash})
+	if err != nil {
+		return err
+	}
+	markerItem, err := marshal(&audit.EventMarker{EventID: entry.EventID, Sequence: entry.Sequence})
+	if err != nil {
+		return err
+	}
+
+	table := aws.String(s.DB.Table())
+	headPut := &types.Put{
+		TableName:           table,
+		Item:                headItem,
+		ConditionExpression: aws.String("attribute_not_exists(PK)"),
+	}
+	if prev.Sequence > 0 {
+		headPut.ConditionExpression = aws.String("#sequence = :sequence")
+		headPut.ExpressionAttributeNames = map[string]string{"#sequence": "sequence"}
+		headPut.ExpressionAttributeValues = map[string]types.AttributeValue{
+			":sequence": &types.AttributeValueMemberN{Value: fmt.Sprint(prev.Sequence)},
+		}
+	}
+
+	_, err = s.writer().TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: []types.TransactWriteItem{
+			{Put: &types.Put{TableName: table, Item: entryItem, ConditionExpression: aws.String("attribute_not_exists(PK)")}},
+			{Put: headPut},
+			{Put: &types.Put{TableName: table, Item: markerItem, ConditionExpression: aws.String("attribute_not_exists(PK)")}},
+		},
+	})
+	return err
+}
+
+func (s *Service) writer() TransactWriter {
+	if s.Writer != nil {
+		return s.Writer
+	}
+	return s.DB.Client()
+}
+
+// marshal returns the DynamoDB representation of an audit log item, including its keys.
+func marshal(item ddb.Keyer) (map[string]types.AttributeValue, error) {
+	keys, err := item.DDBKeys()
+	if err != nil {
+		return nil, err
+	}
+	attrs, err := attributevalue.MarshalMap(item)
+	if err != nil {
+		return nil, err
+	}
+	attrs["PK"] = &types.AttributeValueMemberS{Value: keys.PK}
+	attrs["SK"] = &types.AttributeValueMemberS{Value: keys.SK}
+	return attrs, nil
+}
@@ -0,0 +1,225 @@
+package auditsvc
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/audit"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// conflictingWriter fails the first writes with the given cancellation reasons,
+// and records the entries of the writes which succeed.
+type conflictingWriter struct {
+	failures [][]string
+	calls    int
+	entries  []audit.Entry
+}
+
+func (w *conflictingWriter) TransactWriteItems(ctx context.Context, params *dynamodb.TransactWriteItemsInput, optFns ...func(*dynamodb.Options)) (*dynamodb.TransactWriteItemsOutput, error) {
+	w.calls++
+	if len(w.failures) > 0 {
+		codes := w.failures[0]
+		w.failures = w.failures[1:]
+		var reasons []types.CancellationReason
+		for _, c := range codes {
+			reasons = append(reasons, types.CancellationReason{Code: aws.String(c)})
+		}
+		return nil, &types.TransactionCanceledException{CancellationReasons: reasons}
+	}
+	var e audit.Entry
+	err := attributevalue.UnmarshalMap(params.TransactItems[0].Put.Item, &e)
+	if err != nil {
+		return nil, err
+	}
+	w.entries = append(w.entries, e)
+	return &dynamodb.TransactWriteItemsOutput{}, nil
+}
+
+func TestAppend(t *testing.T) {
+	now := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+	evt := gevent.Event{ID: "evt_1", Type: gevent.RequestCreatedType, Time: now, Detail: json.RawMessage(`{}`)}
+	head := audit.Head{Sequence: 4, Hash: "abc"}
+	headMoved := []string{"None", "ConditionalCheckFailed", "None"}
+	duplicate := []string{"None", "None", "ConditionalCheckFailed"}
+
+	type testcase struct {
+		name         string
+		giveFailures [][]string
+		giveHeadErr  error
+		wantSequence int64
+		wantPrevHash string
+		wantCalls    int
+		wantErr      error
+	}
+
+	testcases := []testcase{
+		{
+			name:         "ok",
+			wantSequence: 5,
+			wantPrevHash: "abc",
+			wantCalls:    1,
+		},
+		{
+			name:         "first entry",
+			giveHeadErr:  ddb.ErrNoItems,
+			wantSequence: 1,
+			wantCalls:    1,
+		},
+		{
+			name:         "retries when head moved",
+			giveFailures: [][]string{headMoved, headMoved},
+			wantSequence: 5,
+			wantPrevHash: "abc",
+			wantCalls:    3,
+		},
+		{
+			name:         "duplicate event",
+			giveFailures: [][]string{duplicate},
+			wantCalls:    1,
+			wantErr:      ErrEventAlreadyAppended,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQueryWithErr(&storage.GetAuditLogHead{Result: &head}, tc.giveHeadErr)
+			w := &conflictingWriter{failures: tc.giveFailures}
+			clk := clock.NewMock()
+			clk.Set(now)
+
+			s := Service{Clock: clk, DB: db, Writer: w}
+			got, err := s.Append(context.Background(), evt)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantCalls, w.calls)
+			if tc.wantErr != nil {
+				return
+			}
+			assert.Equal(t, tc.wantSequence, got.Sequence)
+			assert.Equal(t, tc.wantPrevHash, got.PrevHash)
+			assert.Equal(t, "evt_1", got.EventID)
+			assert.Equal(t, got.ComputeHash(), got.Hash)
+			assert.Equal(t, []audit.Entry{*got}, w.entries)
+		})
+	}
+}
+
+func TestAppendGivesUpAfterMaxAttempts(t *testing.T) {
+	db := ddbmock.New(t)
+	db.MockQuery(&storage.GetAuditLogHead{Result: &audit.Head{Sequence: 1, Hash: "abc"}})
+	headMoved := []string{"None", "ConditionalCheckFailed", "None"}
+	w := &conflictingWriter{failures: [][]string{headMoved, headMoved, headMoved}}
+
+	s := Service{Clock: clock.NewMock(), DB: db, Writer: w, MaxAttempts: 2}
+	_, err := s.Append(context.Background(), gevent.Event{ID: "evt_1"})
+	assert.Error(t, err)
+	assert.Equal(t, 2, w.calls)
+}
+
+type recordingS3 struct {
+	inputs []*s3.PutObjectInput
+	bodies []string
+}
+
+func (r *recordingS3) PutObject(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options)) (*s3.PutObjectOutput, error) {
+	b, err := io.ReadAll(params.Body)
+	if err != nil {
+		return nil, err
+	}
+	r.inputs = append(r.inputs, params)
+	r.bodies = append(r.bodies, string(b))
+	return &s3.PutObjectOutput{}, nil
+}
+
+func TestExport(t *testing.T) {
+	now := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+	var entries []audit.Entry
+	var head audit.Head
+	for _, id := range []string{"evt_1", "evt_2", "evt_3"} {
+		e := audit.NewEntry(head, gevent.Event{ID: id, Type: gevent.RequestCreatedType, Time: now, Detail: json.RawMessage(`{}`)}, now)
+		entries = append(entries, e)
+		head = audit.Head{Sequence: e.Sequence, Hash: e.Hash}
+	}
+
+	type testcase struct {
+		name        string
+		giveExport  audit.Exported
+		giveErr     error
+		giveEntries []audit.Entry
+		wantKeys    []string
+		wantErr     bool
+	}
+
+	testcases := []testcase{
+		{
+			name:        "first export",
+			giveErr:     ddb.ErrNoItems,
+			giveEntries: entries,
+			wantKeys:    []string{audit.ObjectKey(1, 3)},
+		},
+		{
+			name:        "continues from last export",
+			giveExport:  audit.Exported{Sequence: 1, Hash: entries[0].Hash},
+			giveEntries: entries[1:],
+			wantKeys:    []string{audit.ObjectKey(2, 3)},
+		},
+		{
+			name:       "nothing to export",
+			giveExport: audit.Exported{Sequence: 3, Hash: entries[2].Hash},
+		},
+		{
+			name:        "tampered entries are not exported",
+			giveExport:  audit.Exported{Sequence: 1, Hash: "other"},
+			giveEntries: entries[1:],
+			wantErr:     true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQueryWithErr(&storage.GetAuditLogExported{Result: &tc.giveExport}, tc.giveErr)
+			db.MockQuery(&storage.ListAuditLogEntries{Result: tc.giveEntries})
+			clk := clock.NewMock()
+			clk.Set(now)
+			objects := &recordingS3{}
+
+			s := Service{Clock: clk, DB: db, S3: objects, Bucket: "audit", Retention: 24 * time.Hour}
+			err := s.Export(context.Background())
+			if tc.wantErr {
+				assert.Error(t, err)
+				assert.Empty(t, objects.inputs)
+				return
+			}
+			assert.NoError(t, err)
+
+			var keys []string
+			for i, in := range objects.inputs {
+				keys = append(keys, aws.ToString(in.Key))
+				assert.Equal(t, s3types.ObjectLockModeCompliance, in.ObjectLockMode)
+				assert.Equal(t, now.Add(24*time.Hour), aws.ToTime(in.ObjectLockRetainUntilDate))
+
+				got, err := audit.ReadJSONL(strings.NewReader(objects.bodies[i]))
+				assert.NoError(t, err)
+				assert.Equal(t, len(tc.giveEntries), len(got))
+			}
+			assert.Equal(t, tc.wantKeys, keys)
+		})
+	}
+}
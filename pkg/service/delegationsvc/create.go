@@ -0,0 +1,54 @@
+package delegationsvc
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+type CreateDelegationOpts struct {
+	User   identity.User
+	Create types.CreateDelegationRequest
+}
+
+// CreateDelegation validates and saves a delegation of the user's approvals to another user.
+// Returns apio.APIError so that validation errors bubble up as a 400 error from the api.
+func (s *Service) CreateDelegation(ctx context.Context, in CreateDelegationOpts) (*identity.Delegation, error) {
+	if in.Create.DelegateId == in.User.ID {
+		return nil, apio.NewRequestError(ErrDelegateToSelf, http.StatusBadRequest)
+	}
+	if !in.Create.EndTime.After(in.Create.StartTime) {
+		return nil, apio.NewRequestError(ErrInvalidTimeWindow, http.StatusBadRequest)
+	}
+	now := s.Clock.Now()
+	if !in.Create.EndTime.After(now) {
+		return nil, apio.NewRequestError(ErrTimeWindowInPast, http.StatusBadRequest)
+	}
+	_, err := s.DB.Query(ctx, &storage.GetUser{ID: in.Create.DelegateId})
+	if err == ddb.ErrNoItems {
+		return nil, apio.NewRequestError(ErrDelegateNotFound, http.StatusBadRequest)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	d := identity.Delegation{
+		ID:         types.NewDelegationID(),
+		UserID:     in.User.ID,
+		DelegateID: in.Create.DelegateId,
+		StartTime:  in.Create.StartTime,
+		EndTime:    in.Create.EndTime,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	err = s.DB.Put(ctx, &d)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
@@ -0,0 +1,106 @@
+package delegationsvc
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateDelegation(t *testing.T) {
+	clk := clock.NewMock()
+	now := clk.Now()
+
+	type testcase struct {
+		name          string
+		give          types.CreateDelegationRequest
+		delegateErr   error
+		wantErr       error
+		wantDelegate  string
+		wantStartTime time.Time
+	}
+
+	testcases := []testcase{
+		{
+			name: "ok",
+			give: types.CreateDelegationRequest{
+				DelegateId: "usr_delegate",
+				StartTime:  now,
+				EndTime:    now.Add(time.Hour),
+			},
+			wantDelegate:  "usr_delegate",
+			wantStartTime: now,
+		},
+		{
+			name: "delegate to self",
+			give: types.CreateDelegationRequest{
+				DelegateId: "usr_approver",
+				StartTime:  now,
+				EndTime:    now.Add(time.Hour),
+			},
+			wantErr: apio.NewRequestError(ErrDelegateToSelf, http.StatusBadRequest),
+		},
+		{
+			name: "end before start",
+			give: types.CreateDelegationRequest{
+				DelegateId: "usr_delegate",
+				StartTime:  now.Add(time.Hour),
+				EndTime:    now,
+			},
+			wantErr: apio.NewRequestError(ErrInvalidTimeWindow, http.StatusBadRequest),
+		},
+		{
+			name: "window in the past",
+			give: types.CreateDelegationRequest{
+				DelegateId: "usr_delegate",
+				StartTime:  now.Add(-2 * time.Hour),
+				EndTime:    now.Add(-time.Hour),
+			},
+			wantErr: apio.NewRequestError(ErrTimeWindowInPast, http.StatusBadRequest),
+		},
+		{
+			name: "delegate not found",
+			give: types.CreateDelegationRequest{
+				DelegateId: "usr_delegate",
+				StartTime:  now,
+				EndTime:    now.Add(time.Hour),
+			},
+			delegateErr: ddb.ErrNoItems,
+			wantErr:     apio.NewRequestError(ErrDelegateNotFound, http.StatusBadRequest),
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQueryWithErr(&storage.GetUser{Result: &identity.User{ID: tc.give.DelegateId}}, tc.delegateErr)
+
+			s := Service{
+				Clock: clk,
+				DB:    db,
+			}
+			got, err := s.CreateDelegation(context.Background(), CreateDelegationOpts{
+				User:   identity.User{ID: "usr_approver"},
+				Create: tc.give,
+			})
+			if tc.wantErr != nil {
+				assert.Equal(t, tc.wantErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "usr_approver", got.UserID)
+			assert.Equal(t, tc.wantDelegate, got.DelegateID)
+			assert.Equal(t, tc.wantStartTime, got.StartTime)
+		})
+	}
+}
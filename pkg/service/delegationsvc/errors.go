@@ -0,0 +1,10 @@
+package delegationsvc
+
+import "errors"
+
+var (
+	ErrDelegateToSelf    = errors.New("you cannot delegate approvals to yourself")
+	ErrDelegateNotFound  = errors.New("delegate user does not exist")
+	ErrInvalidTimeWindow = errors.New("endTime must be after startTime")
+	ErrTimeWindowInPast  = errors.New("endTime must be in the future")
+)
@@ -0,0 +1,12 @@
+package delegationsvc
+
+import (
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/ddb"
+)
+
+// Service holds business logic relating to approval delegations.
+type Service struct {
+	Clock clock.Clock
+	DB    ddb.Storage
+}
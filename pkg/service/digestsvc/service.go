@@ -0,0 +1,293 @@
+package digestsvc
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	ahtypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// DefaultTopRules is the number of rules included in Digest.TopRules if BuildOpts.TopRules is not set.
+const DefaultTopRules = 5
+
+// Service builds digests summarising access request activity over a period.
+type Service struct {
+	Clock clock.Clock
+	DB    ddb.Storage
+}
+
+// RequestSummary is a request included in a digest.
+type RequestSummary struct {
+	Request        access.Request
+	RuleName       string
+	RequestorEmail string
+}
+
+// ApproverDigest holds the pending reviews for an approver.
+type ApproverDigest struct {
+	Approver identity.User
+	Pending  []RequestSummary
+}
+
+// Count is the number of requests made for a rule or by a requester.
+type Count struct {
+	// ID is the ID of the rule or requester.
+	ID string
+	// Name is the name of the rule or the email of the requester.
+	Name  string
+	Count int
+}
+
+// Digest summarises access request activity between Start and End.
+type Digest struct {
+	Start time.Time
+	End   time.Time
+	// PendingReviews contains each approver with requests waiting on their review, sorted by approver email.
+	// Pending reviews are included regardless of when the request was made.
+	PendingReviews []ApproverDigest
+	// FailedGrants are approved requests whose grant failed during the period.
+	FailedGrants []RequestSummary
+	// TotalRequests is the number of requests made during the period.
+	TotalRequests int
+	// TopRules are the most requested rules during the period.
+	TopRules []Count
+	// Requesters are the users who made requests during the period, most requests first.
+	Requesters []Count
+}
+
+// BuildOpts are options for Build.
+type BuildOpts struct {
+	// Period is the length of time covered by the digest, ending now.
+	Period time.Duration
+	// TopRules is the number of rules to include in Digest.TopRules. Defaults to DefaultTopRules.
+	TopRules int
+}
+
+// Build builds a digest of the access requests made over the period.
+func (s *Service) Build(ctx context.Context, opts BuildOpts) (*Digest, error) {
+	end := s.Clock.Now()
+	d := Digest{
+		Start: end.Add(-opts.Period),
+		End:   end,
+	}
+	l := lookup{db: s.DB, rules: map[string]string{}, users: map[string]*identity.User{}}
+
+	pending, err := s.pendingReviews(ctx, &l)
+	if err != nil {
+		return nil, errors.Wrap(err, "listing pending reviews")
+	}
+	d.PendingReviews = pending
+
+	failed, err := s.failedGrants(ctx, &l, d.Start)
+	if err != nil {
+		return nil, errors.Wrap(err, "listing failed grants")
+	}
+	d.FailedGrants = failed
+
+	requests, err := s.requestsSince(ctx, d.Start)
+	if err != nil {
+		return nil, errors.Wrap(err, "listing requests")
+	}
+	d.TotalRequests = len(requests)
+
+	rules := map[string]int{}
+	requesters := map[string]int{}
+	for _, r := range requests {
+		rules[r.Rule]++
+		requesters[r.RequestedBy]++
+	}
+	topRules := opts.TopRules
+	if topRules <= 0 {
+		topRules = DefaultTopRules
+	}
+	d.TopRules = rankCounts(rules, topRules, func(id string) string { return l.ruleName(ctx, id) })
+	d.Requesters = rankCounts(requesters, 0, func(id string) string { return l.userEmail(ctx, id) })
+	return &d, nil
+}
+
+// pendingReviews groups the pending requests by each of their reviewers.
+func (s *Service) pendingReviews(ctx context.Context, l *lookup) ([]ApproverDigest, error) {
+	pending, err := s.listRequestsForStatus(ctx, access.PENDING)
+	if err != nil {
+		return nil, err
+	}
+
+	byApprover := map[string][]RequestSummary{}
+	for _, r := range pending {
+		q := storage.ListRequestReviewers{RequestID: r.ID}
+		_, err := s.DB.Query(ctx, &q)
+		if err == ddb.ErrNoItems {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		summary := l.summarise(ctx, r)
+		for _, reviewer := range q.Result {
+			byApprover[reviewer.ReviewerID] = append(byApprover[reviewer.ReviewerID], summary)
+		}
+	}
+
+	var res []ApproverDigest
+	for approverID, requests := range byApprover {
+		u := l.user(ctx, approverID)
+		if u == nil {
+			continue
+		}
+		res = append(res, ApproverDigest{Approver: *u, Pending: requests})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Approver.Email < res[j].Approver.Email })
+	return res, nil
+}
+
+// failedGrants lists the approved requests whose grant failed after since.
+func (s *Service) failedGrants(ctx context.Context, l *lookup, since time.Time) ([]RequestSummary, error) {
+	approved, err := s.listRequestsForStatus(ctx, access.APPROVED)
+	if err != nil {
+		return nil, err
+	}
+	var res []RequestSummary
+	for _, r := range approved {
+		if r.Grant == nil || r.Grant.Status != ahtypes.GrantStatusERROR || r.Grant.UpdatedAt.Before(since) {
+			continue
+		}
+		res = append(res, l.summarise(ctx, r))
+	}
+	return res, nil
+}
+
+// requestsSince lists the requests created after since.
+// Requests are listed newest to oldest, so listing stops at the first page containing an older request.
+func (s *Service) requestsSince(ctx context.Context, since time.Time) ([]access.Request, error) {
+	var res []access.Request
+	var next string
+	for {
+		q := storage.ListRequests{}
+		var opts []func(*ddb.QueryOpts)
+		if next != "" {
+			opts = append(opts, ddb.Page(next))
+		}
+		qr, err := s.DB.Query(ctx, &q, opts...)
+		if err == ddb.ErrNoItems {
+			return res, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range q.Result {
+			if r.CreatedAt.Before(since) {
+				return res, nil
+			}
+			res = append(res, r)
+		}
+		next = qr.NextPage
+		if next == "" {
+			return res, nil
+		}
+	}
+}
+
+// listRequestsForStatus lists all of the requests with the status.
+func (s *Service) listRequestsForStatus(ctx context.Context, status access.Status) ([]access.Request, error) {
+	var res []access.Request
+	var next string
+	for {
+		q := storage.ListRequestsForStatus{Status: status}
+		var opts []func(*ddb.QueryOpts)
+		if next != "" {
+			opts = append(opts, ddb.Page(next))
+		}
+		qr, err := s.DB.Query(ctx, &q, opts...)
+		if err == ddb.ErrNoItems {
+			return res, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, q.Result...)
+		next = qr.NextPage
+		if next == "" {
+			return res, nil
+		}
+	}
+}
+
+// rankCounts sorts the counts from highest to lowest, limited to max if max is greater than zero.
+func rankCounts(counts map[string]int, max int, name func(id string) string) []Count {
+	var res []Count
+	for id, c := range counts {
+		res = append(res, Count{ID: id, Name: name(id), Count: c})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Count == res[j].Count {
+			return res[i].Name < res[j].Name
+		}
+		return res[i].Count > res[j].Count
+	})
+	if max > 0 && len(res) > max {
+		res = res[:max]
+	}
+	return res
+}
+
+// lookup caches the rules and users referenced by a digest.
+type lookup struct {
+	db    ddb.Storage
+	rules map[string]string
+	users map[string]*identity.User
+}
+
+func (l *lookup) summarise(ctx context.Context, r access.Request) RequestSummary {
+	return RequestSummary{
+		Request:        r,
+		RuleName:       l.ruleName(ctx, r.Rule),
+		RequestorEmail: l.userEmail(ctx, r.RequestedBy),
+	}
+}
+
+// ruleName returns the name of the rule, falling back to the rule ID if the rule can't be found.
+func (l *lookup) ruleName(ctx context.Context, id string) string {
+	if name, ok := l.rules[id]; ok {
+		return name
+	}
+	name := id
+	q := storage.GetAccessRuleCurrent{ID: id}
+	_, err := l.db.Query(ctx, &q)
+	if err == nil {
+		name = q.Result.Name
+	} else {
+		zap.S().Infow("failed to look up rule for digest", "rule.id", id, zap.Error(err))
+	}
+	l.rules[id] = name
+	return name
+}
+
+// user returns the user, or nil if the user can't be found.
+func (l *lookup) user(ctx context.Context, id string) *identity.User {
+	if u, ok := l.users[id]; ok {
+		return u
+	}
+	q := storage.GetUser{ID: id}
+	_, err := l.db.Query(ctx, &q)
+	if err != nil {
+		zap.S().Infow("failed to look up user for digest", "user.id", id, zap.Error(err))
+	}
+	l.users[id] = q.Result
+	return q.Result
+}
+
+// userEmail returns the email of the user, falling back to the user ID if the user can't be found.
+func (l *lookup) userEmail(ctx context.Context, id string) string {
+	if u := l.user(ctx, id); u != nil {
+		return u.Email
+	}
+	return id
+}
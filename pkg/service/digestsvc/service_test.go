@@ -0,0 +1,98 @@
+package digestsvc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	ahtypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// statusDB returns the requests for each status from ListRequestsForStatus,
+// as ddbmock returns the same result for every query of a type.
+type statusDB struct {
+	ddb.Storage
+	byStatus map[access.Status][]access.Request
+}
+
+func (s statusDB) Query(ctx context.Context, qb ddb.QueryBuilder, opts ...func(*ddb.QueryOpts)) (*ddb.QueryResult, error) {
+	if q, ok := qb.(*storage.ListRequestsForStatus); ok {
+		q.Result = s.byStatus[q.Status]
+		if len(q.Result) == 0 {
+			return nil, ddb.ErrNoItems
+		}
+		return &ddb.QueryResult{}, nil
+	}
+	return s.Storage.Query(ctx, qb, opts...)
+}
+
+func TestBuild(t *testing.T) {
+	clk := clock.NewMock()
+	now := clk.Now()
+
+	pending := access.Request{ID: "req_pending", Rule: "rul_1", RequestedBy: "usr_1", Status: access.PENDING, CreatedAt: now.Add(-time.Hour)}
+	failed := access.Request{
+		ID: "req_failed", Rule: "rul_1", RequestedBy: "usr_1", Status: access.APPROVED, CreatedAt: now.Add(-2 * time.Hour),
+		Grant: &access.Grant{Status: ahtypes.GrantStatusERROR, UpdatedAt: now.Add(-time.Hour)},
+	}
+	// the grant failed before the period, so it isn't included
+	oldFailure := access.Request{
+		ID: "req_old", Rule: "rul_1", RequestedBy: "usr_1", Status: access.APPROVED, CreatedAt: now.Add(-72 * time.Hour),
+		Grant: &access.Grant{Status: ahtypes.GrantStatusERROR, UpdatedAt: now.Add(-48 * time.Hour)},
+	}
+	active := access.Request{
+		ID: "req_active", Rule: "rul_2", RequestedBy: "usr_2", Status: access.APPROVED, CreatedAt: now.Add(-3 * time.Hour),
+		Grant: &access.Grant{Status: ahtypes.GrantStatusACTIVE, UpdatedAt: now.Add(-3 * time.Hour)},
+	}
+
+	mock := ddbmock.New(t)
+	// requests are listed newest first, and listing stops at the first request made before the period
+	mock.MockQuery(&storage.ListRequests{Result: []access.Request{pending, failed, active, oldFailure}})
+	mock.MockQuery(&storage.ListRequestReviewers{Result: []access.Reviewer{{ReviewerID: "usr_approver", Request: pending}}})
+	mock.MockQuery(&storage.GetUser{Result: &identity.User{ID: "usr_approver", Email: "approver@example.com"}})
+	mock.MockQuery(&storage.GetAccessRuleCurrent{Result: &rule.AccessRule{ID: "rul_1", Name: "prod"}})
+
+	db := statusDB{
+		Storage: mock,
+		byStatus: map[access.Status][]access.Request{
+			access.PENDING:  {pending},
+			access.APPROVED: {failed, active, oldFailure},
+		},
+	}
+	s := Service{Clock: clk, DB: db}
+
+	got, err := s.Build(context.Background(), BuildOpts{Period: 24 * time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, now.Add(-24*time.Hour), got.Start)
+	assert.Equal(t, now, got.End)
+	assert.Equal(t, 3, got.TotalRequests)
+
+	assert.Len(t, got.PendingReviews, 1)
+	assert.Equal(t, "approver@example.com", got.PendingReviews[0].Approver.Email)
+	assert.Equal(t, []RequestSummary{{Request: pending, RuleName: "prod", RequestorEmail: "approver@example.com"}}, got.PendingReviews[0].Pending)
+
+	assert.Len(t, got.FailedGrants, 1)
+	assert.Equal(t, "req_failed", got.FailedGrants[0].Request.ID)
+
+	assert.Equal(t, []Count{{ID: "rul_1", Name: "prod", Count: 2}, {ID: "rul_2", Name: "prod", Count: 1}}, got.TopRules)
+	assert.Equal(t, []Count{{ID: "usr_1", Name: "approver@example.com", Count: 2}, {ID: "usr_2", Name: "approver@example.com", Count: 1}}, got.Requesters)
+}
+
+func TestRankCounts(t *testing.T) {
+	counts := map[string]int{"a": 1, "b": 3, "c": 3, "d": 2}
+	name := func(id string) string { return id }
+
+	assert.Equal(t, []Count{{ID: "b", Name: "b", Count: 3}, {ID: "c", Name: "c", Count: 3}}, rankCounts(counts, 2, name))
+	assert.Len(t, rankCounts(counts, 0, name), 4)
+}
@@ -0,0 +1,153 @@
+package escalationsvc
+
+import (
+	"context"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/schedule"
+	"github.com/common-fate/common-fate/pkg/service/rulesvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
+	"github.com/common-fate/ddb"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// Service escalates pending Access Requests which have not been reviewed in time,
+// according to the escalation settings of their Access Rule.
+type Service struct {
+	Clock clock.Clock
+	DB    ddb.Storage
+}
+
+// Run checks all pending requests and escalates or auto-declines them if required.
+// Escalation depends on when a request was created rather than when it ends, so every pending request is read.
+// A request which can't be escalated is still pending, so it is escalated on the next run.
+func (s *Service) Run(ctx context.Context) error {
+	q := storage.ListRequestsForStatus{Status: access.PENDING}
+	return storage.ForEachPage(ctx, s.DB, &q, func() error {
+		for _, req := range q.Result {
+			err := s.escalateRequest(ctx, req)
+			if err != nil {
+				zap.S().Errorw("failed to escalate request", "request.id", req.ID, zap.Error(err))
+			}
+		}
+		return nil
+	})
+}
+
+// RunEvery escalates requests on the given interval until the context is cancelled.
+// The deployed stack runs the escalation Lambda every minute instead.
+func (s *Service) RunEvery(ctx context.Context, interval time.Duration) {
+	schedule.RunEvery(ctx, s.Clock, interval, "failed to run escalations", s.Run)
+}
+
+func (s *Service) escalateRequest(ctx context.Context, req access.Request) error {
+	q := storage.GetAccessRuleVersion{ID: req.Rule, VersionID: req.RuleVersion}
+	_, err := s.DB.Query(ctx, &q)
+	if err != nil {
+		return errors.Wrap(err, "getting access rule version")
+	}
+	escalation := q.Result.Approval.Escalation
+	if escalation == nil {
+		return nil
+	}
+
+	now := s.Clock.Now()
+	if declineAt, ok := escalation.AutoDeclineAt(req.CreatedAt); ok && !now.Before(declineAt) {
+		return s.autoDecline(ctx, req)
+	}
+	if req.EscalatedAt == nil && !now.Before(escalation.EscalateAt(req.CreatedAt)) {
+		return s.addReviewers(ctx, req, *q.Result)
+	}
+	return nil
+}
+
+// addReviewers adds the escalation users and groups of the rule as reviewers for the request.
+func (s *Service) addReviewers(ctx context.Context, req access.Request, accessRule rule.AccessRule) error {
+	users, err := rulesvc.GetEscalationReviewers(ctx, s.DB, *accessRule.Approval.Escalation)
+	if err != nil {
+		return errors.Wrap(err, "getting escalation reviewers")
+	}
+
+	rq := storage.ListRequestReviewers{RequestID: req.ID}
+	_, err = s.DB.Query(ctx, &rq)
+	if err != nil && err != ddb.ErrNoItems {
+		return errors.Wrap(err, "listing request reviewers")
+	}
+	existing := make(map[string]bool)
+	for _, r := range rq.Result {
+		existing[r.ReviewerID] = true
+	}
+
+	// escalation reviewers may approve any step of a multi-step rule.
+	var steps []int
+	if len(accessRule.Approval.Steps) > 0 {
+		for i := range accessRule.Approval.Steps {
+			steps = append(steps, i)
+		}
+	}
+
+	now := s.Clock.Now()
+	req.EscalatedAt = &now
+	req.UpdatedAt = now
+
+	reviewers := rq.Result
+	added := []string{}
+	for _, u := range users {
+		// users cannot approve their own requests.
+		if u == req.RequestedBy || existing[u] {
+			continue
+		}
+		reviewers = append(reviewers, access.Reviewer{
+			ReviewerID: u,
+			Request:    req,
+			Steps:      steps,
+		})
+		added = append(added, u)
+	}
+
+	evt := gevent.RequestEscalated{Request: req, Action: access.ESCALATED, AddedReviewerIDs: added}
+	items, err := dbupdate.GetUpdateRequestItems(ctx, s.DB, req, dbupdate.WithReviewers(reviewers), dbupdate.WithEvents(evt))
+	if err != nil {
+		return err
+	}
+	escalationEvent := access.NewEscalationEvent(req.ID, now, access.RequestEscalation{Action: access.ESCALATED, AddedReviewers: added})
+	items = append(items, &escalationEvent)
+
+	return s.putIfPending(ctx, req, items...)
+}
+
+// autoDecline declines a request which has not been reviewed before the escalation deadline.
+func (s *Service) autoDecline(ctx context.Context, req access.Request) error {
+	now := s.Clock.Now()
+	req.Status = access.DECLINED
+	req.UpdatedAt = now
+
+	evt := gevent.RequestEscalated{Request: req, Action: access.AUTO_DECLINED, AddedReviewerIDs: []string{}}
+	items, err := dbupdate.GetUpdateRequestItems(ctx, s.DB, req, dbupdate.WithEvents(evt))
+	if err != nil {
+		return err
+	}
+	statusEvent := access.NewStatusChangeEvent(req.ID, now, nil, access.PENDING, access.DECLINED)
+	escalationEvent := access.NewEscalationEvent(req.ID, now, access.RequestEscalation{Action: access.AUTO_DECLINED, AddedReviewers: []string{}})
+	items = append(items, &statusEvent, &escalationEvent)
+
+	return s.putIfPending(ctx, req, items...)
+}
+
+// putIfPending saves the escalated request only if it is still pending.
+// The request was read from the list of pending requests, so it may have been reviewed since.
+// A reviewed request is skipped rather than overwritten, so that an approved request isn't declined while its grant is active.
+func (s *Service) putIfPending(ctx context.Context, req access.Request, items ...ddb.Keyer) error {
+	err := dbupdate.PutItemsIf(ctx, s.DB, dbupdate.RequestStatusIs(access.PENDING), items...)
+	if err == dbupdate.ErrConditionFailed {
+		zap.S().Infow("skipping escalation for request which has already been reviewed", "request.id", req.ID)
+		return nil
+	}
+	return err
+}
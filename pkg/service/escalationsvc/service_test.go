@@ -0,0 +1,174 @@
+package escalationsvc
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	type testcase struct {
+		name           string
+		giveEscalation *rule.Escalation
+		giveEscalated  bool
+		// pendingFor is how long the request has been pending for
+		pendingFor time.Duration
+		// wantEvent is nil if no event should be emitted
+		wantEvent *gevent.RequestEscalated
+	}
+	autoDecline := 120
+
+	testcases := []testcase{
+		{
+			name:       "no escalation configured",
+			pendingFor: 24 * time.Hour,
+		},
+		{
+			name:           "not yet due",
+			giveEscalation: &rule.Escalation{AfterMinutes: 30, Users: []string{"usr_escalation"}},
+			pendingFor:     10 * time.Minute,
+		},
+		{
+			name:           "escalated",
+			giveEscalation: &rule.Escalation{AfterMinutes: 30, Users: []string{"usr_escalation", "usr_reviewer", "usr_requestor"}, Groups: []string{"grp_escalation"}},
+			pendingFor:     45 * time.Minute,
+			wantEvent:      &gevent.RequestEscalated{Action: access.ESCALATED, AddedReviewerIDs: []string{"usr_escalation", "usr_group_member"}},
+		},
+		{
+			name:           "already escalated",
+			giveEscalation: &rule.Escalation{AfterMinutes: 30, Users: []string{"usr_escalation"}, AutoDeclineAfterMinutes: &autoDecline},
+			giveEscalated:  true,
+			pendingFor:     45 * time.Minute,
+		},
+		{
+			name:           "auto declined",
+			giveEscalation: &rule.Escalation{AfterMinutes: 30, Users: []string{"usr_escalation"}, AutoDeclineAfterMinutes: &autoDecline},
+			giveEscalated:  true,
+			pendingFor:     3 * time.Hour,
+			wantEvent:      &gevent.RequestEscalated{Action: access.AUTO_DECLINED, AddedReviewerIDs: []string{}},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			clk := clock.NewMock()
+			now := clk.Now()
+			req := access.Request{
+				ID:          "req_1",
+				RequestedBy: "usr_requestor",
+				Rule:        "rul_1",
+				RuleVersion: "1",
+				Status:      access.PENDING,
+				CreatedAt:   now.Add(-tc.pendingFor),
+			}
+			if tc.giveEscalated {
+				escalatedAt := req.CreatedAt.Add(30 * time.Minute)
+				req.EscalatedAt = &escalatedAt
+			}
+
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.ListRequestsForStatus{Result: []access.Request{req}})
+			db.MockQuery(&storage.GetAccessRuleVersion{Result: &rule.AccessRule{
+				ID:       "rul_1",
+				Version:  "1",
+				Approval: rule.Approval{Users: []string{"usr_reviewer"}, Escalation: tc.giveEscalation},
+			}})
+			db.MockQuery(&storage.ListRequestReviewers{Result: []access.Reviewer{{ReviewerID: "usr_reviewer", Request: req}}})
+			db.MockQuery(&storage.GetGroup{Result: &identity.Group{ID: "grp_escalation", Users: []string{"usr_group_member"}}})
+
+			outbox := &outboxDB{Storage: db}
+			s := Service{Clock: clk, DB: outbox}
+			err := s.Run(context.Background())
+			assert.NoError(t, err)
+
+			if tc.wantEvent == nil {
+				assert.Empty(t, outbox.events)
+				return
+			}
+			// the event is written to the outbox in the same transaction as the updated request.
+			if !assert.Len(t, outbox.events, 1) {
+				return
+			}
+			var got gevent.RequestEscalated
+			err = json.Unmarshal(outbox.events[0].Detail, &got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, gevent.RequestEscalatedType, outbox.events[0].Type)
+			assert.Equal(t, now, outbox.events[0].Time)
+			assert.Equal(t, []dbupdate.Condition{dbupdate.RequestStatusIs(access.PENDING)}, outbox.conditions)
+			assert.Equal(t, tc.wantEvent.Action, got.Action)
+			assert.Equal(t, tc.wantEvent.AddedReviewerIDs, got.AddedReviewerIDs)
+			if got.Action == access.AUTO_DECLINED {
+				assert.Equal(t, access.DECLINED, got.Request.Status)
+			} else {
+				assert.True(t, now.Equal(*got.Request.EscalatedAt))
+			}
+		})
+	}
+}
+
+// outboxDB records the events which are written to the outbox.
+// If reviewed is true, the request has been reviewed since it was listed, so conditional writes fail.
+type outboxDB struct {
+	ddb.Storage
+	reviewed   bool
+	conditions []dbupdate.Condition
+	events     []gevent.Event
+}
+
+func (o *outboxDB) TransactWriteItemsIf(ctx context.Context, cond dbupdate.Condition, tx []ddb.TransactWriteItem) error {
+	o.conditions = append(o.conditions, cond)
+	if o.reviewed {
+		return dbupdate.ErrConditionFailed
+	}
+	for _, item := range tx {
+		if e, ok := item.Put.(*gevent.OutboxEvent); ok {
+			o.events = append(o.events, e.Event)
+		}
+	}
+	return nil
+}
+
+func TestRunSkipsReviewedRequests(t *testing.T) {
+	clk := clock.NewMock()
+	now := clk.Now()
+	autoDecline := 120
+	req := access.Request{
+		ID:          "req_1",
+		RequestedBy: "usr_requestor",
+		Rule:        "rul_1",
+		RuleVersion: "1",
+		Status:      access.PENDING,
+		CreatedAt:   now.Add(-3 * time.Hour),
+	}
+
+	db := ddbmock.New(t)
+	db.MockQuery(&storage.ListRequestsForStatus{Result: []access.Request{req}})
+	db.MockQuery(&storage.GetAccessRuleVersion{Result: &rule.AccessRule{
+		ID:       "rul_1",
+		Version:  "1",
+		Approval: rule.Approval{Users: []string{"usr_reviewer"}, Escalation: &rule.Escalation{AfterMinutes: 30, Users: []string{"usr_escalation"}, AutoDeclineAfterMinutes: &autoDecline}},
+	}})
+	db.MockQuery(&storage.ListRequestReviewers{})
+
+	// the request is approved after it was listed, so it must not be auto-declined.
+	outbox := &outboxDB{Storage: db, reviewed: true}
+	s := Service{Clock: clk, DB: outbox}
+	err := s.Run(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, outbox.conditions, 1)
+	assert.Empty(t, outbox.events)
+}
@@ -3,8 +3,10 @@ package handlersvc
 import (
 	"context"
 
+	"github.com/common-fate/common-fate/pkg/configchange"
 	"github.com/common-fate/common-fate/pkg/handler"
 	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
 
 	"github.com/common-fate/common-fate/pkg/types"
 	"github.com/common-fate/ddb"
@@ -39,7 +41,16 @@ func (s *Service) RegisterHandler(ctx context.Context, req types.RegisterHandler
 		},
 	}
 
-	err = s.DB.Put(ctx, &dbInput)
+	change, err := configchange.New(ctx, s.Clock.Now(), configchange.Handler, dbInput.ID, configchange.CREATED, nil, dbInput)
+	if err != nil {
+		return nil, err
+	}
+	changeItems, err := dbupdate.ConfigChangeItems(change)
+	if err != nil {
+		return nil, err
+	}
+
+	err = dbupdate.PutItems(ctx, s.DB, append([]ddb.Keyer{&dbInput}, changeItems...)...)
 	if err != nil {
 		return nil, err
 	}
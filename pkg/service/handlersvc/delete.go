@@ -3,8 +3,10 @@ package handlersvc
 import (
 	"context"
 
+	"github.com/common-fate/common-fate/pkg/configchange"
 	"github.com/common-fate/common-fate/pkg/handler"
 	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
 	"github.com/common-fate/ddb"
 )
 
@@ -22,5 +24,15 @@ func (s *Service) DeleteHandler(ctx context.Context, handler *handler.Handler) e
 		items = append(items, &q.Result[i])
 	}
 
-	return s.DB.DeleteBatch(ctx, items...)
+	// the routes are deleted along with the handler, so a single change is recorded for the handler.
+	change, err := configchange.New(ctx, s.Clock.Now(), configchange.Handler, handler.ID, configchange.DELETED, handler, nil)
+	if err != nil {
+		return err
+	}
+	changeItems, err := dbupdate.ConfigChangeItems(change)
+	if err != nil {
+		return err
+	}
+
+	return dbupdate.DeleteItems(ctx, s.DB, items, changeItems...)
 }
@@ -3,8 +3,10 @@ package internalidentitysvc
 import (
 	"context"
 
+	"github.com/common-fate/common-fate/pkg/configchange"
 	"github.com/common-fate/common-fate/pkg/identity"
 	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
 	"github.com/common-fate/common-fate/pkg/types"
 	"github.com/common-fate/ddb"
 )
@@ -53,7 +55,11 @@ func (s *Service) CreateGroup(ctx context.Context, in types.CreateGroupRequest)
 			itemsToUpdate = append(itemsToUpdate, &user)
 		}
 	}
-	err := s.DB.PutBatch(ctx, itemsToUpdate...)
+	changeItems, err := s.changeItems(ctx, group.ID, configchange.CREATED, nil, group)
+	if err != nil {
+		return nil, err
+	}
+	err = dbupdate.PutItems(ctx, s.DB, append(itemsToUpdate, changeItems...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -90,7 +96,9 @@ func (s *Service) UpdateGroup(ctx context.Context, group identity.Group, in type
 		}
 	}
 
-	var itemsToUpdate []ddb.Keyer
+	before := group
+	// the group is written in the same transaction as the record of the change.
+	itemsToUpdate := []ddb.Keyer{&group}
 
 	for _, u := range group.Users {
 		if !contains(in.Members, u) {
@@ -116,8 +124,11 @@ func (s *Service) UpdateGroup(ctx context.Context, group identity.Group, in type
 	group.Name = in.Name
 	group.Users = in.Members
 
-	itemsToUpdate = append(itemsToUpdate, &group)
-	err := s.DB.PutBatch(ctx, itemsToUpdate...)
+	changeItems, err := s.changeItems(ctx, group.ID, configchange.UPDATED, before, group)
+	if err != nil {
+		return nil, err
+	}
+	err = dbupdate.PutItems(ctx, s.DB, append(itemsToUpdate, changeItems...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -147,7 +158,9 @@ func (s *Service) DeleteGroup(ctx context.Context, group identity.Group) error {
 		}
 	}
 
-	var itemsToUpdate []ddb.Keyer
+	before := group
+	// the group is written in the same transaction as the record of the change.
+	itemsToUpdate := []ddb.Keyer{&group}
 
 	// remove group from users
 	for _, u := range group.Users {
@@ -160,6 +173,18 @@ func (s *Service) DeleteGroup(ctx context.Context, group identity.Group) error {
 	group.Users = []string{}
 	group.Status = types.IdpStatusARCHIVED
 
-	itemsToUpdate = append(itemsToUpdate, &group)
-	return s.DB.PutBatch(ctx, itemsToUpdate...)
+	changeItems, err := s.changeItems(ctx, group.ID, configchange.ARCHIVED, before, group)
+	if err != nil {
+		return err
+	}
+	return dbupdate.PutItems(ctx, s.DB, append(itemsToUpdate, changeItems...)...)
+}
+
+// changeItems returns the record of a change to a group, to be written with the group.
+func (s *Service) changeItems(ctx context.Context, groupID string, action configchange.Action, before, after interface{}) ([]ddb.Keyer, error) {
+	change, err := configchange.New(ctx, s.Clock.Now(), configchange.Group, groupID, action, before, after)
+	if err != nil {
+		return nil, err
+	}
+	return dbupdate.ConfigChangeItems(change)
 }
@@ -3,8 +3,10 @@ package internalidentitysvc
 import (
 	"context"
 
+	"github.com/common-fate/common-fate/pkg/configchange"
 	"github.com/common-fate/common-fate/pkg/identity"
 	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
 	"github.com/common-fate/common-fate/pkg/types"
 	"github.com/common-fate/ddb"
 )
@@ -30,8 +32,9 @@ func (s *Service) UpdateUserGroups(ctx context.Context, user identity.User, grou
 		}
 	}
 
-	// Append the user to all the groups that the
-	var itemsToUpdate []ddb.Keyer
+	before := user
+	// the user is written in the same transaction as the record of the change.
+	itemsToUpdate := []ddb.Keyer{&user}
 	now := s.Clock.Now()
 	// add user to all these groups
 	for _, g := range groups {
@@ -70,8 +73,16 @@ func (s *Service) UpdateUserGroups(ctx context.Context, user identity.User, grou
 	}
 	user.Groups = updatedUserGroups
 	user.UpdatedAt = s.Clock.Now()
-	itemsToUpdate = append(itemsToUpdate, &user)
-	err := s.DB.PutBatch(ctx, itemsToUpdate...)
+
+	change, err := configchange.New(ctx, now, configchange.User, user.ID, configchange.UPDATED, before, user)
+	if err != nil {
+		return nil, err
+	}
+	changeItems, err := dbupdate.ConfigChangeItems(change)
+	if err != nil {
+		return nil, err
+	}
+	err = dbupdate.PutItems(ctx, s.DB, append(itemsToUpdate, changeItems...)...)
 	if err != nil {
 		return nil, err
 	}
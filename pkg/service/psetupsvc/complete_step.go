@@ -7,9 +7,11 @@ import (
 	"strings"
 
 	"github.com/common-fate/common-fate/accesshandler/pkg/providerregistry"
+	"github.com/common-fate/common-fate/pkg/configchange"
 	"github.com/common-fate/common-fate/pkg/gconfig"
 	"github.com/common-fate/common-fate/pkg/providersetup"
 	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
 	"github.com/common-fate/common-fate/pkg/types"
 	"github.com/common-fate/ddb"
 )
@@ -35,18 +37,29 @@ func (s *Service) CompleteStep(ctx context.Context, setupID string, stepIndex in
 	if err == ddb.ErrNoItems {
 		return nil, ErrProviderSetupNotFound
 	}
+	if err != nil {
+		return nil, err
+	}
 	setup := q.Result
 
 	if stepIndex >= len(setup.Steps) {
 		return nil, ErrInvalidStepIndex
 	}
 
+	// copy the setup before it is changed, so that the change can be recorded.
+	before := *setup
+	before.Steps = append([]providersetup.StepOverview{}, setup.Steps...)
+	before.ConfigValues = make(map[string]string, len(setup.ConfigValues))
+	for k, v := range setup.ConfigValues {
+		before.ConfigValues[k] = v
+	}
+
 	setup.Steps[stepIndex].Complete = body.Complete
 
 	if !body.Complete {
 		// if the step is marked incomplete, don't update any values.
 		// just mark the step as incomplete and then return.
-		err = s.DB.Put(ctx, setup)
+		err = s.save(ctx, before, setup)
 		if err != nil {
 			return nil, err
 		}
@@ -110,10 +123,23 @@ func (s *Service) CompleteStep(ctx context.Context, setupID string, stepIndex in
 		setup.ConfigValues[k] = v
 	}
 
-	err = s.DB.Put(ctx, setup)
+	err = s.save(ctx, before, setup)
 	if err != nil {
 		return nil, err
 	}
 	return setup, nil
 
 }
+
+// save writes the updated setup along with the record of the change.
+func (s *Service) save(ctx context.Context, before providersetup.Setup, setup *providersetup.Setup) error {
+	change, err := configchange.New(ctx, s.Clock.Now(), configchange.ProviderSetup, setup.ID, configchange.UPDATED, before, setup)
+	if err != nil {
+		return err
+	}
+	changeItems, err := dbupdate.ConfigChangeItems(change)
+	if err != nil {
+		return err
+	}
+	return dbupdate.PutItems(ctx, s.DB, append([]ddb.Keyer{setup}, changeItems...)...)
+}
@@ -4,18 +4,23 @@ import (
 	"context"
 	"errors"
 
+	"github.com/benbjohnson/clock"
+
 	"github.com/common-fate/common-fate/accesshandler/pkg/providerregistry"
 	"github.com/common-fate/common-fate/accesshandler/pkg/providers"
 	"github.com/common-fate/common-fate/accesshandler/pkg/psetup"
+	"github.com/common-fate/common-fate/pkg/configchange"
 	"github.com/common-fate/common-fate/pkg/deploy"
 	"github.com/common-fate/common-fate/pkg/gconfig"
 	"github.com/common-fate/common-fate/pkg/providersetup"
 	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
 	"github.com/common-fate/common-fate/pkg/types"
 	"github.com/common-fate/ddb"
 )
 
 type Service struct {
+	Clock            clock.Clock
 	DB               ddb.Storage
 	DeploymentSuffix string
 	TemplateData     psetup.TemplateData
@@ -120,8 +125,18 @@ func (s *Service) Create(ctx context.Context, providerType string, existingProvi
 		})
 	}
 
+	change, err := configchange.New(ctx, s.Clock.Now(), configchange.ProviderSetup, ps.ID, configchange.CREATED, nil, ps)
+	if err != nil {
+		return nil, err
+	}
+	changeItems, err := dbupdate.ConfigChangeItems(change)
+	if err != nil {
+		return nil, err
+	}
+	items = append(items, changeItems...)
+
 	// save the provider setup
-	err = s.DB.PutBatch(ctx, items...)
+	err = dbupdate.PutItems(ctx, s.DB, items...)
 	if err != nil {
 		return nil, err
 	}
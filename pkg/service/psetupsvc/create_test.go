@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/benbjohnson/clock"
 	"github.com/common-fate/common-fate/accesshandler/pkg/providerregistry"
 	"github.com/common-fate/common-fate/accesshandler/pkg/psetup"
 	"github.com/common-fate/common-fate/pkg/deploy"
@@ -114,6 +115,7 @@ func TestCreate(t *testing.T) {
 			db.MockQuery(&storage.ListProviderSetupsForType{Result: tc.existingProviderSetups})
 
 			s := Service{
+				Clock:        clock.NewMock(),
 				DB:           db,
 				TemplateData: tc.templateData,
 			}
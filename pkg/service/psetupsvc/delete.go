@@ -0,0 +1,81 @@
+package psetupsvc
+
+import (
+	"context"
+
+	"github.com/common-fate/common-fate/pkg/configchange"
+	"github.com/common-fate/common-fate/pkg/providersetup"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
+	"github.com/common-fate/ddb"
+	"golang.org/x/sync/errgroup"
+)
+
+// Delete removes an in-progress provider setup and its steps.
+func (s *Service) Delete(ctx context.Context, setupID string) (*providersetup.Setup, error) {
+	g, gctx := errgroup.WithContext(ctx)
+
+	var setup providersetup.Setup
+	g.Go(func() error {
+		q := storage.GetProviderSetup{
+			ID: setupID,
+		}
+
+		_, err := s.DB.Query(gctx, &q)
+		if err == ddb.ErrNoItems {
+			return ErrProviderSetupNotFound
+		}
+		if err != nil {
+			return err
+		}
+		setup = *q.Result
+		return nil
+	})
+
+	var steps []providersetup.Step
+	g.Go(func() error {
+		q := storage.ListProviderSetupSteps{
+			SetupID: setupID,
+		}
+
+		_, err := s.DB.Query(gctx, &q)
+		if err != nil {
+			return err
+		}
+		steps = q.Result
+		return nil
+	})
+	err := g.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	items := []ddb.Keyer{&setup}
+	for i := range steps {
+		items = append(items, &steps[i])
+	}
+
+	err = s.remove(ctx, configchange.DELETED, setup, items)
+	if err != nil {
+		return nil, err
+	}
+	return &setup, nil
+}
+
+// Complete removes a provider setup once the provider has been added to the deployment configuration.
+func (s *Service) Complete(ctx context.Context, setup providersetup.Setup) error {
+	return s.remove(ctx, configchange.COMPLETED, setup, []ddb.Keyer{&setup})
+}
+
+// remove deletes the items along with recording the change to the setup.
+func (s *Service) remove(ctx context.Context, action configchange.Action, setup providersetup.Setup, items []ddb.Keyer) error {
+	change, err := configchange.New(ctx, s.Clock.Now(), configchange.ProviderSetup, setup.ID, action, setup, nil)
+	if err != nil {
+		return err
+	}
+	changeItems, err := dbupdate.ConfigChangeItems(change)
+	if err != nil {
+		return err
+	}
+	return dbupdate.DeleteItems(ctx, s.DB, items, changeItems...)
+}
@@ -0,0 +1,208 @@
+package reconcilesvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	ahtypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/schedule"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
+	"github.com/common-fate/ddb"
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultSettle is how long after a grant changes status before it is verified,
+	// as providers may take some time to apply a change.
+	defaultSettle = 5 * time.Minute
+	// defaultLookback is how long after a grant ends that it is verified for orphaned access.
+	defaultLookback = 24 * time.Hour
+)
+
+// Service compares the access in each provider with the status of the grants made by Common Fate.
+// Drift is recorded on the request and a grant drifted event is written to the outbox so that administrators are notified.
+//
+// Only providers which implement the Verifier interface in the Access Handler can be reconciled,
+// grants for other providers are skipped.
+type Service struct {
+	Clock    clock.Clock
+	DB       ddb.Storage
+	AHClient ahtypes.ClientWithResponsesInterface
+	// AutoRevoke removes access which remains in the provider after the grant has ended.
+	// Access which another active grant provides is never revoked.
+	AutoRevoke bool
+	// Settle defaults to 5 minutes if not set.
+	Settle time.Duration
+	// Lookback defaults to 24 hours if not set.
+	Lookback time.Duration
+}
+
+// errNotSupported is returned by verify if the provider can't verify grants.
+var errNotSupported = errors.New("provider does not support verifying grants")
+
+// Run verifies the grants which are active or ended within the lookback period against the provider.
+// A grant which can't be verified, such as when the provider is unavailable, is skipped until the next run.
+func (s *Service) Run(ctx context.Context) error {
+	log := zap.S()
+	reqs, err := s.recentRequests(ctx)
+	if err != nil {
+		return err
+	}
+
+	// access which remains after a grant has ended isn't orphaned if another active grant provides it,
+	// such as a grant from another rule for the same group or the next occurrence of a recurring request.
+	active := make(map[string]bool)
+	for _, req := range reqs {
+		if req.Grant != nil && req.Grant.Status == ahtypes.GrantStatusACTIVE {
+			active[req.Grant.AccessKey()] = true
+		}
+	}
+
+	for _, req := range reqs {
+		err = s.reconcile(ctx, req, active)
+		if err != nil {
+			log.Errorw("failed to reconcile grant", "request.id", req.ID, zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// recentRequests lists the approved requests whose grants end after the start of the lookback period.
+// Grants which ended before then aren't verified, so they don't need to be read.
+func (s *Service) recentRequests(ctx context.Context) ([]access.Request, error) {
+	var reqs []access.Request
+	q := storage.ListApprovedRequestsEndingAfter{EndingAfter: s.Clock.Now().Add(-s.lookback())}
+	err := storage.ForEachPage(ctx, s.DB, &q, func() error {
+		reqs = append(reqs, q.Result...)
+		return nil
+	})
+	return reqs, err
+}
+
+// RunEvery reconciles grants on the given interval until the context is cancelled.
+// The deployed stack runs the reconciler Lambda on a schedule instead.
+func (s *Service) RunEvery(ctx context.Context, interval time.Duration) {
+	schedule.RunEvery(ctx, s.Clock, interval, "failed to reconcile grants", s.Run)
+}
+
+// reconcile verifies the grant of a request and records any drift.
+// Drift is only reported once, when it is first detected or when it changes kind.
+// active contains the access keys of the active grants, which are never reported as orphaned.
+func (s *Service) reconcile(ctx context.Context, req access.Request, active map[string]bool) error {
+	now := s.Clock.Now()
+	settle := s.Settle
+	if settle == 0 {
+		settle = defaultSettle
+	}
+	shouldBeActive, check := req.GrantShouldBeActive(now, settle, s.lookback())
+	if !check {
+		return nil
+	}
+	if !shouldBeActive && active[req.Grant.AccessKey()] {
+		zap.S().Debugw("skipping ended grant as another active grant provides the same access", "request.id", req.ID)
+		return nil
+	}
+	isActive, err := s.verify(ctx, req)
+	if err == errNotSupported {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	kind, drifted := access.DetectDrift(shouldBeActive, isActive)
+	if !drifted {
+		if req.GrantDrift == nil {
+			return nil
+		}
+		zap.S().Infow("grant is back in sync with the provider", "request.id", req.ID)
+		req.GrantDrift = nil
+		return s.save(ctx, req, now)
+	}
+	if req.GrantDrift != nil && req.GrantDrift.Kind == kind {
+		return nil
+	}
+
+	drift := access.GrantDrift{Kind: kind, DetectedAt: now}
+	if kind == access.ORPHANED_ACCESS && s.AutoRevoke {
+		err = s.deprovision(ctx, req)
+		if err != nil {
+			return err
+		}
+		drift.AutoRevoked = true
+	}
+	zap.S().Infow("detected grant drift", "request.id", req.ID, "drift", drift)
+	req.GrantDrift = &drift
+	return s.save(ctx, req, now, gevent.GrantDrifted{
+		Grant:       req.Grant.ToAHGrant(req.ID),
+		Drift:       string(kind),
+		AutoRevoked: drift.AutoRevoked,
+	})
+}
+
+func grantAccess(req access.Request) ahtypes.GrantAccess {
+	return ahtypes.GrantAccess{
+		Id:       req.ID,
+		Provider: req.Grant.Provider,
+		Subject:  openapi_types.Email(req.Grant.Subject),
+		With: ahtypes.GrantAccess_With{
+			AdditionalProperties: req.Grant.With.AdditionalProperties,
+		},
+	}
+}
+
+// verify asks the Access Handler whether the access for the grant is in effect in the provider.
+// Grants for target groups aren't managed by the Access Handler, so they are reported as not supported.
+func (s *Service) verify(ctx context.Context, req access.Request) (bool, error) {
+	res, err := s.AHClient.VerifyGrantWithResponse(ctx, grantAccess(req))
+	if err != nil {
+		return false, err
+	}
+	switch res.StatusCode() {
+	case http.StatusOK:
+		if !res.JSON200.Supported {
+			return false, errNotSupported
+		}
+		return res.JSON200.Active, nil
+	case http.StatusBadRequest:
+		return false, errNotSupported
+	}
+	return false, fmt.Errorf("unhandled response code from access handler when verifying grant: %d %s", res.StatusCode(), string(res.Body))
+}
+
+// deprovision removes orphaned access for the grant from the provider.
+func (s *Service) deprovision(ctx context.Context, req access.Request) error {
+	res, err := s.AHClient.DeprovisionGrantWithResponse(ctx, grantAccess(req))
+	if err != nil {
+		return err
+	}
+	if res.StatusCode() != http.StatusOK {
+		return fmt.Errorf("unhandled response code from access handler when deprovisioning grant: %d %s", res.StatusCode(), string(res.Body))
+	}
+	return nil
+}
+
+// save updates the request, and writes the events to the outbox in the same transaction.
+func (s *Service) save(ctx context.Context, req access.Request, now time.Time, events ...gevent.EventTyper) error {
+	req.UpdatedAt = now
+	items, err := dbupdate.GetUpdateRequestItems(ctx, s.DB, req, dbupdate.WithEvents(events...))
+	if err != nil {
+		return err
+	}
+	return dbupdate.PutItems(ctx, s.DB, items...)
+}
+
+func (s *Service) lookback() time.Duration {
+	if s.Lookback == 0 {
+		return defaultLookback
+	}
+	return s.Lookback
+}
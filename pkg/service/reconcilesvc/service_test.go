@@ -0,0 +1,210 @@
+package reconcilesvc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	ahtypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/accesshandler/pkg/types/ahmocks"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func verifyResponse(supported, active bool) *ahtypes.VerifyGrantResponse {
+	return &ahtypes.VerifyGrantResponse{
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+		JSON200: &struct {
+			Active    bool `json:"active"`
+			Supported bool `json:"supported"`
+		}{Active: active, Supported: supported},
+	}
+}
+
+func TestRun(t *testing.T) {
+	type testcase struct {
+		name           string
+		giveStatus     ahtypes.GrantStatus
+		giveUpdatedAgo time.Duration
+		giveDrift      *access.GrantDrift
+		// giveOtherActive adds another request with an active grant for the same access
+		giveOtherActive bool
+		autoRevoke      bool
+		// withVerify is nil if the grant shouldn't be verified
+		withVerify      *ahtypes.VerifyGrantResponse
+		wantDeprovision bool
+		wantEvent       *gevent.GrantDrifted
+	}
+
+	testcases := []testcase{
+		{
+			name:           "active grant in sync",
+			giveStatus:     ahtypes.GrantStatusACTIVE,
+			giveUpdatedAgo: time.Hour,
+			withVerify:     verifyResponse(true, true),
+		},
+		{
+			name:           "active grant missing access",
+			giveStatus:     ahtypes.GrantStatusACTIVE,
+			giveUpdatedAgo: time.Hour,
+			withVerify:     verifyResponse(true, false),
+			wantEvent:      &gevent.GrantDrifted{Drift: "MISSING_ACCESS"},
+		},
+		{
+			name:           "missing access already reported",
+			giveStatus:     ahtypes.GrantStatusACTIVE,
+			giveUpdatedAgo: time.Hour,
+			giveDrift:      &access.GrantDrift{Kind: access.MISSING_ACCESS},
+			withVerify:     verifyResponse(true, false),
+		},
+		{
+			name:           "expired grant with orphaned access",
+			giveStatus:     ahtypes.GrantStatusEXPIRED,
+			giveUpdatedAgo: time.Hour,
+			withVerify:     verifyResponse(true, true),
+			wantEvent:      &gevent.GrantDrifted{Drift: "ORPHANED_ACCESS"},
+		},
+		{
+			name:            "orphaned access is auto revoked",
+			giveStatus:      ahtypes.GrantStatusREVOKED,
+			giveUpdatedAgo:  time.Hour,
+			autoRevoke:      true,
+			withVerify:      verifyResponse(true, true),
+			wantDeprovision: true,
+			wantEvent:       &gevent.GrantDrifted{Drift: "ORPHANED_ACCESS", AutoRevoked: true},
+		},
+		{
+			name:            "access provided by another active grant isn't orphaned",
+			giveStatus:      ahtypes.GrantStatusEXPIRED,
+			giveUpdatedAgo:  time.Hour,
+			giveOtherActive: true,
+			autoRevoke:      true,
+		},
+		{
+			name:           "provider does not support verifying",
+			giveStatus:     ahtypes.GrantStatusACTIVE,
+			giveUpdatedAgo: time.Hour,
+			withVerify:     verifyResponse(false, false),
+		},
+		{
+			name:           "status changed recently",
+			giveStatus:     ahtypes.GrantStatusACTIVE,
+			giveUpdatedAgo: time.Minute,
+		},
+		{
+			name:           "grant ended outside of the lookback",
+			giveStatus:     ahtypes.GrantStatusEXPIRED,
+			giveUpdatedAgo: 48 * time.Hour,
+		},
+		{
+			name:       "pending grant",
+			giveStatus: ahtypes.GrantStatusPENDING,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			clk := clock.NewMock()
+			now := clk.Now()
+			req := access.Request{
+				ID:     "req_1",
+				Status: access.APPROVED,
+				Grant: &access.Grant{
+					Provider:  "okta",
+					Subject:   "user@example.com",
+					Status:    tc.giveStatus,
+					Start:     now.Add(-2 * time.Hour),
+					End:       now.Add(time.Hour),
+					UpdatedAt: now.Add(-tc.giveUpdatedAgo),
+				},
+				GrantDrift: tc.giveDrift,
+			}
+
+			reqs := []access.Request{req}
+			if tc.giveOtherActive {
+				// the other grant changed status recently, so it isn't verified itself.
+				other := access.Request{
+					ID:     "req_2",
+					Status: access.APPROVED,
+					Grant: &access.Grant{
+						Provider:  "okta",
+						Subject:   "User@example.com",
+						Status:    ahtypes.GrantStatusACTIVE,
+						Start:     now,
+						End:       now.Add(time.Hour),
+						UpdatedAt: now,
+					},
+				}
+				reqs = append(reqs, other)
+			}
+
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.ListApprovedRequestsEndingAfter{Result: reqs})
+			db.MockQueryWithErr(&storage.ListRequestReviewers{}, ddb.ErrNoItems)
+
+			ctrl := gomock.NewController(t)
+			ah := ahmocks.NewMockClientWithResponsesInterface(ctrl)
+			if tc.withVerify != nil {
+				ah.EXPECT().VerifyGrantWithResponse(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, body ahtypes.GrantAccess, _ ...ahtypes.RequestEditorFn) (*ahtypes.VerifyGrantResponse, error) {
+					assert.Equal(t, "req_1", body.Id)
+					assert.Equal(t, "okta", body.Provider)
+					return tc.withVerify, nil
+				})
+			}
+			if tc.wantDeprovision {
+				ah.EXPECT().DeprovisionGrantWithResponse(gomock.Any(), gomock.Any()).Return(&ahtypes.DeprovisionGrantResponse{HTTPResponse: &http.Response{StatusCode: http.StatusOK}}, nil)
+			}
+
+			outbox := &outboxDB{Storage: db}
+			s := Service{Clock: clk, DB: outbox, AHClient: ah, AutoRevoke: tc.autoRevoke}
+			err := s.Run(context.Background())
+			assert.NoError(t, err)
+
+			if tc.wantEvent == nil {
+				assert.Empty(t, outbox.events)
+				return
+			}
+			// the event is written to the outbox in the same transaction as the request, which is marked as updated.
+			if !assert.Len(t, outbox.events, 1) || !assert.Len(t, outbox.requests, 1) {
+				return
+			}
+			assert.Equal(t, now, outbox.requests[0].UpdatedAt)
+			assert.Equal(t, now, outbox.events[0].Time)
+			var got gevent.GrantDrifted
+			err = json.Unmarshal(outbox.events[0].Detail, &got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, "req_1", got.Grant.ID)
+			assert.Equal(t, tc.wantEvent.Drift, got.Drift)
+			assert.Equal(t, tc.wantEvent.AutoRevoked, got.AutoRevoked)
+		})
+	}
+}
+
+// outboxDB records the requests and events which are written to the database in a transaction.
+type outboxDB struct {
+	ddb.Storage
+	requests []access.Request
+	events   []gevent.Event
+}
+
+func (o *outboxDB) TransactWriteItems(ctx context.Context, tx []ddb.TransactWriteItem) error {
+	for _, item := range tx {
+		switch v := item.Put.(type) {
+		case *access.Request:
+			o.requests = append(o.requests, *v)
+		case *gevent.OutboxEvent:
+			o.events = append(o.events, v.Event)
+		}
+	}
+	return o.Storage.TransactWriteItems(ctx, tx)
+}
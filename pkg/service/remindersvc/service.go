@@ -0,0 +1,85 @@
+package remindersvc
+
+import (
+	"context"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	ahtypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/schedule"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
+	"github.com/common-fate/ddb"
+	"go.uber.org/zap"
+)
+
+// Service reminds requestors that their grant is about to expire.
+// Reminders are delivered by the notifiers, which handle the grant expiring event
+// written to the outbox by the service.
+type Service struct {
+	Clock clock.Clock
+	DB    ddb.Storage
+	// ExpiryReminders are how long before the end of a grant to remind the requestor.
+	ExpiryReminders []time.Duration
+}
+
+// Run checks the grants which haven't ended yet and sends any reminders which are due.
+// If a reminder can't be sent it is retried on the next run, as it isn't recorded on the request.
+func (s *Service) Run(ctx context.Context) error {
+	if len(s.ExpiryReminders) == 0 {
+		return nil
+	}
+	q := storage.ListApprovedRequestsEndingAfter{EndingAfter: s.Clock.Now()}
+	return storage.ForEachPage(ctx, s.DB, &q, func() error {
+		for _, req := range q.Result {
+			err := s.remind(ctx, req)
+			if err != nil {
+				zap.S().Errorw("failed to send expiry reminder", "request.id", req.ID, zap.Error(err))
+			}
+		}
+		return nil
+	})
+}
+
+// RunEvery sends reminders on the given interval until the context is cancelled.
+// The deployed stack runs the reminders Lambda on a schedule instead.
+func (s *Service) RunEvery(ctx context.Context, interval time.Duration) {
+	schedule.RunEvery(ctx, s.Clock, interval, "failed to send expiry reminders", s.Run)
+}
+
+// remind sends a reminder for the request if one is due.
+// The reminder is recorded on the request in the same transaction as the grant expiring event is written to the outbox,
+// so the requestor is reminded once. The write only succeeds if the grant is still active and hasn't been extended,
+// so that a revocation or extension made after the request was listed isn't overwritten.
+func (s *Service) remind(ctx context.Context, req access.Request) error {
+	if req.Grant == nil || req.Grant.Status != ahtypes.GrantStatusACTIVE {
+		return nil
+	}
+	now := s.Clock.Now()
+	before, due := req.DueExpiryReminder(now, s.ExpiryReminders)
+	if !due {
+		return nil
+	}
+	cond, err := dbupdate.GrantIsActiveUntil(req.Grant.End)
+	if err != nil {
+		return err
+	}
+	req.ExpiryReminder = &access.ExpiryReminder{
+		GrantEnd: req.Grant.End,
+		Before:   before,
+		SentAt:   now,
+	}
+	req.UpdatedAt = now
+	items, err := dbupdate.GetUpdateRequestItems(ctx, s.DB, req, dbupdate.WithEvents(gevent.GrantExpiring{Grant: req.Grant.ToAHGrant(req.ID)}))
+	if err != nil {
+		return err
+	}
+	err = dbupdate.PutItemsIf(ctx, s.DB, cond, items...)
+	if err == dbupdate.ErrConditionFailed {
+		zap.S().Infow("skipping expiry reminder for grant which was changed after it was listed", "request.id", req.ID)
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,150 @@
+package remindersvc
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	ahtypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	type testcase struct {
+		name        string
+		giveStatus  ahtypes.GrantStatus
+		giveSent    bool
+		giveChanged bool
+		remainingIn time.Duration
+		wantWrite   bool
+		wantEvent   bool
+	}
+
+	testcases := []testcase{
+		{name: "not yet due", giveStatus: ahtypes.GrantStatusACTIVE, remainingIn: time.Hour},
+		{name: "due", giveStatus: ahtypes.GrantStatusACTIVE, remainingIn: 5 * time.Minute, wantWrite: true, wantEvent: true},
+		{name: "already sent", giveStatus: ahtypes.GrantStatusACTIVE, giveSent: true, remainingIn: 5 * time.Minute},
+		{name: "grant is not active", giveStatus: ahtypes.GrantStatusPENDING, remainingIn: 5 * time.Minute},
+		{name: "grant changed after it was listed", giveStatus: ahtypes.GrantStatusACTIVE, giveChanged: true, remainingIn: 5 * time.Minute, wantWrite: true},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			clk := clock.NewMock()
+			now := clk.Now()
+			req := access.Request{
+				ID:     "req_1",
+				Status: access.APPROVED,
+				Grant: &access.Grant{
+					Subject: "user@example.com",
+					Status:  tc.giveStatus,
+					Start:   now.Add(-time.Hour),
+					End:     now.Add(tc.remainingIn),
+				},
+			}
+			if tc.giveSent {
+				req.ExpiryReminder = &access.ExpiryReminder{GrantEnd: req.Grant.End, Before: 10 * time.Minute}
+			}
+
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.ListApprovedRequestsEndingAfter{Result: []access.Request{req}})
+			db.MockQueryWithErr(&storage.ListRequestReviewers{}, ddb.ErrNoItems)
+			outbox := &outboxDB{Storage: db, changed: tc.giveChanged}
+
+			s := Service{Clock: clk, DB: outbox, ExpiryReminders: []time.Duration{10 * time.Minute}}
+			err := s.Run(context.Background())
+			assert.NoError(t, err)
+
+			if !tc.wantWrite {
+				assert.Empty(t, outbox.conditions)
+				return
+			}
+			// the reminder is only saved if the grant is still active and hasn't been extended.
+			want, err := dbupdate.GrantIsActiveUntil(req.Grant.End)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, []dbupdate.Condition{want}, outbox.conditions)
+			if !tc.wantEvent {
+				assert.Empty(t, outbox.events)
+				return
+			}
+			if !assert.Len(t, outbox.events, 1) || !assert.Len(t, outbox.requests, 1) {
+				return
+			}
+			assert.Equal(t, now, outbox.requests[0].ExpiryReminder.SentAt)
+			assert.Equal(t, now, outbox.events[0].Time)
+			var got gevent.GrantExpiring
+			err = json.Unmarshal(outbox.events[0].Detail, &got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, "req_1", got.Grant.ID)
+			assert.True(t, req.Grant.End.Equal(got.Grant.End.Time))
+		})
+	}
+}
+
+// outboxDB records the conditional transactions which save a request and write its events to the outbox.
+type outboxDB struct {
+	ddb.Storage
+	// changed causes the condition to fail, as if the grant changed after it was listed.
+	changed    bool
+	conditions []dbupdate.Condition
+	requests   []access.Request
+	events     []gevent.Event
+}
+
+func (o *outboxDB) TransactWriteItemsIf(ctx context.Context, cond dbupdate.Condition, tx []ddb.TransactWriteItem) error {
+	o.conditions = append(o.conditions, cond)
+	if o.changed {
+		return dbupdate.ErrConditionFailed
+	}
+	for _, item := range tx {
+		switch v := item.Put.(type) {
+		case *access.Request:
+			o.requests = append(o.requests, *v)
+		case *gevent.OutboxEvent:
+			o.events = append(o.events, v.Event)
+		}
+	}
+	return nil
+}
+
+func TestSettingsExpiryReminders(t *testing.T) {
+	tests := []struct {
+		name    string
+		give    string
+		want    []time.Duration
+		wantErr string
+	}{
+		{name: "sorted longest first", give: "10m, 1h", want: []time.Duration{time.Hour, 10 * time.Minute}},
+		{name: "invalid", give: "10m,soon", wantErr: "invalid expiry reminder 'soon', use a duration such as 10m or 1h"},
+		{name: "negative", give: "-10m", wantErr: "invalid expiry reminder '-10m', use a duration such as 10m or 1h"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var s Settings
+			err := s.Config().Load(context.Background(), &gconfig.MapLoader{Values: map[string]string{"expiryReminders": tc.give}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := s.ExpiryReminders()
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
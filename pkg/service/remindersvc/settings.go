@@ -0,0 +1,39 @@
+package remindersvc
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/gconfig"
+)
+
+// Settings configure when requestors are reminded that their grant is about to expire.
+type Settings struct {
+	expiryReminders gconfig.StringValue
+}
+
+func (s *Settings) Config() gconfig.Config {
+	return gconfig.Config{
+		gconfig.StringField("expiryReminders", &s.expiryReminders, "a comma separated list of how long before a grant expires to remind the requestor, such as '1h,10m'", gconfig.WithDefaultFunc(func() string { return "10m" })),
+	}
+}
+
+// ExpiryReminders parses the reminders, returning them from longest to shortest.
+func (s *Settings) ExpiryReminders() ([]time.Duration, error) {
+	var res []time.Duration
+	for _, v := range strings.Split(s.expiryReminders.Get(), ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid expiry reminder '%s', use a duration such as 10m or 1h", v)
+		}
+		res = append(res, d)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i] > res[j] })
+	return res, nil
+}
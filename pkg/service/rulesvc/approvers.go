@@ -2,11 +2,17 @@ package rulesvc
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
 	"sort"
 	"sync"
+	"time"
 
+	"github.com/common-fate/apikit/apio"
 	"github.com/common-fate/common-fate/pkg/rule"
 	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
 	"github.com/common-fate/ddb"
 	"golang.org/x/sync/errgroup"
 )
@@ -47,15 +53,87 @@ func (u *userMap) All() []string {
 // GetApprovers gets all the approvers for a rule, both those assigned as individuals and those
 // assigned via a group. It de-duplicates users, so if a user is assigned as an approver through
 // multiple groups they'll only be returned once.
+// For rules with approval steps, the approvers of every step are returned and the flat Users and Groups are ignored,
+// matching the approvers which are assigned to review a request.
 func GetApprovers(ctx context.Context, db ddb.Storage, rule rule.AccessRule) ([]string, error) {
 	users := newUserMap()
 
-	for _, u := range rule.Approval.Users {
-		users.Add(u)
+	// collect the groups into a new slice, so that appending doesn't modify the groups of the rule.
+	var groups []string
+	for _, step := range rule.Approval.GetSteps() {
+		for _, u := range step.Users {
+			users.Add(u)
+		}
+		groups = append(groups, step.Groups...)
+	}
+
+	err := addGroupMembers(ctx, db, users, groups)
+	if err != nil {
+		return nil, err
+	}
+
+	res := users.All()
+	return res, nil
+}
+
+// GetStepApprovers gets the approvers for each approval step of a rule.
+// The result is indexed by step, in the order returned by rule.Approval.GetSteps().
+// Users are de-duplicated within each step, but a user may be an approver for multiple steps.
+func GetStepApprovers(ctx context.Context, db ddb.Storage, rule rule.AccessRule) ([][]string, error) {
+	steps := rule.Approval.GetSteps()
+	res := make([][]string, len(steps))
+	for i, step := range steps {
+		users := newUserMap()
+		for _, u := range step.Users {
+			users.Add(u)
+		}
+		err := addGroupMembers(ctx, db, users, step.Groups)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = users.All()
+	}
+	return res, nil
+}
+
+// GetDelegates looks up the delegations of each approver and returns the delegates which are active at the given time.
+// The result maps each delegate's user ID to the IDs of the approvers they are acting on behalf of.
+func GetDelegates(ctx context.Context, db ddb.Storage, approvers []string, at time.Time) (map[string][]string, error) {
+	var mu sync.Mutex
+	res := make(map[string][]string)
+	wg, gctx := errgroup.WithContext(ctx)
+	for _, a := range approvers {
+		id := a
+		wg.Go(func() error {
+			q := &storage.ListDelegationsForUser{UserID: id}
+			_, err := db.Query(gctx, q)
+			if err != nil && err != ddb.ErrNoItems {
+				return err
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			for _, d := range q.Result {
+				if d.IsActive(at) && !contains(res[d.DelegateID], d.UserID) {
+					res[d.DelegateID] = append(res[d.DelegateID], d.UserID)
+				}
+			}
+			return nil
+		})
 	}
+	err := wg.Wait()
+	if err != nil {
+		return nil, err
+	}
+	for _, onBehalfOf := range res {
+		sort.Strings(onBehalfOf)
+	}
+	return res, nil
+}
 
+// addGroupMembers concurrently looks up each group and adds its members to users.
+func addGroupMembers(ctx context.Context, db ddb.Storage, users *userMap, groups []string) error {
 	wg, gctx := errgroup.WithContext(ctx)
-	for _, g := range rule.Approval.Groups {
+	for _, g := range groups {
 		id := g
 		wg.Go(func() error {
 			q := &storage.GetGroup{ID: id}
@@ -69,11 +147,130 @@ func GetApprovers(ctx context.Context, db ddb.Storage, rule rule.AccessRule) ([]
 			return nil
 		})
 	}
-	err := wg.Wait()
+	return wg.Wait()
+}
+
+// validateApprovalSteps checks that each approval step has at least one approver
+// and that the users and groups referenced by the steps exist.
+// returns apio.APIError so it will bubble up as a 400 error from api usage
+func validateApprovalSteps(ctx context.Context, db ddb.Storage, steps []types.ApprovalStep) error {
+	for i, step := range steps {
+		if len(step.Users) == 0 && len(step.Groups) == 0 {
+			return apio.NewRequestError(fmt.Errorf("approval step %d must have at least one user or group", i), http.StatusBadRequest)
+		}
+		if step.RequiredApprovals < 1 {
+			return apio.NewRequestError(fmt.Errorf("approval step %d must require at least one approval", i), http.StatusBadRequest)
+		}
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+	for _, step := range steps {
+		for _, u := range step.Users {
+			id := u
+			g.Go(func() error {
+				_, err := db.Query(gctx, &storage.GetUser{ID: id})
+				if err == ddb.ErrNoItems {
+					return apio.NewRequestError(fmt.Errorf("approval step user %s does not exist", id), http.StatusBadRequest)
+				}
+				return err
+			})
+		}
+		for _, grp := range step.Groups {
+			id := grp
+			g.Go(func() error {
+				_, err := db.Query(gctx, &storage.GetGroup{ID: id})
+				if err == ddb.ErrNoItems {
+					return apio.NewRequestError(fmt.Errorf("approval step group %s does not exist", id), http.StatusBadRequest)
+				}
+				return err
+			})
+		}
+	}
+	return g.Wait()
+}
+
+// GetEscalationReviewers gets the users who should be added as reviewers when a request is escalated,
+// including the members of the escalation groups. Users are de-duplicated and the result is sorted.
+func GetEscalationReviewers(ctx context.Context, db ddb.Storage, escalation rule.Escalation) ([]string, error) {
+	users := newUserMap()
+	for _, u := range escalation.Users {
+		users.Add(u)
+	}
+	err := addGroupMembers(ctx, db, users, escalation.Groups)
 	if err != nil {
 		return nil, err
 	}
+	return users.All(), nil
+}
 
-	res := users.All()
-	return res, nil
+// validateEscalation checks the escalation timings and that the users and groups referenced by the escalation exist.
+// returns apio.APIError so it will bubble up as a 400 error from api usage
+func validateEscalation(ctx context.Context, db ddb.Storage, escalation types.Escalation) error {
+	if escalation.AfterMinutes < 1 {
+		return apio.NewRequestError(errors.New("escalation afterMinutes must be at least 1"), http.StatusBadRequest)
+	}
+	if len(escalation.Users) == 0 && len(escalation.Groups) == 0 {
+		return apio.NewRequestError(errors.New("escalation must have at least one user or group"), http.StatusBadRequest)
+	}
+	if escalation.AutoDeclineAfterMinutes != nil && *escalation.AutoDeclineAfterMinutes <= escalation.AfterMinutes {
+		return apio.NewRequestError(errors.New("escalation autoDeclineAfterMinutes must be greater than afterMinutes"), http.StatusBadRequest)
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+	for _, u := range escalation.Users {
+		id := u
+		g.Go(func() error {
+			_, err := db.Query(gctx, &storage.GetUser{ID: id})
+			if err == ddb.ErrNoItems {
+				return apio.NewRequestError(fmt.Errorf("escalation user %s does not exist", id), http.StatusBadRequest)
+			}
+			return err
+		})
+	}
+	for _, grp := range escalation.Groups {
+		id := grp
+		g.Go(func() error {
+			_, err := db.Query(gctx, &storage.GetGroup{ID: id})
+			if err == ddb.ErrNoItems {
+				return apio.NewRequestError(fmt.Errorf("escalation group %s does not exist", id), http.StatusBadRequest)
+			}
+			return err
+		})
+	}
+	return g.Wait()
+}
+
+// validateBreakGlass checks that the break-glass config has at least one permitted user or group and that they exist.
+// Break-glass requests are reviewed by the rule's approvers after the fact, so the rule must require approval.
+// returns apio.APIError so it will bubble up as a 400 error from api usage
+func validateBreakGlass(ctx context.Context, db ddb.Storage, breakGlass types.BreakGlassConfig, approval rule.Approval) error {
+	if len(breakGlass.Users) == 0 && len(breakGlass.Groups) == 0 {
+		return apio.NewRequestError(errors.New("break-glass must have at least one user or group"), http.StatusBadRequest)
+	}
+	if !approval.IsRequired() {
+		return apio.NewRequestError(errors.New("break-glass can only be enabled for access rules which require approval"), http.StatusBadRequest)
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+	for _, u := range breakGlass.Users {
+		id := u
+		g.Go(func() error {
+			_, err := db.Query(gctx, &storage.GetUser{ID: id})
+			if err == ddb.ErrNoItems {
+				return apio.NewRequestError(fmt.Errorf("break-glass user %s does not exist", id), http.StatusBadRequest)
+			}
+			return err
+		})
+	}
+	for _, grp := range breakGlass.Groups {
+		id := grp
+		g.Go(func() error {
+			_, err := db.Query(gctx, &storage.GetGroup{ID: id})
+			if err == ddb.ErrNoItems {
+				return apio.NewRequestError(fmt.Errorf("break-glass group %s does not exist", id), http.StatusBadRequest)
+			}
+			return err
+		})
+	}
+	return g.Wait()
 }
@@ -3,6 +3,7 @@ package rulesvc
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/common-fate/common-fate/pkg/identity"
 	"github.com/common-fate/common-fate/pkg/rule"
@@ -67,6 +68,19 @@ func TestGetApprovers(t *testing.T) {
 			},
 			want: []string{"usr_2"},
 		},
+		{
+			name: "steps ignore flat users and groups",
+			giveRule: rule.AccessRule{
+				Approval: rule.Approval{
+					Users:  []string{"usr_1"},
+					Groups: []string{"grp_1"},
+					Steps: []rule.ApprovalStep{
+						{Users: []string{"usr_3"}},
+					},
+				},
+			},
+			want: []string{"usr_3"},
+		},
 		// returning an empty array rather than nil ensures that our API endpoints
 		// that use this method don't return null when the frontend is expecting an array.
 		{
@@ -91,3 +105,76 @@ func TestGetApprovers(t *testing.T) {
 	}
 
 }
+
+func TestGetApproversDoesNotModifyRule(t *testing.T) {
+	db := ddbmock.New(t)
+	db.MockQuery(&storage.GetGroup{Result: &identity.Group{}})
+
+	// the spare capacity in the groups slice would be written to if it was appended to directly.
+	groups := make([]string, 1, 2)
+	groups[0] = "grp_1"
+	r := rule.AccessRule{
+		Approval: rule.Approval{
+			Steps: []rule.ApprovalStep{
+				{Groups: groups},
+				{Groups: []string{"grp_2"}},
+			},
+		},
+	}
+	_, err := GetApprovers(context.Background(), db, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"grp_1"}, r.Approval.Steps[0].Groups)
+	assert.Equal(t, []string{"grp_1", ""}, groups[:2])
+}
+
+func TestGetDelegates(t *testing.T) {
+	now := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	type testcase struct {
+		name            string
+		giveApprovers   []string
+		mockDelegations []identity.Delegation
+		want            map[string][]string
+	}
+
+	testcases := []testcase{
+		{
+			name:          "no delegations",
+			giveApprovers: []string{"usr_1"},
+			want:          map[string][]string{},
+		},
+		{
+			name:          "active delegation",
+			giveApprovers: []string{"usr_1"},
+			mockDelegations: []identity.Delegation{
+				{UserID: "usr_1", DelegateID: "usr_2", StartTime: now.Add(-time.Hour), EndTime: now.Add(time.Hour)},
+			},
+			want: map[string][]string{"usr_2": {"usr_1"}},
+		},
+		{
+			name:          "inactive delegations are ignored",
+			giveApprovers: []string{"usr_1"},
+			mockDelegations: []identity.Delegation{
+				{UserID: "usr_1", DelegateID: "usr_2", StartTime: now.Add(time.Hour), EndTime: now.Add(2 * time.Hour)},
+				{UserID: "usr_1", DelegateID: "usr_3", StartTime: now.Add(-2 * time.Hour), EndTime: now},
+			},
+			want: map[string][]string{},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.ListDelegationsForUser{Result: tc.mockDelegations})
+
+			got, err := GetDelegates(context.Background(), db, tc.giveApprovers, now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
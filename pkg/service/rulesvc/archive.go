@@ -5,6 +5,7 @@ import (
 
 	"github.com/common-fate/analytics-go"
 	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/configchange"
 	"github.com/common-fate/common-fate/pkg/rule"
 	"github.com/common-fate/common-fate/pkg/storage"
 	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
@@ -24,6 +25,15 @@ func (s *Service) ArchiveAccessRule(ctx context.Context, userId string, in rule.
 	newVersion.Version = types.NewVersionID()
 	newVersion.Current = true
 
+	change, err := configchange.New(ctx, newVersion.Metadata.UpdatedAt, configchange.AccessRule, in.ID, configchange.ARCHIVED, in, newVersion)
+	if err != nil {
+		return nil, err
+	}
+	changeItems, err := dbupdate.ConfigChangeItems(change)
+	if err != nil {
+		return nil, err
+	}
+
 	// Set the existing version to not current
 	in.Current = false
 
@@ -62,7 +72,8 @@ func (s *Service) ArchiveAccessRule(ctx context.Context, userId string, in rule.
 		}
 	}
 
-	err := s.DB.PutBatch(ctx, items...)
+	items = append(items, changeItems...)
+	err = dbupdate.PutItems(ctx, s.DB, items...)
 	if err != nil {
 		return nil, err
 	}
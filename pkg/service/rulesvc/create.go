@@ -10,8 +10,10 @@ import (
 	"github.com/common-fate/apikit/apio"
 	"github.com/common-fate/apikit/logger"
 	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/configchange"
 	"github.com/common-fate/common-fate/pkg/rule"
 	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
 	"github.com/common-fate/common-fate/pkg/types"
 	"github.com/common-fate/ddb"
 	"github.com/pkg/errors"
@@ -255,6 +257,10 @@ func (s *Service) CreateAccessRule(ctx context.Context, userID string, in types.
 	if in.TimeConstraints.MaxDurationSeconds > 26*7*24*3600 {
 		return nil, errors.New("access rule cannot be longer than 6 months")
 	}
+	err = rule.ValidateTimeConstraints(in.TimeConstraints)
+	if err != nil {
+		return nil, apio.NewRequestError(err, http.StatusBadRequest)
+	}
 
 	approvals := rule.Approval{}
 
@@ -266,13 +272,48 @@ func (s *Service) CreateAccessRule(ctx context.Context, userID string, in types.
 		approvals.Users = *in.Approval.Users
 	}
 
+	if in.Approval.Steps != nil {
+		err = validateApprovalSteps(ctx, s.DB, *in.Approval.Steps)
+		if err != nil {
+			return nil, err
+		}
+		approvals.Steps = rule.ApprovalStepsFromAPI(*in.Approval.Steps)
+	}
+
+	if in.Approval.Escalation != nil {
+		err = validateEscalation(ctx, s.DB, *in.Approval.Escalation)
+		if err != nil {
+			return nil, err
+		}
+		approvals.Escalation = rule.EscalationFromAPI(*in.Approval.Escalation)
+	}
+
+	var breakGlass *rule.BreakGlass
+	if in.BreakGlass != nil {
+		err = validateBreakGlass(ctx, s.DB, *in.BreakGlass, approvals)
+		if err != nil {
+			return nil, err
+		}
+		breakGlass = rule.BreakGlassFromAPI(*in.BreakGlass)
+	}
+
+	var ticketReference *rule.TicketReference
+	if in.TicketReference != nil {
+		ticketReference, err = rule.TicketReferenceFromAPI(*in.TicketReference)
+		if err != nil {
+			return nil, apio.NewRequestError(err, http.StatusBadRequest)
+		}
+	}
+
 	rul := rule.AccessRule{
-		ID:          id,
-		Approval:    approvals,
-		Status:      rule.ACTIVE,
-		Description: in.Description,
-		Name:        in.Name,
-		Groups:      in.Groups,
+		ID:              id,
+		Approval:        approvals,
+		BreakGlass:      breakGlass,
+		TicketReference: ticketReference,
+		Status:          rule.ACTIVE,
+		Description:     in.Description,
+		Name:            in.Name,
+		Groups:          in.Groups,
 		Metadata: rule.AccessRuleMetadata{
 			CreatedAt: now,
 			CreatedBy: userID,
@@ -287,8 +328,17 @@ func (s *Service) CreateAccessRule(ctx context.Context, userID string, in types.
 
 	log.Debugw("saving access rule", "rule", rul)
 
-	// save the request.
-	err = s.DB.Put(ctx, &rul)
+	change, err := configchange.New(ctx, now, configchange.AccessRule, rul.ID, configchange.CREATED, nil, rul)
+	if err != nil {
+		return nil, err
+	}
+	changeItems, err := dbupdate.ConfigChangeItems(change)
+	if err != nil {
+		return nil, err
+	}
+
+	// save the rule along with the record of the change.
+	err = dbupdate.PutItems(ctx, s.DB, append([]ddb.Keyer{&rul}, changeItems...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -30,8 +30,9 @@ func (s *Service) GetRule(ctx context.Context, ID string, user *identity.User, i
 		}
 
 		return &rule.GetAccessRuleResponse{
-			Rule:       q.Result,
-			CanRequest: canRequest,
+			Rule:          q.Result,
+			CanRequest:    canRequest,
+			CanBreakGlass: canRequest && q.Result.BreakGlass != nil && q.Result.BreakGlass.Permits(*user),
 		}, nil
 	}
 
@@ -46,19 +47,21 @@ func canGet(user *identity.User, rule *rule.AccessRule, isAdmin bool) bool {
 	if isAdmin {
 		return true
 	}
-	// DE = User can see a rule they're an approver for
-	for _, au := range rule.Approval.Users {
-		if au == user.ID {
-			return true
-		}
-	}
-	// DE = User can see a rule they're an approver of (via groups)
-	for _, group := range user.Groups {
-		for _, g := range rule.Approval.Groups {
-			if g == group {
+	for _, step := range rule.Approval.GetSteps() {
+		// DE = User can see a rule they're an approver for
+		for _, au := range step.Users {
+			if au == user.ID {
 				return true
 			}
 		}
+		// DE = User can see a rule they're an approver of (via groups)
+		for _, group := range user.Groups {
+			for _, g := range step.Groups {
+				if g == group {
+					return true
+				}
+			}
+		}
 	}
 	// DE = User can see a rule they're assigned to (via the groups)
 	for _, group := range user.Groups {
@@ -0,0 +1,20 @@
+package rulesvc
+
+import (
+	"context"
+
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+)
+
+// ListUserAccessRules lists the active access rules which the user can request access with.
+func (s *Service) ListUserAccessRules(ctx context.Context, user identity.User) ([]rule.AccessRule, error) {
+	q := storage.ListAccessRulesForStatus{Status: rule.ACTIVE}
+	_, err := s.DB.Query(ctx, &q)
+	if err != nil && err != ddb.ErrNoItems {
+		return nil, err
+	}
+	return FilterRulesByGroupMap(user.Groups, q.Result), nil
+}
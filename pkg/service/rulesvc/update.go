@@ -2,11 +2,16 @@ package rulesvc
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/common-fate/analytics-go"
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/common-fate/pkg/configchange"
 	"github.com/common-fate/common-fate/pkg/rule"
 	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
 	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -78,18 +83,63 @@ func (s *Service) UpdateRule(ctx context.Context, in *UpdateOpts) (*rule.AccessR
 	} else {
 		newVersion.Approval.Groups = []string{}
 	}
+	newVersion.Approval.Steps = nil
+	if in.UpdateRequest.Approval.Steps != nil {
+		err = validateApprovalSteps(ctx, s.DB, *in.UpdateRequest.Approval.Steps)
+		if err != nil {
+			return nil, err
+		}
+		newVersion.Approval.Steps = rule.ApprovalStepsFromAPI(*in.UpdateRequest.Approval.Steps)
+	}
+	newVersion.Approval.Escalation = nil
+	if in.UpdateRequest.Approval.Escalation != nil {
+		err = validateEscalation(ctx, s.DB, *in.UpdateRequest.Approval.Escalation)
+		if err != nil {
+			return nil, err
+		}
+		newVersion.Approval.Escalation = rule.EscalationFromAPI(*in.UpdateRequest.Approval.Escalation)
+	}
+	newVersion.BreakGlass = nil
+	if in.UpdateRequest.BreakGlass != nil {
+		err = validateBreakGlass(ctx, s.DB, *in.UpdateRequest.BreakGlass, newVersion.Approval)
+		if err != nil {
+			return nil, err
+		}
+		newVersion.BreakGlass = rule.BreakGlassFromAPI(*in.UpdateRequest.BreakGlass)
+	}
+	newVersion.TicketReference = nil
+	if in.UpdateRequest.TicketReference != nil {
+		newVersion.TicketReference, err = rule.TicketReferenceFromAPI(*in.UpdateRequest.TicketReference)
+		if err != nil {
+			return nil, apio.NewRequestError(err, http.StatusBadRequest)
+		}
+	}
 	newVersion.Groups = in.UpdateRequest.Groups
 	newVersion.Metadata.UpdatedBy = in.UpdaterID
 	newVersion.Metadata.UpdatedAt = clk.Now()
+	err = rule.ValidateTimeConstraints(in.UpdateRequest.TimeConstraints)
+	if err != nil {
+		return nil, apio.NewRequestError(err, http.StatusBadRequest)
+	}
 	newVersion.TimeConstraints = in.UpdateRequest.TimeConstraints
 	newVersion.Version = types.NewVersionID()
 	newVersion.Target = target
 
+	change, err := configchange.New(ctx, newVersion.Metadata.UpdatedAt, configchange.AccessRule, in.Rule.ID, configchange.UPDATED, in.Rule, newVersion)
+	if err != nil {
+		return nil, err
+	}
+	changeItems, err := dbupdate.ConfigChangeItems(change)
+	if err != nil {
+		return nil, err
+	}
+
 	// Set the existing version to not current
 	in.Rule.Current = false
 
 	// updated the previous version to be a version and inserts the new one as current
-	err = s.DB.PutBatch(ctx, &newVersion, &in.Rule)
+	items := append([]ddb.Keyer{&newVersion, &in.Rule}, changeItems...)
+	err = dbupdate.PutItems(ctx, s.DB, items...)
 	if err != nil {
 		return nil, err
 	}
@@ -8,7 +8,9 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/common-fate/common-fate/pkg/configchange"
 	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
 	"github.com/common-fate/common-fate/pkg/target"
 	"github.com/pkg/errors"
 
@@ -114,8 +116,16 @@ func (s *Service) CreateGroup(ctx context.Context, req types.CreateTargetGroupRe
 	//based on the target schema provider type set the Icon
 
 	log.Debugw("saving target group", "group", group)
+	change, err := configchange.New(ctx, now, configchange.TargetGroup, group.ID, configchange.CREATED, nil, group)
+	if err != nil {
+		return nil, err
+	}
+	changeItems, err := dbupdate.ConfigChangeItems(change)
+	if err != nil {
+		return nil, err
+	}
 	// save the request.
-	err = s.DB.Put(ctx, &group)
+	err = dbupdate.PutItems(ctx, s.DB, append([]ddb.Keyer{&group}, changeItems...)...)
 	if err != nil {
 		return nil, err
 	}
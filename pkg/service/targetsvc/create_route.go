@@ -3,8 +3,11 @@ package targetsvc
 import (
 	"context"
 
+	"github.com/common-fate/common-fate/pkg/configchange"
 	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
 	"github.com/common-fate/common-fate/pkg/target"
+	"github.com/common-fate/ddb"
 
 	"github.com/common-fate/common-fate/pkg/types"
 )
@@ -34,7 +37,16 @@ func (s *Service) CreateRoute(ctx context.Context, group string, req types.Creat
 		Valid: false,
 	}
 
-	err = s.DB.Put(ctx, &route)
+	change, err := configchange.New(ctx, s.Clock.Now(), configchange.TargetGroupLink, configchange.TargetGroupLinkID(route.Group, route.Handler, route.Kind), configchange.CREATED, nil, route)
+	if err != nil {
+		return nil, err
+	}
+	changeItems, err := dbupdate.ConfigChangeItems(change)
+	if err != nil {
+		return nil, err
+	}
+
+	err = dbupdate.PutItems(ctx, s.DB, append([]ddb.Keyer{&route}, changeItems...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -3,7 +3,9 @@ package targetsvc
 import (
 	"context"
 
+	"github.com/common-fate/common-fate/pkg/configchange"
 	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
 	"github.com/common-fate/common-fate/pkg/target"
 	"github.com/common-fate/ddb"
 )
@@ -22,5 +24,28 @@ func (s *Service) DeleteGroup(ctx context.Context, group *target.Group) error {
 		items = append(items, &q.Result[i])
 	}
 
-	return s.DB.DeleteBatch(ctx, items...)
+	// the routes are deleted along with the group, so a single change is recorded for the group.
+	change, err := configchange.New(ctx, s.Clock.Now(), configchange.TargetGroup, group.ID, configchange.DELETED, group, nil)
+	if err != nil {
+		return err
+	}
+	changeItems, err := dbupdate.ConfigChangeItems(change)
+	if err != nil {
+		return err
+	}
+
+	return dbupdate.DeleteItems(ctx, s.DB, items, changeItems...)
+}
+
+// DeleteRoute removes the link between a target group and a handler.
+func (s *Service) DeleteRoute(ctx context.Context, route target.Route) error {
+	change, err := configchange.New(ctx, s.Clock.Now(), configchange.TargetGroupLink, configchange.TargetGroupLinkID(route.Group, route.Handler, route.Kind), configchange.DELETED, route, nil)
+	if err != nil {
+		return err
+	}
+	changeItems, err := dbupdate.ConfigChangeItems(change)
+	if err != nil {
+		return err
+	}
+	return dbupdate.DeleteItems(ctx, s.DB, []ddb.Keyer{&route}, changeItems...)
 }
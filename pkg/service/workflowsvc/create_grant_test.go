@@ -9,6 +9,7 @@ import (
 	"github.com/common-fate/common-fate/accesshandler/pkg/types"
 	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
 	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
 	"github.com/common-fate/common-fate/pkg/identity"
 	"github.com/common-fate/common-fate/pkg/rule"
 	"github.com/common-fate/common-fate/pkg/service/workflowsvc/mocks"
@@ -109,17 +110,13 @@ func TestCreateGrant(t *testing.T) {
 			runtime := mocks.NewMockRuntime(ctrl)
 			runtime.EXPECT().Grant(gomock.Any(), gomock.Any(), gomock.Any()).Return(tc.withCreateGrantResponseErr).AnyTimes()
 
-			eventbus := mocks.NewMockEventPutter(ctrl)
-			eventbus.EXPECT().Put(gomock.Any(), gomock.Any()).Return(tc.withCreateGrantResponseErr).AnyTimes()
-
 			c := ddbmock.New(t)
 			c.MockQueryWithErr(&storage.GetUser{Result: tc.withUser}, tc.wantUserErr)
 
 			s := Service{
-				Runtime:  runtime,
-				DB:       c,
-				Clk:      clk,
-				Eventbus: eventbus,
+				Runtime: runtime,
+				DB:      c,
+				Clk:     clk,
 			}
 
 			gotGrant, err := s.Grant(context.Background(), tc.giveRequest, tc.giveRule)
@@ -129,3 +126,15 @@ func TestCreateGrant(t *testing.T) {
 		})
 	}
 }
+
+func TestGrantEvents(t *testing.T) {
+	grant := &access.Grant{Provider: "test", Subject: "user1@example.com", Status: ahTypes.GrantStatusPENDING}
+	request := access.Request{ID: "req_1", Grant: grant}
+
+	// Access Providers emit GrantCreated from the Access Handler.
+	assert.Empty(t, GrantEvents(request, rule.AccessRule{Target: rule.Target{ProviderID: "test"}}))
+	assert.Empty(t, GrantEvents(access.Request{ID: "req_1"}, rule.AccessRule{Target: rule.Target{TargetGroupID: "test"}}))
+
+	got := GrantEvents(request, rule.AccessRule{Target: rule.Target{TargetGroupID: "test"}})
+	assert.Equal(t, []gevent.EventTyper{gevent.GrantCreated{Grant: grant.ToAHGrant("req_1")}}, got)
+}
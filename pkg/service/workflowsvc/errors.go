@@ -9,7 +9,10 @@ var (
 	ErrGrantInactive = errors.New("only active grants can be revoked")
 	// ErrNoGrant is returned when attempting to revoke a request which has no grant yet
 	ErrNoGrant = errors.New("request has no grant")
-	// ErrNoGrant is returned when attempting to revoke a request which has no grant yet
+	// ErrGrantNotExtendable is returned when attempting to extend a grant which is not active
+	ErrGrantNotExtendable = errors.New("only active grants can be extended")
+	// ErrExtendNotSupported is returned when the runtime can't reschedule the deactivation of a grant
+	ErrExtendNotSupported = errors.New("grants for this access provider can't be extended")
 )
 
 // GrantValidationError is returned if grantValidation fails
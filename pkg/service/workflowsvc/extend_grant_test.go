@@ -0,0 +1,91 @@
+package workflowsvc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/service/workflowsvc/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateExtension(t *testing.T) {
+	type testcase struct {
+		name          string
+		giveRequest   access.Request
+		giveEnd       time.Time
+		withCanExtend bool
+		wantErr       error
+	}
+	clk := clock.NewMock()
+	end := clk.Now().Add(time.Hour)
+	active := access.Request{
+		Grant: &access.Grant{
+			Status: ahTypes.GrantStatusACTIVE,
+			End:    end,
+		},
+	}
+	expired := access.Request{
+		Grant: &access.Grant{
+			Status: ahTypes.GrantStatusEXPIRED,
+			End:    clk.Now().Add(-time.Hour),
+		},
+	}
+
+	testcases := []testcase{
+		{
+			name:          "ok",
+			giveRequest:   active,
+			giveEnd:       end.Add(time.Hour),
+			withCanExtend: true,
+		},
+		{
+			name:        "no grant",
+			giveRequest: access.Request{},
+			giveEnd:     end.Add(time.Hour),
+			wantErr:     ErrNoGrant,
+		},
+		{
+			name:        "grant not active",
+			giveRequest: expired,
+			giveEnd:     end.Add(time.Hour),
+			wantErr:     ErrGrantNotExtendable,
+		},
+		{
+			name:        "end not after the current end",
+			giveRequest: active,
+			giveEnd:     end,
+			wantErr:     ErrGrantNotExtendable,
+		},
+		{
+			name:          "runtime can't extend",
+			giveRequest:   active,
+			giveEnd:       end.Add(time.Hour),
+			withCanExtend: false,
+			wantErr:       ErrExtendNotSupported,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			runtime := mocks.NewMockRuntime(ctrl)
+			runtime.EXPECT().CanExtend(false).Return(tc.withCanExtend).AnyTimes()
+
+			s := Service{
+				Runtime: runtime,
+				Clk:     clk,
+			}
+			err := s.ValidateExtension(tc.giveRequest, tc.giveEnd, rule.AccessRule{})
+			if tc.wantErr != nil {
+				assert.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -7,6 +7,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	types "github.com/common-fate/common-fate/accesshandler/pkg/types"
 	gomock "github.com/golang/mock/gomock"
@@ -35,6 +36,34 @@ func (m *MockRuntime) EXPECT() *MockRuntimeMockRecorder {
 	return m.recorder
 }
 
+// CanExtend mocks base method.
+func (m *MockRuntime) CanExtend(arg0 bool) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanExtend", arg0)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// CanExtend indicates an expected call of CanExtend.
+func (mr *MockRuntimeMockRecorder) CanExtend(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanExtend", reflect.TypeOf((*MockRuntime)(nil).CanExtend), arg0)
+}
+
+// Extend mocks base method.
+func (m *MockRuntime) Extend(arg0 context.Context, arg1 string, arg2 time.Time, arg3 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Extend", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Extend indicates an expected call of Extend.
+func (mr *MockRuntimeMockRecorder) Extend(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Extend", reflect.TypeOf((*MockRuntime)(nil).Extend), arg0, arg1, arg2, arg3)
+}
+
 // Grant mocks base method.
 func (m *MockRuntime) Grant(arg0 context.Context, arg1 types.CreateGrant, arg2 bool) error {
 	m.ctrl.T.Helper()
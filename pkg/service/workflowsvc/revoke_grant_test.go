@@ -8,6 +8,7 @@ import (
 	"github.com/benbjohnson/clock"
 	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
 	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
 	"github.com/common-fate/common-fate/pkg/identity"
 	"github.com/common-fate/common-fate/pkg/rule"
 	"github.com/common-fate/common-fate/pkg/service/workflowsvc/mocks"
@@ -50,8 +51,9 @@ func TestRevokeGrant(t *testing.T) {
 			giveRequest: access.Request{
 				RequestedBy: "user1",
 				Grant: &access.Grant{
-					Status: ahTypes.GrantStatus(ahTypes.GrantStatusACTIVE),
-					End:    time.Now().Add(time.Hour),
+					Subject: "user1@example.com",
+					Status:  ahTypes.GrantStatus(ahTypes.GrantStatusACTIVE),
+					End:     time.Now().Add(time.Hour),
 				},
 			},
 			withRevokeGrantResponseErr: nil,
@@ -77,8 +79,9 @@ func TestRevokeGrant(t *testing.T) {
 			giveRequest: access.Request{
 				RequestedBy: "user1",
 				Grant: &access.Grant{
-					Status: ahTypes.GrantStatus(ahTypes.GrantStatusACTIVE),
-					End:    time.Now().Add(time.Hour),
+					Subject: "user1@example.com",
+					Status:  ahTypes.GrantStatus(ahTypes.GrantStatusACTIVE),
+					End:     time.Now().Add(time.Hour),
 				},
 			},
 			withRevokeGrantResponseErr: ErrNoGrant,
@@ -129,8 +132,9 @@ func TestRevokeGrant(t *testing.T) {
 			giveRequest: access.Request{
 				RequestedBy: "user1",
 				Grant: &access.Grant{
-					Status: ahTypes.GrantStatus(ahTypes.GrantStatusACTIVE),
-					End:    time.Now().Add(time.Hour),
+					Subject: "user1@example.com",
+					Status:  ahTypes.GrantStatus(ahTypes.GrantStatusACTIVE),
+					End:     time.Now().Add(time.Hour),
 				},
 			},
 			withRevokeGrantResponseErr:    ddb.ErrNoItems,
@@ -149,19 +153,15 @@ func TestRevokeGrant(t *testing.T) {
 			runtime := mocks.NewMockRuntime(ctrl)
 			runtime.EXPECT().Revoke(gomock.Any(), gomock.Any(), gomock.Any()).Return(tc.withRevokeGrantResponseErr).AnyTimes()
 
-			eventbus := mocks.NewMockEventPutter(ctrl)
-			eventbus.EXPECT().Put(gomock.Any(), gomock.Any()).Return(tc.withRevokeGrantResponseErr).AnyTimes()
-
 			c := ddbmock.New(t)
 			c.MockQueryWithErr(&storage.GetUser{Result: tc.withUser}, tc.wantUserErr)
 			c.MockQueryWithErr(&storage.GetAccessRuleVersion{Result: &tc.getRule}, tc.withGetRuleVersionResponseErr)
 			c.MockQueryWithErr(&storage.ListRequestReviewers{Result: tc.requestReviewers}, tc.wantUserErr)
 
 			s := Service{
-				Runtime:  runtime,
-				DB:       c,
-				Clk:      clk,
-				Eventbus: eventbus,
+				Runtime: runtime,
+				DB:      c,
+				Clk:     clk,
 			}
 
 			gotRequest, err := s.Revoke(context.Background(), tc.giveRequest, tc.revokerID, tc.withUser.Email)
@@ -171,3 +171,136 @@ func TestRevokeGrant(t *testing.T) {
 		})
 	}
 }
+
+// outboxDB records the items which are written to the database in a transaction.
+type outboxDB struct {
+	ddb.Storage
+	tx []ddb.Keyer
+}
+
+func (o *outboxDB) TransactWriteItems(ctx context.Context, tx []ddb.TransactWriteItem) error {
+	for _, item := range tx {
+		o.tx = append(o.tx, item.Put)
+	}
+	return o.Storage.TransactWriteItems(ctx, tx)
+}
+
+func TestRevokeGrantOutboxTimestamp(t *testing.T) {
+	clk := clock.NewMock()
+	created := time.Date(2022, 1, 1, 9, 0, 0, 0, time.UTC)
+	clk.Set(created.Add(time.Hour))
+
+	ctrl := gomock.NewController(t)
+	runtime := mocks.NewMockRuntime(ctrl)
+	runtime.EXPECT().Revoke(gomock.Any(), "req_1", true).Return(nil)
+
+	c := ddbmock.New(t)
+	c.MockQuery(&storage.GetAccessRuleVersion{Result: &rule.AccessRule{ID: "rule1", Target: rule.Target{TargetGroupID: "123"}}})
+	c.MockQuery(&storage.ListRequestReviewers{Result: []access.Reviewer{}})
+	db := &outboxDB{Storage: c}
+
+	s := Service{Runtime: runtime, DB: db, Clk: clk}
+	_, err := s.Revoke(context.Background(), access.Request{
+		ID:        "req_1",
+		CreatedAt: created,
+		UpdatedAt: created,
+		Grant: &access.Grant{
+			Subject:   "user1@example.com",
+			Status:    ahTypes.GrantStatusACTIVE,
+			End:       clk.Now().Add(time.Hour),
+			UpdatedAt: created,
+		},
+	}, "usr_1", "user1@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !assert.Len(t, db.tx, 2) {
+		return
+	}
+	req := db.tx[0].(*access.Request)
+	assert.Equal(t, clk.Now(), req.UpdatedAt)
+
+	// the outbox relay redelivers events which are older than the relay interval,
+	// so the event must be stamped with the time of the revocation rather than the last update of the request.
+	outboxed := db.tx[1].(*gevent.OutboxEvent)
+	assert.Equal(t, gevent.GrantRevokedType, outboxed.Event.Type)
+	assert.Equal(t, clk.Now(), outboxed.CreatedAt)
+	assert.Equal(t, clk.Now(), outboxed.Event.Time)
+}
+
+func TestRevokeRecurringRequest(t *testing.T) {
+	clk := clock.NewMock()
+	start := clk.Now().Add(-time.Hour)
+	parentID := "req_parent"
+	otherID := "req_other"
+	parent := access.Request{
+		ID:              parentID,
+		RequestedBy:     "usr_1",
+		Rule:            "rul_1",
+		Status:          access.APPROVED,
+		RequestedTiming: access.Timing{Duration: 2 * time.Hour, StartTime: &start, Recurrence: &access.Recurrence{Frequency: access.FrequencyDaily, Interval: 1, Until: start.AddDate(0, 0, 3)}},
+	}
+	occurrence := func(id string, recurrenceOf *string, status ahTypes.GrantStatus) access.Request {
+		return access.Request{
+			ID:           id,
+			RequestedBy:  "usr_1",
+			Rule:         "rul_1",
+			Status:       access.APPROVED,
+			RecurrenceOf: recurrenceOf,
+			Grant:        &access.Grant{Subject: "user1@example.com", Status: status, End: clk.Now().Add(time.Hour)},
+		}
+	}
+
+	type testcase struct {
+		name        string
+		giveResults []access.Request
+		wantRevoked []string
+		wantErr     error
+	}
+	testcases := []testcase{
+		{
+			name: "active and scheduled occurrences are revoked",
+			giveResults: []access.Request{
+				occurrence("req_active", &parentID, ahTypes.GrantStatusACTIVE),
+				occurrence("req_scheduled", &parentID, ahTypes.GrantStatusPENDING),
+				occurrence("req_revoked", &parentID, ahTypes.GrantStatusREVOKED),
+				occurrence("req_other_series", &otherID, ahTypes.GrantStatusACTIVE),
+				occurrence("req_not_recurring", nil, ahTypes.GrantStatusACTIVE),
+			},
+			wantRevoked: []string{"req_active", "req_scheduled"},
+		},
+		{
+			name: "no occurrences left to revoke",
+			giveResults: []access.Request{
+				occurrence("req_revoked", &parentID, ahTypes.GrantStatusREVOKED),
+			},
+			wantErr: ErrGrantInactive,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			runtime := mocks.NewMockRuntime(ctrl)
+			var revoked []string
+			runtime.EXPECT().Revoke(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, grantID string, isForTargetGroup bool) error {
+				revoked = append(revoked, grantID)
+				return nil
+			}).AnyTimes()
+
+			c := ddbmock.New(t)
+			c.MockQuery(&storage.ListRequestsForUserAndRuleAndRequestend{Result: tc.giveResults})
+			c.MockQuery(&storage.GetAccessRuleVersion{Result: &rule.AccessRule{ID: "rul_1"}})
+			c.MockQuery(&storage.ListRequestReviewers{Result: []access.Reviewer{}})
+
+			s := Service{Runtime: runtime, DB: c, Clk: clk}
+			_, err := s.Revoke(context.Background(), parent, "usr_1", "user1@example.com")
+			if tc.wantErr != nil {
+				assert.Equal(t, tc.wantErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantRevoked, revoked)
+		})
+	}
+}
@@ -7,11 +7,13 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	aws_config "github.com/aws/aws-sdk-go-v2/config"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	sfnTypes "github.com/aws/aws-sdk-go-v2/service/sfn/types"
 	"github.com/common-fate/apikit/logger"
 	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
 	"github.com/common-fate/common-fate/pkg/cfaws"
@@ -21,13 +23,14 @@ import (
 	"github.com/common-fate/common-fate/pkg/storage"
 	"github.com/common-fate/common-fate/pkg/targetgroupgranter"
 	"github.com/common-fate/ddb"
+	"github.com/common-fate/iso8601"
 	"github.com/common-fate/provider-registry-sdk-go/pkg/msg"
 )
 
 type Runtime struct {
 	StateMachineARN string
 	AHClient        ahTypes.ClientWithResponsesInterface
-	Eventbus        *gevent.Sender
+	Eventbus        gevent.EventPutter
 	DB              ddb.Storage
 	RequestRouter   *requestroutersvc.Service
 }
@@ -87,6 +90,41 @@ func (r *Runtime) Revoke(ctx context.Context, grantID string, isForTargetGroup b
 	return r.revokeProvider(ctx, grantID)
 }
 
+// Extend reschedules the end of an active grant.
+// Target group grants don't need the workflow execution to be changed, because the granter workflow
+// checks the request for an extended end time before deactivating access.
+// Grants for built in providers are extended by the Access Handler.
+func (r *Runtime) Extend(ctx context.Context, grantID string, end time.Time, isForTargetGroup bool) error {
+	if isForTargetGroup {
+		return nil
+	}
+	response, err := r.AHClient.PostGrantsExtendWithResponse(ctx, grantID, ahTypes.PostGrantsExtendJSONRequestBody{
+		End: iso8601.New(end),
+	})
+	if err != nil {
+		return err
+	}
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+		return nil
+	case http.StatusBadRequest:
+		return fmt.Errorf(*response.JSON400.Error)
+	case http.StatusNotFound:
+		return fmt.Errorf(*response.JSON404.Error)
+	case http.StatusInternalServerError:
+		return fmt.Errorf(*response.JSON500.Error)
+	default:
+		logger.Get(ctx).Errorw("unhandled Access Handler response", "body", string(response.Body))
+		return errors.New("unhandled response code from access provider service when extending access")
+	}
+}
+
+// CanExtend returns true, as grants for both target groups and built in providers can be extended.
+func (r *Runtime) CanExtend(isForTargetGroup bool) bool {
+	return true
+}
+
 func BuildExecutionARN(stateMachineARN string, grantID string) string {
 
 	splitARN := strings.Split(stateMachineARN, ":")
@@ -149,14 +187,21 @@ func (r *Runtime) revokeTargetGroup(ctx context.Context, grantID string) error {
 	//if the state of the grant is in the active state
 	if lastState.Type == "WaitStateEntered" && *lastState.StateEnteredEventDetails.Name == "Wait for Window End" {
 
-		// Pull the state from the output of the activate step so it can be used when revoking access
-		exitActivateStepEvent := statefn.Events[len(statefn.Events)-2]
-		if exitActivateStepEvent.Type != "TaskStateExited" || exitActivateStepEvent.StateExitedEventDetails == nil {
+		// Pull the state from the output of the most recent task so it can be used when revoking access.
+		// This is the activate step, or the extension check if the workflow has already checked for an extension.
+		var exitTaskEvent *sfnTypes.HistoryEvent
+		for i := len(statefn.Events) - 2; i >= 0; i-- {
+			if statefn.Events[i].Type == sfnTypes.HistoryEventTypeTaskStateExited {
+				exitTaskEvent = &statefn.Events[i]
+				break
+			}
+		}
+		if exitTaskEvent == nil || exitTaskEvent.StateExitedEventDetails == nil {
 			return errors.New("unexpected workflow state")
 		}
 
 		var gs targetgroupgranter.GrantState
-		err = json.Unmarshal([]byte(aws.ToString(exitActivateStepEvent.StateExitedEventDetails.Output)), &gs)
+		err = json.Unmarshal([]byte(aws.ToString(exitTaskEvent.StateExitedEventDetails.Output)), &gs)
 		if err != nil {
 			return err
 		}
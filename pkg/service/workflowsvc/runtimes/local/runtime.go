@@ -2,8 +2,10 @@ package mock
 
 import (
 	"context"
+	"time"
 
 	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/service/workflowsvc"
 )
 
 type Runtime struct {
@@ -16,3 +18,12 @@ func (r *Runtime) Grant(ctx context.Context, grant ahTypes.CreateGrant, isForTar
 func (r *Runtime) Revoke(ctx context.Context, grantID string, isForTargetGroup bool) error {
 	return nil
 }
+
+// Extend returns ErrExtendNotSupported, as grants aren't scheduled by this runtime so there is no deactivation to move.
+func (r *Runtime) Extend(ctx context.Context, grantID string, end time.Time, isForTargetGroup bool) error {
+	return workflowsvc.ErrExtendNotSupported
+}
+
+func (r *Runtime) CanExtend(isForTargetGroup bool) bool {
+	return false
+}
@@ -2,6 +2,7 @@ package mock
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/common-fate/apikit/logger"
@@ -10,6 +11,9 @@ import (
 
 // The mock runtime always returns success
 type Runtime struct {
+	mu sync.Mutex
+	// extendedEnds holds the new end time of grants which have been extended
+	extendedEnds map[string]time.Time
 }
 
 func (r *Runtime) Grant(ctx context.Context, grant ahTypes.CreateGrant, isForTargetGroup bool) error {
@@ -20,8 +24,16 @@ func (r *Runtime) Grant(ctx context.Context, grant ahTypes.CreateGrant, isForTar
 
 		logger.Get(ctx).Infow("activating grant", "grant", grant)
 
-		dur := grant.End.Sub(grant.Start.Time)
-		time.Sleep(dur)
+		end := grant.End.Time
+		for {
+			time.Sleep(time.Until(end))
+			extendedEnd, ok := r.extendedEnd(grant.Id)
+			if !ok || !extendedEnd.After(end) {
+				break
+			}
+			logger.Get(ctx).Infow("grant was extended", "grant", grant, "end", extendedEnd)
+			end = extendedEnd
+		}
 
 		logger.Get(ctx).Infow("deactivating grant", "grant", grant)
 	}()
@@ -31,3 +43,24 @@ func (r *Runtime) Grant(ctx context.Context, grant ahTypes.CreateGrant, isForTar
 func (r *Runtime) Revoke(ctx context.Context, grantID string, isForTargetGroup bool) error {
 	return nil
 }
+
+func (r *Runtime) Extend(ctx context.Context, grantID string, end time.Time, isForTargetGroup bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.extendedEnds == nil {
+		r.extendedEnds = make(map[string]time.Time)
+	}
+	r.extendedEnds[grantID] = end
+	return nil
+}
+
+func (r *Runtime) CanExtend(isForTargetGroup bool) bool {
+	return true
+}
+
+func (r *Runtime) extendedEnd(grantID string) (time.Time, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	end, ok := r.extendedEnds[grantID]
+	return end, ok
+}
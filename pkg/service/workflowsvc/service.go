@@ -2,6 +2,8 @@ package workflowsvc
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/benbjohnson/clock"
 	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
@@ -23,19 +25,21 @@ type Runtime interface {
 	// isForTargetGroup tells the runtime how to process the request
 	// revoke is expected to be syncronous
 	Revoke(ctx context.Context, grantID string, isForTargetGroup bool) error
+	// isForTargetGroup tells the runtime how to process the request
+	// extend reschedules the deactivation of an active grant to the new end time
+	Extend(ctx context.Context, grantID string, end time.Time, isForTargetGroup bool) error
+	// CanExtend returns whether the runtime is able to extend grants
+	CanExtend(isForTargetGroup bool) bool
 }
 
-//go:generate go run github.com/golang/mock/mockgen -destination=mocks/eventputter.go -package=mocks . EventPutter
-type EventPutter interface {
-	Put(ctx context.Context, detail gevent.EventTyper) error
-}
 type Service struct {
-	Runtime  Runtime
-	DB       ddb.Storage
-	Clk      clock.Clock
-	Eventbus EventPutter
+	Runtime Runtime
+	DB      ddb.Storage
+	Clk     clock.Clock
 }
 
+// Grant starts the workflow for the grant of a request and returns the pending grant.
+// The caller is responsible for saving the grant on the request, along with the events returned by GrantEvents.
 func (s *Service) Grant(ctx context.Context, request access.Request, accessRule rule.AccessRule) (*access.Grant, error) {
 	// Contains logic for preparing a grant and emitting events
 	createGrant, err := s.prepareCreateGrantRequest(ctx, request, accessRule)
@@ -47,26 +51,6 @@ func (s *Service) Grant(ctx context.Context, request access.Request, accessRule
 		return nil, err
 	}
 
-	// @TODO because v1 access providers sends this event in the rest API method
-	// We have to skip sending here here unless it is for a target group
-	// in which case the event can be sent from here
-	// There is still some issues with a race condition here, for asap requests, the grant could start in step functions before this event it sent.
-	// or they could arrive at the same times, if instead this event is produced by the step functions or local mock workflow, then the race condition won't exist
-	// SHIFT THIS TO THE STEP FUNCTION
-	if accessRule.Target.IsForTargetGroup() {
-		err = s.Eventbus.Put(ctx, &gevent.GrantCreated{Grant: ahTypes.Grant{
-			ID:       createGrant.Id,
-			Provider: createGrant.Provider,
-			End:      createGrant.End,
-			Start:    createGrant.Start,
-			Status:   ahTypes.GrantStatusPENDING,
-			Subject:  createGrant.Subject,
-			With:     ahTypes.Grant_With(createGrant.With),
-		}})
-		if err != nil {
-			return nil, err
-		}
-	}
 	now := s.Clk.Now()
 	return &access.Grant{
 		Provider:  createGrant.Provider,
@@ -80,9 +64,23 @@ func (s *Service) Grant(ctx context.Context, request access.Request, accessRule
 	}, nil
 }
 
+// GrantEvents returns the events to write to the outbox when a request granted by Grant is saved.
+// Grants for Access Providers emit their GrantCreated event from the Access Handler,
+// so an event is only returned for grants to target groups.
+func GrantEvents(request access.Request, accessRule rule.AccessRule) []gevent.EventTyper {
+	if request.Grant == nil || !accessRule.Target.IsForTargetGroup() {
+		return nil
+	}
+	return []gevent.EventTyper{gevent.GrantCreated{Grant: request.Grant.ToAHGrant(request.ID)}}
+}
+
 // Revoke attepmts to syncronously revoke access to a request
 // If it is successful, the request is updated in the database, and the updated request is returned from this method
 func (s *Service) Revoke(ctx context.Context, request access.Request, revokerID string, revokerEmail string) (*access.Request, error) {
+	// recurring requests aren't granted themselves, so revoking one revokes the grant of each of its occurrences.
+	if request.Grant == nil && request.Status == access.APPROVED && request.IsRecurring() {
+		return nil, s.revokeOccurrences(ctx, request, revokerID, revokerEmail)
+	}
 	if request.Grant == nil {
 		return nil, ErrNoGrant
 	}
@@ -107,10 +105,19 @@ func (s *Service) Revoke(ctx context.Context, request access.Request, revokerID
 		return nil, err
 	}
 
+	// the outbox events are stamped with the time the request was updated, so it must be updated along with the grant.
+	// Otherwise the outbox relay would treat the events as undelivered and publish them a second time.
+	now := s.Clk.Now()
 	previousStatus := request.Grant.Status
 	request.Grant.Status = ahTypes.GrantStatusREVOKED
-	request.Grant.UpdatedAt = s.Clk.Now()
-	items, err := dbupdate.GetUpdateRequestItems(ctx, s.DB, request)
+	request.Grant.UpdatedAt = now
+	request.UpdatedAt = now
+	// Emit an event for the grant revoke
+	// We have chosen to emit events from the Common Fate app for grant revocation rather than from the access handler because we are using a syncronous API.
+	// All effects from revoking will be implemented in this syncronous api rather than triggered from the events.
+	// So the event is written to the outbox with the updated grant status, and published once the grant has been saved.
+	revoked := gevent.GrantRevoked{Grant: request.Grant.ToAHGrant(request.ID), Actor: revokerID, RevokerEmail: revokerEmail}
+	items, err := dbupdate.GetUpdateRequestItems(ctx, s.DB, request, dbupdate.WithEvents(revoked))
 	if err != nil {
 		return nil, err
 	}
@@ -120,19 +127,96 @@ func (s *Service) Revoke(ctx context.Context, request access.Request, revokerID
 
 	items = append(items, &requestEvent)
 
-	err = s.DB.PutBatch(ctx, items...)
+	err = dbupdate.PutItems(ctx, s.DB, items...)
 	if err != nil {
 		return nil, err
 	}
-	// Emit an event for the grant revoke
-	// We have chosen to emit events from the Common Fate app for grant revocation rather than from the access handler because we are using a syncronous API.
-	// All effects from revoking will be implemented in this syncronous api rather than triggered from the events.
-	// So we update the grant status here and save the grant before emitting the event
-	err = s.Eventbus.Put(ctx, gevent.GrantRevoked{Grant: request.Grant.ToAHGrant(request.ID), Actor: revokerID, RevokerEmail: revokerEmail})
+	return nil, nil
+}
+
+// revokeOccurrences revokes every occurrence of a recurring request which hasn't ended,
+// including the occurrences which are scheduled to start later, so that the whole series is stopped.
+// ErrGrantInactive is returned if there are no occurrences left to revoke.
+func (s *Service) revokeOccurrences(ctx context.Context, parent access.Request, revokerID string, revokerEmail string) error {
+	now := s.Clk.Now()
+	var occurrences []access.Request
+	hasMore := true
+	var next string
+	for hasMore {
+		// occurrences are requested by the same user for the same rule, so only requests which end after now are read.
+		q := storage.ListRequestsForUserAndRuleAndRequestend{
+			UserID:               parent.RequestedBy,
+			RuleID:               parent.Rule,
+			RequestEndComparator: storage.GreaterThan,
+			CompareTo:            now,
+		}
+		var opts []func(*ddb.QueryOpts)
+		if next != "" {
+			opts = append(opts, ddb.Page(next))
+		}
+		res, err := s.DB.Query(ctx, &q, opts...)
+		if err == ddb.ErrNoItems {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		next = res.NextPage
+		hasMore = next != ""
+
+		for _, req := range q.Result {
+			if req.RecurrenceOf == nil || *req.RecurrenceOf != parent.ID || req.Grant == nil {
+				continue
+			}
+			if req.Grant.Status == ahTypes.GrantStatusACTIVE || req.Grant.Status == ahTypes.GrantStatusPENDING {
+				occurrences = append(occurrences, req)
+			}
+		}
+	}
+	if len(occurrences) == 0 {
+		return ErrGrantInactive
+	}
+	for _, occurrence := range occurrences {
+		_, err := s.Revoke(ctx, occurrence, revokerID, revokerEmail)
+		if err != nil {
+			return fmt.Errorf("revoking occurrence %s: %w", occurrence.ID, err)
+		}
+	}
+	return nil
+}
+
+// Cancel revokes the grant for a request which couldn't be saved after it was granted.
+// Unlike Revoke, the request isn't updated in the database and no events are published.
+func (s *Service) Cancel(ctx context.Context, request access.Request, accessRule rule.AccessRule) error {
+	return s.Runtime.Revoke(ctx, request.ID, accessRule.Target.IsForTargetGroup())
+}
+
+// ValidateExtension returns an error if the active grant for a request can't be extended to the new end time.
+// It is used to reject an extension before it is sent for review.
+func (s *Service) ValidateExtension(request access.Request, end time.Time, accessRule rule.AccessRule) error {
+	if request.Grant == nil {
+		return ErrNoGrant
+	}
+	if request.Grant.Status != ahTypes.GrantStatusACTIVE || request.Grant.End.Before(s.Clk.Now()) {
+		return ErrGrantNotExtendable
+	}
+	if !end.After(request.Grant.End) {
+		return ErrGrantNotExtendable
+	}
+	if !s.Runtime.CanExtend(accessRule.Target.IsForTargetGroup()) {
+		return ErrExtendNotSupported
+	}
+	return nil
+}
+
+// Extend reschedules the deactivation of the active grant for a request to the new end time.
+// The caller is responsible for saving the updated grant end time on the request.
+func (s *Service) Extend(ctx context.Context, request access.Request, end time.Time, accessRule rule.AccessRule) error {
+	err := s.ValidateExtension(request, end, accessRule)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	return nil, nil
+	return s.Runtime.Extend(ctx, request.ID, end, accessRule.Target.IsForTargetGroup())
 }
 
 // prepareCreateGrantRequest prepares the data for requesting
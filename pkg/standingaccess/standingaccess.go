@@ -0,0 +1,153 @@
+// Package standingaccess finds access which exists in a provider but wasn't granted by Common Fate.
+package standingaccess
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/apikit/logger"
+	ahtypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
+)
+
+// Assignment is access in a provider which isn't covered by an active grant.
+type Assignment struct {
+	ProviderID   string
+	ProviderType string
+	// Subject is the email address of the user who has the access.
+	Subject string
+	// With holds the arguments which grant the same access, keyed by argument ID.
+	With map[string]string
+}
+
+func (a Assignment) ToAPI() types.StandingAccess {
+	return types.StandingAccess{
+		ProviderId:   a.ProviderID,
+		ProviderType: a.ProviderType,
+		Subject:      a.Subject,
+		With:         types.StandingAccess_With{AdditionalProperties: a.With},
+	}
+}
+
+// Finder lists the assignments in each provider and compares them with the active grants.
+// Only providers which implement the Inventorier interface in the Access Handler are checked.
+type Finder struct {
+	Clock    clock.Clock
+	DB       ddb.Storage
+	AHClient ahtypes.ClientWithResponsesInterface
+}
+
+// Find returns the assignments which aren't covered by an active grant, sorted by provider and subject.
+func (f *Finder) Find(ctx context.Context) ([]Assignment, error) {
+	log := logger.Get(ctx)
+	active, err := f.activeGrants(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := f.AHClient.ListProvidersWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if res.JSON200 == nil {
+		return nil, fmt.Errorf("unhandled response code from access handler when listing providers: %d %s", res.StatusCode(), string(res.Body))
+	}
+
+	found := []Assignment{}
+	for _, p := range *res.JSON200 {
+		ar, err := f.AHClient.ListProviderAssignmentsWithResponse(ctx, p.Id)
+		if err != nil {
+			return nil, err
+		}
+		if ar.JSON200 == nil {
+			return nil, fmt.Errorf("unhandled response code from access handler when listing assignments for provider %s: %d %s", p.Id, ar.StatusCode(), string(ar.Body))
+		}
+		if !ar.JSON200.Supported {
+			log.Debugw("provider does not support listing assignments", "provider.id", p.Id)
+			continue
+		}
+		for _, as := range ar.JSON200.Assignments {
+			if active[access.AccessKey(p.Id, as.Subject, as.With.AdditionalProperties)] {
+				continue
+			}
+			found = append(found, Assignment{
+				ProviderID:   p.Id,
+				ProviderType: p.Type,
+				Subject:      as.Subject,
+				With:         as.With.AdditionalProperties,
+			})
+		}
+	}
+	sort.SliceStable(found, func(i, j int) bool {
+		if found[i].ProviderID != found[j].ProviderID {
+			return found[i].ProviderID < found[j].ProviderID
+		}
+		return found[i].Subject < found[j].Subject
+	})
+	return found, nil
+}
+
+// activeGrants returns the keys of the grants which are currently active.
+// Only requests which haven't ended are read, as an active grant ends in the future.
+func (f *Finder) activeGrants(ctx context.Context) (map[string]bool, error) {
+	active := map[string]bool{}
+	q := storage.ListApprovedRequestsEndingAfter{EndingAfter: f.Clock.Now()}
+	err := storage.ForEachPage(ctx, f.DB, &q, func() error {
+		for _, req := range q.Result {
+			if req.Grant != nil && req.Grant.Status == ahtypes.GrantStatusACTIVE {
+				active[req.Grant.AccessKey()] = true
+			}
+		}
+		return nil
+	})
+	return active, err
+}
+
+// Remove removes the assignment from the provider.
+func (f *Finder) Remove(ctx context.Context, a Assignment) error {
+	res, err := f.AHClient.DeprovisionGrantWithResponse(ctx, ahtypes.GrantAccess{
+		// the assignment wasn't made by a grant, so there is no grant ID to use.
+		Id:       "standing-access",
+		Provider: a.ProviderID,
+		Subject:  openapi_types.Email(a.Subject),
+		With:     ahtypes.GrantAccess_With{AdditionalProperties: a.With},
+	})
+	if err != nil {
+		return err
+	}
+	if res.StatusCode() != http.StatusOK {
+		return fmt.Errorf("unhandled response code from access handler when removing assignment: %d %s", res.StatusCode(), string(res.Body))
+	}
+	return nil
+}
+
+// CreateAccessRuleRequest returns a request to create an access rule which grants the same access as the assignment,
+// so that the standing access can be replaced with access requested when it is needed.
+func (a Assignment) CreateAccessRuleRequest(groups []string, maxDurationSeconds int) types.CreateAccessRuleRequest {
+	with := make(map[string]types.CreateAccessRuleTargetDetailArguments, len(a.With))
+	var args []string
+	for k, v := range a.With {
+		with[k] = types.CreateAccessRuleTargetDetailArguments{Values: []string{v}, Groupings: types.CreateAccessRuleTargetDetailArguments_Groupings{AdditionalProperties: map[string][]string{}}}
+		args = append(args, v)
+	}
+	sort.Strings(args)
+	return types.CreateAccessRuleRequest{
+		Name:        fmt.Sprintf("%s %s", a.ProviderID, strings.Join(args, " ")),
+		Description: fmt.Sprintf("Replaces standing access held by %s.", a.Subject),
+		Groups:      groups,
+		Approval:    types.ApproverConfig{Groups: &[]string{}, Users: &[]string{}},
+		Target: types.CreateAccessRuleTarget{
+			ProviderId: a.ProviderID,
+			With:       types.CreateAccessRuleTarget_With{AdditionalProperties: with},
+		},
+		TimeConstraints: types.TimeConstraints{MaxDurationSeconds: maxDurationSeconds},
+	}
+}
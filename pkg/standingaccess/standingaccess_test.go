@@ -0,0 +1,90 @@
+package standingaccess
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/benbjohnson/clock"
+	ahtypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/accesshandler/pkg/types/ahmocks"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func assignmentsResponse(supported bool, assignments ...ahtypes.ProviderAssignment) *ahtypes.ListProviderAssignmentsResponse {
+	if assignments == nil {
+		assignments = []ahtypes.ProviderAssignment{}
+	}
+	return &ahtypes.ListProviderAssignmentsResponse{
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+		JSON200: &struct {
+			Assignments []ahtypes.ProviderAssignment `json:"assignments"`
+			Supported   bool                         `json:"supported"`
+		}{Assignments: assignments, Supported: supported},
+	}
+}
+
+func TestFind(t *testing.T) {
+	granted := access.Request{
+		ID:     "req_1",
+		Status: access.APPROVED,
+		Grant: &access.Grant{
+			Provider: "okta",
+			Subject:  "Granted@example.com",
+			Status:   ahtypes.GrantStatusACTIVE,
+			With:     ahtypes.Grant_With{AdditionalProperties: map[string]string{"groupId": "admins"}},
+		},
+	}
+	expired := access.Request{
+		ID:     "req_2",
+		Status: access.APPROVED,
+		Grant: &access.Grant{
+			Provider: "okta",
+			Subject:  "expired@example.com",
+			Status:   ahtypes.GrantStatusEXPIRED,
+			With:     ahtypes.Grant_With{AdditionalProperties: map[string]string{"groupId": "admins"}},
+		},
+	}
+
+	db := ddbmock.New(t)
+	db.MockQuery(&storage.ListApprovedRequestsEndingAfter{Result: []access.Request{granted, expired}})
+
+	ctrl := gomock.NewController(t)
+	ah := ahmocks.NewMockClientWithResponsesInterface(ctrl)
+	ah.EXPECT().ListProvidersWithResponse(gomock.Any()).Return(&ahtypes.ListProvidersResponse{
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+		JSON200:      &[]ahtypes.Provider{{Id: "okta", Type: "okta"}, {Id: "flask", Type: "ecs-shell"}},
+	}, nil)
+	with := ahtypes.ProviderAssignment_With{AdditionalProperties: map[string]string{"groupId": "admins"}}
+	ah.EXPECT().ListProviderAssignmentsWithResponse(gomock.Any(), "okta").Return(assignmentsResponse(true,
+		ahtypes.ProviderAssignment{Subject: "granted@example.com", With: with},
+		ahtypes.ProviderAssignment{Subject: "expired@example.com", With: with},
+	), nil)
+	ah.EXPECT().ListProviderAssignmentsWithResponse(gomock.Any(), "flask").Return(assignmentsResponse(false), nil)
+
+	f := Finder{Clock: clock.NewMock(), DB: db, AHClient: ah}
+	got, err := f.Find(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Assignment{
+		{ProviderID: "okta", ProviderType: "okta", Subject: "expired@example.com", With: map[string]string{"groupId": "admins"}},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestCreateAccessRuleRequest(t *testing.T) {
+	a := Assignment{ProviderID: "okta", ProviderType: "okta", Subject: "user@example.com", With: map[string]string{"groupId": "admins"}}
+	got := a.CreateAccessRuleRequest([]string{"engineering"}, 3600)
+	assert.Equal(t, "okta admins", got.Name)
+	assert.Equal(t, []string{"engineering"}, got.Groups)
+	assert.Equal(t, "okta", got.Target.ProviderId)
+	assert.Equal(t, []string{"admins"}, got.Target.With.AdditionalProperties["groupId"].Values)
+	assert.Equal(t, 3600, got.TimeConstraints.MaxDurationSeconds)
+	assert.Equal(t, types.ApproverConfig{Groups: &[]string{}, Users: &[]string{}}, got.Approval)
+}
@@ -0,0 +1,133 @@
+package dbupdate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	ahtypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/ddb"
+)
+
+// maxTransactItems is the maximum number of items DynamoDB allows in a single transaction.
+const maxTransactItems = 100
+
+// ErrConditionFailed is returned by PutItemsIf when the item being updated no longer meets the condition,
+// usually because it was changed after it was read.
+var ErrConditionFailed = errors.New("the item was changed after it was read")
+
+// Condition is a DynamoDB condition expression which must hold for an item to be written.
+type Condition struct {
+	Expression string
+	Names      map[string]string
+	Values     map[string]types.AttributeValue
+}
+
+// RequestStatusIs is a condition that the saved request still has the given status.
+func RequestStatusIs(status access.Status) Condition {
+	return Condition{
+		Expression: "#status = :status",
+		Names:      map[string]string{"#status": "status"},
+		Values:     map[string]types.AttributeValue{":status": &types.AttributeValueMemberS{Value: string(status)}},
+	}
+}
+
+// GrantIsActiveUntil is a condition that the saved request's grant is still active and ends at the given time,
+// so that a grant which was revoked or extended after the request was read isn't overwritten.
+func GrantIsActiveUntil(end time.Time) (Condition, error) {
+	endValue, err := attributevalue.Marshal(end)
+	if err != nil {
+		return Condition{}, err
+	}
+	return Condition{
+		Expression: "#grant.#status = :grantStatus AND #grant.#end = :grantEnd",
+		Names:      map[string]string{"#grant": "grant", "#status": "status", "#end": "end"},
+		Values: map[string]types.AttributeValue{
+			":grantStatus": &types.AttributeValueMemberS{Value: string(ahtypes.GrantStatusACTIVE)},
+			":grantEnd":    endValue,
+		},
+	}, nil
+}
+
+// ConditionalWriter is implemented by storage which can write a transaction with a condition on its first item.
+// The ddb client doesn't support condition expressions, so PutItemsIf calls DynamoDB directly
+// unless the storage implements ConditionalWriter, which allows conditional writes to be tested.
+type ConditionalWriter interface {
+	TransactWriteItemsIf(ctx context.Context, cond Condition, tx []ddb.TransactWriteItem) error
+}
+
+// PutItemsIf writes the items in a single transaction, with the condition applied to the first item.
+// It is used when the first item was read from a list which may be out of date, such as in a scheduled job,
+// so that a change made after the item was read isn't overwritten.
+// If the condition doesn't hold, nothing is written and ErrConditionFailed is returned.
+//
+// Unlike PutItems, every item is written in the transaction, so at most 100 items can be written.
+func PutItemsIf(ctx context.Context, db ddb.Storage, cond Condition, items ...ddb.Keyer) error {
+	if len(items) == 0 {
+		return nil
+	}
+	if len(items) > maxTransactItems {
+		return fmt.Errorf("can't conditionally write %d items, the maximum is %d", len(items), maxTransactItems)
+	}
+	tx := make([]ddb.TransactWriteItem, len(items))
+	for i, item := range items {
+		tx[i] = ddb.TransactWriteItem{Put: item}
+	}
+	if cw, ok := db.(ConditionalWriter); ok {
+		return cw.TransactWriteItemsIf(ctx, cond, tx)
+	}
+
+	table := db.Table()
+	in := dynamodb.TransactWriteItemsInput{TransactItems: make([]types.TransactWriteItem, len(tx))}
+	for i := range tx {
+		attrs, err := marshalItem(tx[i].Put)
+		if err != nil {
+			return err
+		}
+		put := &types.Put{Item: attrs, TableName: &table}
+		if i == 0 {
+			put.ConditionExpression = &cond.Expression
+			put.ExpressionAttributeNames = cond.Names
+			put.ExpressionAttributeValues = cond.Values
+		}
+		in.TransactItems[i] = types.TransactWriteItem{Put: put}
+	}
+	_, err := db.Client().TransactWriteItems(ctx, &in)
+	var cancelled *types.TransactionCanceledException
+	if errors.As(err, &cancelled) && len(cancelled.CancellationReasons) > 0 {
+		reason := cancelled.CancellationReasons[0]
+		if reason.Code != nil && *reason.Code == "ConditionalCheckFailed" {
+			return ErrConditionFailed
+		}
+	}
+	return err
+}
+
+// marshalItem converts an item to its DynamoDB representation, including its keys,
+// in the same way as the ddb client.
+func marshalItem(item ddb.Keyer) (map[string]types.AttributeValue, error) {
+	keys, err := item.DDBKeys()
+	if err != nil {
+		return nil, err
+	}
+	attrs, err := attributevalue.MarshalMap(item)
+	if err != nil {
+		return nil, err
+	}
+	v := reflect.ValueOf(keys)
+	for i := 0; i < v.NumField(); i++ {
+		if val := v.Field(i).String(); val != "" {
+			attrs[v.Type().Field(i).Name] = &types.AttributeValueMemberS{Value: val}
+		}
+	}
+	if et, ok := item.(ddb.EntityTyper); ok {
+		attrs["ddb:type"] = &types.AttributeValueMemberS{Value: et.EntityType()}
+	}
+	return attrs, nil
+}
@@ -0,0 +1,85 @@
+package dbupdate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	ahtypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// conditionRecorder records the condition and items of conditional transactions.
+type conditionRecorder struct {
+	ddb.Storage
+	cond Condition
+	tx   []ddb.Keyer
+}
+
+func (c *conditionRecorder) TransactWriteItemsIf(ctx context.Context, cond Condition, tx []ddb.TransactWriteItem) error {
+	c.cond = cond
+	for _, item := range tx {
+		c.tx = append(c.tx, item.Put)
+	}
+	return nil
+}
+
+func TestPutItemsIf(t *testing.T) {
+	request := access.Request{ID: "req_1", Status: access.DECLINED}
+	reviewer := access.Reviewer{ReviewerID: "usr_1", Request: request}
+	db := &conditionRecorder{Storage: ddbmock.New(t)}
+
+	err := PutItemsIf(context.Background(), db, RequestStatusIs(access.PENDING), &request, &reviewer)
+	assert.NoError(t, err)
+	// every item is written in the conditional transaction
+	assert.Equal(t, []ddb.Keyer{&request, &reviewer}, db.tx)
+	assert.Equal(t, "#status = :status", db.cond.Expression)
+	assert.Equal(t, &types.AttributeValueMemberS{Value: "PENDING"}, db.cond.Values[":status"])
+}
+
+func TestPutItemsIfTooManyItems(t *testing.T) {
+	items := make([]ddb.Keyer, maxTransactItems+1)
+	for i := range items {
+		items[i] = &access.Request{ID: "req_1"}
+	}
+	db := &conditionRecorder{Storage: ddbmock.New(t)}
+	err := PutItemsIf(context.Background(), db, RequestStatusIs(access.PENDING), items...)
+	assert.EqualError(t, err, "can't conditionally write 101 items, the maximum is 100")
+	assert.Empty(t, db.tx)
+}
+
+func TestGrantIsActiveUntil(t *testing.T) {
+	end := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+	cond, err := GrantIsActiveUntil(end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	request := access.Request{ID: "req_1", Grant: &access.Grant{Status: ahtypes.GrantStatusACTIVE, End: end}}
+	attrs, err := marshalItem(&request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the condition values must match the saved grant for the condition to hold.
+	grant := attrs["grant"].(*types.AttributeValueMemberM).Value
+	assert.Equal(t, grant["status"], cond.Values[":grantStatus"])
+	assert.Equal(t, grant["end"], cond.Values[":grantEnd"])
+}
+
+func TestMarshalItemIncludesKeys(t *testing.T) {
+	request := access.Request{ID: "req_1", Status: access.PENDING}
+	attrs, err := marshalItem(&request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys, err := request.DDBKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, &types.AttributeValueMemberS{Value: keys.PK}, attrs["PK"])
+	assert.Equal(t, &types.AttributeValueMemberS{Value: keys.SK}, attrs["SK"])
+	assert.Equal(t, &types.AttributeValueMemberS{Value: "PENDING"}, attrs["status"])
+}
@@ -0,0 +1,103 @@
+package dbupdate
+
+import (
+	"context"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/configchange"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/ddb"
+)
+
+// WithEvents adds the events to the outbox in the same write as the request.
+// The events are published by the outbox relay once the request has been saved,
+// so an event is never lost if the request update succeeds, and never published if it fails.
+// Items including events must be written with PutItems.
+func WithEvents(events ...gevent.EventTyper) func(*UpdateRequestOpts) {
+	return func(uro *UpdateRequestOpts) {
+		uro.Events = append(uro.Events, events...)
+	}
+}
+
+// OutboxEvents returns outbox items for the events, to be written with PutItems.
+func OutboxEvents(now time.Time, events ...gevent.EventTyper) ([]ddb.Keyer, error) {
+	var items []ddb.Keyer
+	for _, e := range events {
+		evt, err := gevent.NewEvent(e, now)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, &gevent.OutboxEvent{Event: evt, CreatedAt: now})
+	}
+	return items, nil
+}
+
+// PutItems writes the items to the database.
+// The first item, which is the request for items returned by GetUpdateRequestItems,
+// is written in a single transaction with any outbox events and configuration changes.
+// The remaining items are written first, so that the events are only published once all items have been saved.
+func PutItems(ctx context.Context, db ddb.Storage, items ...ddb.Keyer) error {
+	if len(items) == 0 {
+		return nil
+	}
+	tx := []ddb.TransactWriteItem{{Put: items[0]}}
+	var rest []ddb.Keyer
+	for _, item := range items[1:] {
+		if isTransactional(item) {
+			tx = append(tx, ddb.TransactWriteItem{Put: item})
+		} else {
+			rest = append(rest, item)
+		}
+	}
+	if len(tx) == 1 {
+		return db.PutBatch(ctx, items...)
+	}
+	if len(rest) > 0 {
+		err := db.PutBatch(ctx, rest...)
+		if err != nil {
+			return err
+		}
+	}
+	return db.TransactWriteItems(ctx, tx)
+}
+
+// DeleteItems deletes the items from the database, and writes any outbox events and configuration changes in puts.
+// The first item is deleted in a single transaction with the puts.
+// The remaining items are deleted first, so that the events are only published once all items have been deleted.
+func DeleteItems(ctx context.Context, db ddb.Storage, items []ddb.Keyer, puts ...ddb.Keyer) error {
+	if len(items) == 0 {
+		return db.PutBatch(ctx, puts...)
+	}
+	if len(puts) == 0 {
+		return db.DeleteBatch(ctx, items...)
+	}
+	if len(items) > 1 {
+		err := db.DeleteBatch(ctx, items[1:]...)
+		if err != nil {
+			return err
+		}
+	}
+	tx := []ddb.TransactWriteItem{{Delete: items[0]}}
+	for _, item := range puts {
+		tx = append(tx, ddb.TransactWriteItem{Put: item})
+	}
+	return db.TransactWriteItems(ctx, tx)
+}
+
+// ConfigChangeItems returns the change and its event, to be written with PutItems or DeleteItems.
+func ConfigChangeItems(c configchange.Change) ([]ddb.Keyer, error) {
+	events, err := OutboxEvents(c.CreatedAt, gevent.ConfigChanged{Change: c})
+	if err != nil {
+		return nil, err
+	}
+	return append([]ddb.Keyer{&c}, events...), nil
+}
+
+// isTransactional returns whether the item must be written in the same transaction as the item it relates to.
+func isTransactional(item ddb.Keyer) bool {
+	switch item.(type) {
+	case *gevent.OutboxEvent, *configchange.Change:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package dbupdate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/configchange"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/target"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// writeRecorder records the items written in batches and in transactions.
+type writeRecorder struct {
+	ddb.Storage
+	batch    []ddb.Keyer
+	tx       []ddb.Keyer
+	deleted  []ddb.Keyer
+	txDelete []ddb.Keyer
+}
+
+func (w *writeRecorder) DeleteBatch(ctx context.Context, items ...ddb.Keyer) error {
+	w.deleted = append(w.deleted, items...)
+	return nil
+}
+
+func (w *writeRecorder) PutBatch(ctx context.Context, items ...ddb.Keyer) error {
+	w.batch = append(w.batch, items...)
+	return nil
+}
+
+func (w *writeRecorder) TransactWriteItems(ctx context.Context, tx []ddb.TransactWriteItem) error {
+	for _, item := range tx {
+		if item.Delete != nil {
+			w.txDelete = append(w.txDelete, item.Delete)
+		} else {
+			w.tx = append(w.tx, item.Put)
+		}
+	}
+	return nil
+}
+
+func TestPutItemsWithEvents(t *testing.T) {
+	now := time.Date(2022, 1, 1, 9, 0, 0, 0, time.UTC)
+	request := access.Request{ID: "req_1", Status: access.CANCELLED, UpdatedAt: now}
+	reviewers := []access.Reviewer{{ReviewerID: "usr_1"}}
+
+	items, err := GetUpdateRequestItems(context.Background(), ddbmock.New(t), request, WithReviewers(reviewers), WithEvents(gevent.RequestCancelled{Request: request}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !assert.Len(t, items, 3) {
+		return
+	}
+	outboxed := items[2].(*gevent.OutboxEvent)
+	assert.Equal(t, gevent.RequestCancelledType, outboxed.Event.Type)
+	assert.Equal(t, now, outboxed.Event.Time)
+	assert.NotEmpty(t, outboxed.Event.ID)
+
+	db := &writeRecorder{Storage: ddbmock.New(t)}
+	err = PutItems(context.Background(), db, items...)
+	assert.NoError(t, err)
+	// the request and its events are written together, after the other items have been saved.
+	assert.Equal(t, []ddb.Keyer{items[0], items[2]}, db.tx)
+	assert.Equal(t, []ddb.Keyer{items[1]}, db.batch)
+}
+
+func TestPutItemsWithoutEvents(t *testing.T) {
+	request := access.Request{ID: "req_1"}
+	db := &writeRecorder{Storage: ddbmock.New(t)}
+	err := PutItems(context.Background(), db, &request)
+	assert.NoError(t, err)
+	assert.Empty(t, db.tx)
+	assert.Equal(t, []ddb.Keyer{&request}, db.batch)
+}
+
+func TestDeleteItemsWithConfigChange(t *testing.T) {
+	now := time.Date(2022, 1, 1, 9, 0, 0, 0, time.UTC)
+	group := target.Group{ID: "tg_1"}
+	route := target.Route{Group: "tg_1", Handler: "handler"}
+	change := configchange.Change{ID: "his_1", ResourceType: configchange.TargetGroup, ResourceID: "tg_1", Action: configchange.DELETED, CreatedAt: now}
+
+	changeItems, err := ConfigChangeItems(change)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !assert.Len(t, changeItems, 2) {
+		return
+	}
+	outboxed := changeItems[1].(*gevent.OutboxEvent)
+	assert.Equal(t, gevent.TargetGroupDeletedType, outboxed.Event.Type)
+
+	db := &writeRecorder{Storage: ddbmock.New(t)}
+	err = DeleteItems(context.Background(), db, []ddb.Keyer{&group, &route}, changeItems...)
+	assert.NoError(t, err)
+	// the group is deleted in the same transaction as the change is recorded, after the routes have been deleted.
+	assert.Equal(t, []ddb.Keyer{&route}, db.deleted)
+	assert.Equal(t, []ddb.Keyer{&group}, db.txDelete)
+	assert.Equal(t, changeItems, db.tx)
+}
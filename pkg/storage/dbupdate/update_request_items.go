@@ -4,12 +4,15 @@ import (
 	"context"
 
 	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
 	"github.com/common-fate/common-fate/pkg/storage"
 	"github.com/common-fate/ddb"
 )
 
 type UpdateRequestOpts struct {
 	Reviewers []access.Reviewer
+	// Events are written to the outbox with the request. See WithEvents.
+	Events []gevent.EventTyper
 }
 
 // WithReviewers allows reviewers to be passed in if they have already be fetched in a previous query
@@ -30,7 +33,8 @@ func GetUpdateRequestItems(ctx context.Context, db ddb.Storage, r access.Request
 	if o.Reviewers == nil {
 		rq := storage.ListRequestReviewers{RequestID: r.ID}
 		_, err := db.Query(ctx, &rq)
-		if err != nil {
+		// requests which were approved automatically don't have any reviewers.
+		if err != nil && err != ddb.ErrNoItems {
 			return nil, err
 		}
 		o.Reviewers = rq.Result
@@ -43,5 +47,9 @@ func GetUpdateRequestItems(ctx context.Context, db ddb.Storage, r access.Request
 		rvc.Request = r
 		items[1+i] = &rvc
 	}
-	return items, nil
+	outbox, err := OutboxEvents(r.UpdatedAt, o.Events...)
+	if err != nil {
+		return nil, err
+	}
+	return append(items, outbox...), nil
 }
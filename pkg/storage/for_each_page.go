@@ -0,0 +1,36 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/common-fate/ddb"
+)
+
+// ForEachPage runs the query for every page of results, calling fn after each page is loaded into the query.
+// fn reads the page from the query's result. If fn returns an error, no more pages are read and the error is returned.
+//
+// A query which returns no items isn't an error, fn is not called.
+func ForEachPage(ctx context.Context, db ddb.Storage, q ddb.QueryBuilder, fn func() error) error {
+	var next string
+	for {
+		var opts []func(*ddb.QueryOpts)
+		if next != "" {
+			opts = append(opts, ddb.Page(next))
+		}
+		res, err := db.Query(ctx, q, opts...)
+		if err == ddb.ErrNoItems {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		err = fn()
+		if err != nil {
+			return err
+		}
+		next = res.NextPage
+		if next == "" {
+			return nil
+		}
+	}
+}
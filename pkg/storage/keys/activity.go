@@ -0,0 +1,29 @@
+package keys
+
+const RequestActivityKey = "REQUEST_ACTIVITY#"
+
+type requestActivityKeys struct {
+	PK1        string
+	SK1        func(requestID string, timestamp string, source string, recordID string) string
+	SK1Request func(requestID string) string
+}
+
+var RequestActivity = requestActivityKeys{
+	PK1: RequestActivityKey,
+	SK1: func(requestID string, timestamp string, source string, recordID string) string {
+		return requestID + "#" + timestamp + "#" + source + "#" + recordID
+	},
+	SK1Request: func(requestID string) string { return requestID + "#" },
+}
+
+const ActivityCursorKey = "ACTIVITY_CURSOR#"
+
+type activityCursorKeys struct {
+	PK1 string
+	SK1 func(source string) string
+}
+
+var ActivityCursor = activityCursorKeys{
+	PK1: ActivityCursorKey,
+	SK1: func(source string) string { return source },
+}
@@ -0,0 +1,37 @@
+package keys
+
+import "fmt"
+
+const AuditLogKey = "AUDIT_LOG#"
+
+type auditLogKeys struct {
+	PK1 string
+	// SK1 zero-pads the sequence number so that entries sort in the order they were appended.
+	SK1          func(sequence int64) string
+	SK1EntriesTo string
+	SK1Head      string
+	SK1Exported  string
+}
+
+var AuditLog = auditLogKeys{
+	PK1: AuditLogKey,
+	SK1: func(sequence int64) string { return fmt.Sprintf("ENTRY#%020d", sequence) },
+	// SK1EntriesTo sorts after every entry, and is used as the upper bound when querying entries.
+	SK1EntriesTo: "ENTRY#~",
+	SK1Head:      "HEAD",
+	SK1Exported:  "EXPORTED",
+}
+
+const AuditLogEventKey = "AUDIT_LOG_EVENT#"
+
+type auditLogEventKeys struct {
+	PK1 string
+	SK1 func(eventID string) string
+}
+
+// AuditLogEvent records that an event has been appended to the audit log,
+// so that an event which is delivered more than once is only appended once.
+var AuditLogEvent = auditLogEventKeys{
+	PK1: AuditLogEventKey,
+	SK1: func(eventID string) string { return eventID },
+}
@@ -0,0 +1,19 @@
+package keys
+
+const BreakGlassReviewKey = "BREAK_GLASS_REVIEW#"
+
+type breakGlassReviewKeys struct {
+	PK1          string
+	SK1          func(requestID string) string
+	GSI1PK       string
+	GSI1SK       func(status string, requestID string) string
+	GSI1SKStatus func(status string) string
+}
+
+var BreakGlassReview = breakGlassReviewKeys{
+	PK1:          BreakGlassReviewKey,
+	SK1:          func(requestID string) string { return requestID },
+	GSI1PK:       BreakGlassReviewKey,
+	GSI1SK:       func(status string, requestID string) string { return status + "#" + requestID },
+	GSI1SKStatus: func(status string) string { return status + "#" },
+}
@@ -0,0 +1,23 @@
+package keys
+
+const ConfigChangeKey = "CONFIG_CHANGE#"
+
+type configChangeKeys struct {
+	PK1    string
+	SK1    func(createdAt string, changeID string) string
+	GSI1PK func(resourceType string, resourceID string) string
+	GSI1SK func(createdAt string, changeID string) string
+	GSI2PK func(resourceType string) string
+	GSI2SK func(createdAt string, changeID string) string
+}
+
+var ConfigChange = configChangeKeys{
+	PK1: ConfigChangeKey,
+	SK1: func(createdAt string, changeID string) string { return createdAt + "#" + changeID },
+	GSI1PK: func(resourceType string, resourceID string) string {
+		return ConfigChangeKey + resourceType + "#" + resourceID
+	},
+	GSI1SK: func(createdAt string, changeID string) string { return createdAt + "#" + changeID },
+	GSI2PK: func(resourceType string) string { return ConfigChangeKey + resourceType },
+	GSI2SK: func(createdAt string, changeID string) string { return createdAt + "#" + changeID },
+}
@@ -0,0 +1,15 @@
+package keys
+
+const DelegationKey = "DELEGATION#"
+
+type delegationKeys struct {
+	PK1     string
+	SK1     func(userID string, delegationID string) string
+	SK1User func(userID string) string
+}
+
+var Delegation = delegationKeys{
+	PK1:     DelegationKey,
+	SK1:     func(userID string, delegationID string) string { return userID + "#" + delegationID },
+	SK1User: func(userID string) string { return userID + "#" },
+}
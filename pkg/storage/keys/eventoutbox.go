@@ -0,0 +1,13 @@
+package keys
+
+const EventOutboxKey = "EVENT_OUTBOX#"
+
+type eventOutboxKeys struct {
+	PK1 string
+	SK1 func(createdAt string, eventID string) string
+}
+
+var EventOutbox = eventOutboxKeys{
+	PK1: EventOutboxKey,
+	SK1: func(createdAt string, eventID string) string { return createdAt + "#" + eventID },
+}
@@ -0,0 +1,15 @@
+package keys
+
+const RequestTicketKey = "REQUEST_TICKET#"
+
+type requestTicketKeys struct {
+	PK1          string
+	SK1          func(reference string, requestID string) string
+	SK1Reference func(reference string) string
+}
+
+var RequestTicket = requestTicketKeys{
+	PK1:          RequestTicketKey,
+	SK1:          func(reference string, requestID string) string { return reference + "#" + requestID },
+	SK1Reference: func(reference string) string { return reference + "#" },
+}
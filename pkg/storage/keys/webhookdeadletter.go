@@ -0,0 +1,17 @@
+package keys
+
+const WebhookDeadLetterKey = "WEBHOOK_DEAD_LETTER#"
+
+type webhookDeadLetterKeys struct {
+	PK1         string
+	SK1         func(endpointID string, createdAt string, eventID string) string
+	SK1Endpoint func(endpointID string) string
+}
+
+var WebhookDeadLetter = webhookDeadLetterKeys{
+	PK1: WebhookDeadLetterKey,
+	SK1: func(endpointID string, createdAt string, eventID string) string {
+		return endpointID + "#" + createdAt + "#" + eventID
+	},
+	SK1Endpoint: func(endpointID string) string { return endpointID + "#" },
+}
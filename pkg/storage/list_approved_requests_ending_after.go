@@ -0,0 +1,43 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+)
+
+// ListApprovedRequestsEndingAfter lists the approved requests which end at or after the given time.
+// See the access.Request.DDBKeys for a comment explaining what the end time represents for requests.
+// Requests with revoked grants are always returned, because their end time is recorded as the time the request was created.
+//
+// There is no index which orders requests by status and end time, so the end time is applied as a filter:
+// the query still reads every approved request and only returns the requests which match.
+// The cost of the query grows with the number of approved requests rather than the number which end after the given time.
+type ListApprovedRequestsEndingAfter struct {
+	EndingAfter time.Time
+	Result      []access.Request `ddb:"result"`
+}
+
+func (l *ListApprovedRequestsEndingAfter) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		// newest to oldest
+		ScanIndexForward:       aws.Bool(false),
+		IndexName:              aws.String(keys.IndexNames.GSI2),
+		KeyConditionExpression: aws.String("GSI2PK = :pk1"),
+		FilterExpression:       aws.String("GSI3SK >= :end OR grant.#status = :revoked"),
+		ExpressionAttributeNames: map[string]string{
+			"#status": "status",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk1":     &types.AttributeValueMemberS{Value: keys.AccessRequest.GSI2PK(string(access.APPROVED))},
+			":end":     &types.AttributeValueMemberS{Value: keys.AccessRequest.GSI3SK(l.EndingAfter)},
+			":revoked": &types.AttributeValueMemberS{Value: string(ahTypes.GrantStatusREVOKED)},
+		},
+	}
+	return &qi, nil
+}
@@ -0,0 +1,28 @@
+package storage
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/audit"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+)
+
+// ListAuditLogEntries lists the audit log entries after a sequence number, in the order they were appended.
+type ListAuditLogEntries struct {
+	AfterSequence int64
+	Result        []audit.Entry `ddb:"result"`
+}
+
+func (l *ListAuditLogEntries) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		ScanIndexForward:       aws.Bool(true),
+		KeyConditionExpression: aws.String("PK = :pk1 AND SK BETWEEN :from AND :to"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk1":  &types.AttributeValueMemberS{Value: keys.AuditLog.PK1},
+			":from": &types.AttributeValueMemberS{Value: keys.AuditLog.SK1(l.AfterSequence + 1)},
+			":to":   &types.AttributeValueMemberS{Value: keys.AuditLog.SK1EntriesTo},
+		},
+	}
+	return &qi, nil
+}
@@ -0,0 +1,26 @@
+package storage
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+)
+
+type ListBreakGlassReviewsForStatus struct {
+	Status access.BreakGlassReviewStatus
+	Result []access.BreakGlassReview `ddb:"result"`
+}
+
+func (l *ListBreakGlassReviewsForStatus) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		IndexName:              aws.String(keys.IndexNames.GSI1),
+		KeyConditionExpression: aws.String("GSI1PK = :pk1 and begins_with(GSI1SK, :sk1)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk1": &types.AttributeValueMemberS{Value: keys.BreakGlassReview.GSI1PK},
+			":sk1": &types.AttributeValueMemberS{Value: keys.BreakGlassReview.GSI1SKStatus(string(l.Status))},
+		},
+	}
+	return &qi, nil
+}
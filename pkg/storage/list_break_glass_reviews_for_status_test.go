@@ -0,0 +1,37 @@
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb/ddbtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListBreakGlassReviewsForStatus(t *testing.T) {
+	db := newTestingStorage(t)
+	now := time.Now().UTC().Truncate(time.Second)
+	open := access.BreakGlassReview{
+		RequestID:   types.NewRequestID(),
+		Rule:        types.NewAccessRuleID(),
+		RequestedBy: types.NewUserID(),
+		Reason:      "incident",
+		Approvers:   []string{types.NewUserID()},
+		Status:      access.BreakGlassReviewOpen,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	acknowledged := open
+	acknowledged.RequestID = types.NewRequestID()
+	acknowledged.Status = access.BreakGlassReviewAcknowledged
+	ddbtest.PutFixtures(t, db, []*access.BreakGlassReview{&open, &acknowledged})
+
+	q := &ListBreakGlassReviewsForStatus{Status: access.BreakGlassReviewOpen}
+	_, err := db.Query(context.TODO(), q)
+	assert.NoError(t, err)
+	assert.Contains(t, q.Result, open)
+	assert.NotContains(t, q.Result, acknowledged)
+}
@@ -0,0 +1,63 @@
+package storage
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/configchange"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+)
+
+// ListConfigChanges lists changes to all resources, newest first.
+type ListConfigChanges struct {
+	Result []configchange.Change `ddb:"result"`
+}
+
+func (l *ListConfigChanges) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		// newest to oldest
+		ScanIndexForward:       aws.Bool(false),
+		KeyConditionExpression: aws.String("PK = :pk1"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk1": &types.AttributeValueMemberS{Value: keys.ConfigChange.PK1},
+		},
+	}
+	return &qi, nil
+}
+
+// ListConfigChangesForResourceType lists changes to resources of a type, newest first.
+type ListConfigChangesForResourceType struct {
+	ResourceType configchange.ResourceType
+	Result       []configchange.Change `ddb:"result"`
+}
+
+func (l *ListConfigChangesForResourceType) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		IndexName:              &keys.IndexNames.GSI2,
+		ScanIndexForward:       aws.Bool(false),
+		KeyConditionExpression: aws.String("GSI2PK = :pk1"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk1": &types.AttributeValueMemberS{Value: keys.ConfigChange.GSI2PK(string(l.ResourceType))},
+		},
+	}
+	return &qi, nil
+}
+
+// ListConfigChangesForResource lists the changes to a single resource, newest first.
+type ListConfigChangesForResource struct {
+	ResourceType configchange.ResourceType
+	ResourceID   string
+	Result       []configchange.Change `ddb:"result"`
+}
+
+func (l *ListConfigChangesForResource) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		IndexName:              &keys.IndexNames.GSI1,
+		ScanIndexForward:       aws.Bool(false),
+		KeyConditionExpression: aws.String("GSI1PK = :pk1"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk1": &types.AttributeValueMemberS{Value: keys.ConfigChange.GSI1PK(string(l.ResourceType), l.ResourceID)},
+		},
+	}
+	return &qi, nil
+}
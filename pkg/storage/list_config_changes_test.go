@@ -0,0 +1,31 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/configchange"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb/ddbtest"
+)
+
+func TestListConfigChangesForResource(t *testing.T) {
+	s := newTestingStorage(t)
+
+	ruleID := types.NewAccessRuleID()
+	now := time.Now().UTC().Truncate(time.Second)
+	c1 := configchange.Change{ID: types.NewHistoryID(), ResourceType: configchange.AccessRule, ResourceID: ruleID, Action: configchange.CREATED, ActorID: "usr_1", Fields: []configchange.FieldChange{}, CreatedAt: now}
+	c2 := configchange.Change{ID: types.NewHistoryID(), ResourceType: configchange.AccessRule, ResourceID: ruleID, Action: configchange.UPDATED, ActorID: "usr_1", Reason: "widen access", Fields: []configchange.FieldChange{{Field: "name", Before: []byte(`"a"`), After: []byte(`"b"`)}}, CreatedAt: now.Add(time.Minute)}
+	other := configchange.Change{ID: types.NewHistoryID(), ResourceType: configchange.AccessRule, ResourceID: types.NewAccessRuleID(), Action: configchange.CREATED, Fields: []configchange.FieldChange{}, CreatedAt: now}
+	ddbtest.PutFixtures(t, s, []*configchange.Change{&c1, &c2, &other})
+
+	tc := []ddbtest.QueryTestCase{
+		{
+			Name:  "newest first",
+			Query: &ListConfigChangesForResource{ResourceType: configchange.AccessRule, ResourceID: ruleID},
+			Want:  &ListConfigChangesForResource{ResourceType: configchange.AccessRule, ResourceID: ruleID, Result: []configchange.Change{c2, c1}},
+		},
+	}
+
+	ddbtest.RunQueryTests(t, s, tc)
+}
@@ -0,0 +1,26 @@
+package storage
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+)
+
+// ListDelegationsForUser lists the delegations which a user has created for their approvals.
+type ListDelegationsForUser struct {
+	UserID string
+	Result []identity.Delegation `ddb:"result"`
+}
+
+func (l *ListDelegationsForUser) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		KeyConditionExpression: aws.String("PK = :pk1 and begins_with(SK, :sk1)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk1": &types.AttributeValueMemberS{Value: keys.Delegation.PK1},
+			":sk1": &types.AttributeValueMemberS{Value: keys.Delegation.SK1User(l.UserID)},
+		},
+	}
+	return &qi, nil
+}
@@ -0,0 +1,42 @@
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb/ddbtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListDelegationsForUser(t *testing.T) {
+	db := newTestingStorage(t)
+	now := time.Now().UTC().Truncate(time.Second)
+	userID := types.NewUserID()
+	d := identity.Delegation{
+		ID:         types.NewDelegationID(),
+		UserID:     userID,
+		DelegateID: types.NewUserID(),
+		StartTime:  now,
+		EndTime:    now.Add(time.Hour),
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	other := identity.Delegation{
+		ID:         types.NewDelegationID(),
+		UserID:     types.NewUserID(),
+		DelegateID: userID,
+		StartTime:  now,
+		EndTime:    now.Add(time.Hour),
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	ddbtest.PutFixtures(t, db, []*identity.Delegation{&d, &other})
+
+	q := &ListDelegationsForUser{UserID: userID}
+	_, err := db.Query(context.TODO(), q)
+	assert.NoError(t, err)
+	assert.Equal(t, []identity.Delegation{d}, q.Result)
+}
@@ -0,0 +1,25 @@
+package storage
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+)
+
+// ListOutboxEvents lists the events in the outbox, oldest first.
+type ListOutboxEvents struct {
+	Result []gevent.OutboxEvent `ddb:"result"`
+}
+
+func (l *ListOutboxEvents) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		ScanIndexForward:       aws.Bool(true),
+		KeyConditionExpression: aws.String("PK = :pk1"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk1": &types.AttributeValueMemberS{Value: keys.EventOutbox.PK1},
+		},
+	}
+	return &qi, nil
+}
@@ -0,0 +1,26 @@
+package storage
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/activity"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+)
+
+// ListRequestActivity lists the provider activity attributed to a request, oldest first.
+type ListRequestActivity struct {
+	RequestID string
+	Result    []activity.Event `ddb:"result"`
+}
+
+func (l *ListRequestActivity) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		KeyConditionExpression: aws.String("PK = :pk1 AND begins_with(SK, :sk1)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk1": &types.AttributeValueMemberS{Value: keys.RequestActivity.PK1},
+			":sk1": &types.AttributeValueMemberS{Value: keys.RequestActivity.SK1Request(l.RequestID)},
+		},
+	}
+	return &qi, nil
+}
@@ -0,0 +1,30 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/activity"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb/ddbtest"
+)
+
+func TestListRequestActivity(t *testing.T) {
+	s := newTestingStorage(t)
+
+	reqID := types.NewRequestID()
+	now := time.Now().UTC().Truncate(time.Second)
+	e1 := activity.Event{RequestID: reqID, ID: "evt_1", Source: "file", Action: "s3:GetObject", Timestamp: now, AttributedBy: activity.AttributedBySubject, CreatedAt: now}
+	e2 := activity.Event{RequestID: reqID, ID: "evt_2", Source: "file", Action: "s3:PutObject", Timestamp: now.Add(time.Minute), AttributedBy: activity.AttributedBySession, CreatedAt: now}
+	ddbtest.PutFixtures(t, s, []*activity.Event{&e2, &e1})
+
+	tc := []ddbtest.QueryTestCase{
+		{
+			Name:  "ok",
+			Query: &ListRequestActivity{RequestID: reqID},
+			Want:  &ListRequestActivity{RequestID: reqID, Result: []activity.Event{e1, e2}},
+		},
+	}
+
+	ddbtest.RunQueryTests(t, s, tc)
+}
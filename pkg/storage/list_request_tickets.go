@@ -0,0 +1,26 @@
+package storage
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+)
+
+// ListRequestTickets lists the requests which were made with a ticket reference.
+type ListRequestTickets struct {
+	Reference string
+	Result    []access.RequestTicket `ddb:"result"`
+}
+
+func (l *ListRequestTickets) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		KeyConditionExpression: aws.String("PK = :pk1 AND begins_with(SK, :sk1)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk1": &types.AttributeValueMemberS{Value: keys.RequestTicket.PK1},
+			":sk1": &types.AttributeValueMemberS{Value: keys.RequestTicket.SK1Reference(l.Reference)},
+		},
+	}
+	return &qi, nil
+}
@@ -0,0 +1,32 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb/ddbtest"
+)
+
+func TestListRequestTickets(t *testing.T) {
+	s := newTestingStorage(t)
+
+	// use a unique reference so that tickets from other test runs aren't returned
+	ref := "OPS-" + types.NewRequestID()
+	now := time.Now().UTC().Truncate(time.Second)
+	t1 := access.RequestTicket{Reference: ref, RequestID: types.NewRequestID(), CreatedAt: now}
+	// a reference which has the first reference as a prefix should not be returned
+	t2 := access.RequestTicket{Reference: ref + "1", RequestID: types.NewRequestID(), CreatedAt: now}
+	ddbtest.PutFixtures(t, s, []*access.RequestTicket{&t1, &t2})
+
+	tc := []ddbtest.QueryTestCase{
+		{
+			Name:  "ok",
+			Query: &ListRequestTickets{Reference: ref},
+			Want:  &ListRequestTickets{Reference: ref, Result: []access.RequestTicket{t1}},
+		},
+	}
+
+	ddbtest.RunQueryTests(t, s, tc)
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
 	"github.com/common-fate/common-fate/pkg/access"
 	"github.com/common-fate/common-fate/pkg/types"
 	"github.com/common-fate/ddb"
@@ -187,6 +188,33 @@ func TestListRequestsForStatus(t *testing.T) {
 		})
 	}
 }
+func TestListApprovedRequestsEndingAfter(t *testing.T) {
+	s := newTestingStorage(t)
+	now := time.Now().In(time.UTC)
+	// set up test fixture data.
+	active := exampleRequest()
+	active.Status = access.APPROVED
+	active.Grant = &access.Grant{Status: ahTypes.GrantStatusACTIVE, Start: now.Add(-time.Hour), End: now.Add(time.Hour)}
+	expired := exampleRequest()
+	expired.Status = access.APPROVED
+	expired.Grant = &access.Grant{Status: ahTypes.GrantStatusEXPIRED, Start: now.Add(-3 * time.Hour), End: now.Add(-2 * time.Hour)}
+	revoked := exampleRequest()
+	revoked.Status = access.APPROVED
+	revoked.CreatedAt = now.Add(-3 * time.Hour)
+	revoked.Grant = &access.Grant{Status: ahTypes.GrantStatusREVOKED, Start: now.Add(-3 * time.Hour), End: now.Add(time.Hour)}
+
+	ddbtest.PutFixtures(t, s, []access.Request{active, expired, revoked})
+
+	q := ListApprovedRequestsEndingAfter{EndingAfter: now.Add(-time.Hour)}
+	_, err := s.Query(context.Background(), &q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Contains(t, q.Result, active)
+	assert.Contains(t, q.Result, revoked)
+	assert.NotContains(t, q.Result, expired)
+}
+
 func TestListRequestsForUser(t *testing.T) {
 	s := newTestingStorage(t)
 	// set up test fixture data.
@@ -6,6 +6,7 @@ import (
 
 	"github.com/common-fate/apikit/logger"
 	"github.com/common-fate/ddb"
+	"github.com/common-fate/iso8601"
 	"github.com/common-fate/provider-registry-sdk-go/pkg/msg"
 
 	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
@@ -13,6 +14,7 @@ import (
 	"github.com/common-fate/common-fate/pkg/config"
 	"github.com/common-fate/common-fate/pkg/gevent"
 	"github.com/common-fate/common-fate/pkg/handler"
+	"github.com/common-fate/common-fate/pkg/rule"
 	"github.com/common-fate/common-fate/pkg/service/requestroutersvc"
 	"github.com/common-fate/common-fate/pkg/storage"
 	"github.com/pkg/errors"
@@ -31,6 +33,8 @@ type EventType string
 const (
 	ACTIVATE   EventType = "ACTIVATE"
 	DEACTIVATE EventType = "DEACTIVATE"
+	// EXTEND checks whether the grant has been extended before it is deactivated.
+	EXTEND EventType = "EXTEND"
 )
 
 type GrantState struct {
@@ -49,6 +53,10 @@ func (g *Granter) HandleRequest(ctx context.Context, in InputEvent) (GrantState,
 	log := logger.Get(ctx).With("grant.id", grant.ID)
 	log.Infow("Handling event", "event", in)
 
+	if in.Action == EXTEND {
+		return g.checkForExtension(ctx, in)
+	}
+
 	tgq := storage.GetTargetGroup{
 		ID: in.Grant.Provider,
 	}
@@ -74,6 +82,10 @@ func (g *Granter) HandleRequest(ctx context.Context, in InputEvent) (GrantState,
 	switch in.Action {
 	case ACTIVATE:
 		log.Infow("activating grant")
+		err = g.checkBlackout(ctx, grant)
+		if err != nil {
+			break
+		}
 		grantResponse, err = func() (out *msg.GrantResponse, err error) {
 			defer func() {
 				if r := recover(); r != nil {
@@ -169,3 +181,56 @@ func (g *Granter) HandleRequest(ctx context.Context, in InputEvent) (GrantState,
 	}
 	return out, nil
 }
+
+// checkBlackout returns an error if the grant starts inside a blackout of its access rule.
+// Blackouts are read from the current version of the rule, so that a change freeze declared after
+// a scheduled request was approved still prevents the grant from being activated.
+// Break-glass requests are used during incidents and are not held to blackouts.
+func (g *Granter) checkBlackout(ctx context.Context, grant ahTypes.Grant) error {
+	q := storage.GetRequest{ID: grant.ID}
+	_, err := g.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if q.Result.BreakGlass {
+		return nil
+	}
+	rq := storage.GetAccessRuleCurrent{ID: q.Result.Rule}
+	_, err = g.DB.Query(ctx, &rq)
+	if err == ddb.ErrNoItems {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if b, ok := rq.Result.InBlackout(grant.Start.Time); ok {
+		return fmt.Errorf("grant start falls inside the blackout from %s", rule.DescribeBlackout(*b))
+	}
+	return nil
+}
+
+// checkForExtension looks up the request for the grant and moves the end time of the grant
+// if the request has been extended. The workflow waits until the new end time before deactivating access.
+func (g *Granter) checkForExtension(ctx context.Context, in InputEvent) (GrantState, error) {
+	grant := in.Grant
+	log := logger.Get(ctx).With("grant.id", grant.ID)
+	out := GrantState{Grant: grant, State: in.State}
+
+	q := storage.GetRequest{ID: grant.ID}
+	_, err := g.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		log.Infow("request not found for grant, deactivating at the original end time")
+		return out, nil
+	}
+	if err != nil {
+		return GrantState{}, err
+	}
+	if q.Result.Grant != nil && q.Result.Grant.End.After(grant.End.Time) {
+		log.Infow("grant has been extended", "end", q.Result.Grant.End)
+		out.Grant.End = iso8601.New(q.Result.Grant.End)
+	}
+	return out, nil
+}
@@ -0,0 +1,36 @@
+// Package ticket validates the ticket references given on access requests, such as Jira issue keys or incident IDs.
+package ticket
+
+import (
+	"context"
+	"fmt"
+)
+
+// Input is the information about a request which is passed to a Validator.
+type Input struct {
+	Reference string `json:"reference"`
+	RuleID    string `json:"ruleId"`
+	// RequesterEmail is the email address of the user making the request.
+	RequesterEmail string `json:"requesterEmail"`
+}
+
+// Validator checks that a ticket reference refers to a real ticket, for example by looking it up in an issue tracker.
+type Validator interface {
+	// Validate returns an InvalidError if the ticket reference is not valid.
+	// Other errors mean that the ticket reference couldn't be checked.
+	Validate(ctx context.Context, in Input) error
+}
+
+// InvalidError is returned by a Validator if the ticket reference is not valid.
+type InvalidError struct {
+	Reference string
+	// Reason is optional and is shown to the user making the request.
+	Reason string
+}
+
+func (e InvalidError) Error() string {
+	if e.Reason == "" {
+		return fmt.Sprintf("ticket reference %s is not valid", e.Reference)
+	}
+	return fmt.Sprintf("ticket reference %s is not valid: %s", e.Reference, e.Reason)
+}
@@ -0,0 +1,64 @@
+package ticket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// DefaultWebhookTimeout is how long the WebhookValidator waits for a response if a Client isn't set.
+const DefaultWebhookTimeout = 10 * time.Second
+
+// WebhookValidator validates ticket references by sending the Input as JSON in a POST request to a URL.
+//
+// The endpoint should respond with a 2xx status if the ticket reference is valid, or a 4xx status if it is not.
+// A 4xx response can include a JSON body with a "message" field explaining why the ticket reference is invalid.
+// Any other response is treated as an error.
+type WebhookValidator struct {
+	URL string
+	// Client is optional. If nil, an http.Client with a DefaultWebhookTimeout timeout is used.
+	Client *http.Client
+}
+
+type webhookResponse struct {
+	Message string `json:"message"`
+}
+
+func (v *WebhookValidator) Validate(ctx context.Context, in Input) error {
+	body, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := v.Client
+	if client == nil {
+		client = &http.Client{Timeout: DefaultWebhookTimeout}
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("calling ticket validator: %w", err)
+	}
+	defer res.Body.Close()
+
+	switch {
+	case res.StatusCode >= 200 && res.StatusCode < 300:
+		return nil
+	case res.StatusCode >= 400 && res.StatusCode < 500:
+		var wr webhookResponse
+		// the message is optional, so a body which isn't JSON is ignored.
+		data, _ := io.ReadAll(io.LimitReader(res.Body, 4096))
+		_ = json.Unmarshal(data, &wr)
+		return InvalidError{Reference: in.Reference, Reason: wr.Message}
+	default:
+		return fmt.Errorf("ticket validator returned unexpected status %d", res.StatusCode)
+	}
+}
@@ -0,0 +1,69 @@
+package ticket
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookValidator(t *testing.T) {
+	type testcase struct {
+		name       string
+		giveStatus int
+		giveBody   string
+		wantErr    error
+	}
+
+	testcases := []testcase{
+		{
+			name:       "valid",
+			giveStatus: http.StatusOK,
+		},
+		{
+			name:       "invalid with message",
+			giveStatus: http.StatusNotFound,
+			giveBody:   `{"message": "issue does not exist"}`,
+			wantErr:    InvalidError{Reference: "OPS-123", Reason: "issue does not exist"},
+		},
+		{
+			name:       "invalid without message",
+			giveStatus: http.StatusUnprocessableEntity,
+			giveBody:   "not json",
+			wantErr:    InvalidError{Reference: "OPS-123"},
+		},
+		{
+			name:       "server error",
+			giveStatus: http.StatusInternalServerError,
+			wantErr:    errors.New("ticket validator returned unexpected status 500"),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got Input
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				err := json.NewDecoder(r.Body).Decode(&got)
+				assert.NoError(t, err)
+				w.WriteHeader(tc.giveStatus)
+				_, _ = w.Write([]byte(tc.giveBody))
+			}))
+			defer srv.Close()
+
+			in := Input{Reference: "OPS-123", RuleID: "rul_123", RequesterEmail: "alice@example.com"}
+			v := WebhookValidator{URL: srv.URL}
+			err := v.Validate(context.Background(), in)
+			assert.Equal(t, in, got)
+			if tc.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.wantErr.Error())
+		})
+	}
+}
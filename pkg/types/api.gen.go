@@ -39,8 +39,36 @@ const (
 
 // Defines values for ApprovalMethod.
 const (
-	AUTOMATIC ApprovalMethod = "AUTOMATIC"
-	REVIEWED  ApprovalMethod = "REVIEWED"
+	AUTOMATIC  ApprovalMethod = "AUTOMATIC"
+	BREAKGLASS ApprovalMethod = "BREAK_GLASS"
+	REVIEWED   ApprovalMethod = "REVIEWED"
+)
+
+// Defines values for BreakGlassReviewStatus.
+const (
+	ACKNOWLEDGED BreakGlassReviewStatus = "ACKNOWLEDGED"
+	FLAGGED      BreakGlassReviewStatus = "FLAGGED"
+	OPEN         BreakGlassReviewStatus = "OPEN"
+)
+
+// Defines values for ConfigChangeAction.
+const (
+	Archived  ConfigChangeAction = "archived"
+	Completed ConfigChangeAction = "completed"
+	Created   ConfigChangeAction = "created"
+	Deleted   ConfigChangeAction = "deleted"
+	Updated   ConfigChangeAction = "updated"
+)
+
+// Defines values for ConfigChangeResourceType.
+const (
+	ConfigChangeResourceTypeAccessrule      ConfigChangeResourceType = "accessrule"
+	ConfigChangeResourceTypeGroup           ConfigChangeResourceType = "group"
+	ConfigChangeResourceTypeHandler         ConfigChangeResourceType = "handler"
+	ConfigChangeResourceTypeProvidersetup   ConfigChangeResourceType = "providersetup"
+	ConfigChangeResourceTypeTargetgroup     ConfigChangeResourceType = "targetgroup"
+	ConfigChangeResourceTypeTargetgrouplink ConfigChangeResourceType = "targetgrouplink"
+	ConfigChangeResourceTypeUser            ConfigChangeResourceType = "user"
 )
 
 // Defines values for GrantStatus.
@@ -82,11 +110,29 @@ const (
 	ProviderSetupValidationStatusSUCCESS    ProviderSetupValidationStatus = "SUCCESS"
 )
 
+// Defines values for RecurrenceFrequency.
+const (
+	DAILY  RecurrenceFrequency = "DAILY"
+	WEEKLY RecurrenceFrequency = "WEEKLY"
+)
+
+// Defines values for RequestActivityAttribution.
+const (
+	SESSION RequestActivityAttribution = "SESSION"
+	SUBJECT RequestActivityAttribution = "SUBJECT"
+)
+
 // Defines values for RequestArgumentFormElement.
 const (
 	RequestArgumentFormElementSELECT RequestArgumentFormElement = "SELECT"
 )
 
+// Defines values for RequestEscalationAction.
+const (
+	AUTODECLINED RequestEscalationAction = "AUTO_DECLINED"
+	ESCALATED    RequestEscalationAction = "ESCALATED"
+)
+
 // Defines values for RequestEventFromGrantStatus.
 const (
 	RequestEventFromGrantStatusACTIVE  RequestEventFromGrantStatus = "ACTIVE"
@@ -105,6 +151,19 @@ const (
 	RequestEventToGrantStatusREVOKED RequestEventToGrantStatus = "REVOKED"
 )
 
+// Defines values for RequestExtensionStatus.
+const (
+	RequestExtensionStatusAPPROVED RequestExtensionStatus = "APPROVED"
+	RequestExtensionStatusDECLINED RequestExtensionStatus = "DECLINED"
+	RequestExtensionStatusPENDING  RequestExtensionStatus = "PENDING"
+)
+
+// Defines values for RequestGrantDriftKind.
+const (
+	MISSINGACCESS  RequestGrantDriftKind = "MISSING_ACCESS"
+	ORPHANEDACCESS RequestGrantDriftKind = "ORPHANED_ACCESS"
+)
+
 // Defines values for RequestStatus.
 const (
 	RequestStatusAPPROVED  RequestStatus = "APPROVED"
@@ -115,8 +174,8 @@ const (
 
 // Defines values for ReviewDecision.
 const (
-	APPROVED ReviewDecision = "APPROVED"
-	DECLINED ReviewDecision = "DECLINED"
+	ReviewDecisionAPPROVED ReviewDecision = "APPROVED"
+	ReviewDecisionDECLINED ReviewDecision = "DECLINED"
 )
 
 // Defines values for TargetArgumentRequestFormElement.
@@ -131,6 +190,17 @@ const (
 	TargetArgumentRuleFormElementSELECT      TargetArgumentRuleFormElement = "SELECT"
 )
 
+// Defines values for Weekday.
+const (
+	FR Weekday = "FR"
+	MO Weekday = "MO"
+	SA Weekday = "SA"
+	SU Weekday = "SU"
+	TH Weekday = "TH"
+	TU Weekday = "TU"
+	WE Weekday = "WE"
+)
+
 // Access Rule contains information for an end user to make a request for access.
 type AccessRule struct {
 	CreatedAt   time.Time `json:"createdAt"`
@@ -154,8 +224,11 @@ type AccessRule struct {
 // AccessRuleDetail contains detailed information about a rule and is used in administrative apis.
 type AccessRuleDetail struct {
 	// Approver config for access rules
-	Approval    ApproverConfig `json:"approval"`
-	Description string         `json:"description"`
+	Approval ApproverConfig `json:"approval"`
+
+	// Break-glass settings for an access rule. Permitted users can approve their own requests immediately during an incident, and the request is reviewed by the rule's approvers afterwards.
+	BreakGlass  *BreakGlassConfig `json:"breakGlass,omitempty"`
+	Description string            `json:"description"`
 
 	// The group IDs that the access rule applies to.
 	Groups    []string           `json:"groups"`
@@ -170,6 +243,9 @@ type AccessRuleDetail struct {
 	// A detailed target for an access rule
 	Target AccessRuleTargetDetail `json:"target"`
 
+	// Requires requests for an access rule to include a ticket reference, such as a Jira issue key or an incident ID.
+	TicketReference *TicketReferenceConfig `json:"ticketReference,omitempty"`
+
 	// Time configuration for an Access Rule.
 	TimeConstraints TimeConstraints `json:"timeConstraints"`
 
@@ -224,17 +300,133 @@ type AccessRuleTargetDetailArguments_Groupings struct {
 	AdditionalProperties map[string][]string `json:"-"`
 }
 
+// The days of the week and hours of the day that access can be active, in a given timezone.
+type ActiveWindow struct {
+	// The time of day that access must end by, in HH:MM format. Use 24:00 for the end of the day.
+	EndTime string `json:"endTime"`
+
+	// The time of day that access can become active from, in HH:MM format.
+	StartTime string `json:"startTime"`
+
+	// An IANA timezone name, such as Australia/Sydney.
+	Timezone string `json:"timezone"`
+
+	// The days of the week that access can be active. If empty, access can be active on any day.
+	Weekdays *[]Weekday `json:"weekdays,omitempty"`
+}
+
 // Describes whether a request has been approved automatically or from a review
 type ApprovalMethod string
 
+// Progress made towards an approval step of a request.
+type ApprovalProgress struct {
+	// The number of approvals the step has received.
+	Approvals int `json:"approvals"`
+
+	// The number of approvals required to complete the step.
+	RequiredApprovals int `json:"requiredApprovals"`
+
+	// The index of the approval step.
+	Step     int    `json:"step"`
+	StepName string `json:"stepName"`
+}
+
+// A single stage of a multi-stage approval workflow.
+type ApprovalStep struct {
+	// The group IDs whose members may approve this step.
+	Groups []string `json:"groups"`
+	Name   string   `json:"name"`
+
+	// The number of distinct approvals required to complete this step.
+	RequiredApprovals int `json:"requiredApprovals"`
+
+	// The user IDs of the approvers for this step.
+	Users []string `json:"users"`
+}
+
 // Approver config for access rules
 type ApproverConfig struct {
-	Groups *[]string `json:"groups,omitempty"`
+	// Escalation settings applied to requests which have not been reviewed in time.
+	Escalation *Escalation `json:"escalation,omitempty"`
+	Groups     *[]string   `json:"groups,omitempty"`
+
+	// Ordered approval steps. When steps are provided, every step must be satisfied in order before the request is approved, and the top level users and groups are ignored.
+	Steps *[]ApprovalStep `json:"steps,omitempty"`
 
 	// The user IDs of the approvers for the request.
 	Users *[]string `json:"users,omitempty"`
 }
 
+// A period when access can't be active.
+type Blackout struct {
+	End    time.Time `json:"end"`
+	Reason *string   `json:"reason,omitempty"`
+	Start  time.Time `json:"start"`
+}
+
+// Break-glass settings for an access rule. Permitted users can approve their own requests immediately during an incident, and the request is reviewed by the rule's approvers afterwards.
+type BreakGlassConfig struct {
+	// The group IDs whose members are permitted to use break-glass access.
+	Groups []string `json:"groups"`
+
+	// The user IDs of the users permitted to use break-glass access.
+	Users []string `json:"users"`
+}
+
+// A post-incident review of a request made using break-glass access.
+type BreakGlassReview struct {
+	AccessRuleId string    `json:"accessRuleId"`
+	Comment      *string   `json:"comment,omitempty"`
+	CreatedAt    time.Time `json:"createdAt"`
+	Reason       string    `json:"reason"`
+	RequestId    string    `json:"requestId"`
+	RequestedBy  string    `json:"requestedBy"`
+	ReviewedBy   *string   `json:"reviewedBy,omitempty"`
+
+	// The status of the post-incident review of a break-glass request.
+	Status    BreakGlassReviewStatus `json:"status"`
+	UpdatedAt time.Time              `json:"updatedAt"`
+}
+
+// The status of the post-incident review of a break-glass request.
+type BreakGlassReviewStatus string
+
+// A change made by an administrator to the configuration of Common Fate.
+type ConfigChange struct {
+	Action ConfigChangeAction `json:"action"`
+
+	// The ID of the user who made the change.
+	ActorId   string    `json:"actorId"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// The fields of the resource which were changed.
+	Fields []ConfigFieldChange `json:"fields"`
+	Id     string              `json:"id"`
+
+	// The reason given for the change, if any.
+	Reason     *string `json:"reason,omitempty"`
+	ResourceId string  `json:"resourceId"`
+
+	// The type of resource which was changed. User changes are changes to the internal groups a user is a member of.
+	ResourceType ConfigChangeResourceType `json:"resourceType"`
+}
+
+// ConfigChangeAction defines model for ConfigChange.Action.
+type ConfigChangeAction string
+
+// The type of resource which was changed. User changes are changes to the internal groups a user is a member of.
+type ConfigChangeResourceType string
+
+// A change to a field of a resource. Nested fields are separated by dots, such as approval.users.
+type ConfigFieldChange struct {
+	// The value of the field after the change. Omitted if the field isn't set.
+	After *interface{} `json:"after,omitempty"`
+
+	// The value of the field before the change. Omitted if the field wasn't set.
+	Before *interface{} `json:"before,omitempty"`
+	Field  string       `json:"field"`
+}
+
 // a request body for creating a Access Rule Target
 type CreateAccessRuleTarget struct {
 	ProviderId string                      `json:"providerId"`
@@ -265,6 +457,15 @@ type CreateRequestWith struct {
 // CreateRequestWithSubRequest defines model for CreateRequestWithSubRequest.
 type CreateRequestWithSubRequest = []CreateRequestWith
 
+// A delegation of a user's approvals to another user for a time window.
+type Delegation struct {
+	// The ID of the user who can review requests on behalf of the approver.
+	DelegateId string    `json:"delegateId"`
+	EndTime    time.Time `json:"endTime"`
+	Id         string    `json:"id"`
+	StartTime  time.Time `json:"startTime"`
+}
+
 // Diagnostic defines model for Diagnostic.
 type Diagnostic struct {
 	Code    string   `json:"code"`
@@ -272,6 +473,21 @@ type Diagnostic struct {
 	Message string   `json:"message"`
 }
 
+// Escalation settings applied to requests which have not been reviewed in time.
+type Escalation struct {
+	// The number of minutes a request may be pending before it is escalated.
+	AfterMinutes int `json:"afterMinutes"`
+
+	// If set, the request is automatically declined once it has been pending for this many minutes.
+	AutoDeclineAfterMinutes *int `json:"autoDeclineAfterMinutes,omitempty"`
+
+	// The group IDs whose members are added as reviewers when the request is escalated.
+	Groups []string `json:"groups"`
+
+	// The user IDs of the reviewers added when the request is escalated.
+	Users []string `json:"users"`
+}
+
 // Favorite defines model for Favorite.
 type Favorite struct {
 	Id     string `json:"id"`
@@ -431,6 +647,9 @@ type ProviderSetupValidation struct {
 // The status of the validation.
 type ProviderSetupValidationStatus string
 
+// How often a recurring request repeats.
+type RecurrenceFrequency string
+
 // A request to access something made by an end user in Common Fate.
 type Request struct {
 	AccessRuleId      string `json:"accessRuleId"`
@@ -439,30 +658,44 @@ type Request struct {
 	// Describes whether a request has been approved automatically or from a review
 	ApprovalMethod *ApprovalMethod `json:"approvalMethod,omitempty"`
 
+	// The most recent request to extend the grant of an access request.
+	Extension *RequestExtension `json:"extension,omitempty"`
+
 	// A temporary assignment of a user to a principal.
-	Grant       *Grant    `json:"grant,omitempty"`
-	ID          string    `json:"id"`
-	Reason      *string   `json:"reason,omitempty"`
-	RequestedAt time.Time `json:"requestedAt"`
-	Requestor   string    `json:"requestor"`
+	Grant  *Grant  `json:"grant,omitempty"`
+	ID     string  `json:"id"`
+	Reason *string `json:"reason,omitempty"`
+
+	// The ID of the recurring request which this request is an occurrence of.
+	RecurrenceOf *string   `json:"recurrenceOf,omitempty"`
+	RequestedAt  time.Time `json:"requestedAt"`
+	Requestor    string    `json:"requestor"`
 
 	// The status of an Access Request.
-	Status    RequestStatus `json:"status"`
-	Timing    RequestTiming `json:"timing"`
-	UpdatedAt time.Time     `json:"updatedAt"`
+	Status RequestStatus `json:"status"`
+
+	// The ticket reference given when the request was made.
+	TicketReference *string       `json:"ticketReference,omitempty"`
+	Timing          RequestTiming `json:"timing"`
+	UpdatedAt       time.Time     `json:"updatedAt"`
 }
 
 // Access Rule contains information for an end user to make a request for access.
 type RequestAccessRule struct {
-	CanRequest  bool   `json:"canRequest"`
-	Description string `json:"description"`
-	ID          string `json:"id"`
-	IsCurrent   bool   `json:"isCurrent"`
-	Name        string `json:"name"`
+	// Whether the user is permitted to use break-glass access for this rule.
+	CanBreakGlass bool   `json:"canBreakGlass"`
+	CanRequest    bool   `json:"canRequest"`
+	Description   string `json:"description"`
+	ID            string `json:"id"`
+	IsCurrent     bool   `json:"isCurrent"`
+	Name          string `json:"name"`
 
 	// A detailed target for an access rule request
 	Target RequestAccessRuleTarget `json:"target"`
 
+	// Requires requests for an access rule to include a ticket reference, such as a Jira issue key or an incident ID.
+	TicketReference *TicketReferenceConfig `json:"ticketReference,omitempty"`
+
 	// Time configuration for an Access Rule.
 	TimeConstraints TimeConstraints `json:"timeConstraints"`
 
@@ -484,6 +717,30 @@ type RequestAccessRuleTarget_Arguments struct {
 	AdditionalProperties map[string]RequestArgument `json:"-"`
 }
 
+// An action recorded in a provider audit log which was performed using the grant of an access request.
+type RequestActivity struct {
+	Account *string `json:"account,omitempty"`
+	Action  string  `json:"action"`
+
+	// How an audit log record was attributed to the grant of a request.
+	AttributedBy RequestActivityAttribution `json:"attributedBy"`
+
+	// The ID of the record in the source audit log.
+	Id        string  `json:"id"`
+	RequestId string  `json:"requestId"`
+	Resource  *string `json:"resource,omitempty"`
+	SessionId *string `json:"sessionId,omitempty"`
+
+	// The audit log the action was read from, e.g. aws-cloudtrail.
+	Source    string    `json:"source"`
+	SourceIp  *string   `json:"sourceIp,omitempty"`
+	Subject   string    `json:"subject"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// How an audit log record was attributed to the grant of a request.
+type RequestActivityAttribution string
+
 // RequestArgument defines model for RequestArgument.
 type RequestArgument struct {
 	Description *string                     `json:"description,omitempty"`
@@ -498,6 +755,27 @@ type RequestArgument struct {
 // RequestArgumentFormElement defines model for RequestArgument.FormElement.
 type RequestArgumentFormElement string
 
+// The auto-approval policy statement which approved a request or extension without a review.
+type RequestAutoApproval struct {
+	// Why the policy statement approved the request.
+	Justification string `json:"justification"`
+
+	// The ID of the policy statement which matched the request.
+	Statement string `json:"statement"`
+}
+
+// Break-glass usage of a request, or the outcome of its post-incident review.
+type RequestBreakGlass struct {
+	// The comment left by the approver who reviewed the break-glass request.
+	Comment *string `json:"comment,omitempty"`
+
+	// The reason given by the user when using break-glass access.
+	Reason *string `json:"reason,omitempty"`
+
+	// The status of the post-incident review of a break-glass request.
+	ReviewStatus BreakGlassReviewStatus `json:"reviewStatus"`
+}
+
 // A request to access something made by an end user in Common Fate.
 type RequestDetail struct {
 	// Access Rule contains information for an end user to make a request for access.
@@ -510,17 +788,26 @@ type RequestDetail struct {
 	// true if the requesting user is a reviewer of this request.
 	CanReview bool `json:"canReview"`
 
+	// The most recent request to extend the grant of an access request.
+	Extension *RequestExtension `json:"extension,omitempty"`
+
 	// A temporary assignment of a user to a principal.
-	Grant       *Grant    `json:"grant,omitempty"`
-	ID          string    `json:"id"`
-	Reason      *string   `json:"reason,omitempty"`
-	RequestedAt time.Time `json:"requestedAt"`
-	Requestor   string    `json:"requestor"`
+	Grant  *Grant  `json:"grant,omitempty"`
+	ID     string  `json:"id"`
+	Reason *string `json:"reason,omitempty"`
+
+	// The ID of the recurring request which this request is an occurrence of.
+	RecurrenceOf *string   `json:"recurrenceOf,omitempty"`
+	RequestedAt  time.Time `json:"requestedAt"`
+	Requestor    string    `json:"requestor"`
 
 	// The status of an Access Request.
-	Status    RequestStatus `json:"status"`
-	Timing    RequestTiming `json:"timing"`
-	UpdatedAt time.Time     `json:"updatedAt"`
+	Status RequestStatus `json:"status"`
+
+	// The ticket reference given when the request was made.
+	TicketReference *string       `json:"ticketReference,omitempty"`
+	Timing          RequestTiming `json:"timing"`
+	UpdatedAt       time.Time     `json:"updatedAt"`
 }
 
 // RequestDetail_Arguments defines model for RequestDetail.Arguments.
@@ -528,20 +815,49 @@ type RequestDetail_Arguments struct {
 	AdditionalProperties map[string]With `json:"-"`
 }
 
+// An escalation applied to a request which was not reviewed in time.
+type RequestEscalation struct {
+	Action RequestEscalationAction `json:"action"`
+
+	// The user IDs of the reviewers added to the request by the escalation.
+	AddedReviewers []string `json:"addedReviewers"`
+}
+
+// RequestEscalationAction defines model for RequestEscalation.Action.
+type RequestEscalationAction string
+
 // RequestEvent defines model for RequestEvent.
 type RequestEvent struct {
-	Actor     *string   `json:"actor,omitempty"`
-	CreatedAt time.Time `json:"createdAt"`
+	Actor *string `json:"actor,omitempty"`
+
+	// Progress made towards an approval step of a request.
+	ApprovalProgress *ApprovalProgress `json:"approvalProgress,omitempty"`
+
+	// The auto-approval policy statement which approved a request or extension without a review.
+	AutoApproval *RequestAutoApproval `json:"autoApproval,omitempty"`
+
+	// Break-glass usage of a request, or the outcome of its post-incident review.
+	BreakGlass *RequestBreakGlass `json:"breakGlass,omitempty"`
+	CreatedAt  time.Time          `json:"createdAt"`
+
+	// An escalation applied to a request which was not reviewed in time.
+	Escalation *RequestEscalation `json:"escalation,omitempty"`
 
 	// The current state of the grant.
 	FromGrantStatus *RequestEventFromGrantStatus `json:"fromGrantStatus,omitempty"`
 
 	// The status of an Access Request.
-	FromStatus         *RequestStatus `json:"fromStatus,omitempty"`
-	FromTiming         *RequestTiming `json:"fromTiming,omitempty"`
-	GrantCreated       *bool          `json:"grantCreated,omitempty"`
-	GrantFailureReason *string        `json:"grantFailureReason,omitempty"`
-	Id                 string         `json:"id"`
+	FromStatus   *RequestStatus `json:"fromStatus,omitempty"`
+	FromTiming   *RequestTiming `json:"fromTiming,omitempty"`
+	GrantCreated *bool          `json:"grantCreated,omitempty"`
+
+	// Drift detected between a grant and the access in the provider.
+	GrantDrift         *RequestGrantDrift `json:"grantDrift,omitempty"`
+	GrantFailureReason *string            `json:"grantFailureReason,omitempty"`
+	Id                 string             `json:"id"`
+
+	// The IDs of the approvers who delegated their review to the actor.
+	OnBehalfOf *[]string `json:"onBehalfOf,omitempty"`
 
 	// An event which was recorded relating to the grant.
 	RecordedEvent  *map[string]string `json:"recordedEvent,omitempty"`
@@ -562,6 +878,51 @@ type RequestEventFromGrantStatus string
 // The current state of the grant.
 type RequestEventToGrantStatus string
 
+// The most recent request to extend the grant of an access request.
+type RequestExtension struct {
+	// The number of seconds the grant is extended by.
+	ExtendBySeconds int       `json:"extendBySeconds"`
+	Reason          *string   `json:"reason,omitempty"`
+	RequestedAt     time.Time `json:"requestedAt"`
+
+	// The status of a request to extend an active grant.
+	Status    RequestExtensionStatus `json:"status"`
+	UpdatedAt time.Time              `json:"updatedAt"`
+}
+
+// The status of a request to extend an active grant.
+type RequestExtensionStatus string
+
+// Drift detected between a grant and the access in the provider.
+type RequestGrantDrift struct {
+	// Whether orphaned access was removed from the provider automatically.
+	AutoRevoked bool                  `json:"autoRevoked"`
+	Kind        RequestGrantDriftKind `json:"kind"`
+}
+
+// RequestGrantDriftKind defines model for RequestGrantDrift.Kind.
+type RequestGrantDriftKind string
+
+// Repeats a scheduled request, following a subset of the iCalendar RRULE format.
+// Each occurrence starts at the same time of day as the start time of the request and lasts for the duration of the request.
+// The request is approved once, and each occurrence is granted separately.
+type RequestRecurrence struct {
+	// The days of the week a weekly recurrence occurs on. Defaults to the weekday of the start time.
+	ByWeekday *[]Weekday `json:"byWeekday,omitempty"`
+
+	// How often a recurring request repeats.
+	Frequency RecurrenceFrequency `json:"frequency"`
+
+	// The number of days or weeks between occurrences. Defaults to 1.
+	Interval *int `json:"interval,omitempty"`
+
+	// The recurrence in iCalendar RRULE format.
+	Rrule *string `json:"rrule,omitempty"`
+
+	// No occurrences start at or after this time.
+	Until time.Time `json:"until"`
+}
+
 // The status of an Access Request.
 type RequestStatus string
 
@@ -569,6 +930,11 @@ type RequestStatus string
 type RequestTiming struct {
 	DurationSeconds int `json:"durationSeconds"`
 
+	// Repeats a scheduled request, following a subset of the iCalendar RRULE format.
+	// Each occurrence starts at the same time of day as the start time of the request and lasts for the duration of the request.
+	// The request is approved once, and each occurrence is granted separately.
+	Recurrence *RequestRecurrence `json:"recurrence,omitempty"`
+
 	// iso8601 timestamp in UTC timezone
 	StartTime *time.Time `json:"startTime,omitempty"`
 }
@@ -576,6 +942,23 @@ type RequestTiming struct {
 // A decision made on an Access Request.
 type ReviewDecision string
 
+// Access which a user holds in a provider without an active Common Fate grant.
+type StandingAccess struct {
+	ProviderId   string `json:"providerId"`
+	ProviderType string `json:"providerType"`
+
+	// The email address of the user who holds the access.
+	Subject string `json:"subject"`
+
+	// The provider arguments which grant the same access.
+	With StandingAccess_With `json:"with"`
+}
+
+// The provider arguments which grant the same access.
+type StandingAccess_With struct {
+	AdditionalProperties map[string]string `json:"-"`
+}
+
 // Handler represents a deployment of a provider.
 // Handlers can be linked to target groups via routes
 type TGHandler struct {
@@ -653,10 +1036,31 @@ type TargetSchema struct {
 	AdditionalProperties map[string]TargetArgument `json:"-"`
 }
 
+// Requires requests for an access rule to include a ticket reference, such as a Jira issue key or an incident ID.
+type TicketReferenceConfig struct {
+	// Describes the ticket reference to users making a request.
+	Description *string `json:"description,omitempty"`
+
+	// A regular expression which the ticket reference must match.
+	Pattern *string `json:"pattern,omitempty"`
+
+	// Check the ticket reference with the ticket validator configured for the deployment.
+	Validate *bool `json:"validate,omitempty"`
+}
+
 // Time configuration for an Access Rule.
 type TimeConstraints struct {
+	// The days of the week and hours of the day that access can be active, in a given timezone.
+	ActiveWindow *ActiveWindow `json:"activeWindow,omitempty"`
+
+	// Periods when access can't be active, such as change freezes.
+	Blackouts *[]Blackout `json:"blackouts,omitempty"`
+
 	// The maximum duration in seconds the access is allowed for.
 	MaxDurationSeconds int `json:"maxDurationSeconds"`
+
+	// The minimum number of seconds between a scheduled request being made and its access starting.
+	MinimumNoticeSeconds *int `json:"minimumNoticeSeconds,omitempty"`
 }
 
 // User defines model for User.
@@ -671,6 +1075,9 @@ type User struct {
 	UpdatedAt time.Time `json:"updatedAt"`
 }
 
+// A day of the week, using iCalendar RRULE day codes.
+type Weekday string
+
 // With defines model for With.
 type With struct {
 	FieldDescription  *string `json:"fieldDescription,omitempty"`
@@ -747,6 +1154,24 @@ type ListAccessRulesResponse struct {
 	Next        *string      `json:"next"`
 }
 
+// ListBreakGlassReviewsResponse defines model for ListBreakGlassReviewsResponse.
+type ListBreakGlassReviewsResponse struct {
+	Next    *string            `json:"next"`
+	Reviews []BreakGlassReview `json:"reviews"`
+}
+
+// ListConfigChangesResponse defines model for ListConfigChangesResponse.
+type ListConfigChangesResponse struct {
+	Changes []ConfigChange `json:"changes"`
+	Next    *string        `json:"next"`
+}
+
+// ListDelegationsResponse defines model for ListDelegationsResponse.
+type ListDelegationsResponse struct {
+	Delegations []Delegation `json:"delegations"`
+	Next        *string      `json:"next"`
+}
+
 // ListFavoritesResponse defines model for ListFavoritesResponse.
 type ListFavoritesResponse struct {
 	Favorites []Favorite `json:"favorites"`
@@ -770,6 +1195,12 @@ type ListProviderSetupsResponse struct {
 	ProviderSetups []ProviderSetup `json:"providerSetups"`
 }
 
+// ListRequestActivityResponse defines model for ListRequestActivityResponse.
+type ListRequestActivityResponse struct {
+	Activity []RequestActivity `json:"activity"`
+	Next     *string           `json:"next"`
+}
+
 // ListRequestEventsResponse defines model for ListRequestEventsResponse.
 type ListRequestEventsResponse struct {
 	Events []RequestEvent `json:"events"`
@@ -782,6 +1213,11 @@ type ListRequestsResponse struct {
 	Requests []Request `json:"requests"`
 }
 
+// ListStandingAccessResponse defines model for ListStandingAccessResponse.
+type ListStandingAccessResponse struct {
+	StandingAccess []StandingAccess `json:"standingAccess"`
+}
+
 // ListTargetGroupResponse defines model for ListTargetGroupResponse.
 type ListTargetGroupResponse struct {
 	Next         *string       `json:"next,omitempty"`
@@ -812,8 +1248,11 @@ type ReviewResponse struct {
 // CreateAccessRuleRequest defines model for CreateAccessRuleRequest.
 type CreateAccessRuleRequest struct {
 	// Approver config for access rules
-	Approval    ApproverConfig `json:"approval"`
-	Description string         `json:"description"`
+	Approval ApproverConfig `json:"approval"`
+
+	// Break-glass settings for an access rule. Permitted users can approve their own requests immediately during an incident, and the request is reviewed by the rule's approvers afterwards.
+	BreakGlass  *BreakGlassConfig `json:"breakGlass,omitempty"`
+	Description string            `json:"description"`
 
 	// The group IDs that the access rule applies to.
 	Groups []string `json:"groups"`
@@ -822,10 +1261,20 @@ type CreateAccessRuleRequest struct {
 	// a request body for creating a Access Rule Target
 	Target CreateAccessRuleTarget `json:"target"`
 
+	// Requires requests for an access rule to include a ticket reference, such as a Jira issue key or an incident ID.
+	TicketReference *TicketReferenceConfig `json:"ticketReference,omitempty"`
+
 	// Time configuration for an Access Rule.
 	TimeConstraints TimeConstraints `json:"timeConstraints"`
 }
 
+// CreateDelegationRequest defines model for CreateDelegationRequest.
+type CreateDelegationRequest struct {
+	DelegateId string    `json:"delegateId"`
+	EndTime    time.Time `json:"endTime"`
+	StartTime  time.Time `json:"startTime"`
+}
+
 // CreateFavoriteRequest defines model for CreateFavoriteRequest.
 type CreateFavoriteRequest struct {
 	AccessRuleId string                       `json:"accessRuleId"`
@@ -850,10 +1299,16 @@ type CreateProviderSetupRequest struct {
 
 // CreateRequestRequest defines model for CreateRequestRequest.
 type CreateRequestRequest struct {
-	AccessRuleId string                       `json:"accessRuleId"`
-	Reason       *string                      `json:"reason,omitempty"`
-	Timing       RequestTiming                `json:"timing"`
-	With         *CreateRequestWithSubRequest `json:"with,omitempty"`
+	AccessRuleId string `json:"accessRuleId"`
+
+	// Use break-glass access to approve the request immediately. A reason is required, and the request is reviewed by the rule's approvers afterwards.
+	BreakGlass *bool   `json:"breakGlass,omitempty"`
+	Reason     *string `json:"reason,omitempty"`
+
+	// A ticket reference, such as a Jira issue key or an incident ID. Required if the access rule has a ticket reference configured.
+	TicketReference *string                      `json:"ticketReference,omitempty"`
+	Timing          RequestTiming                `json:"timing"`
+	With            *CreateRequestWithSubRequest `json:"with,omitempty"`
 }
 
 // CreateTargetGroupLink defines model for CreateTargetGroupLink.
@@ -878,6 +1333,12 @@ type CreateUserRequest struct {
 	LastName  string              `json:"lastName"`
 }
 
+// ExtendRequestRequest defines model for ExtendRequestRequest.
+type ExtendRequestRequest struct {
+	ExtendBySeconds int     `json:"extendBySeconds"`
+	Reason          *string `json:"reason,omitempty"`
+}
+
 // ProviderSetupStepCompleteRequest defines model for ProviderSetupStepCompleteRequest.
 type ProviderSetupStepCompleteRequest struct {
 	// Whether the step is complete or not.
@@ -897,6 +1358,14 @@ type RegisterHandlerRequest struct {
 	Runtime string `json:"runtime"`
 }
 
+// ReviewBreakGlassRequest defines model for ReviewBreakGlassRequest.
+type ReviewBreakGlassRequest struct {
+	Comment *string `json:"comment,omitempty"`
+
+	// The status of the post-incident review of a break-glass request.
+	Status BreakGlassReviewStatus `json:"status"`
+}
+
 // ReviewRequest defines model for ReviewRequest.
 type ReviewRequest struct {
 	Comment *string `json:"comment,omitempty"`
@@ -906,6 +1375,12 @@ type ReviewRequest struct {
 	OverrideTiming *RequestTiming `json:"overrideTiming,omitempty"`
 }
 
+// ReviewRequestExtensionRequest defines model for ReviewRequestExtensionRequest.
+type ReviewRequestExtensionRequest struct {
+	// A decision made on an Access Request.
+	Decision ReviewDecision `json:"decision"`
+}
+
 // UserLookupAccessRuleParams defines parameters for UserLookupAccessRule.
 type UserLookupAccessRuleParams struct {
 	// the provider type i.e. commonfate/aws-sso. type should be encoded i.e.  backslash -> %2
@@ -933,6 +1408,17 @@ type AdminListAccessRulesParams struct {
 // AdminListAccessRulesParamsStatus defines parameters for AdminListAccessRules.
 type AdminListAccessRulesParamsStatus string
 
+// AdminListConfigChangesParams defines parameters for AdminListConfigChanges.
+type AdminListConfigChangesParams struct {
+	ResourceType *ConfigChangeResourceType `form:"resourceType,omitempty" json:"resourceType,omitempty"`
+
+	// requires resourceType to be set
+	ResourceId *string `form:"resourceId,omitempty" json:"resourceId,omitempty"`
+
+	// encrypted token containing pagination info
+	NextToken *string `form:"nextToken,omitempty" json:"nextToken,omitempty"`
+}
+
 // AdminListGroupsParams defines parameters for AdminListGroups.
 type AdminListGroupsParams struct {
 	// encrypted token containing pagination info
@@ -954,6 +1440,9 @@ type AdminListRequestsParams struct {
 	// omit this param to view all results
 	Status *AdminListRequestsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
 
+	// only return requests made with this ticket reference
+	TicketReference *string `form:"ticketReference,omitempty" json:"ticketReference,omitempty"`
+
 	// encrypted token containing pagination info
 	NextToken *string `form:"nextToken,omitempty" json:"nextToken,omitempty"`
 }
@@ -978,6 +1467,12 @@ type AdminUpdateUserJSONBody struct {
 	Groups []string `json:"groups"`
 }
 
+// UserListBreakGlassReviewsParams defines parameters for UserListBreakGlassReviews.
+type UserListBreakGlassReviewsParams struct {
+	// filter reviews by status
+	Status *BreakGlassReviewStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
 // UserListRequestsParams defines parameters for UserListRequests.
 type UserListRequestsParams struct {
 	// omit this param to view all results
@@ -1005,6 +1500,12 @@ type UserListRequestsUpcomingParams struct {
 	NextToken *string `form:"nextToken,omitempty" json:"nextToken,omitempty"`
 }
 
+// UserListRequestActivityParams defines parameters for UserListRequestActivity.
+type UserListRequestActivityParams struct {
+	// encrypted token containing pagination info
+	NextToken *string `form:"nextToken,omitempty" json:"nextToken,omitempty"`
+}
+
 // AdminCreateAccessRuleJSONRequestBody defines body for AdminCreateAccessRule for application/json ContentType.
 type AdminCreateAccessRuleJSONRequestBody CreateAccessRuleRequest
 
@@ -1038,6 +1539,12 @@ type AdminCreateUserJSONRequestBody CreateUserRequest
 // AdminUpdateUserJSONRequestBody defines body for AdminUpdateUser for application/json ContentType.
 type AdminUpdateUserJSONRequestBody AdminUpdateUserJSONBody
 
+// UserReviewBreakGlassRequestJSONRequestBody defines body for UserReviewBreakGlassRequest for application/json ContentType.
+type UserReviewBreakGlassRequestJSONRequestBody ReviewBreakGlassRequest
+
+// UserCreateDelegationJSONRequestBody defines body for UserCreateDelegation for application/json ContentType.
+type UserCreateDelegationJSONRequestBody CreateDelegationRequest
+
 // UserCreateFavoriteJSONRequestBody defines body for UserCreateFavorite for application/json ContentType.
 type UserCreateFavoriteJSONRequestBody CreateFavoriteRequest
 
@@ -1047,6 +1554,12 @@ type UserUpdateFavoriteJSONRequestBody CreateFavoriteRequest
 // UserCreateRequestJSONRequestBody defines body for UserCreateRequest for application/json ContentType.
 type UserCreateRequestJSONRequestBody CreateRequestRequest
 
+// UserExtendRequestJSONRequestBody defines body for UserExtendRequest for application/json ContentType.
+type UserExtendRequestJSONRequestBody ExtendRequestRequest
+
+// UserReviewRequestExtensionJSONRequestBody defines body for UserReviewRequestExtension for application/json ContentType.
+type UserReviewRequestExtensionJSONRequestBody ReviewRequestExtensionRequest
+
 // UserReviewRequestJSONRequestBody defines body for UserReviewRequest for application/json ContentType.
 type UserReviewRequestJSONRequestBody ReviewRequest
 
@@ -1421,6 +1934,59 @@ func (a RequestDetail_Arguments) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
+// Getter for additional properties for StandingAccess_With. Returns the specified
+// element and whether it was found
+func (a StandingAccess_With) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for StandingAccess_With
+func (a *StandingAccess_With) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for StandingAccess_With to handle AdditionalProperties
+func (a *StandingAccess_With) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for StandingAccess_With to handle AdditionalProperties
+func (a StandingAccess_With) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
 // Getter for additional properties for TargetArgument_Groups. Returns the specified
 // element and whether it was found
 func (a TargetArgument_Groups) Get(fieldName string) (value TargetArgumentGroup, found bool) {
@@ -1637,6 +2203,9 @@ type ClientInterface interface {
 	// AdminGetAccessRuleVersion request
 	AdminGetAccessRuleVersion(ctx context.Context, ruleId string, version string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// AdminListConfigChanges request
+	AdminListConfigChanges(ctx context.Context, params *AdminListConfigChangesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// AdminGetDeploymentVersion request
 	AdminGetDeploymentVersion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1728,6 +2297,9 @@ type ClientInterface interface {
 	// AdminGetRequest request
 	AdminGetRequest(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// AdminListStandingAccess request
+	AdminListStandingAccess(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// AdminListTargetGroups request
 	AdminListTargetGroups(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1766,6 +2338,25 @@ type ClientInterface interface {
 
 	AdminUpdateUser(ctx context.Context, userId string, body AdminUpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UserListBreakGlassReviews request
+	UserListBreakGlassReviews(ctx context.Context, params *UserListBreakGlassReviewsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserReviewBreakGlassRequest request with any body
+	UserReviewBreakGlassRequestWithBody(ctx context.Context, requestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserReviewBreakGlassRequest(ctx context.Context, requestId string, body UserReviewBreakGlassRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserListDelegations request
+	UserListDelegations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserCreateDelegation request with any body
+	UserCreateDelegationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserCreateDelegation(ctx context.Context, body UserCreateDelegationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserDeleteDelegation request
+	UserDeleteDelegation(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// UserListFavorites request
 	UserListFavorites(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1808,12 +2399,25 @@ type ClientInterface interface {
 	// UserGetAccessToken request
 	UserGetAccessToken(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UserListRequestActivity request
+	UserListRequestActivity(ctx context.Context, requestId string, params *UserListRequestActivityParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// UserCancelRequest request
 	UserCancelRequest(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// UserListRequestEvents request
 	UserListRequestEvents(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UserExtendRequest request with any body
+	UserExtendRequestWithBody(ctx context.Context, requestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserExtendRequest(ctx context.Context, requestId string, body UserExtendRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserReviewRequestExtension request with any body
+	UserReviewRequestExtensionWithBody(ctx context.Context, requestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserReviewRequestExtension(ctx context.Context, requestId string, body UserReviewRequestExtensionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// UserReviewRequest request with any body
 	UserReviewRequestWithBody(ctx context.Context, requestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1985,8 +2589,8 @@ func (c *Client) AdminGetAccessRuleVersion(ctx context.Context, ruleId string, v
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminGetDeploymentVersion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminGetDeploymentVersionRequest(c.Server)
+func (c *Client) AdminListConfigChanges(ctx context.Context, params *AdminListConfigChangesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminListConfigChangesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1997,8 +2601,8 @@ func (c *Client) AdminGetDeploymentVersion(ctx context.Context, reqEditors ...Re
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminListGroups(ctx context.Context, params *AdminListGroupsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminListGroupsRequest(c.Server, params)
+func (c *Client) AdminGetDeploymentVersion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminGetDeploymentVersionRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -2009,9 +2613,21 @@ func (c *Client) AdminListGroups(ctx context.Context, params *AdminListGroupsPar
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminCreateGroupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminCreateGroupRequestWithBody(c.Server, contentType, body)
-	if err != nil {
+func (c *Client) AdminListGroups(ctx context.Context, params *AdminListGroupsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminListGroupsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminCreateGroupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminCreateGroupRequestWithBody(c.Server, contentType, body)
+	if err != nil {
 		return nil, err
 	}
 	req = req.WithContext(ctx)
@@ -2369,6 +2985,18 @@ func (c *Client) AdminGetRequest(ctx context.Context, requestId string, reqEdito
 	return c.Client.Do(req)
 }
 
+func (c *Client) AdminListStandingAccess(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminListStandingAccessRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) AdminListTargetGroups(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewAdminListTargetGroupsRequest(c.Server)
 	if err != nil {
@@ -2537,6 +3165,90 @@ func (c *Client) AdminUpdateUser(ctx context.Context, userId string, body AdminU
 	return c.Client.Do(req)
 }
 
+func (c *Client) UserListBreakGlassReviews(ctx context.Context, params *UserListBreakGlassReviewsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserListBreakGlassReviewsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserReviewBreakGlassRequestWithBody(ctx context.Context, requestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserReviewBreakGlassRequestRequestWithBody(c.Server, requestId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserReviewBreakGlassRequest(ctx context.Context, requestId string, body UserReviewBreakGlassRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserReviewBreakGlassRequestRequest(c.Server, requestId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserListDelegations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserListDelegationsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserCreateDelegationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserCreateDelegationRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserCreateDelegation(ctx context.Context, body UserCreateDelegationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserCreateDelegationRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserDeleteDelegation(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserDeleteDelegationRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) UserListFavorites(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewUserListFavoritesRequest(c.Server)
 	if err != nil {
@@ -2717,6 +3429,18 @@ func (c *Client) UserGetAccessToken(ctx context.Context, requestId string, reqEd
 	return c.Client.Do(req)
 }
 
+func (c *Client) UserListRequestActivity(ctx context.Context, requestId string, params *UserListRequestActivityParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserListRequestActivityRequest(c.Server, requestId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) UserCancelRequest(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewUserCancelRequestRequest(c.Server, requestId)
 	if err != nil {
@@ -2741,6 +3465,54 @@ func (c *Client) UserListRequestEvents(ctx context.Context, requestId string, re
 	return c.Client.Do(req)
 }
 
+func (c *Client) UserExtendRequestWithBody(ctx context.Context, requestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserExtendRequestRequestWithBody(c.Server, requestId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserExtendRequest(ctx context.Context, requestId string, body UserExtendRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserExtendRequestRequest(c.Server, requestId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserReviewRequestExtensionWithBody(ctx context.Context, requestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserReviewRequestExtensionRequestWithBody(c.Server, requestId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserReviewRequestExtension(ctx context.Context, requestId string, body UserReviewRequestExtensionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserReviewRequestExtensionRequest(c.Server, requestId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) UserReviewRequestWithBody(ctx context.Context, requestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewUserReviewRequestRequestWithBody(c.Server, requestId, contentType, body)
 	if err != nil {
@@ -3268,6 +4040,85 @@ func NewAdminGetAccessRuleVersionRequest(server string, ruleId string, version s
 	return req, nil
 }
 
+// NewAdminListConfigChangesRequest generates requests for AdminListConfigChanges
+func NewAdminListConfigChangesRequest(server string, params *AdminListConfigChangesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/config-changes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.ResourceType != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "resourceType", runtime.ParamLocationQuery, *params.ResourceType); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.ResourceId != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "resourceId", runtime.ParamLocationQuery, *params.ResourceId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.NextToken != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "nextToken", runtime.ParamLocationQuery, *params.NextToken); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewAdminGetDeploymentVersionRequest generates requests for AdminGetDeploymentVersion
 func NewAdminGetDeploymentVersionRequest(server string) (*http.Request, error) {
 	var err error
@@ -4213,6 +5064,22 @@ func NewAdminListRequestsRequest(server string, params *AdminListRequestsParams)
 
 	}
 
+	if params.TicketReference != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ticketReference", runtime.ParamLocationQuery, *params.TicketReference); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
 	if params.NextToken != nil {
 
 		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "nextToken", runtime.ParamLocationQuery, *params.NextToken); err != nil {
@@ -4273,6 +5140,33 @@ func NewAdminGetRequestRequest(server string, requestId string) (*http.Request,
 	return req, nil
 }
 
+// NewAdminListStandingAccessRequest generates requests for AdminListStandingAccess
+func NewAdminListStandingAccessRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/standing-access")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewAdminListTargetGroupsRequest generates requests for AdminListTargetGroups
 func NewAdminListTargetGroupsRequest(server string) (*http.Request, error) {
 	var err error
@@ -4685,7 +5579,202 @@ func NewAdminUpdateUserRequestWithBody(server string, userId string, contentType
 	return req, nil
 }
 
-// NewUserListFavoritesRequest generates requests for UserListFavorites
+// NewUserListBreakGlassReviewsRequest generates requests for UserListBreakGlassReviews
+func NewUserListBreakGlassReviewsRequest(server string, params *UserListBreakGlassReviewsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/break-glass-reviews")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Status != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserReviewBreakGlassRequestRequest calls the generic UserReviewBreakGlassRequest builder with application/json body
+func NewUserReviewBreakGlassRequestRequest(server string, requestId string, body UserReviewBreakGlassRequestJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserReviewBreakGlassRequestRequestWithBody(server, requestId, "application/json", bodyReader)
+}
+
+// NewUserReviewBreakGlassRequestRequestWithBody generates requests for UserReviewBreakGlassRequest with any type of body
+func NewUserReviewBreakGlassRequestRequestWithBody(server string, requestId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "requestId", runtime.ParamLocationPath, requestId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/break-glass-reviews/%s/review", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserListDelegationsRequest generates requests for UserListDelegations
+func NewUserListDelegationsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/delegations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserCreateDelegationRequest calls the generic UserCreateDelegation builder with application/json body
+func NewUserCreateDelegationRequest(server string, body UserCreateDelegationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserCreateDelegationRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUserCreateDelegationRequestWithBody generates requests for UserCreateDelegation with any type of body
+func NewUserCreateDelegationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/delegations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserDeleteDelegationRequest generates requests for UserDeleteDelegation
+func NewUserDeleteDelegationRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/delegations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserListFavoritesRequest generates requests for UserListFavorites
 func NewUserListFavoritesRequest(server string) (*http.Request, error) {
 	var err error
 
@@ -5182,6 +6271,60 @@ func NewUserGetAccessTokenRequest(server string, requestId string) (*http.Reques
 	return req, nil
 }
 
+// NewUserListRequestActivityRequest generates requests for UserListRequestActivity
+func NewUserListRequestActivityRequest(server string, requestId string, params *UserListRequestActivityParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "requestId", runtime.ParamLocationPath, requestId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/requests/%s/activity", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.NextToken != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "nextToken", runtime.ParamLocationQuery, *params.NextToken); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewUserCancelRequestRequest generates requests for UserCancelRequest
 func NewUserCancelRequestRequest(server string, requestId string) (*http.Request, error) {
 	var err error
@@ -5250,19 +6393,19 @@ func NewUserListRequestEventsRequest(server string, requestId string) (*http.Req
 	return req, nil
 }
 
-// NewUserReviewRequestRequest calls the generic UserReviewRequest builder with application/json body
-func NewUserReviewRequestRequest(server string, requestId string, body UserReviewRequestJSONRequestBody) (*http.Request, error) {
+// NewUserExtendRequestRequest calls the generic UserExtendRequest builder with application/json body
+func NewUserExtendRequestRequest(server string, requestId string, body UserExtendRequestJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUserReviewRequestRequestWithBody(server, requestId, "application/json", bodyReader)
+	return NewUserExtendRequestRequestWithBody(server, requestId, "application/json", bodyReader)
 }
 
-// NewUserReviewRequestRequestWithBody generates requests for UserReviewRequest with any type of body
-func NewUserReviewRequestRequestWithBody(server string, requestId string, contentType string, body io.Reader) (*http.Request, error) {
+// NewUserExtendRequestRequestWithBody generates requests for UserExtendRequest with any type of body
+func NewUserExtendRequestRequestWithBody(server string, requestId string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5277,7 +6420,7 @@ func NewUserReviewRequestRequestWithBody(server string, requestId string, conten
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/requests/%s/review", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/requests/%s/extend", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5297,13 +6440,24 @@ func NewUserReviewRequestRequestWithBody(server string, requestId string, conten
 	return req, nil
 }
 
-// NewUserRevokeRequestRequest generates requests for UserRevokeRequest
-func NewUserRevokeRequestRequest(server string, requestid string) (*http.Request, error) {
+// NewUserReviewRequestExtensionRequest calls the generic UserReviewRequestExtension builder with application/json body
+func NewUserReviewRequestExtensionRequest(server string, requestId string, body UserReviewRequestExtensionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserReviewRequestExtensionRequestWithBody(server, requestId, "application/json", bodyReader)
+}
+
+// NewUserReviewRequestExtensionRequestWithBody generates requests for UserReviewRequestExtension with any type of body
+func NewUserReviewRequestExtensionRequestWithBody(server string, requestId string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "requestid", runtime.ParamLocationPath, requestid)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "requestId", runtime.ParamLocationPath, requestId)
 	if err != nil {
 		return nil, err
 	}
@@ -5313,7 +6467,7 @@ func NewUserRevokeRequestRequest(server string, requestid string) (*http.Request
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/requests/%s/revoke", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/requests/%s/extension/review", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5323,21 +6477,104 @@ func NewUserRevokeRequestRequest(server string, requestid string) (*http.Request
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewUserGetMeRequest generates requests for UserGetMe
-func NewUserGetMeRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+// NewUserReviewRequestRequest calls the generic UserReviewRequest builder with application/json body
+func NewUserReviewRequestRequest(server string, requestId string, body UserReviewRequestJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserReviewRequestRequestWithBody(server, requestId, "application/json", bodyReader)
+}
+
+// NewUserReviewRequestRequestWithBody generates requests for UserReviewRequest with any type of body
+func NewUserReviewRequestRequestWithBody(server string, requestId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "requestId", runtime.ParamLocationPath, requestId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/requests/%s/review", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserRevokeRequestRequest generates requests for UserRevokeRequest
+func NewUserRevokeRequestRequest(server string, requestid string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "requestid", runtime.ParamLocationPath, requestid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/requests/%s/revoke", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserGetMeRequest generates requests for UserGetMe
+func NewUserGetMeRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
 	operationPath := fmt.Sprintf("/api/v1/users/me")
@@ -5472,6 +6709,9 @@ type ClientWithResponsesInterface interface {
 	// AdminGetAccessRuleVersion request
 	AdminGetAccessRuleVersionWithResponse(ctx context.Context, ruleId string, version string, reqEditors ...RequestEditorFn) (*AdminGetAccessRuleVersionResponse, error)
 
+	// AdminListConfigChanges request
+	AdminListConfigChangesWithResponse(ctx context.Context, params *AdminListConfigChangesParams, reqEditors ...RequestEditorFn) (*AdminListConfigChangesResponse, error)
+
 	// AdminGetDeploymentVersion request
 	AdminGetDeploymentVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminGetDeploymentVersionResponse, error)
 
@@ -5563,6 +6803,9 @@ type ClientWithResponsesInterface interface {
 	// AdminGetRequest request
 	AdminGetRequestWithResponse(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*AdminGetRequestResponse, error)
 
+	// AdminListStandingAccess request
+	AdminListStandingAccessWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListStandingAccessResponse, error)
+
 	// AdminListTargetGroups request
 	AdminListTargetGroupsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListTargetGroupsResponse, error)
 
@@ -5601,6 +6844,25 @@ type ClientWithResponsesInterface interface {
 
 	AdminUpdateUserWithResponse(ctx context.Context, userId string, body AdminUpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminUpdateUserResponse, error)
 
+	// UserListBreakGlassReviews request
+	UserListBreakGlassReviewsWithResponse(ctx context.Context, params *UserListBreakGlassReviewsParams, reqEditors ...RequestEditorFn) (*UserListBreakGlassReviewsResponse, error)
+
+	// UserReviewBreakGlassRequest request with any body
+	UserReviewBreakGlassRequestWithBodyWithResponse(ctx context.Context, requestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserReviewBreakGlassRequestResponse, error)
+
+	UserReviewBreakGlassRequestWithResponse(ctx context.Context, requestId string, body UserReviewBreakGlassRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*UserReviewBreakGlassRequestResponse, error)
+
+	// UserListDelegations request
+	UserListDelegationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListDelegationsResponse, error)
+
+	// UserCreateDelegation request with any body
+	UserCreateDelegationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserCreateDelegationResponse, error)
+
+	UserCreateDelegationWithResponse(ctx context.Context, body UserCreateDelegationJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCreateDelegationResponse, error)
+
+	// UserDeleteDelegation request
+	UserDeleteDelegationWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*UserDeleteDelegationResponse, error)
+
 	// UserListFavorites request
 	UserListFavoritesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListFavoritesResponse, error)
 
@@ -5643,12 +6905,25 @@ type ClientWithResponsesInterface interface {
 	// UserGetAccessToken request
 	UserGetAccessTokenWithResponse(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*UserGetAccessTokenResponse, error)
 
+	// UserListRequestActivity request
+	UserListRequestActivityWithResponse(ctx context.Context, requestId string, params *UserListRequestActivityParams, reqEditors ...RequestEditorFn) (*UserListRequestActivityResponse, error)
+
 	// UserCancelRequest request
 	UserCancelRequestWithResponse(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*UserCancelRequestResponse, error)
 
 	// UserListRequestEvents request
 	UserListRequestEventsWithResponse(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*UserListRequestEventsResponse, error)
 
+	// UserExtendRequest request with any body
+	UserExtendRequestWithBodyWithResponse(ctx context.Context, requestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserExtendRequestResponse, error)
+
+	UserExtendRequestWithResponse(ctx context.Context, requestId string, body UserExtendRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*UserExtendRequestResponse, error)
+
+	// UserReviewRequestExtension request with any body
+	UserReviewRequestExtensionWithBodyWithResponse(ctx context.Context, requestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserReviewRequestExtensionResponse, error)
+
+	UserReviewRequestExtensionWithResponse(ctx context.Context, requestId string, body UserReviewRequestExtensionJSONRequestBody, reqEditors ...RequestEditorFn) (*UserReviewRequestExtensionResponse, error)
+
 	// UserReviewRequest request with any body
 	UserReviewRequestWithBodyWithResponse(ctx context.Context, requestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserReviewRequestResponse, error)
 
@@ -5960,6 +7235,40 @@ func (r AdminGetAccessRuleVersionResponse) StatusCode() int {
 	return 0
 }
 
+type AdminListConfigChangesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Changes []ConfigChange `json:"changes"`
+		Next    *string        `json:"next"`
+	}
+	JSON400 *struct {
+		Error string `json:"error"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminListConfigChangesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminListConfigChangesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type AdminGetDeploymentVersionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -6676,6 +7985,36 @@ func (r AdminGetRequestResponse) StatusCode() int {
 	return 0
 }
 
+type AdminListStandingAccessResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		StandingAccess []StandingAccess `json:"standingAccess"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminListStandingAccessResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminListStandingAccessResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type AdminListTargetGroupsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -6987,12 +8326,12 @@ func (r AdminUpdateUserResponse) StatusCode() int {
 	return 0
 }
 
-type UserListFavoritesResponse struct {
+type UserListBreakGlassReviewsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *struct {
-		Favorites []Favorite `json:"favorites"`
-		Next      *string    `json:"next"`
+		Next    *string            `json:"next"`
+		Reviews []BreakGlassReview `json:"reviews"`
 	}
 	JSON500 *struct {
 		Error string `json:"error"`
@@ -7000,7 +8339,7 @@ type UserListFavoritesResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r UserListFavoritesResponse) Status() string {
+func (r UserListBreakGlassReviewsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7008,27 +8347,33 @@ func (r UserListFavoritesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UserListFavoritesResponse) StatusCode() int {
+func (r UserListBreakGlassReviewsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UserCreateFavoriteResponse struct {
+type UserReviewBreakGlassRequestResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *FavoriteDetail
+	JSON200      *BreakGlassReview
 	JSON400      *struct {
 		Error string `json:"error"`
 	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
 	JSON500 *struct {
 		Error string `json:"error"`
 	}
 }
 
 // Status returns HTTPResponse.Status
-func (r UserCreateFavoriteResponse) Status() string {
+func (r UserReviewBreakGlassRequestResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7036,20 +8381,27 @@ func (r UserCreateFavoriteResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UserCreateFavoriteResponse) StatusCode() int {
+func (r UserReviewBreakGlassRequestResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UserDeleteFavoriteResponse struct {
+type UserListDelegationsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *struct {
+		Delegations []Delegation `json:"delegations"`
+		Next        *string      `json:"next"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
 }
 
 // Status returns HTTPResponse.Status
-func (r UserDeleteFavoriteResponse) Status() string {
+func (r UserListDelegationsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7057,18 +8409,18 @@ func (r UserDeleteFavoriteResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UserDeleteFavoriteResponse) StatusCode() int {
+func (r UserListDelegationsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UserGetFavoriteResponse struct {
+type UserCreateDelegationResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *FavoriteDetail
-	JSON404      *struct {
+	JSON201      *Delegation
+	JSON400      *struct {
 		Error string `json:"error"`
 	}
 	JSON500 *struct {
@@ -7077,7 +8429,7 @@ type UserGetFavoriteResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r UserGetFavoriteResponse) Status() string {
+func (r UserCreateDelegationResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7085,21 +8437,26 @@ func (r UserGetFavoriteResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UserGetFavoriteResponse) StatusCode() int {
+func (r UserCreateDelegationResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UserUpdateFavoriteResponse struct {
+type UserDeleteDelegationResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *FavoriteDetail
+	JSON404      *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
 }
 
 // Status returns HTTPResponse.Status
-func (r UserUpdateFavoriteResponse) Status() string {
+func (r UserDeleteDelegationResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7107,24 +8464,27 @@ func (r UserUpdateFavoriteResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UserUpdateFavoriteResponse) StatusCode() int {
+func (r UserDeleteDelegationResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UserListRequestsResponse struct {
+type UserListFavoritesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *struct {
-		Next     *string   `json:"next"`
-		Requests []Request `json:"requests"`
+		Favorites []Favorite `json:"favorites"`
+		Next      *string    `json:"next"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
 	}
 }
 
 // Status returns HTTPResponse.Status
-func (r UserListRequestsResponse) Status() string {
+func (r UserListFavoritesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7132,23 +8492,27 @@ func (r UserListRequestsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UserListRequestsResponse) StatusCode() int {
+func (r UserListFavoritesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UserCreateRequestResponse struct {
+type UserCreateFavoriteResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *struct {
-		Requests []Request `json:"requests"`
+	JSON201      *FavoriteDetail
+	JSON400      *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
 	}
 }
 
 // Status returns HTTPResponse.Status
-func (r UserCreateRequestResponse) Status() string {
+func (r UserCreateFavoriteResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7156,24 +8520,20 @@ func (r UserCreateRequestResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UserCreateRequestResponse) StatusCode() int {
+func (r UserCreateFavoriteResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UserListRequestsPastResponse struct {
+type UserDeleteFavoriteResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *struct {
-		Next     *string   `json:"next"`
-		Requests []Request `json:"requests"`
-	}
 }
 
 // Status returns HTTPResponse.Status
-func (r UserListRequestsPastResponse) Status() string {
+func (r UserDeleteFavoriteResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7181,24 +8541,27 @@ func (r UserListRequestsPastResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UserListRequestsPastResponse) StatusCode() int {
+func (r UserDeleteFavoriteResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UserListRequestsUpcomingResponse struct {
+type UserGetFavoriteResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *struct {
-		Next     *string   `json:"next"`
-		Requests []Request `json:"requests"`
+	JSON200      *FavoriteDetail
+	JSON404      *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
 	}
 }
 
 // Status returns HTTPResponse.Status
-func (r UserListRequestsUpcomingResponse) Status() string {
+func (r UserGetFavoriteResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7206,22 +8569,143 @@ func (r UserListRequestsUpcomingResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UserListRequestsUpcomingResponse) StatusCode() int {
+func (r UserGetFavoriteResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UserGetRequestResponse struct {
+type UserUpdateFavoriteResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *RequestDetail
-	JSON404      *struct {
-		Error string `json:"error"`
-	}
-	JSON500 *struct {
-		Error string `json:"error"`
+	JSON200      *FavoriteDetail
+}
+
+// Status returns HTTPResponse.Status
+func (r UserUpdateFavoriteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserUpdateFavoriteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserListRequestsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Next     *string   `json:"next"`
+		Requests []Request `json:"requests"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserListRequestsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserListRequestsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserCreateRequestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Requests []Request `json:"requests"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserCreateRequestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserCreateRequestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserListRequestsPastResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Next     *string   `json:"next"`
+		Requests []Request `json:"requests"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserListRequestsPastResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserListRequestsPastResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserListRequestsUpcomingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Next     *string   `json:"next"`
+		Requests []Request `json:"requests"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserListRequestsUpcomingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserListRequestsUpcomingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserGetRequestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RequestDetail
+	JSON404      *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
 	}
 }
 
@@ -7294,6 +8778,37 @@ func (r UserGetAccessTokenResponse) StatusCode() int {
 	return 0
 }
 
+type UserListRequestActivityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Activity []RequestActivity `json:"activity"`
+		Next     *string           `json:"next"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserListRequestActivityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserListRequestActivityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type UserCancelRequestResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -7356,6 +8871,74 @@ func (r UserListRequestEventsResponse) StatusCode() int {
 	return 0
 }
 
+type UserExtendRequestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Request
+	JSON400      *struct {
+		Error string `json:"error"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserExtendRequestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserExtendRequestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserReviewRequestExtensionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Request
+	JSON400      *struct {
+		Error string `json:"error"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserReviewRequestExtensionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserReviewRequestExtensionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type UserReviewRequestResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -7574,6 +9157,15 @@ func (c *ClientWithResponses) AdminGetAccessRuleVersionWithResponse(ctx context.
 	return ParseAdminGetAccessRuleVersionResponse(rsp)
 }
 
+// AdminListConfigChangesWithResponse request returning *AdminListConfigChangesResponse
+func (c *ClientWithResponses) AdminListConfigChangesWithResponse(ctx context.Context, params *AdminListConfigChangesParams, reqEditors ...RequestEditorFn) (*AdminListConfigChangesResponse, error) {
+	rsp, err := c.AdminListConfigChanges(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminListConfigChangesResponse(rsp)
+}
+
 // AdminGetDeploymentVersionWithResponse request returning *AdminGetDeploymentVersionResponse
 func (c *ClientWithResponses) AdminGetDeploymentVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminGetDeploymentVersionResponse, error) {
 	rsp, err := c.AdminGetDeploymentVersion(ctx, reqEditors...)
@@ -7857,6 +9449,15 @@ func (c *ClientWithResponses) AdminGetRequestWithResponse(ctx context.Context, r
 	return ParseAdminGetRequestResponse(rsp)
 }
 
+// AdminListStandingAccessWithResponse request returning *AdminListStandingAccessResponse
+func (c *ClientWithResponses) AdminListStandingAccessWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListStandingAccessResponse, error) {
+	rsp, err := c.AdminListStandingAccess(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminListStandingAccessResponse(rsp)
+}
+
 // AdminListTargetGroupsWithResponse request returning *AdminListTargetGroupsResponse
 func (c *ClientWithResponses) AdminListTargetGroupsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListTargetGroupsResponse, error) {
 	rsp, err := c.AdminListTargetGroups(ctx, reqEditors...)
@@ -7979,74 +9580,135 @@ func (c *ClientWithResponses) AdminUpdateUserWithResponse(ctx context.Context, u
 	return ParseAdminUpdateUserResponse(rsp)
 }
 
-// UserListFavoritesWithResponse request returning *UserListFavoritesResponse
-func (c *ClientWithResponses) UserListFavoritesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListFavoritesResponse, error) {
-	rsp, err := c.UserListFavorites(ctx, reqEditors...)
+// UserListBreakGlassReviewsWithResponse request returning *UserListBreakGlassReviewsResponse
+func (c *ClientWithResponses) UserListBreakGlassReviewsWithResponse(ctx context.Context, params *UserListBreakGlassReviewsParams, reqEditors ...RequestEditorFn) (*UserListBreakGlassReviewsResponse, error) {
+	rsp, err := c.UserListBreakGlassReviews(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUserListFavoritesResponse(rsp)
+	return ParseUserListBreakGlassReviewsResponse(rsp)
 }
 
-// UserCreateFavoriteWithBodyWithResponse request with arbitrary body returning *UserCreateFavoriteResponse
-func (c *ClientWithResponses) UserCreateFavoriteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserCreateFavoriteResponse, error) {
-	rsp, err := c.UserCreateFavoriteWithBody(ctx, contentType, body, reqEditors...)
+// UserReviewBreakGlassRequestWithBodyWithResponse request with arbitrary body returning *UserReviewBreakGlassRequestResponse
+func (c *ClientWithResponses) UserReviewBreakGlassRequestWithBodyWithResponse(ctx context.Context, requestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserReviewBreakGlassRequestResponse, error) {
+	rsp, err := c.UserReviewBreakGlassRequestWithBody(ctx, requestId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUserCreateFavoriteResponse(rsp)
+	return ParseUserReviewBreakGlassRequestResponse(rsp)
 }
 
-func (c *ClientWithResponses) UserCreateFavoriteWithResponse(ctx context.Context, body UserCreateFavoriteJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCreateFavoriteResponse, error) {
-	rsp, err := c.UserCreateFavorite(ctx, body, reqEditors...)
+func (c *ClientWithResponses) UserReviewBreakGlassRequestWithResponse(ctx context.Context, requestId string, body UserReviewBreakGlassRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*UserReviewBreakGlassRequestResponse, error) {
+	rsp, err := c.UserReviewBreakGlassRequest(ctx, requestId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUserCreateFavoriteResponse(rsp)
+	return ParseUserReviewBreakGlassRequestResponse(rsp)
 }
 
-// UserDeleteFavoriteWithResponse request returning *UserDeleteFavoriteResponse
-func (c *ClientWithResponses) UserDeleteFavoriteWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*UserDeleteFavoriteResponse, error) {
-	rsp, err := c.UserDeleteFavorite(ctx, id, reqEditors...)
+// UserListDelegationsWithResponse request returning *UserListDelegationsResponse
+func (c *ClientWithResponses) UserListDelegationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListDelegationsResponse, error) {
+	rsp, err := c.UserListDelegations(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUserDeleteFavoriteResponse(rsp)
+	return ParseUserListDelegationsResponse(rsp)
 }
 
-// UserGetFavoriteWithResponse request returning *UserGetFavoriteResponse
-func (c *ClientWithResponses) UserGetFavoriteWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*UserGetFavoriteResponse, error) {
-	rsp, err := c.UserGetFavorite(ctx, id, reqEditors...)
+// UserCreateDelegationWithBodyWithResponse request with arbitrary body returning *UserCreateDelegationResponse
+func (c *ClientWithResponses) UserCreateDelegationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserCreateDelegationResponse, error) {
+	rsp, err := c.UserCreateDelegationWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUserGetFavoriteResponse(rsp)
+	return ParseUserCreateDelegationResponse(rsp)
 }
 
-// UserUpdateFavoriteWithBodyWithResponse request with arbitrary body returning *UserUpdateFavoriteResponse
-func (c *ClientWithResponses) UserUpdateFavoriteWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserUpdateFavoriteResponse, error) {
-	rsp, err := c.UserUpdateFavoriteWithBody(ctx, id, contentType, body, reqEditors...)
+func (c *ClientWithResponses) UserCreateDelegationWithResponse(ctx context.Context, body UserCreateDelegationJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCreateDelegationResponse, error) {
+	rsp, err := c.UserCreateDelegation(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUserUpdateFavoriteResponse(rsp)
+	return ParseUserCreateDelegationResponse(rsp)
 }
 
-func (c *ClientWithResponses) UserUpdateFavoriteWithResponse(ctx context.Context, id string, body UserUpdateFavoriteJSONRequestBody, reqEditors ...RequestEditorFn) (*UserUpdateFavoriteResponse, error) {
-	rsp, err := c.UserUpdateFavorite(ctx, id, body, reqEditors...)
+// UserDeleteDelegationWithResponse request returning *UserDeleteDelegationResponse
+func (c *ClientWithResponses) UserDeleteDelegationWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*UserDeleteDelegationResponse, error) {
+	rsp, err := c.UserDeleteDelegation(ctx, id, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUserUpdateFavoriteResponse(rsp)
+	return ParseUserDeleteDelegationResponse(rsp)
 }
 
-// UserListRequestsWithResponse request returning *UserListRequestsResponse
-func (c *ClientWithResponses) UserListRequestsWithResponse(ctx context.Context, params *UserListRequestsParams, reqEditors ...RequestEditorFn) (*UserListRequestsResponse, error) {
-	rsp, err := c.UserListRequests(ctx, params, reqEditors...)
+// UserListFavoritesWithResponse request returning *UserListFavoritesResponse
+func (c *ClientWithResponses) UserListFavoritesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListFavoritesResponse, error) {
+	rsp, err := c.UserListFavorites(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUserListRequestsResponse(rsp)
+	return ParseUserListFavoritesResponse(rsp)
+}
+
+// UserCreateFavoriteWithBodyWithResponse request with arbitrary body returning *UserCreateFavoriteResponse
+func (c *ClientWithResponses) UserCreateFavoriteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserCreateFavoriteResponse, error) {
+	rsp, err := c.UserCreateFavoriteWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserCreateFavoriteResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserCreateFavoriteWithResponse(ctx context.Context, body UserCreateFavoriteJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCreateFavoriteResponse, error) {
+	rsp, err := c.UserCreateFavorite(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserCreateFavoriteResponse(rsp)
+}
+
+// UserDeleteFavoriteWithResponse request returning *UserDeleteFavoriteResponse
+func (c *ClientWithResponses) UserDeleteFavoriteWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*UserDeleteFavoriteResponse, error) {
+	rsp, err := c.UserDeleteFavorite(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserDeleteFavoriteResponse(rsp)
+}
+
+// UserGetFavoriteWithResponse request returning *UserGetFavoriteResponse
+func (c *ClientWithResponses) UserGetFavoriteWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*UserGetFavoriteResponse, error) {
+	rsp, err := c.UserGetFavorite(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserGetFavoriteResponse(rsp)
+}
+
+// UserUpdateFavoriteWithBodyWithResponse request with arbitrary body returning *UserUpdateFavoriteResponse
+func (c *ClientWithResponses) UserUpdateFavoriteWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserUpdateFavoriteResponse, error) {
+	rsp, err := c.UserUpdateFavoriteWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserUpdateFavoriteResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserUpdateFavoriteWithResponse(ctx context.Context, id string, body UserUpdateFavoriteJSONRequestBody, reqEditors ...RequestEditorFn) (*UserUpdateFavoriteResponse, error) {
+	rsp, err := c.UserUpdateFavorite(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserUpdateFavoriteResponse(rsp)
+}
+
+// UserListRequestsWithResponse request returning *UserListRequestsResponse
+func (c *ClientWithResponses) UserListRequestsWithResponse(ctx context.Context, params *UserListRequestsParams, reqEditors ...RequestEditorFn) (*UserListRequestsResponse, error) {
+	rsp, err := c.UserListRequests(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserListRequestsResponse(rsp)
 }
 
 // UserCreateRequestWithBodyWithResponse request with arbitrary body returning *UserCreateRequestResponse
@@ -8111,6 +9773,15 @@ func (c *ClientWithResponses) UserGetAccessTokenWithResponse(ctx context.Context
 	return ParseUserGetAccessTokenResponse(rsp)
 }
 
+// UserListRequestActivityWithResponse request returning *UserListRequestActivityResponse
+func (c *ClientWithResponses) UserListRequestActivityWithResponse(ctx context.Context, requestId string, params *UserListRequestActivityParams, reqEditors ...RequestEditorFn) (*UserListRequestActivityResponse, error) {
+	rsp, err := c.UserListRequestActivity(ctx, requestId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserListRequestActivityResponse(rsp)
+}
+
 // UserCancelRequestWithResponse request returning *UserCancelRequestResponse
 func (c *ClientWithResponses) UserCancelRequestWithResponse(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*UserCancelRequestResponse, error) {
 	rsp, err := c.UserCancelRequest(ctx, requestId, reqEditors...)
@@ -8129,6 +9800,40 @@ func (c *ClientWithResponses) UserListRequestEventsWithResponse(ctx context.Cont
 	return ParseUserListRequestEventsResponse(rsp)
 }
 
+// UserExtendRequestWithBodyWithResponse request with arbitrary body returning *UserExtendRequestResponse
+func (c *ClientWithResponses) UserExtendRequestWithBodyWithResponse(ctx context.Context, requestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserExtendRequestResponse, error) {
+	rsp, err := c.UserExtendRequestWithBody(ctx, requestId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserExtendRequestResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserExtendRequestWithResponse(ctx context.Context, requestId string, body UserExtendRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*UserExtendRequestResponse, error) {
+	rsp, err := c.UserExtendRequest(ctx, requestId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserExtendRequestResponse(rsp)
+}
+
+// UserReviewRequestExtensionWithBodyWithResponse request with arbitrary body returning *UserReviewRequestExtensionResponse
+func (c *ClientWithResponses) UserReviewRequestExtensionWithBodyWithResponse(ctx context.Context, requestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserReviewRequestExtensionResponse, error) {
+	rsp, err := c.UserReviewRequestExtensionWithBody(ctx, requestId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserReviewRequestExtensionResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserReviewRequestExtensionWithResponse(ctx context.Context, requestId string, body UserReviewRequestExtensionJSONRequestBody, reqEditors ...RequestEditorFn) (*UserReviewRequestExtensionResponse, error) {
+	rsp, err := c.UserReviewRequestExtension(ctx, requestId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserReviewRequestExtensionResponse(rsp)
+}
+
 // UserReviewRequestWithBodyWithResponse request with arbitrary body returning *UserReviewRequestResponse
 func (c *ClientWithResponses) UserReviewRequestWithBodyWithResponse(ctx context.Context, requestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserReviewRequestResponse, error) {
 	rsp, err := c.UserReviewRequestWithBody(ctx, requestId, contentType, body, reqEditors...)
@@ -8597,6 +10302,62 @@ func ParseAdminGetAccessRuleVersionResponse(rsp *http.Response) (*AdminGetAccess
 	return response, nil
 }
 
+// ParseAdminListConfigChangesResponse parses an HTTP response from a AdminListConfigChangesWithResponse call
+func ParseAdminListConfigChangesResponse(rsp *http.Response) (*AdminListConfigChangesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminListConfigChangesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Changes []ConfigChange `json:"changes"`
+			Next    *string        `json:"next"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseAdminGetDeploymentVersionResponse parses an HTTP response from a AdminGetDeploymentVersionWithResponse call
 func ParseAdminGetDeploymentVersionResponse(rsp *http.Response) (*AdminGetDeploymentVersionResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
@@ -9613,6 +11374,52 @@ func ParseAdminGetRequestResponse(rsp *http.Response) (*AdminGetRequestResponse,
 	return response, nil
 }
 
+// ParseAdminListStandingAccessResponse parses an HTTP response from a AdminListStandingAccessWithResponse call
+func ParseAdminListStandingAccessResponse(rsp *http.Response) (*AdminListStandingAccessResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminListStandingAccessResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			StandingAccess []StandingAccess `json:"standingAccess"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseAdminListTargetGroupsResponse parses an HTTP response from a AdminListTargetGroupsWithResponse call
 func ParseAdminListTargetGroupsResponse(rsp *http.Response) (*AdminListTargetGroupsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
@@ -9909,12 +11716,240 @@ func ParseAdminListTargetRoutesResponse(rsp *http.Response) (*AdminListTargetRou
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
-			Error string `json:"error"`
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminRemoveTargetGroupLinkResponse parses an HTTP response from a AdminRemoveTargetGroupLinkWithResponse call
+func ParseAdminRemoveTargetGroupLinkResponse(rsp *http.Response) (*AdminRemoveTargetGroupLinkResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminRemoveTargetGroupLinkResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminListUsersResponse parses an HTTP response from a AdminListUsersWithResponse call
+func ParseAdminListUsersResponse(rsp *http.Response) (*AdminListUsersResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminListUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Next  *string `json:"next"`
+			Users []User  `json:"users"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminCreateUserResponse parses an HTTP response from a AdminCreateUserWithResponse call
+func ParseAdminCreateUserResponse(rsp *http.Response) (*AdminCreateUserResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminCreateUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminUpdateUserResponse parses an HTTP response from a AdminUpdateUserWithResponse call
+func ParseAdminUpdateUserResponse(rsp *http.Response) (*AdminUpdateUserResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminUpdateUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserListBreakGlassReviewsResponse parses an HTTP response from a UserListBreakGlassReviewsWithResponse call
+func ParseUserListBreakGlassReviewsResponse(rsp *http.Response) (*UserListBreakGlassReviewsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserListBreakGlassReviewsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Next    *string            `json:"next"`
+			Reviews []BreakGlassReview `json:"reviews"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
@@ -9930,20 +11965,27 @@ func ParseAdminListTargetRoutesResponse(rsp *http.Response) (*AdminListTargetRou
 	return response, nil
 }
 
-// ParseAdminRemoveTargetGroupLinkResponse parses an HTTP response from a AdminRemoveTargetGroupLinkWithResponse call
-func ParseAdminRemoveTargetGroupLinkResponse(rsp *http.Response) (*AdminRemoveTargetGroupLinkResponse, error) {
+// ParseUserReviewBreakGlassRequestResponse parses an HTTP response from a UserReviewBreakGlassRequestWithResponse call
+func ParseUserReviewBreakGlassRequestResponse(rsp *http.Response) (*UserReviewBreakGlassRequestResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminRemoveTargetGroupLinkResponse{
+	response := &UserReviewBreakGlassRequestResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BreakGlassReview
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
 			Error string `json:"error"`
@@ -9985,15 +12027,15 @@ func ParseAdminRemoveTargetGroupLinkResponse(rsp *http.Response) (*AdminRemoveTa
 	return response, nil
 }
 
-// ParseAdminListUsersResponse parses an HTTP response from a AdminListUsersWithResponse call
-func ParseAdminListUsersResponse(rsp *http.Response) (*AdminListUsersResponse, error) {
+// ParseUserListDelegationsResponse parses an HTTP response from a UserListDelegationsWithResponse call
+func ParseUserListDelegationsResponse(rsp *http.Response) (*UserListDelegationsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminListUsersResponse{
+	response := &UserListDelegationsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -10001,35 +12043,44 @@ func ParseAdminListUsersResponse(rsp *http.Response) (*AdminListUsersResponse, e
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Next  *string `json:"next"`
-			Users []User  `json:"users"`
+			Delegations []Delegation `json:"delegations"`
+			Next        *string      `json:"next"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseAdminCreateUserResponse parses an HTTP response from a AdminCreateUserWithResponse call
-func ParseAdminCreateUserResponse(rsp *http.Response) (*AdminCreateUserResponse, error) {
+// ParseUserCreateDelegationResponse parses an HTTP response from a UserCreateDelegationWithResponse call
+func ParseUserCreateDelegationResponse(rsp *http.Response) (*UserCreateDelegationResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminCreateUserResponse{
+	response := &UserCreateDelegationResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest User
+		var dest Delegation
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10044,15 +12095,6 @@ func ParseAdminCreateUserResponse(rsp *http.Response) (*AdminCreateUserResponse,
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
 			Error string `json:"error"`
@@ -10067,44 +12109,28 @@ func ParseAdminCreateUserResponse(rsp *http.Response) (*AdminCreateUserResponse,
 	return response, nil
 }
 
-// ParseAdminUpdateUserResponse parses an HTTP response from a AdminUpdateUserWithResponse call
-func ParseAdminUpdateUserResponse(rsp *http.Response) (*AdminUpdateUserResponse, error) {
+// ParseUserDeleteDelegationResponse parses an HTTP response from a UserDeleteDelegationWithResponse call
+func ParseUserDeleteDelegationResponse(rsp *http.Response) (*UserDeleteDelegationResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminUpdateUserResponse{
+	response := &UserDeleteDelegationResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest User
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
@@ -10520,6 +12546,53 @@ func ParseUserGetAccessTokenResponse(rsp *http.Response) (*UserGetAccessTokenRes
 	return response, nil
 }
 
+// ParseUserListRequestActivityResponse parses an HTTP response from a UserListRequestActivityWithResponse call
+func ParseUserListRequestActivityResponse(rsp *http.Response) (*UserListRequestActivityResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserListRequestActivityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Activity []RequestActivity `json:"activity"`
+			Next     *string           `json:"next"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseUserCancelRequestResponse parses an HTTP response from a UserCancelRequestWithResponse call
 func ParseUserCancelRequestResponse(rsp *http.Response) (*UserCancelRequestResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
@@ -10620,6 +12693,130 @@ func ParseUserListRequestEventsResponse(rsp *http.Response) (*UserListRequestEve
 	return response, nil
 }
 
+// ParseUserExtendRequestResponse parses an HTTP response from a UserExtendRequestWithResponse call
+func ParseUserExtendRequestResponse(rsp *http.Response) (*UserExtendRequestResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserExtendRequestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Request
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserReviewRequestExtensionResponse parses an HTTP response from a UserReviewRequestExtensionWithResponse call
+func ParseUserReviewRequestExtensionResponse(rsp *http.Response) (*UserReviewRequestExtensionResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserReviewRequestExtensionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Request
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseUserReviewRequestResponse parses an HTTP response from a UserReviewRequestWithResponse call
 func ParseUserReviewRequestResponse(rsp *http.Response) (*UserReviewRequestResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
@@ -10786,6 +12983,9 @@ type ServerInterface interface {
 	// Get Access Rule Version
 	// (GET /api/v1/admin/access-rules/{ruleId}/versions/{version})
 	AdminGetAccessRuleVersion(w http.ResponseWriter, r *http.Request, ruleId string, version string)
+	// List configuration changes
+	// (GET /api/v1/admin/config-changes)
+	AdminListConfigChanges(w http.ResponseWriter, r *http.Request, params AdminListConfigChangesParams)
 	// Get deployment version details
 	// (GET /api/v1/admin/deployment/version)
 	AdminGetDeploymentVersion(w http.ResponseWriter, r *http.Request)
@@ -10867,6 +13067,9 @@ type ServerInterface interface {
 	// Get a request
 	// (GET /api/v1/admin/requests/{requestId})
 	AdminGetRequest(w http.ResponseWriter, r *http.Request, requestId string)
+	// List standing access
+	// (GET /api/v1/admin/standing-access)
+	AdminListStandingAccess(w http.ResponseWriter, r *http.Request)
 	// Get target groups
 	// (GET /api/v1/admin/target-groups)
 	AdminListTargetGroups(w http.ResponseWriter, r *http.Request)
@@ -10897,6 +13100,21 @@ type ServerInterface interface {
 	// Update User
 	// (POST /api/v1/admin/users/{userId})
 	AdminUpdateUser(w http.ResponseWriter, r *http.Request, userId string)
+	// List Break-Glass Reviews
+	// (GET /api/v1/break-glass-reviews)
+	UserListBreakGlassReviews(w http.ResponseWriter, r *http.Request, params UserListBreakGlassReviewsParams)
+	// Review Break-Glass Request
+	// (POST /api/v1/break-glass-reviews/{requestId}/review)
+	UserReviewBreakGlassRequest(w http.ResponseWriter, r *http.Request, requestId string)
+	// List Delegations
+	// (GET /api/v1/delegations)
+	UserListDelegations(w http.ResponseWriter, r *http.Request)
+	// Create Delegation
+	// (POST /api/v1/delegations)
+	UserCreateDelegation(w http.ResponseWriter, r *http.Request)
+	// Delete Delegation
+	// (DELETE /api/v1/delegations/{id})
+	UserDeleteDelegation(w http.ResponseWriter, r *http.Request, id string)
 	// ListFavorites
 	// (GET /api/v1/favorites)
 	UserListFavorites(w http.ResponseWriter, r *http.Request)
@@ -10933,12 +13151,21 @@ type ServerInterface interface {
 	// Get Access Token
 	// (GET /api/v1/requests/{requestId}/access-token)
 	UserGetAccessToken(w http.ResponseWriter, r *http.Request, requestId string)
+	// List request activity
+	// (GET /api/v1/requests/{requestId}/activity)
+	UserListRequestActivity(w http.ResponseWriter, r *http.Request, requestId string, params UserListRequestActivityParams)
 	// Cancel a request
 	// (POST /api/v1/requests/{requestId}/cancel)
 	UserCancelRequest(w http.ResponseWriter, r *http.Request, requestId string)
 	// List request events
 	// (GET /api/v1/requests/{requestId}/events)
 	UserListRequestEvents(w http.ResponseWriter, r *http.Request, requestId string)
+	// Extend a request
+	// (POST /api/v1/requests/{requestId}/extend)
+	UserExtendRequest(w http.ResponseWriter, r *http.Request, requestId string)
+	// Review a request extension
+	// (POST /api/v1/requests/{requestId}/extension/review)
+	UserReviewRequestExtension(w http.ResponseWriter, r *http.Request, requestId string)
 	// Review a request
 	// (POST /api/v1/requests/{requestId}/review)
 	UserReviewRequest(w http.ResponseWriter, r *http.Request, requestId string)
@@ -11223,17 +13450,52 @@ func (siw *ServerInterfaceWrapper) AdminGetAccessRuleVersions(w http.ResponseWri
 
 	var err error
 
-	// ------------- Path parameter "ruleId" -------------
-	var ruleId string
+	// ------------- Path parameter "ruleId" -------------
+	var ruleId string
+
+	err = runtime.BindStyledParameter("simple", false, "ruleId", chi.URLParam(r, "ruleId"), &ruleId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminGetAccessRuleVersions(w, r, ruleId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AdminGetAccessRuleVersion operation middleware
+func (siw *ServerInterfaceWrapper) AdminGetAccessRuleVersion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "ruleId" -------------
+	var ruleId string
+
+	err = runtime.BindStyledParameter("simple", false, "ruleId", chi.URLParam(r, "ruleId"), &ruleId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version string
 
-	err = runtime.BindStyledParameter("simple", false, "ruleId", chi.URLParam(r, "ruleId"), &ruleId)
+	err = runtime.BindStyledParameter("simple", false, "version", chi.URLParam(r, "version"), &version)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminGetAccessRuleVersions(w, r, ruleId)
+		siw.Handler.AdminGetAccessRuleVersion(w, r, ruleId, version)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -11243,32 +13505,50 @@ func (siw *ServerInterfaceWrapper) AdminGetAccessRuleVersions(w http.ResponseWri
 	handler(w, r.WithContext(ctx))
 }
 
-// AdminGetAccessRuleVersion operation middleware
-func (siw *ServerInterfaceWrapper) AdminGetAccessRuleVersion(w http.ResponseWriter, r *http.Request) {
+// AdminListConfigChanges operation middleware
+func (siw *ServerInterfaceWrapper) AdminListConfigChanges(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "ruleId" -------------
-	var ruleId string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminListConfigChangesParams
 
-	err = runtime.BindStyledParameter("simple", false, "ruleId", chi.URLParam(r, "ruleId"), &ruleId)
+	// ------------- Optional query parameter "resourceType" -------------
+	if paramValue := r.URL.Query().Get("resourceType"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "resourceType", r.URL.Query(), &params.ResourceType)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resourceType", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "version" -------------
-	var version string
+	// ------------- Optional query parameter "resourceId" -------------
+	if paramValue := r.URL.Query().Get("resourceId"); paramValue != "" {
 
-	err = runtime.BindStyledParameter("simple", false, "version", chi.URLParam(r, "version"), &version)
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "resourceId", r.URL.Query(), &params.ResourceId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resourceId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "nextToken" -------------
+	if paramValue := r.URL.Query().Get("nextToken"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "nextToken", r.URL.Query(), &params.NextToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nextToken", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminGetAccessRuleVersion(w, r, ruleId, version)
+		siw.Handler.AdminListConfigChanges(w, r, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -11886,6 +14166,17 @@ func (siw *ServerInterfaceWrapper) AdminListRequests(w http.ResponseWriter, r *h
 		return
 	}
 
+	// ------------- Optional query parameter "ticketReference" -------------
+	if paramValue := r.URL.Query().Get("ticketReference"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "ticketReference", r.URL.Query(), &params.TicketReference)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ticketReference", Err: err})
+		return
+	}
+
 	// ------------- Optional query parameter "nextToken" -------------
 	if paramValue := r.URL.Query().Get("nextToken"); paramValue != "" {
 
@@ -11934,6 +14225,21 @@ func (siw *ServerInterfaceWrapper) AdminGetRequest(w http.ResponseWriter, r *htt
 	handler(w, r.WithContext(ctx))
 }
 
+// AdminListStandingAccess operation middleware
+func (siw *ServerInterfaceWrapper) AdminListStandingAccess(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminListStandingAccess(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // AdminListTargetGroups operation middleware
 func (siw *ServerInterfaceWrapper) AdminListTargetGroups(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -12197,6 +14503,119 @@ func (siw *ServerInterfaceWrapper) AdminUpdateUser(w http.ResponseWriter, r *htt
 	handler(w, r.WithContext(ctx))
 }
 
+// UserListBreakGlassReviews operation middleware
+func (siw *ServerInterfaceWrapper) UserListBreakGlassReviews(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UserListBreakGlassReviewsParams
+
+	// ------------- Optional query parameter "status" -------------
+	if paramValue := r.URL.Query().Get("status"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserListBreakGlassReviews(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UserReviewBreakGlassRequest operation middleware
+func (siw *ServerInterfaceWrapper) UserReviewBreakGlassRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "requestId" -------------
+	var requestId string
+
+	err = runtime.BindStyledParameter("simple", false, "requestId", chi.URLParam(r, "requestId"), &requestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserReviewBreakGlassRequest(w, r, requestId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UserListDelegations operation middleware
+func (siw *ServerInterfaceWrapper) UserListDelegations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserListDelegations(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UserCreateDelegation operation middleware
+func (siw *ServerInterfaceWrapper) UserCreateDelegation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserCreateDelegation(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UserDeleteDelegation operation middleware
+func (siw *ServerInterfaceWrapper) UserDeleteDelegation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserDeleteDelegation(w, r, id)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // UserListFavorites operation middleware
 func (siw *ServerInterfaceWrapper) UserListFavorites(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -12513,6 +14932,46 @@ func (siw *ServerInterfaceWrapper) UserGetAccessToken(w http.ResponseWriter, r *
 	handler(w, r.WithContext(ctx))
 }
 
+// UserListRequestActivity operation middleware
+func (siw *ServerInterfaceWrapper) UserListRequestActivity(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "requestId" -------------
+	var requestId string
+
+	err = runtime.BindStyledParameter("simple", false, "requestId", chi.URLParam(r, "requestId"), &requestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UserListRequestActivityParams
+
+	// ------------- Optional query parameter "nextToken" -------------
+	if paramValue := r.URL.Query().Get("nextToken"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "nextToken", r.URL.Query(), &params.NextToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nextToken", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserListRequestActivity(w, r, requestId, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // UserCancelRequest operation middleware
 func (siw *ServerInterfaceWrapper) UserCancelRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -12565,6 +15024,58 @@ func (siw *ServerInterfaceWrapper) UserListRequestEvents(w http.ResponseWriter,
 	handler(w, r.WithContext(ctx))
 }
 
+// UserExtendRequest operation middleware
+func (siw *ServerInterfaceWrapper) UserExtendRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "requestId" -------------
+	var requestId string
+
+	err = runtime.BindStyledParameter("simple", false, "requestId", chi.URLParam(r, "requestId"), &requestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserExtendRequest(w, r, requestId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UserReviewRequestExtension operation middleware
+func (siw *ServerInterfaceWrapper) UserReviewRequestExtension(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "requestId" -------------
+	var requestId string
+
+	err = runtime.BindStyledParameter("simple", false, "requestId", chi.URLParam(r, "requestId"), &requestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserReviewRequestExtension(w, r, requestId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // UserReviewRequest operation middleware
 func (siw *ServerInterfaceWrapper) UserReviewRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -12804,6 +15315,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/admin/access-rules/{ruleId}/versions/{version}", wrapper.AdminGetAccessRuleVersion)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/admin/config-changes", wrapper.AdminListConfigChanges)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/admin/deployment/version", wrapper.AdminGetDeploymentVersion)
 	})
@@ -12885,6 +15399,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/admin/requests/{requestId}", wrapper.AdminGetRequest)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/admin/standing-access", wrapper.AdminListStandingAccess)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/admin/target-groups", wrapper.AdminListTargetGroups)
 	})
@@ -12915,6 +15432,21 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/admin/users/{userId}", wrapper.AdminUpdateUser)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/break-glass-reviews", wrapper.UserListBreakGlassReviews)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/break-glass-reviews/{requestId}/review", wrapper.UserReviewBreakGlassRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/delegations", wrapper.UserListDelegations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/delegations", wrapper.UserCreateDelegation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/delegations/{id}", wrapper.UserDeleteDelegation)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/favorites", wrapper.UserListFavorites)
 	})
@@ -12951,12 +15483,21 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/requests/{requestId}/access-token", wrapper.UserGetAccessToken)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/requests/{requestId}/activity", wrapper.UserListRequestActivity)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/requests/{requestId}/cancel", wrapper.UserCancelRequest)
 	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/requests/{requestId}/events", wrapper.UserListRequestEvents)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/requests/{requestId}/extend", wrapper.UserExtendRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/requests/{requestId}/extension/review", wrapper.UserReviewRequestExtension)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/requests/{requestId}/review", wrapper.UserReviewRequest)
 	})
@@ -12976,173 +15517,244 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9+Xvbtrbgv4LR3PmadGRJXrLY883Xcb2kus3iZ8vJm3ed10IkJKEmAQUAZauJ52+f",
-	"DxsJkiBFLV7S11/ubSwQODg4OBvO8rUV0HhKCSKCtw6+thj6kiAufqYhRuoPRwxBgQ6DAHF+nkToXA+Q",
-	"PwWUCETUf8LpNMIBFJiS7h+cEvk3HkxQDOV/TRmdIibMjHA6ZXQGI/nf/2Bo1Dpo/fduBkVXf8e7h2oc",
-	"YkeUjPC4ddduhYgHDE/lKvJjdAvjaYRaB63DMMYEQAUkEBR8uBaw1W7F8PYtImMxaR3s9PZet1tTKARi",
-	"pHXQ+hfc+vNw6z96W/vtzv86ePb8X1dXn3/6b1dXW7/9/v+ukl5v52X36opcXfHP3/7zH612S8ynciEu",
-	"GCYKljGjyVTtJwdVazBBQP0G+scciAkUQEyQhY0lEQIKWUgC2mm1W1igWM1TWsL8ATIG5/LfBMYov2+5",
-	"TwDl5vO73ev12q0YE/vv7dW27tu3gGyMxKKzK1LNQH8lv8cxOqKECwaxobm6iQaF4Xd3bUWjmKGwdfAv",
-	"ewztjKoMnvLUksJdBuBzukk6/AMFonV3JxfROziFM8qw2ATVp7joh/LfD3NalmRKPzAEDcD3eUkEjuV/",
-	"LThjg9yBHnzXbt1gCU0TCjOffsJicpEM7SkViSSH+xQqg53a838jyWv9wy8wrhLKS4iLUTxETH27PH8o",
-	"TV9FW/+ZHfBvnS0PARXwaC6WBa4Wc2eMznCI2AUSm8Dg1Ew3UAv6mK4EBdCR4rZ2tJQFHAmQTDubEgcL",
-	"kZSD1Ieighxr/YzGmCiwxwkOUSghTqZyD0pkjCgDEBB0AzQ7BRaznVaKbIPf75VL1TCj74ul1N4ILQMV",
-	"R3mLyfVa7GQa0XmMiNCnVELSNSb+H6YMS3k2N7jGcRK3Dvb399XJ6n/10j1gItAYsdLec8s7c5p1myJh",
-	"fWodMRovVCCyBU/l8Lt2CxdJ++VenitupdT8+cd/LLzwCgo1a+3OLzli628ZxRArzXlEWQxF68D8pb2I",
-	"65dIYYQZF++XFRnrXXTMlabukOaQ0ghBIn+M4APDUzhHi8gMMQ5MGewVh5yTdxcCTY+oVNI3oToGZqay",
-	"3Ps0QWIixdwEAS7QFGAO7GhAGSBUdLJ9O7gOlE31EUaJ4fthiOWcMDrLLV06wbLc1VOBmZoLICIQQyEY",
-	"zhVQCUcM3ExwMAEBZQzxKSWhlMoKYiXnJNwOkAap7dbt1phumT/GcPovDcPnisNLcVTYW8VpnaMx5gKx",
-	"XyAJo03cS3jDD4OAJvpLl5lILvJ1e+fOdx/gDZeQFM3ZhG8hyMXWditH+Ps5LvUs4c+2xnT2/KdvcPot",
-	"gN8C8g0l3zh8vvUsQEQwGH17RigTk2+cJmLy/KdnctJvN4iL5z8937q6Cr06u+aO5XPuH1vtSptSxsoV",
-	"FGh5IM1ZIJlc26pfYZ4rLcll2y2WEGmsaXBGMIkku4M3fCuC8TCECy80lgDYSdruEbmYr6SQGUY3G7m8",
-	"sflssXITogBzQw716o0E7tiOvmu36AwxhkM0WEU9Ksl3M28T7fWQAGgcNT9wwBRgklAgsfqqWaxzRQaO",
-	"I0T/EWjFCQSQgCECdhdE8g9MgigJ5a/2z3a0UZftHEMazjtXpD8CWEgOSGMsBArbahBleIwJjIor3uAo",
-	"kksmHIUdgwLJnbg+Ng37gF4jcm7+vgYRTKCeyi/4ROGnCnJOJ2lyLP1RDkUTyOWJpB6ya0TawE6Y4kKw",
-	"BEmADhMx0erK2jt3JH616FJSAmsI5WjMBYOCMklHRzSOKQGnUCC/KJMfL6J3uZkSPtWH9XK9iNVjJCCO",
-	"OIBDmhi/XiImiAiJDhSqjSirzEijghG8NjYzzVs7RS+nobFY9KaqkAxBDEkCI5CoDySuLSasMHbwDLJl",
-	"jHRPmIIPPPs9+6kzj6Pfn8vPYSDwTH7nmt6+w6q0I7y7aXIgA0XjGq/gZoKIVX/kFc9YkD2HnIXts5/X",
-	"PiFz4fKemwasuOzPKSArnXg1Og3UPsMCT+YKB8fpOXxETDL+DeBhpmfyqxIOfZlxHfDJ8CAIOIpnUovg",
-	"STABkIOr1qzX2e/0rlrKF0JHIxxgxdAjBDnibanpXrVCNPufb/qD3345vPjFDJ0ytGVGgWGCo5B3FioN",
-	"FvBmaC7uA2CiDTO5J4nbE8boJvgokvMslhF6WEO5rQYDhkTCCAqBtGONXs5mOEAK/n4ouZuYH7l8YAP7",
-	"yfF5ZZ1XuDKwAcBe4cU4KH3R9q/WBEvnCjncPVbnUtmVClxSqcmYO2SuUPkWc5G9iNjXLb4BZBJ0q74h",
-	"SRTBoTQhpCT36JdSPi3lVPaITN5q6wUbURmIMBcSI1rGhxzcTKhS96zW6Ogl6nWMZQwxjzGuudomiC+b",
-	"szGfzuDQYHgd8M3OodKVuBRqT7S5mIo/D8IeHVWPjqSM/qzgkzOk19E+720CUyM7V2M82dU3hyX1VdsB",
-	"ZRkeB1NcpWixeFIscxNIyl7NG2FIrbs59KSPxY3pp4wbjQqLGOM/2iQT97yONEfY4I2BqIE+uSQijJJN",
-	"h38o3Uru3vGtZ6rQ4Vn/vMCScmbQJnA1zU3YGDs5OBZiqLDIcuwGk60po2MmeU7BCOFgiKR5oh8mrafU",
-	"tb9y6krGrYzSfjKTm9qETjmzUT/LWCpq+c3dSQPEEqR4BseYKGPGIrsImYOsB1Wv7t/42wSaUuaVe4u7",
-	"P/4lsmWWYGTZRwuxk1tgHdXJRQhNhHXgbpidpzMvgQgFzmIy0VOvg4INuf3WsEgW+/E2baSUb4qeoviq",
-	"txJelpBHdepHbijQe1GCwb5TbMpz1Zhl3S2jNYyUo0FCqhxz1sjTjnczdeZ2V6ZDyXPkaPDS4BYQFwxz",
-	"FSlDACLaEQsEBTG8RtlyeoSapqMCF3IPNdpDdui5tfnHSIFj1BngGK0Sklrx3JZ9x5Lot53XNzsnaCh2",
-	"/u01Of23f+6Ev8Lt08HJ/r/3/lmawsCmY79a/WP9zH6UMGZooOwxXxBHumLI530Ee7Zb2mG97KlUeh8P",
-	"QULwlwRl/jrlwhlhxBRxSE3MobMOUD5pQ7OK8BSVcBORlXovr8inCSJ2EObG0R62ARY/cNA/BgzFimAD",
-	"Sjjm8oJ2rkizJ0y7m2UjW11CaDv07WJV8kcsNMVmd690tdutkv+j4n5mI7JLGqp/o9DjRjMYgyRUWONq",
-	"kKsA4xkCcIo9F/bh4sifQvD3I/CJGAkYQgGb3/x39osVuAwXUCRL+JYu9PiV+ZPjxlubS/1V+Y05k3bz",
-	"MPuUZhryJS//MUdTy4XeOcS5CUFuvvp57r2NGr3vEOdwjGpGmFXT4DwTetIYCjOLF4pixJPDzzPgXUDc",
-	"6bx4fuccVjWmL9KLWWZ2mkAK8R6SkFvtFiJJLAE9PBr0P5602q3D86Nf+h9Pjv3AXFhaK6G2pFl4rpmJ",
-	"STIqoMNwS2Jj6jwkNVHOK/1C/m0MUqqvxmiOAXk2k8rL+9xVzjD3krSNma4KDlye2x6ycRIjwzSL1kMF",
-	"lg0cNchuwi78UJSd+JTFJxGyEVuWhPvvzy4HrXbr3eXbQf/i5O3J0cCxLAt6ASbj2pjK5jK/tJ9ZGrC5",
-	"4gOemcCFtJ3b9EI0Z8jzhWxyQacRHk8U9qTK0kJ7k90h353coi/zWwXPoREh75CYUE/QyrH61xBxcJOG",
-	"r7hxTEOE0tfDEMBEUKlRBjCK5oAy/YwNbSSay4cuBx/eHQ76R6126/zkY//kU4EV5eHyce3y9l6+3o8j",
-	"8Rp+uSW3e872UnWzfL3tu6cJnM1sUnWzeelqe95MFlJO6lYps2xlGkv11ERz2ndYniokqXHeXobM8mhM",
-	"9++h4orkwBK0MBfhp8BTgk6rR647IGW6fqZYEVqwDn/z72FlLqcSKYp8rgJPjTG6kNt9j+xqIX42xaZK",
-	"2UAbQlIOeHf6ZoC+pnA32Lt5FUevRAWgTtpSU8dqGZo6UJ0FChtsCvMxhmNCucCBL2I69KvYEZqhhdb+",
-	"Wzp+q8YpA7ZKXy/QmZ65rZfOvnNozQG42TmNhi93guFwfxjs7WnBkD73l4Nk/fypOnk3zc9rYM0ZM818",
-	"42wphafZhm7m8zHcebH3ivFtkttQlSJ7bNVYPa/JZLRfdUCJXy+NhzRv8KmmCboncGMueZYqWDiICj22",
-	"6XG8YZD4bSMUTymDbA4g53hMVMCiNNlSNzkEU4ZJgKcwKvvZEKnICEEkBNJ6tZrEWAKgLL/U5bPT29nZ",
-	"6r3c2t4d9HYPdvcPdnud/Z3t/zBap7KRpYm6tayh7No7dbkqbiawgi/z7uUhpaZkRH3SHBeQiUpTmIlH",
-	"wwevMdID7WtRxroHOKMen528P+6/fyPtdGuwn5yffzjX2vKHX0+O5V/+/ax/btTmEm4STa9+WokhjgAM",
-	"QxURYWCw5Oc5mHJ6Y93BFG5d6rayILVdc1KfYVvRtXML9fXxaDGpgVyb01/xsFOR2X9kM8WKqbZrpv6X",
-	"D4UmLGggAb3OPMW9XIAz6NKZcxiUiPJgsB9OMydSao5Zb1BKcM5U2RfNzDC4OwrZ9qtxMOntQbW5X9Fc",
-	"pQCWD+4a+X18Mzu8HlPyczvYgThdrxn73n3xxwxFyf7t9k60o9ZIFZec3+H0Q6vd+nR4/l5fTX0jnWXT",
-	"r5rhaRrMr4PX0fYs3KN2WXqdTGvfXUEMRTCRtrbzjiGlGaBqjM39xOpWzwFkKEsvsUalL8S3XRlsulyI",
-	"KUcRCgQcRkiK4w8KqCy31ZsY5dvSBHKQTQVGGEUhb+tYbnXTdKKUedbKTWMwIKhNKZP/OWVoJD+QAyU/",
-	"0wkqZvP6jBop5ylpLTKXHPzlSKRwws0odDq5ZV96+2JnNNv5s+WmOJeRmubXNFXphCnj0UB/FbaOhtmO",
-	"E9Zf3oaRtDqW4NNFupn0Upj3r/TfFp83ylhX731Nv1EaQy73W7s8TiXdbExeYK7pGUb1OXRuGrZKgjRf",
-	"+ZPmML9AAfM5XeycOnvcnVrdB5WmB7j6GDyL8DVSnv+zPrhGyv0GwRRyfkNZ+Ny7crWkUnOeQa2A54FS",
-	"mhwUE3mrbiaIIZOnoqCw2YtcUKZewEgKIQcxJHCMGFCQHn66ABcX78AZZDBGAjFwIb/pNHsW84vI7Hgc",
-	"rHrI1aWNhhr+Czi7+RPRm53hH/utMp1ViLfF+dvueXZ8Dy6pJCzPounLk+XfEIkluenbU0MLe7bHJsPw",
-	"Zjq6xnn86HgqjyBLrQHDvm2NHTrKx+SKCaPJeFIuynND2fUoojdyAjczFQwmiGfWBlcy8McfCRU//gjm",
-	"SOgcSeSJOrLbxiG0rKEoFDpdzdgnOrS7S6eIwCnuzOOo9o3nqDi3R3FsVghiBCOO2jWmRT4BSkvDFYo6",
-	"+KsPpI/o/eNUnUhPUqdygoEU0oo3MUhCGoNfLy77x8q2nVEcgikViAgMlfgeRTgQXKswkna3+BQFeIRR",
-	"mM3bP+aWSopJr2CEI9Spj2GoeyrNal8Y+nPNsKMP787engyk+fXx8G3/+HDQ//D+t9PD/lulKtu/KW2w",
-	"/74/6B++/e3ow/vT/pvLcz22//63s/MPb85PLi7yk1xcHp2cHFdZbwL5IloOicq+t1n9ttCIxE2oIgvJ",
-	"OH2mSZU/W4yj01TDKRVP+WDWrHYzLyoBVsz8de+3n+nVZaza8I2CV6Eh01NDvHEVGuuFa9gucwUPw9RM",
-	"rhmr3CbxLkOz/S/oz/1hmVX2CRcsCeRKnlcCCaNJKF4t0+LCmWCRCusuVrXpHLjrytIShB5/dCq5l0eA",
-	"K/Z9UV0FzHv8mCJqoCnrYYX52nnQq9Dpbn5j2EwvcNkA04wj97ybVQKqqGC0ckGk9MHYfhM2KEyQzl+H",
-	"snSHG7mCeQ2gKP9m6a8AjqE8ZEeRy0vdCv5XRqI2b826qEJpnEImcJBEkOW0Rm4hUvr2CEAyd3l9Zfhi",
-	"nVaa7TFL/f89wlxscU631IP2717GHdFx83vpvON4oGwuvzNoXeGdF70Xl0dH+r8y32rmwFksNlIpUTyq",
-	"KrJ0iGhVonQeDotEmBbQodZrwmmMxETK1RiGSFoDbvh9QUWu8fZUvM9nAz5msrk8qhROsjgYOB2tYnbM",
-	"s0l9IirUqWU+K90XWlvzNGXwuHasoJnHWxaiaTitOW4nlna1d7NNRD96q3ele3SuQ1q/18Vku1iSs0w9",
-	"FXHvpafszHFtfnrMrBRInCtZdqf8nX9SoMn7SUP5O6HEk1CSkWb5NtUnk1Sd1UqxsNXPCW7k0ypBXhZM",
-	"M483xmlz4cRtB+CVol2L4C7tgq6Ifq0JeNVe5ubqV/Y+41O/DFr4hXqEqTDGMc/Vq1Pqp3m2UfcvLW1m",
-	"6oFlEYOsyOjdGnzL2Vl23z6YPZfBnkhDrYyP2TjYe4Vvxi+3Xa2sOmL8/nSz5V7i1lXG1r6yaexa8XIo",
-	"buW3SC0ZObGvEm9pVUITS4xYWtnJiZAtk9LfCuVfTqEspNVktFTBsxerlLpWhafcUhX6c1lNKx/xiNFY",
-	"kd7F48cLSVguViMq+elgNcJS+9AhfKFfPVUjTiGOEobOq29dxXstQwFlIQrTAy6X3ZO/mKLYN1AyE/0F",
-	"YCjSosqU5ExRvvQriqHj2m2aMRW2t6BPhUwEXZFIBN1EMWSXayi7MruI5QuvD72ZlL/dfvHniy9BhHj4",
-	"Zd+V8kvn26X1ld1kl7Oz8w86uio7gaPD90cnb/VD0vHJ0dv++3wGTB4Az1nkUVXWL40r8gIFlITcH9+m",
-	"wu8GaV1vd4eY09cve9sqiJILGE+lnnI5OFJ/+JMS5AYGriULipCWkTCwMqHJWe5ROv8SjV7fDuEL60fL",
-	"Vej2Gjb6N62fUeI5Uf95+k8ut5zn6LLKYCVYzA+AoSlDXN4NAN0arSpUOH3yAlfEVj2zZbsjTK5NJWGn",
-	"NDwHMwyBqUnTrq2XX18Yv1wmPXXibsrxO0qIUt4PmX/FCYKRmMz9nLRKEmTl65eqU+/CUlm1PgMpjw6H",
-	"JrITb0bE4Sh89Xp3hIKXvZcqg/92S8AxlxBqy9PWvvssyUn9xTU1i7H/I0x0mI5NCW8D+b/6kRaSEFz2",
-	"AdLGZpZ6BjNLaTnTNUuSW8VqyO+mWHUqU94qZb66r6d58zmPEBsmpCMBzcaLKXfqR/WiQqhI2yi0gWl/",
-	"oBx5cRIJrO1dhz/U2OgsidBp87zWdt1cDc1k/eptbOXi+l58pSfoEnCexhoaz8Pe6x6EuzuvXgY6wtV3",
-	"uD69zNKe5l5LU2BVpOPSGKvEgD++2o+G4csXIdoPh9uvdnagg4aKYPbVaias2n8pWA6FzYpp6YUu9Nh7",
-	"tCANNO2071OQ9/m4eG74nB7M/xyR7evp/u31bfGsTg2O8+R6YQKWuIq3TN9oi0Evghr/MoDA5eFAb0Ll",
-	"SpedP5XdwypjN6fJMMJ8gvym66zy9a7oCk2nST3Tmas67S1WxvOpPolm7AGh4X442gtevAodXOsCe2WN",
-	"duOahgniW6dxW1mndio5VEys3qt96kt1LUVl7WTgGuBy/d70rNUaiIPaZscDd3d39+Fwd3t7Z3vbOZ6L",
-	"lAWsL97d1Nbc7M1AFNev6DBGcI9Phua2lp+XCpYbjovBGuYZo1AyJU98Mbw9LltUZaPQNPID1qiRVhPX",
-	"H7ipF5gDGEX0RidlmIaYuuff9otXO3uvTYNH/aeXi/sAeuBzD7/0eFRSpy5N65aKFnf1LetqVMBlq2qV",
-	"Q0pgzTpTHIiEoTWcpFl20z3KKV87Owu64/t0Oty5Ls6yNnbJG+RcHE0Ydg+xFcg//J9AvTWMoEAdTHVi",
-	"WTm/Qn0L3ksMEAfWg9ZEiCk/6HbhDArIeGeMxSQZJhwxU+qyE9C4m3S393a293Z6vZ9m/3tPYvaflE9c",
-	"WNIF69M7Vlj41d5Ob/flvl5YnoatF+AJuTpeoEdGcIj85K8fnRZ9X6VwNk5ws3q7BsQTsr9EtYLyU5bz",
-	"Arj0+2Q1airFW+Ndz0zmgbNrHBZ3/WG6RHDVLUV/4ORFgHsvwsR0lcNkRG2NVqhzZS31Z89x8iqyyKG/",
-	"/PUpVVl1S4cfnvVbTuWG3KSpHtba7vQ0QalEgtZBa7fT6/Raqj/fRB1FF05xd7ZtMg+2mG0A4X2lf4OE",
-	"FC25MjZAdxyzL44d9WiKtLSQOoriJ4V+Fa1CE7adXq+KlabjulU9L1Rl2SSOIZu3DlRt41wPCBXnoL0b",
-	"JyRUjQtbn+U3vp13I5VDV4mAExJOKSbCNNPRncxUxiAdqT5FMyfBWaPnma11GdB4iIkW3CojQbnQUQiC",
-	"CD/3Y62c0De1GU1yQ97sqdQkmE8RwB3UARlVdeEN3+KcdvSvfEKTKARDBBAJaCjBlOPBEAbXPIJ8Arau",
-	"kl5vF4H/saNijlsHrS8JYvOMmZqI98xysx6H8qLeYEjvFhCLMedK2xCHjAB1VdsSZlP9iSGO4qEiPsBo",
-	"hICERgOvwqFMRX2NuQrIi6t0LEPI9tIIWnjD5YHThAig5LFvMTOgH9bO/9l/LRrXeW5ktZSIqhypX2I8",
-	"H36Vo/Z6e4tvab4PVuFuqqWLUT1DKC8IJU7L0eXu7Fdd8uSulm2FplOaRx+/IlfkxLAvndBFSTQHKjdY",
-	"UKBSYlyOkst/hkBnymfvYqpZ6gTZkKVIRX0JqrqXuV+GiOOxLkdumjbagmHeeLJ+WscjpIiTHwSIEVLh",
-	"4VzZHNpa4m0AwS+DwdlebxskBCZiQhn+E4WmCZiKadB9wPyc+g3Kh3StRZBLhfHVEd720oS3AXKVZOMc",
-	"gZ8oSyxZXX8pXrPbz2yoaqaH6Cr5NaxgEbF303JutWRfLvyWv30dcEUGk5Qq3AY4tn7c3/ej8n6kvd02",
-	"oNCU+8Q9HuUXlaiMhB7vEki53kxLVdAX1dTSYSpFoayX1ipYpzgSiOWJfTjPu2a1wd2pUASyhMCSxuQv",
-	"n7tIBUEkYPOpUK+y1yr9SYWmYzIGU93uwnarrICIoFuh2w2voJospbEX2vo119tN315JZtSXsnJkXN/F",
-	"yrmeAy+WEsweqX6m4bx6S3YIRuWSkE5znwKOtjcmLctdCcvC0kYBKQ7QW4lvbK/HN8xB+IWmPcXaS91M",
-	"mSs7VD1H/WCaTJOzeaKKjHOz7oWBt1vTxHOGugE0L55jw5wJ/3HrOR/qZj8O9fTKqPwZhsAB01BYAd2O",
-	"nuMQVH7QeyrAKU2IGvHCt1SfCMQIjMAFYlINUyRXIDV9ChvhAF3Iggme6ce6+6JOrzx5B9k1L5qpUgfV",
-	"AIWdK3JI5mCKSCiJ1TZtM3op5oX6WTqHIYAkQFHk0ysVXg715P91WVZKdaszOoPDzZCfYTfVembWtMvm",
-	"hE0wF5TNTQHU8nt9U2n10S59D1rXhnhEnYAp4uMBBc6SZ9v9av7rrsEpm+IxQbo9/9tuw8P9WyNxCCbD",
-	"yQMRSts70cw5mtVJLouo7ToRMbXEJZzSM05OcSU1ZV1466mp/oRKs9SclhMmbAENs1oiizhs9mRfabFz",
-	"Y7KrRmBpG6IKm/2N/b3WXH8g29jSUtHU13VTfaZ+//3g5Pz94VuVEWH+02Psr2x0F9p4eyztFMFL2thS",
-	"D9cuPFMz64iOCRZUe96mlEYAjwAWAHOACBxW6zt6Qhs+t6Kqbnra3r/9bUKFn4jRvQFVyZxnGr7Y7AZ3",
-	"v451xNidphB/cZ5j9XcpGrE1GWyorYcQ9OiMEMoEv6nHqA2gzWytCm3tejZfKheh8VKtNdRh5X7pWmK5",
-	"JAR0TOlxJd9vIqzHacjhpvwIFo11foH75TMPdB6rspi1qd7guTGzMMGkrsCvEOQ2w2hlS8dO8ARYqrwh",
-	"k2w/1ZLVg4tzNMZcIJZl8ixNqYUpHkIqZplHfyHJaPEIoD3NZUi++xXXC8dzFNOZ8mlms1dKRZcccme4",
-	"t9QZNujfrQUUocBO+n34fioFsF+eVuKz9zB34jv0qTlcbUWJj9f14egMyGCCgustV7T4LZXzxBjUzmdK",
-	"23J4s4c6fslGVwslr08MHK17ZdY+JAd48Eu1CCphVhex0tkmla9t5WbeErv203y+Q6Um2zfDjwqjl5f6",
-	"3pmeiPivRErjk+jyOQlqiTuPfTkcpCgHyi0TQ5W/4zmIizkJLP6WMrYeBaMSWuCAuxCJaVn2+tiQbFil",
-	"g+nMGXL/MZm5Zr0NYzEf5URK6Gt+JN2vWQ/M+tf9NIR5ONcRtX6O4vQHuTdh7hRz+84OoolgzjUlXUdA",
-	"+w+5C9m4NjqR21IAOi8P6AFDVRlH/+C8qTrl8+rp4ZCN+YZoYtmGDId2K0+OXnL3CrIxSLObnyrhdL9C",
-	"Npb/cEoPLnxDMWMrH2DPHBSoHFHV0CP9LIZz/VQfTFDYAQMKGBoxxHWDEPXntmp7o9tFmB9/B8rzD1K8",
-	"dRbLlUM2/pCWFqx9xMDEluDOgFBlNVzQLPJ+sP2AKgMQzVe+B40sVfjzo10fi5QnzW/VBcoqQz7gDfI/",
-	"W6qLsqmbiMSilzqn+40ubgYZAlzgKFrQbqfBvTDLr+oNzFVJr30AK/eWUW1gpoyOmU4aavoy9jMaY8N+",
-	"ci2ELBI0LyJZkLxbqr/yYSyHkNUd1zmELHAJ1qO3MJNrbq15txQCy81yqhG3lN6pjjYTL+rf/YYuOgIw",
-	"2bIkUSAXxYP1DPrpWhU9ckv7YaFSJ1z5pMeHub4ylc6/MgkseyUqzsz3olW71+YvXTbnRKPI7VJSiJZZ",
-	"cAkcfe5+t2/CiZfce3MGb+hto9y5TM1dt1PKPQNXxQf7I3Cua5uBnGvGCQUwPTZVSOQNw0al8RSzKTc9",
-	"4YIyONaajwoSkQoRFirvp3LZEHN3XWSzd0OKOCBUlU2u4sIGoetTYXGmOmq0YwEEpfZP63K8brEH0YNe",
-	"4EIvp3u32MsNpJo+ci+1+++DO6juY92v8v/6JES3tfzCV4wUSWSE6FbeTV09xFxRNYu+n6pgqSk449ly",
-	"uniTzTqVaO6FgSUV3ctNZZRi18EKZ2oyjHGeyi8EWklZKzWdsoxgQczB+vLvcsFpOpzI6d61EX5k7dpH",
-	"lFm21RKvbrOVz5mdltoMJlOl5X2SMk2njpoM051eD3z4FdjjUOWgTYIrQ8pmcrp9qexTrr0R+r9tzdUR",
-	"TYhqwIoJn6JAWO+Y87FTlSttZVnsLPi7aQPrh3Wv18sAxfnehxIQQoWEJW0GBp5JtJiCQO1SX2Jebt8p",
-	"94uJZTvPK66UPY+H0f0+5hwr5bJdGeU3FsI2F2SBt8pJs4bqLMxXlfbxeTaill3TGAvjL5XD0vxsvQpP",
-	"IsFXyEz11CTOF5m2paf/ChmrFtUVRPN/acLAm5NBqk0uQxbdr2mF8QYZB1kCUlYn2q9qZX0I7rtew+KE",
-	"gkeLUsg1flkxSd2p/76ORqbLmGyVwt4rbrdT4nJ135czyRN5Dc8VCV82Ik77r/JFVld0guUw8wBRcQ7M",
-	"Tycubq+3/4hx5i4pNGGYuQu0MKZO/724SKVjrUhUDxHi80h80Y+ZJaPmavHVe6h7853GzrmoB89sl7vn",
-	"jxVLV75Y3QiT68V+AHcb/WO/jY9XNsuaCIC3Es5NCAE10d29U7Kuh7zhWP7viv4logHMXwEnkVC9kfAN",
-	"yIauaT6yOL9QD7TJN1ia5G699GoDzDnSzahoeqaHPZu79iNc8ibnl5AnyoT0U2CZCfk4dcFMzQh9xUf+",
-	"wnymKvtSO2se4PmX50WXisRquNGI0XglfqTq4lXynlMkgkmhrF6No+fS/PwUEptXdqHITVRm1/jqDK6Y",
-	"iWxbnG4gEdlUPV9RudAbvn/TUkH518tCtiXnG9207lf5f8aBtlhj1oM381yQ5psawguiRFUA0rxEF7rk",
-	"E1ybieontMbUkS+kvnwrhEI1dKf8fzFH7D4V5Co6fnhptKnc2MUkPIIzynCdmlrmjZKxSVr7gQP5eSK/",
-	"DwteYd4BlTXXT9M1V2Xl6QwbD6d0YfO7a713MP2s7B/PqfWqGD1QtW0xB6oIu/x5pMY6Rdpt8S4OZ+pp",
-	"Dcc2vHaKdPFZHQBQgWXNwSxQq0sQO8NDSBG71j2WktyUZHAQW18RPL1dC52FNuBNnrgkimyF8unqsbnT",
-	"baJQ31VQ9IJbb51DKUzpm2yBkAUFIebTCM4BTAf/wNMi56aY+ogpagkrSPcNEgt29kDU9ugvR/VUdh9+",
-	"uUUFLVIKUJ0ipK6bRyP3nqj+/CGY0UOTx92i+7/w4V1Ffaci84oMVDdO0+/fSeDQvbM4mNNE3rORMt9S",
-	"KaED3uVvASTm++qWJ3+hV3s+oTcZGsQEilQ5UQ3HMlyOKGsDBsUEMTmOVH01gVy3RBYTFHMUzRCvzFnR",
-	"U9cnrfzVAg0UwcZzNzhkCTXpHbxG2Yu4pCij8HAaIx34ekWuiHI3gDjhwpQ3nBcqGoKbCSIghtemLK+J",
-	"RACXaTMAp4a+oHKku65b0B8TW6knowR3JYZGiCESIN4BHyT53GCObL1+sNfby0KUbFHV+lr9mpm5sREr",
-	"cUMzwVqheIWZ6lSeBWEMHp7XnUJNAl7Gd2w0BXWD0yq0bYBup1JYtY22O6PXKHQZ5EKudgYVjN+1p2qN",
-	"YJ8FZ5JMA2q759eeS6mMsApx1w0XwgLzlNcoSBhDRERzWyyRMpXNGSaRvoJDlU8j77m2ZjABo0QkDC0W",
-	"VJcW6L+PteJYlwrgSjuNFGMrrdgkVLhtwSkDyoK1Ak+xaMWkweJ4MtslRQ7ApgNWqkkUK8+lZCUhUFJ4",
-	"ONdhkJq6NITPCBXoABi91iv23Z50uaWfV/ZP+TtY7akEq/nIyFZMbpwtYrvOlpIlUmXBzYJzCZESIGVS",
-	"ppuoq0AjxdIY0gVUF7ThuYe8kqXTlsuANM01MepPYRNPkh4Uo29CCFoiPBwFWDGyvKRwvn8iwUKGHuyW",
-	"nhYhaN2xYTuG1UCofHhR1oY0uTUQHt+vlU1zMIEz2zoklAZ7hMw7oHkpNGavSnKpIK4jtcqGBNXC6nvr",
-	"vXo8CrEemWNY3lZxKQrNEBH8QfSoRarviQZlTYVTz/IEXqVcbxdAdm9Pi5/o03kUfnKOtH+txEWsKgzT",
-	"9yPrJZS2jSI35SwZolJHwpJzxORN6c8leWIG6E3mD2u7KWGmW+KCLodeKtabWcPNkZ9gJf+GnaIi5kNj",
-	"e3VmgTW50Gu0FLngDZGLbirsGuZGGmmYsuRANMM04dI0N/Z7B5yMRkjb6TiOUYihQNEcVB0kvUb1Uue7",
-	"lxznBmXEui+aEoUO/ojRQnHhr1eZuU0iOh7rltzVDcvfIPEOraZUJmKSj39q1HHH03Aja1hcNMwb4soN",
-	"lFkgYF0zvhYrafjKI0WG3EfrIliD1PY9hRgpKFQfNT1twqLWQWsixPSg241oAKMJ5eLgde91ryUZkwHt",
-	"q10zBfGunf5NB504f3BDm3nr7vPd/w8AAP//g+TqbgYAAQA=",
+	"H4sIAAAAAAAC/+x9a3cbt5LgX8Fy754kdyiKkuWX9uy5S0uyzRvb0ohyPDNRJgG7QRJRs8EAaEmMo/3t",
+	"e/BGd6MffEiWc/MlsdjdQKFQqCrU83MnIvMFSVHKWefwc4ei3zLE+CsSYyR/OKIIcjSIIsTYeZagc/WC",
+	"eBSRlKNU/hMuFgmOIMck3f2VkVT8xqIZmkPxrwUlC0S5HhEuFpRcw0T8+28UTTqHnf+566DYVd+x3YF8",
+	"D9Ejkk7wtHPX7YwpgldvEshY07ev7Jvu6xixiOKFgFF8jm7hfJGgzmFnEM9xCqBcIuAEnF5x2Ol25vD2",
+	"HUqnfNY53O8fvOh2FpBzRNPOYedHuPP7YOe/+jsvu73/ffjtdz9eXv70j/9xebnz8y//7zLr9/ef7V5e",
+	"ppeX7Kc//vtvnW6HLxdiIsYpTiUsU0qyhVxFDqrOxQwB+QwMjxngM8gBnyEDG80SBCSqkQC01+l2MEdz",
+	"OU5pCv0DpBQuxd8pnKP8usU6ARSLz6/2oN/vduY4NX/vrbf00Lo5pFPEm3avSHMX6ivxPY6uED9HE0RR",
+	"GqGmgS7yrzta4Hgu/mKcQqwpv36c/Ot3d115UjBFcefwR7OdXUfbGt95qrPrLwPwk0UWGf+KIt65uxOT",
+	"KEwcowRN5eHa/PTFaiw0jINUg9JYLFY8mxA6h7xz2IkhRzsC4NKOdju3O1Oyo38Ur/Tk13fdDuOQ8m0M",
+	"VUC1B78/iYO8FpOv4TWhmG+Di1nqVJh8mPNjDnHpAUVQA3yfbIvjufhXw2nRyL1QL991OzeYz5o+Uvuj",
+	"P/2E+WyUjfVfJRrI4d5CpbFTu/9vxEHdxiHKiZISykuIm6P5GFH57eocuzR8FW39t9vgn3s7AQIq4FGz",
+	"KANcLebOKLnGMaIjxLeBwYUe7kJOGBKDAhRAJlL+mbeFdGaIg2zR25aAbkRSDtIQigqaRecVmuJUgj3N",
+	"cIxiAXG2EGuQQnxCKIAgRTdACThgMNvrWGRr/H6tXCqvp+Wx85EhIJ/vTMULntqlZCeSmNNqKMDzOYox",
+	"5ChZ9sAAKC4HMANmh7oApnH+E/HwGqMbFIPxUj3KEvQNMxNQBuCEI3oDacx6Dv4xIQmCaQM3DfDEkkqS",
+	"X/EAqFcANe90AcuiGYAMQPBPTCHAjGUIXKElEMSRApxGOEYpB8PjHjjXSwV4UlIGZ3KM4vggkopORlFc",
+	"PCdPn31tbL2WKynNUHL1dzi92oilLxKynKOUV2hGVzgNP1hQTCjmS00ueJ7NO4cvX76Up0v91bdrwClH",
+	"U0QDao03vTemnrctEjbnGBNK5o3qsJvwtXj9rtvBRfby7CAvmXYsR/np739rZLoSCjlq7co/MkQ3XzKa",
+	"Q5zk1FT1S7dJ8pZIYYIp4x9WFdubMVvM5P3VI02PkSXwgeEp7KNBpEOMB5ODvWKTT245SuOtCUMkh3u1",
+	"HKGIpLH8yZ7QZ4EjuoocKK67MFUbvWFgRRgnQA2gtAgKUy40IWiNFObF8VKoEplQ38QLTM0mFYmcvjbi",
+	"aHFExLV/G1efSI9UFnWfZojPEJVgM44WQhibt4VoSwkPC1wlsH6ASaamgHGMxZgwOctNXaL+st6ohgLX",
+	"ciyAUo6o0wQyhii4meFoBiJCKWILIrBMFMRSTxNw9zrF/cpfT+dw8aOC4acKArA4KqytgtLP0RQzjuhb",
+	"mMbJNngavGGDKCKZ+tJnxIIDf97bvwvxEnjDBCRFA1nGdhBkfGevk2MaL3Mc/tuMfbszJdff/eMPuPgj",
+	"gn9E6R8o+4PB73a+jVDKKUz++DYllM/+YCTjs+/+8a0Y9I8bxPh3//hu5/IyDt45lWQp7/Pw2NwOlFFF",
+	"2804AUqWCgMZEAKia64Pcae7gYTqdmiWcm3QiNEEZgnvHAqU7SRwPo5hIzPEcccN0vW3yMd8JYUIndbZ",
+	"NbdyjOf6s2Ytl3HIsxXMrgrckfqqiAg9WO1KH3h9MYow04RfrwQL4I7N23fdjrhRUByji3WU6JIWqMdt",
+	"JS9Se6P5xtx5tJjQN0s9We8yvfDuDUbISBBABFMwRsCsIhWcEqdRksXiqfnZvK0vtlb+kHjZu0yHE4Dl",
+	"xYvMMefiUiZeIhRPcQqT4ow3OEnElBkT15PihkuZz7Zk6lxvT+9lUywWkFlgT88kZBBTEKt9uyBXKD3X",
+	"v2+w/hlUQ4VVQ154VMG07CBtVj+c5MhD3k49z8oVSrvADGjpgNNMWowHGZ8phX7jlXs6cbWCInUBrCAU",
+	"b2PGKeREalJHZD4nKXgNOQorLOLjJroSiynhU35Yr/kWsXqMOMQJA3BMMu0PyvgMpVygA8VyIdJ2pHWO",
+	"gqluY2y6u6lyoHxcxPpOrxZVhWQI5jDNYAIy+YFvtjEql4dn4Kax9gsJH/j2F/eot5wnv3wnPocRx9fi",
+	"O99AGNqsypt2cDVtNuRC0rjCK7iZodQouYK9OfZr9iFnBwxZ+TbeIX3g8vblFmKobHUuIMsOvB6dRnKd",
+	"cUEeqQvKsd2HHxBVDH9jPFyrkcIKo0df+r0e+KR5EAQMza8RdZa5y851v/ey17/sSIstmUxwhKUwSxBk",
+	"iHXFfeayE6Prf3szvPj57WD0Vr+6oGhHvwXGGU5yRsYKLmsAb4fm4joATpXpQkmVbueEUrINPorEOM0y",
+	"Qr3WUjzKlwFFPKMpioGw9OjbF73GEZLwD2OUcsyXRz4f2MJ6cnxe2q8qjH1YA2COcDMOSl90w7O1wdK5",
+	"RA7zt9U7VGamApeUlyHMPDKXqHyHGXeedBNTwbaAzBTdym/SLEngOEGdQyHJA7q1kE8rub4CIpN1umrC",
+	"dpaUBDNpMlEyPmbgZkakqmuUM9+YIgzp1DHEPMaY4mrbID43Zms+7eBQYATdhO32odLYvhJqT5RRwIq/",
+	"AMK+OKq+OJIc/RnBJ0awx7F4S37Qw6iuJO3RWgS28bRKSNw8q/A7aPHmewjNSBp7SiYczWA63QqtRWqk",
+	"1gjx598epRkoVqCyMyiu10K9MlgrgibQ5WKH2FauAna01ghzEGwPXZrIfHDWIzQPPQZjJkZoG/iamLFa",
+	"Y8vMvnVcOVDWw5RFi8GT1Gi2gSQXDNkKQ3Le7aHHxu61Pnhl3ChUGMRoI/422XqAjbdH2MUbDVGL696K",
+	"iNB3YDL+VV59xOo957C7qQzOhucFjSFnpdgGrha5AVtjJwdHI4YKk6ymDeB0Z0HJlCLGijYCBsZIWA9U",
+	"dJNxV/nmkdxtwikT+k49ENYQzJdbUb7UUKsaEwwI21S/9IgbScUAeB7iTq7FWraANnRtYuhXQZqcfnsY",
+	"00BsA18WMg9ZD6yp3rdRaxtoslx/xGEqfCb6HrI5olhuwNZYyMPRiIzCLK0YmnwVzFASC4fQwrIwknGG",
+	"Y1QwoBsE5cKU7k8ycjfNCiLSfdSIsdwEm9yZfYSQjCN2H+igduQVECHBaT5HauhNULAlf88GpqhmB862",
+	"rVNlVqKGKAbtrIWXFTSdOsU29ypQa8n5arflsmjN0+9W0Ucn0sIsIJUeGWPdUx5XPbTzt0qbUTls15lu",
+	"hKWVQ1ywyE5UrC5KlQdO+KTm8Aq56dQbcphep1tYv3aNDAKntiarZtUctopoGvcdzZKf91/c7J+gMd//",
+	"9xfp63//5378Pdx7fXHy8j/6/6xK01GpCZ3hsRyTHWWUahoou0obEs/WzBELZ4dtltXV7ShP5aq7Uul2",
+	"GoAsxb9lyDlqpO1+ghGVxCF0fI/OekA6IzXNSsKTVMJ0woB1W12mn4TXUb+Emfawxl2A+TdMhEZRNJcE",
+	"G5GUYSYOaO8ybRehZFazagqbTwhdj759rAr+iLmiWHf2Ske72ykZvivOp3vDHdJY/o3igP9EY0zkDAis",
+	"MfmSf7XC1wjABQ4c2K8lbfUx5Jp+AS4zRxzGkMP2fOO9+WINHtUuHM7NZQLh1uVunvfnkWTA/nm5nt7b",
+	"bvusXkt7LbljkAvqLa7lhe89It+GOqG/erUMnmqF3veIMThFNW/oWW32xKqpwnqUIBRFN4knVRzwPiD+",
+	"cEE8v/c2qxrTI3vAy0xTEUgh1FIQcqfbQanIJvixMzi6GP5w0ul2BudHb4c/nByHgRkZWiuhtqTfBI6Z",
+	"DnzWiqjHuEvCa+HFMbS5IlTaPcPLuLBUX43RHCMLLMZK7ftcVc48ECRpk9RWlYGwOtce0Gk2R5ppFu8w",
+	"FVjWcNQguw27CENRdlIROj9JkAmWNiQ8/HD28aLT7bz/+O5iODp5d3J04d1vC/oFTqe1iRvtdYfSeq5t",
+	"Vsia8SN6AB/Sbm7RjWh2yAvlhTBOFgmeziT2hOrTQQezJ2P2ZHaLflveSnik9Rl9wmlMbirC0+CSmYyG",
+	"G4SupJI6Ixm1v8ZwqdQ0fSZ06La0kqOuVGTBFF+jFAj2+jtJUVmL9eo7lEEQn4nJihPNM8blPXe8lNO8",
+	"fXv4/j1Q/F7mWID9g8N+3wp38aqDWQDh9Kq954f9foVKRfnqsCkkRGRuECEjyspg5oDov6wAwiAuwKBS",
+	"MBx8GFjUAqEJuHDBQSYkfYLh7mgZp2jZC40utlVsc0sCqNzrHhhOAJov+LIbfA7EfSddGuS3snV9UrA1",
+	"Wz8Nhqqrb9ij5JF8iE9preo94jMSCCM+ln+NkQjeMgHFfmT5GCEbzxWLkGgirnoRTBKZRi0DC6EJwfdF",
+	"88eL0/eDi+FRp9s5P/lhePLp5LjT7bw6Pxl8//Obd4PRKLeKPJQhtaZ8/p+9eDlP+Av42216e6DOvx7m",
+	"TPv/yss1T8AcxghwItPTgQ1Zg4nKqPNzCaovqRUk5lIF7YsuV0/glKII4WuVNh5Kh1R0MFh9Fj/w22YE",
+	"mqnDs4kn4QlwGqNbc1Ry6Kke6UO4ckrJG4IWHe+DrofR0PoDhGJ3uIbkR8GlDQDD6TSRCuYUqZ2eZwnH",
+	"O+oHu9IbQq8mCbkp73+7u/7NjDAEdNkPMIdLr/YCZhaRm9SWGqEo0wnsQcfeSmQUY8ZxGvFmevKgt6m9",
+	"eyGSsI6A8rzikURTjsAEppR8WwND4borxnNg75mt6GukSLSCtqzdqUxd+rlJkHXGaalcs7KqwCKYQN4i",
+	"gerEvZmzN7UnIIHQwHac0lhm7+bOOOsBaQeQ/waQ2uyPuAvQNaJL+UQpLWMEGOSYTbCy9BExIBijCaGo",
+	"WDXEiBJXUoSTBUjQNUqUh0b+rlYnp8XTlOgSG+0iVP0dDCBhA5pEvkhYgSrzxGWJJ0BerxIYXZEseA9d",
+	"IIpJrDJhnD7yDfc0lpAiunmRMZef7zjPiVI+lwhSoKIqwYQi9DuqVDq3XKFMjSlVIv/8WgSGkFu0+5aQ",
+	"/MoLiGWIC2sZC1yRe+AMUZWEqWnWC3oXdIIpIDepoRbmF9gBcUZ1ApOpP7ON6jqbCyh5xO2yOBErC9QQ",
+	"Wk1krXDYFCK3DEGFF3nqwgoM3RRpo5Z+dJB26JASxnfMxgYzU6XimQkdpGJt9bWlSgv2ErDLz3x7ZYvD",
+	"lzvsVaFCw7juaaWd0xB0xePNUt+rjKQ1Sw2HK8m6QIUSSf7KLII8C3ajE65EOC2Iq51pVGZlVpJcPr7f",
+	"Si5zRTs9O/kgbKdH3384/fTu5PiNvKG9fjd48yZvSa2ALUA9udj4wPHQokKeAVFWpZgUrBNWSzlXhUzh",
+	"ciillk96ZXpH3H6ITaXRTFy6dEC7+tEotXHQ5gYjTuiwsTKGLndC1LIk/HKZQevEqkeyRi5PMEriChJR",
+	"zwyEFDGS0Qjpoiw3iBoY2+tVamtfi3Gr0zJw3FA3swypeqZNakbPUsB1RTE2mIbNPGZNlexIPTalD9sm",
+	"m5z734Vri/hv5ODoGlJ0pGN3yd9572j5U4fYQiVotdUci/sNmd1uWaxF/6WEvvm3Pns45YiKghJGB3cp",
+	"/FpXAGTisxHFLbXrQBn+5af5vxJROK7bmekY+a61wst8bSOT9X2tCkPnedRXsB+fRqt5ECcAqmNi5LMa",
+	"uwc+SGZvjpBAEUMLSMXmCaYVE8682oL6utETcIfkt1DTwrslLebmiCpI5Ns+CwGnWh3C/muYCb2fIRUt",
+	"pi5arefw7mW1k9zA3Czyx2bbjnqttH/+noTIPFyLurQkmCuKItmFPFbKre0Hk1lnWdiZVcE1NvFLhdew",
+	"tndKMo+if6oCT60x2uil+hrdTI342ZZ7qVRmc0tIygHvD98O0BcEPokObp7Pk+e8AlCvHmjrDMwSNHWg",
+	"ehMUFtgWZi+BMei3Nk8Vtxbc9hvmG9gJgCmRDgzxTBcAlv6sG+kcKbPmfJH2VhqeuOdrDdte7onwCc1g",
+	"MimajYJqy9ZKv1eoWvdVER7Hna6PsUbHlLefAT5wjOE0JYzjKFRSLA4HwkgrYRPdviPTd/I9Ga5WFVVT",
+	"WJ0auaumdt/5y3EAtzuVk/Gz/Wg8fjmODg6Ud+okZ+othO7bZ87wpOICpSXEEptS6GbwGoGUcOWds6Yi",
+	"rHzSFUrIe5yaJIU6N8BcvZazWCyFjXGBZAqL0R9ULTJtvkZxszNA+A6PUZTgFA1q4RlOBA66JctxzvcY",
+	"q5FiQNJIAmOdlQZO60eYCy+tXlYzmOvazmAcCxu6tdxRpky1hVXkEHYvhjQ3vwJpa1AU8wb9TezW2NY8",
+	"ug9wApuKXeIDFRyuujtDlZEsxMq0d0h/40Fr4Wl3zG+Wyyncf3rwnLI9VQbLDFAVhHVsQrDUuFpSma96",
+	"oHR6V8ZDtQnvkdQg93fgRis6rg55YSMqYrDabscbCtNwXB+aLwiFdAkgY3iaylpPVrlQ98MFxWmEFzCp",
+	"dK2Uz6OsKKzjaGxl4XxkzH5/f3+n/2xn78lF/8nhk5eHT/q9l/t7/9XpbiSyu7lYvTqFxm/1IOFzEe55",
+	"SInu0lRfkds6eIK2Ssq/GD5YjRU1UnHCAkIeAE5bN85OPhwPP7zpdF2w6cn5+em5Cms5/V7aTE/+42x4",
+	"rm2mJdxkil7DtCIqdws+LWNSfG0zvDHl2ul1GxMuCOtA6vqhkBUONXV8AnzbBnfWNm2pSI2qaN1yZEop",
+	"l8XyRr1dypsiTT3NoiIYiC65lw+wg86OnMOgNoYVMTiMF87Kb+OmTCSzJThvKPdFuwgp+GQS073n02jW",
+	"P4Bycd+jpayRXd64KxR2zFyb1+sxJT43L3sQ2/nase8nT3+9Rkn28nZvP9mXc1h1Phcz+/q00+18Gpx/",
+	"UEdTnUhvWvtVOzwtouVV9CLZu44PiJmWXGWL2sxFMIc8mgmdz8vlEdIMEPmOKY6ujGpLqSQajFlDd6g6",
+	"WrULcLXqXAwlKOIiU1eI41MJlCv+HqwpG1qSUKzdUNos2lWqtTxpqsasTgzLDaMxwImpRCz+uaBoIj4Q",
+	"Lwp+phRUvXi1R60MFJa0GhVVh5UciRR2uB2FLma39Lf+S74/ud7/XU51VilzzZPWKh3Xlv0W+itfLnLL",
+	"OXOcvLwMLWlVNu6nkV2MPRSKUbq/DT5VQTCZ89b2G6kx3PmI8SzAW5MXmCl6hkl9+WG/T4Gsna2/Ctcb",
+	"xmyEIop49ZiqvYI/tLHIy+qm4mPwbYJFgnIKBmdD224ILCBjN4TG3wVnrpZUcswzyGdloKQmB/lMnKqb",
+	"GdJGfQ2FKfzMOJEdnGTgunomr8RwiiiQkA4+jcBo9B6cQQrniCMKRuKbXruUrrCIdNvjYTVArj5ttNTw",
+	"n8Lrm98Rudkf//qyU6azCvHW3ODA38+g6c5Kwio3S6ANRkskluRmaE0t7U7XB3Q2jm8Wkyucx4+qSBAK",
+	"WtEvmMLzpokameTrJfEZJdl0Vu66ZkJlxQC+qx5czBBztw1lKPn731PC//53sES8Mm7NdvPAsTWYFYVC",
+	"b1cxdu1S3CULlMIFFrWra/OTjopjBxTHdp1SJjBhqFtztcjHMShpuEbXk3B7DpsAOjy26oTdSVUFG1wI",
+	"IS15E4VpTObg+9HH4bG8214THIMF4SjlGErxPUlwxJlSYQTt7rAFirAM6rTjCjuTppJivXAwwUk45oG1",
+	"jmXJNfHzr2FHp+/P3p1ciOvXD4N3w+PBxfD0w8+vB8N3J8feb1IbHH4YXgwH734+Ov3wevjm47l6d/jh",
+	"57Pz0zfnJ6NRfpDRx6OTk+Oq21s4ZHaQyqYNJtTGhNgL3MSyNofowODEkFL+TLBJ65CLUnehUz1ntaut",
+	"qcdjsWi6f77DTK+u2Ld+WLQqtGR6XHnxAznBCuuFY9gtc4UAwxzpcII2rHIvnT+h6Prlb+j3l+Myqxym",
+	"jNMssnU4i4Wj0ELXYl+vCt7IG6C5fpR7t2rROXA3laUlCANeGiu5V0eAL/YDtIwLmA/YMXnSQlNWrxXG",
+	"6+ZBr0Knv/itYdMe4PIFTDGOXB6Wa5VV0eJr7Y5h1llivolb9HSw49ehzK5wK0cwrwEU5d+1fQrgFIpN",
+	"DkQP6jtomP+Vkaiut3peVKE0LiDlOMoSSHNaIzMQodgPXTMnozJerk4rdWt0MUe/JJjxHcbIjnS6/BJk",
+	"3AmZrlA92Hk3g3kjbeW3g9YX3nnRO/p4dKT+5WyrzoDTLDaslChuVRVZekS0LlGeI6XSRei1gAel0bKM",
+	"jrdEKANcFc+QHwjZarxuFC0Q5Dml5ngwfPefwpB1cvL9u//04Q/NF9hjL6ajpsuhNuYwMkd8JkDywm9t",
+	"Xa2C5r56HLp74QenMpTfKqWjtsnl0W/fdTu2r1Pbyp/2fena1a6g+sLHUFXkDFkeQiVzaiPmzTaeTpoO",
+	"eplilK9f2tl8N3gKSGSG1aGY1cH4GxYN0eME25O0jdnXO+EV52nq5awy0QvdllWAcMmZLWJbBT33tthJ",
+	"fxsFV8KRwwabHhezffX9PSvlIJRPV0WuQSkKy/kbbIneL1eOD6avarqWh/qGtUgIcpEeNEsqOolF0G91",
+	"V37+V8m/Up3pUOW/v6piPeJagI7Eu4WTVuYP9XUBq2hgrYJC1X4tPwx5nYhrA6YeJxhwvL2aTF0P4LVK",
+	"BhVLpIesSiqBBFAUERrruonO+gazGHOQkKmX2LFAVLBnmYoq6Li+mXVQsSt43XMJUJWKHOcUjzOb77dC",
+	"6fqB/lRrZc13IIUNY3fUqS0WF3UKUENyUPAhQ0ycxopP3YdliN32KNeu3MobGRIIY10xB/WmPSDaGUcJ",
+	"yWJxkpPgAnRSUbh8lhdTEtJ5EONwvriPAGA/S1Jjwg8nselPDogCpYQ4kW0/0HRifMIJ3r5g6u2BphqB",
+	"fweCyXJyJySUGTn6+OqfohBXtzM6GY2Gpx9q4PaBqr6iWR61sgO0om5YTakw5eNsf/l30QGhy7+mATaS",
+	"IQAVpmDMco1mpfFDBw1IIWxLiehGni5nhxb1Vb957mpWPrPuEMyB/TM70tImwKZ0Gh08xzfTZ3vaJqDG",
+	"yTgZeFVrQ2yBkx1bXGNBEhwtVdSZmF+zcldiyWrShLo2xtIro2vryvjeMiP/NWNC0YkqzGWfZkudLlyY",
+	"385cKHARvO5ZOqyNLgwv0USF1M8TCFpDep/yKwxsqb8V1eyk7griV4DImC0QpMGV7UgF+CTjshiaaDfD",
+	"WTAFO2ifrcaefggSNOHGgWzbKIrUExvlz2f5S1DdlrVOvPVc1uqI1lUoqMjtH22ze31uyPJWu1FqNrq6",
+	"DOb9WcdWC9Ha1By2sQptE7uKKJS3ibCrwnB47xwLvLk0YU2q1HZLLZOox+X/Mun9ZdL7FzPpFWopu7NW",
+	"ccdsNurV5ZaJdtD2sZ9VBgt0KRCfEt4moaxUcuNkdDR4N7hQgcsfL05/Pj45ejf8UBFWITOTzk2i0np5",
+	"TVqTtwnhSoK5lW6S2mTLOOThLG9JfW5TrslXqAVcxSmEgTqWbQSDfV+n2w1adlMI6U8rtVQoS+XKUier",
+	"8qv2BfLKWyKuT5TMJf8ffflUEAHLaD0GKz69WI/1yXWo7Kw4bEiWbxxTPGlrHH7jPjCfv4Y4ySg6r5ac",
+	"FZG8JH0ls6erxWmgHp9QiE0ycqwrrum8bM0W5OFaLcfSGN3scS2z0WuU+szSfAEoStSF1jcvrBXpp9lZ",
+	"7X7VG7c4eSz0zsma1M7JWrTeZLEKVuDJ8eh2toDbvae/P/0tShCLf3vp2wJOfFW2jPY5kXEAkbok2huI",
+	"VIDjlQ236rNXyxGKSBo35nUz9Zo3DWZ6ahmyXFWMuKkc3MYMfiWV02K4qfLbpsUllSZXxHFRpatzwTpi",
+	"qNEMCstp6oQRIBuYmnrkdQf47Oz8VGWV5fSyCoirC7yVBUA5v1n8DGLEUSQrJiF+I2uIm3xGTeqavF3I",
+	"eUVAltBjztE1uUJxtceY0MUMpi5xSTHnuTRryRLl/hz5SgLhS+kVVum9BpXvh6PR8MObnwcmdun0/Ozt",
+	"4MPJsfmlMXZJjtjNLae8Ax5eq4nGBQeVEXKuooyE9VXY2rJECidtvJqQJCG6WR/Lxgxxw/vxEUxQGkMK",
+	"zs8/vjsx5fwv0xMYzfzLqcwSZUB3sWJwnu8ZAE2l82LSr6FbsfsJZNyV1I29Mn++ZfAyvQhXDpYVH1TN",
+	"VFSADjNFZCi2JbuSpXLD5olqvDSV+Ft2iZD/S5bAXf/VvAwI7/AxmsAs4baGmm5B4ELDDTo2bxQgVEEv",
+	"Aq2eZZaDyGRwLUe00ljt1QOXSKByMcweY4dtll/3XnNpDUqDQScX1lSiNjGtIkdVfTM+TZNlde/RlIdM",
+	"fh+ID7neESht7KbmGmZ2i7boNpt48XsKtvKp9w509alfuVeSPUd+VwYnB5xwOBp8ODp5965JOjQKBaez",
+	"FTxc+oR7ikpI0fCZWgtNwENafV8TzMiLZ/09YH2SgsI+XhwBr8nGdtSG4kLLOLwwlqE2auYBIcvfksmL",
+	"2zF8asJQxRXnGEWYVdbJUs+UFZukAYIIk0N443PTBXZ+VOqeHYwn0y4uZdKZEREpnQ9ssN4tq8z4Tfmt",
+	"YrNSLT/z+CKcVLt2ZQZx91RrcGpMuBFNQ4W40gdlKJzKYsyCGpdKl7IyuARFm+KCOQT5bvxi1cHCLgd4",
+	"1MWbt7qwZ9kvrx4AihYUMbkECFzqmFJrrfoHLlP9ge22IyqHanOfCjHShUmvMQS6OXZJZ7xhg7qwlht2",
+	"jqZVDvfYxsJvK35+kqXSoDig4RlnCCZ8tgxf9qtK6gphMm+bLW7ezsPS9RHlo8WBlEeHRxNux9sxs3gS",
+	"P3/xZIKiZ/1nshno7Q6HUyYgVHFTwPR6v+vqX/yYicIVA01wqrKdTVfILhD/VbluQjX8OARIRU04VRM6",
+	"l/9qMRiuJtg6Prb8aort790hwrWF1V/n40AKLTx0trUqqKAXXuxaIR/KxBRh5HftPGJPi5OdcFTgRqfb",
+	"JthEqHSv27e269aN1TLeQ1K0erc8fxBfoapkBRprGQUy7r/oQ/hk//mzSBUKCW1uMLBPvwFMneOtlEFY",
+	"HWOVGAiXqQmjYfzsaYxexuO95/v70ENDRU2g9dqmint7u6Mlp30tXheYilZDYbuu/mqikXr3Hv2JGhq9",
+	"er2a0p6tslc30fL3Sbp3tXh5e3Vb3KvXGsd5ch3pvG8GoJ/qVswd5kRHSwMIfB4O1CKk4aWstRnLSvt6",
+	"dotsnGA2Q2Ff2XVltlFR97HD2Dhr862294Tx/FrtRDv2gND4ZTw5iJ4+jz1cnws1JXAx2rqmoWshVGjE",
+	"lahfUEyoDkkuX828Zq4VA8u0v5D6UtiC/Eg+uF1jH7OgmFGrNRAPte22Bz558uQlHD/Z29vf2/O2Z2RZ",
+	"wObi3ePK+dHbgcivnpPxHMEDNhvr0xrMoggY/iSamSsVG4jE5wTgNEqyGMnayPlwDK+mPfgnphBgxjJk",
+	"C9i4fkVgeNxrEmBVTSZ5KA5EZfbIJnlXuajQfJVAcSHyACv2ijDQn56Ndvb2n4RuZAvIOaJpOCJsKpkc",
+	"ul1QFQBug3UCEMu2ZzKeMQ/if4vJf+zvvPzp3/5WUUJGJquWITiaoegqPJksyOE90YMQajOdvYJiXlGO",
+	"cEa3Jc4gXYXuduVsncJFFc+LSdea9gptu8sxJH6z3vqoOe9dEZmg23yFOnzKNmmsrk+ao5Vc6zLW2jpr",
+	"u4wFWPAc3h6XDV4BZyC8FaZSZ//Gac5Hp0HHDEBhtVdbLG2s6sPO4d7T5/sHL/p9z+z6rB+yu+rHHwjH",
+	"EaoHSr0ZcBs6N07JsaCLiEiLk7h6YW4z86RZDqfTnG04AGNBTgRw6DP+UhpUiWZFk5OyvFVFM4NB9pgy",
+	"/qFKAVmn12KFtpnAmnkWOOIZRRsE5rkCkfeoo5raow5pDnQvCs4uNR/sVr6Jyc1qKlt3NKPY38ROJH74",
+	"v5E0E05EUC4mqjZnuUSd/BZ8EBhIPVgPOzPOF+xwdxdeQw4p600xn2VjIYsiksoUvojMd7PdvYP9vYP9",
+	"fv8f1//nQGD2n4TNfFjshPUV8taY+PnBfv/Js5dqYrEblX6rAfA8TsJn09Ux3EVningtIjHKlSJ4Lwpq",
+	"XnyUxQjEv96KTmDn4ro+EP/56O+XASEg30xTjEBNjeOGG24Cxyh8OFVeR9P3VVfh1hVMjUVBARKoybZC",
+	"S45ytoiXZLNyClA1aioV79arVq/lVo3j4qpPFytUz7gl6FecPY1w/2mcCaKVPscJ0UWDOFQmd3M2nZ1f",
+	"MAqaeKcjf7hLBnLvU1FqseP3HPIHtTfEzl6vrwhKVooT9WZ7/V6/IxXDmdyKXbjAu9d7urTcjurZe/i5",
+	"E8x+fYO4EM65Br9Sf3aZAz2Zl4SULBvGmtu9w35yrQorYQuSMjXZfr9fxejte7uFMc71A7nBLJvPIV12",
+	"DjviLV8NE3MZu6vo4fpRdtsS34RWvpvIIqmVCDhJ4wXBMpqJZzSVK1clYWUTsgRdexWsFXq+lWVgsaw8",
+	"NMapUn2khms891GCvwtjrVyxdWFKVooFBctjWmOFMAzjHuoBR1W7Ig+TMdJTT9mMZLI/FkCpYJCxeh+M",
+	"YXTFEshmYOcy6/efIPC/9juCpjuHnd8yRJeO1euSZs6mZDsSliYNRowEl4DoHMsbyUhcM1Mgj2pXwKx7",
+	"YVDE0HycqKYdJEFAQKOAl+ULdHdF674JQV6cpWcYgltLK2jhjVT+hEsB4LhiMv3CMK4d/6fwsdASUjfi",
+	"T3Q62O6vOk7NjddKlS8RVTk2vMR4Tr8Xbx30D5pP6QmlhFadTTl18Y4+hkzGuKgbn039b39mP6ueFne1",
+	"bEul7bPQRe0yvUxPUtPZGFIESJosgSz+zAmQQbbe+/kC116PQB1pSLKFbWCs4mRlRWiZSud/GSOGp6mM",
+	"51Us1Ga/Bes2DG2jhpgg2ahujpD0iDJ5GVV2HNYFELy9uDg76O+BLIUZnxGKf0cxQGJjVGKqYF2qAFqZ",
+	"57xB+VIJGxHkSmU36ghvb2XC2wK5CrLxtiBMlCWWLI+/EK/u9FO/sa7SQ1QwTw0raCL2XUMt9dK63NW9",
+	"2NlbhJ1ZqhAMNRESlExsOspf56PyfAzsHmyu0Nix8jT8RSi/qEQ5Evpyh0DI9XZaqoS+qKaWNlMqCmW9",
+	"tFbBeo0Tjmie2MfLvNNImQN6FYqAq/ha0phszoHtiNFGYUJpRJcLVejhCqWmiJW4By/g1Oib8joShihF",
+	"t/xCfLqOarKSxq6yk9fQ2+VWKTIjoeJ/R9opl+Ns4Q0v9st07vNXJF5WL8m8glG576lt+lTC0d7WpKWb",
+	"TWExJCxNCo3kAP21+MbeZnxDb0RYaJpdrD3U7ZS5sqU9sNUPpsm02ZtHqsh4J+teGLjwZQf2UNYgQ6y4",
+	"jy1rk4W3W435UCf7y1BPP1C/A8bAA1NTWAHdnp7jEVQxYJyD1yRL5RtPQ1MNTaP0EaJCDZMkVyA1tQtb",
+	"4QC7kEYzfK3CCO6LOoPy5D2kV6x4TRU6qAIo7l2mg3Rp+27mHNCqS1CuQZIqExTBNEJJEtIrJV4GavB/",
+	"XZZlqW59RqdxuB3y0+ymWs88t1cm/SqYYcYJXeoOl+VIorbS6gcz9T1oXVviEXUCpoiPBxQ4K+7t7mf9",
+	"r7sWu6y7g0R2eWGnf8vN/Usj8QjG4eSBCKUbHOja25r1SU5FhuyoUIv6ayrzAnqYK8QkhsHaiM28mk2S",
+	"irv5tIAu0EFlrFvohsNUcIKR2ub1FN3I2GhMZbKUjm4EpkSkSI4QU0obj7QFGfBkrh/ktr2K+UKlJpq/",
+	"htJcc4NEwzuiBhBrNKxRZj8IN23iplQpWxXXcxWnc6SRGSaHwpXWX0qnbcCrP8+5P0Dg0k1d7FkeaWMk",
+	"UF9x03YYWs3L8Niv+LkdyrOJh7qFbkF3eCdJPRfUFVmia1QhXBTarhefWytQuNdPyKs4XilBju0U9RKk",
+	"HgWlUWo4tFuUBTR2DWKaUOKCiGrYnzLT6Wx++X4lI3hjntea6B7osHTDnMeViC2Z94YfLk7OPwzeyRIi",
+	"+p8/dbd3ChV66ojbInhFu5q4e8tvTe2CIzJNsY5hBQtCEmG3x6q+RgrH1XccNaAJ5l/zei4/fwibm4Lz",
+	"sRjatsDi9H4a/Lc8wbufpyp+/U5RSLjj0rH8XUVN+wpHmBDU244QygS/LQf0FtCml1aFtm49my81k1B4",
+	"qb4p1GHlfun69PvCyt/YDJfjSr7fRkGf2gSIbdkODRrrbIH3y2ceaD/WZTEbU73Gc2tmYW4hnsCvEOQm",
+	"33lt64YZ4BGwVHFCZm491ZI1gAuRe8w4oi6veGVKLQzxEFLR5UH/iSSjwSOAZjdXIfndz7heOJ7Lykws",
+	"N3qlVPTJIbeHByvtYbEuwl23QkClBJhBvw57b6UADsvTSnz2H+ZMfIV2dI+rrSnx8aZ2W1WPIRJJYju+",
+	"aAnfVM4zfaH2PpPalsebA9Tx1r1dLZSCdnBwtOmR2XiTPODB22oRVMKsahClcl8rPey+1grHJFNGPPNp",
+	"3k5SqckO9etHhbdXl/rBkR6J+K9ESuud2GXLNKol7jz2xevAohxIs8wcymziwEaMlmlk8LfSZeuLYFRA",
+	"CzxwG5Foe+3Xx4O51yoNTGfeK/cfh+36b7WPv/5yhlEffe23ZPezq79UH9GzsP3ZlyqKPsxRzlxvsnsT",
+	"5m5jvraNaCOYcwWxNhHQ4U3ehXRaG5HMTGEiVSUAqBfGppGb+NzFUXit6OrpYSBm3Q5N9LTLWGsOuzp3",
+	"qrecJ2Ffq1nKo6OX3LmCdApsrZXHSji7nyGdij+8jl6NPhT9bmXQxVmxpFwPXHifzeFSheeILO8euCCA",
+	"oglFTFUgkD93wQIyNdkv+uEvQFr+gcVbr1muDOj01HbsqnVi4NTUTXBAaCerA80g7xtm1tKrdDzKr0IO",
+	"DVco4acvdnwMUh41v5UHyDVce8ATFA5VkAdlWydRRgu01J9MbUuKAOMits0VtI505UhVHoEhDirt3Dl9",
+	"S02/rjUw1/q+1gGWy49UswroF6aJSHvP2Cs0xZr9TDMcy/LLPFtYJChelLrEmDOv3HelYyyHkPUN1zmE",
+	"NJgE69FbGGkzz35uPyQCJf5aIm4lvVNu7e7n3N/Dlia6FOB0x5BEgVwkD1YjKNe1LMHo98LAXKZL+fJJ",
+	"vR+DRT0JKONfmQRWPRIVexbyaNWutb2ny+SZKRTJ7Nss8mRy2vIQePrc/S5fpxCsuPb2DF7T21a5c5ma",
+	"5foNMd8zcFV8cDgB56rSKsiZZrxQgK5S5WUY9A3FWqUJlNbjs2JRJcYJhVOl+cggEciROGGgbtoYM39e",
+	"ZDL2Y4Jks6+Zrs4T4sIaoZtTYXGkOmo07wIIcq9vg+Pt+qexMVF02wd46E/+ADf2UWnStk7ulVb/dXAH",
+	"xpH4XfxvmMbotpZfhCrsI4GMGN3KanULdxlRo6jzKavw6zJdgSXbydss1quNdS8MLKsofK7WFhdEQAWJ",
+	"j7LxHOepfMTRWspajmrFIIYRNMQcbC7/PjbspseJ9Klgao2b8yO/HuAXklk/aBBYQOLI4kDFPHlnmzT3",
+	"mmwhtbxPQqapdHGdVb7f74PT74HZDtk/TSe1UyTvTBoBYjJEKaFMWSPUv00F+InIsZCm0JQtUMSNdcz7",
+	"2KsRam5gv6i1/GBf+gXIQlQVsB70+w5QPMnfjiKYpkRWEDQ7FoNvJ7JBtyxR1vWQ52ON52v4I4BTw3a+",
+	"qzhSZj8eRvf7IWdYKddwdJTfWgjrY91krfJKK0C5F/qryvvxuXujll2TOebaXipeszUZ1CxMVDpfIxs9",
+	"0Ckj3znF9FNpk6Uukwk09ZmFq3QHXetT9qLJlwGtgLnYefdPFUpvtryCeP+TZBS8ObmwWu0q5Ln72bYG",
+	"bJHtFGrHF1b5XK/e+64V05zM9MWiJWwF3w0KZHiNGzfRDJluXrIDbY+augB8v2GNqvAier1ou5gx+TV3",
+	"q3ElZgefRmA0OrVFsSBjeJqqxhTiJnd6xSEgFAx+zygCg2MdW67KxLAZXmjOEGOKIp4sSx3zLlPREKtk",
+	"kWTZYkEol0xWC0xM87NTkWuFoiuZwlvNeEvtX9Y7zPlhHkuKiyEPvfNtOIjKN9spJXRUoM8rJb8+8rxB",
+	"HkmcRy7rbtVYT2WZzTczWNO8m8PMA8R7ejA/nojPg/7LL5hB4ZPCygeoMVpU/V6cpNJkXCSqhwhe+0KS",
+	"NoyZFeNBa/HVf6hz85VGhfqoB9+qbEAUf/elokTLB2tXdE9rtnD5yxged7rbgG41AfBOwLkNISAHurt3",
+	"SlZ9R7acpfJV0b9ANID5I+ClyErvH9uCbNjVTf6aM2fViyatDAtjk9+XqFrD9bZ0OyqaGulh9+au+wUO",
+	"eZv9y9JHyoSUk7vMhFrUVHCEvmb4SmE83f1opZW1D13+0/Oij2lSz41kE/h1+JG0AVTynteIy8a2fpHY",
+	"GhPmR/34MaTsr22UE4uozBsLVc1dM8defLqlFHvdYWRN5UIt+P6vlhLKP19+vUZ+u5O2+1n8T5tkmzVm",
+	"9fJ2HGE2k1oTnuiTJg5d0R5Xl2MdJrTW1JFvC7J626FCbw+v1U4x+/E+FeQqOj79/qsjYU0TzSQ8pghe",
+	"7UwTKIqrybbp7aK1BSnu2MZ6+ksZNOoGdC4i15FO0WipDnh1u5FXYrw3YrhzDV6DGJqo4s4GpPESWPdY",
+	"S99ZbQe1Ajima9X6Yqm0wO3biuUUO3IO4LBY35ohQBi+52lX/daO263pEqlieIPoKiU3CYqnCBAKJgn0",
+	"Wi9qWruB2v2gekn5VKl7zkt/vVqF9NdL3+bYhlIg6bj3yVRXvver7gepVmHY31bnWFu5LkB4qPvkgkV6",
+	"/Je2FSgUFA5QjYPQO0DCyDmF7fNfvPcdp5xBpqsn206ZmGqShAmrZpvH3uzrciZvjO3zpDyAYV9r8PTr",
+	"D5HD0jfMYURWWUwJnyGqMKiMKjLG9Ea24XQezpsZTlBOKgnnKJkAMpngSDQhyrnO3U7EGTWhPGpME/Cj",
+	"2IliHd6eVjAKpWc6RKyv6bsxHkLf9yC+D61/W/p7DrGtz2qjW8cE3XsHNri76r3C7n4NBbKa8HbPHoEJ",
+	"vCYUc9TMNd1t3eME4vNMfB8XIl9YD1Ryy9d2znV5pR1h65zSh20FNmk/K8cA5QzNstkfkL2DMAOyyZ14",
+	"PJHvek3wDA9k8FryPsFQVSrjQjFFqIKtK7CszqQBan1OZ0Z4CD5n5rrHVh3b4nUeYus5nT1d7fkcg9eC",
+	"KNwMVbwut7ttON1dBUU3nHrjrrQw2fjXAiFzItJIFgkUvX3My98w20RON6ubUEktcQXpvkG8YWUPRG1f",
+	"PDqunsruQy40FQ+0FCDjT4X1NY9GFtxR9flDMKOHJo+7pvPfGOQslXMrMkVXOavWUj9ZXnV3Z2BJMnHO",
+	"JtKhUDD4iGdCM1bfV19W/kQR0mxGbhwaVJ113+zlcDkhtAsolDcVPoNp1VczY8ngMzRnKLlGVYs0Q9cX",
+	"CPizBVNLgp0v/UD8FdSk9/AKecYjV6OfkTlSSYYi7eKjjKidZ4zr9hHLQscIcDNDKZjDK932SEdbg4+2",
+	"2aLXo5ATMM/P6zdMxKmpiuoowZ/JRtazHjgV5HODGTL9EMFB/8Clg5imNfW9EBUz28BMlRtgo7Snwkh1",
+	"Kk9DqHaA5+0uIOOVjO9YawryBNsuP12AbhdCWJnuCNfkCsU+g2zkamdQwvhV+043SGho2JNsEZG5gK5p",
+	"X0ptmsR+aONsXGCeMkI8oxSlIvBcx7oTKivnxFmijuBY1i4Q51zdZnAKJhnPKGoWVB8N0H9ta8W2rpSk",
+	"Yju5FvPYjNhMiWKDelBCgbzBGoEnWbRk0qA5Z8Z0ofWbs1hNoljl25KVgMD0deEzS10Kwm9TwtEhsO1E",
+	"AmLfT7TITf1dZX/avxJyHktCToiMTEeq1pn56v1AYrpVFvyKIz4hkhQImeR0E3kUSCJZmm2+U9/m+B5y",
+	"+FcuEVUGpG1ev/oUFBbxKOlBMvo2hCBffEAKMGJkdUnhff9Iwtc1PZglPTZC4Pi6rjCv6ximbaaAoojQ",
+	"GOXS9QDMYsxBQqbmOn0jk885p3icKZ1Ct2KHqbWCO0rapnRtUogGZsl/Dn3ILOex5PqZWyJ0aH5cJK+u",
+	"S18kGERdsIWVSQERcHcYdWwJZvAaWS+ucv+qYEwdrqktPTJiqMppK2fZkm7WWNx/s0CLL8Kfj/Q2rH49",
+	"9ykKXYspH+Tq0MTcThQoG/IUNcpj4yjIrO1x8RN0y1Eaf2F+YnA0J1RbCEga5C5VjIVp0YyZNkHoGjFi",
+	"cUyXOStGjJhoM2YEumf564IsTYz25/1ua6KZG6gNhwG6lEtuSphxIvTKCCbJUr9aZRo8kRuxgWkwN8BD",
+	"+EnsHP/CUWsK6RuzYEM0XzLWUwfgQWv+c6RMJuXT6Ed1irelFJBm+zHynS8FbbkmjtOwbzPt+mGcxZH+",
+	"Og0PGsPpnC3I28s1zsUjOA0lIWRbTtt4nupTkI+8LzurdM0w9bmOOCU3zj/Z9cuhKZdVccyCgGpxvjY+",
+	"Vuv5m8wQrQhndXLBilzIFVqJXPC2AuWFoTnnKLGqjYDJFcZD15hkzNcGwMlkgpTfBM/nKMaQo2QJqjaS",
+	"XKH6K9FXf6051yizpZPaEoVKD5ujxrtMuFeTc2MlZDpVJiJ5xqusfe/Reka+jM/yGZKWzRcU5NR5soPN",
+	"plVslWEDvqOkJa78VLqG25/vVqnFik1w+0K5Y42ItIRdrPLCwWuSpXHQN1KN1O49JSFKKBC9NsNmNOkc",
+	"dmacLw53dxMSwWRGGD980X/R79z9ZEH7bOa0IN517W+STfk/+MUPWOfup7v/PwCIm+E+iGABAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -33,7 +33,19 @@ import type {
   UserLookupAccessRuleParams,
   ListFavoritesResponseResponse,
   FavoriteDetail,
-  CreateFavoriteRequestBody
+  CreateFavoriteRequestBody,
+  ListDelegationsResponseResponse,
+  Delegation,
+  CreateDelegationRequestBody,
+  ListBreakGlassReviewsResponseResponse,
+  UserListBreakGlassReviewsParams,
+  BreakGlassReview,
+  ReviewBreakGlassRequestBody,
+  Request,
+  ExtendRequestRequestBody,
+  ReviewRequestExtensionRequestBody,
+  ListRequestActivityResponseResponse,
+  UserListRequestActivityParams
 } from '.././types'
 import type {
   AccessInstructions
@@ -395,6 +407,51 @@ export const useUserListRequestEvents = <TError = ErrorType<ErrorResponseRespons
   }
 }
 
+/**
+ * Lists the actions recorded in provider audit logs which were attributed to the grant of the request.
+Returns a HTTP401 response if the user is not the requestor or a reviewer.
+
+ * @summary List request activity
+ */
+export const userListRequestActivity = (
+    requestId: string,
+    params?: UserListRequestActivityParams,
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<ListRequestActivityResponseResponse>(
+      {url: `/api/v1/requests/${requestId}/activity`, method: 'get',
+        params
+    },
+      options);
+    }
+  
+
+export const getUserListRequestActivityKey = (requestId: string,
+    params?: UserListRequestActivityParams,) => [`/api/v1/requests/${requestId}/activity`, ...(params ? [params]: [])];
+
+    
+export type UserListRequestActivityQueryResult = NonNullable<Awaited<ReturnType<typeof userListRequestActivity>>>
+export type UserListRequestActivityQueryError = ErrorType<ErrorResponseResponse>
+
+export const useUserListRequestActivity = <TError = ErrorType<ErrorResponseResponse>>(
+ requestId: string,
+    params?: UserListRequestActivityParams, options?: { swr?:SWRConfiguration<Awaited<ReturnType<typeof userListRequestActivity>>, TError> & { swrKey?: Key, enabled?: boolean }, request?: SecondParameter<typeof customInstance> }
+
+  ) => {
+
+  const {swr: swrOptions, request: requestOptions} = options ?? {}
+
+  const isEnabled = swrOptions?.enabled !== false && !!(requestId)
+    const swrKey = swrOptions?.swrKey ?? (() => isEnabled ? getUserListRequestActivityKey(requestId,params) : null);
+  const swrFn = () => userListRequestActivity(requestId,params, requestOptions);
+
+  const query = useSwr<Awaited<ReturnType<typeof swrFn>>, TError>(swrKey, swrFn, swrOptions)
+
+  return {
+    swrKey,
+    ...query
+  }
+}
+
 /**
  * Review an access request made by a user. The reviewing user must be an approver for a request. Users cannot review their own requests, even if they are an approver for the Access Rule.
  * @summary Review a request
@@ -426,6 +483,39 @@ export const userCancelRequest = (
     }
   
 
+/**
+ * Users can request more time on an access request they have created while its grant is active. The extension is reviewed by the approvers of the Access Rule, unless the Access Rule does not require approval or the extension is automatically approved.
+ * @summary Extend a request
+ */
+export const userExtendRequest = (
+    requestId: string,
+    extendRequestRequestBody: ExtendRequestRequestBody,
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<Request>(
+      {url: `/api/v1/requests/${requestId}/extend`, method: 'post',
+      headers: {'Content-Type': 'application/json', },
+      data: extendRequestRequestBody
+    },
+      options);
+    }
+  
+
+/**
+ * @summary Review a request extension
+ */
+export const userReviewRequestExtension = (
+    requestId: string,
+    reviewRequestExtensionRequestBody: ReviewRequestExtensionRequestBody,
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<Request>(
+      {url: `/api/v1/requests/${requestId}/extension/review`, method: 'post',
+      headers: {'Content-Type': 'application/json', },
+      data: reviewRequestExtensionRequestBody
+    },
+      options);
+    }
+  
+
 /**
  * Admins and approvers can revoke access previously approved. Effective immediately 
  * @summary Revoke an active request
@@ -802,3 +892,129 @@ export const userUpdateFavorite = (
     }
   
 
+/**
+ * Returns the delegations the user has created for their approvals.
+ * @summary List Delegations
+ */
+export const userListDelegations = (
+    
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<ListDelegationsResponseResponse>(
+      {url: `/api/v1/delegations`, method: 'get'
+    },
+      options);
+    }
+  
+
+export const getUserListDelegationsKey = () => [`/api/v1/delegations`];
+
+    
+export type UserListDelegationsQueryResult = NonNullable<Awaited<ReturnType<typeof userListDelegations>>>
+export type UserListDelegationsQueryError = ErrorType<ErrorResponseResponse>
+
+export const useUserListDelegations = <TError = ErrorType<ErrorResponseResponse>>(
+  options?: { swr?:SWRConfiguration<Awaited<ReturnType<typeof userListDelegations>>, TError> & { swrKey?: Key, enabled?: boolean }, request?: SecondParameter<typeof customInstance> }
+
+  ) => {
+
+  const {swr: swrOptions, request: requestOptions} = options ?? {}
+
+  const isEnabled = swrOptions?.enabled !== false
+    const swrKey = swrOptions?.swrKey ?? (() => isEnabled ? getUserListDelegationsKey() : null);
+  const swrFn = () => userListDelegations(requestOptions);
+
+  const query = useSwr<Awaited<ReturnType<typeof swrFn>>, TError>(swrKey, swrFn, swrOptions)
+
+  return {
+    swrKey,
+    ...query
+  }
+}
+
+/**
+ * Delegates the user's approvals to another user for a time window, such as while the user is out of office. Access requests created during the window can be reviewed by the delegate.
+ * @summary Create Delegation
+ */
+export const userCreateDelegation = (
+    createDelegationRequestBody: CreateDelegationRequestBody,
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<Delegation>(
+      {url: `/api/v1/delegations`, method: 'post',
+      headers: {'Content-Type': 'application/json', },
+      data: createDelegationRequestBody
+    },
+      options);
+    }
+  
+
+/**
+ * Delete a delegation
+ * @summary Delete Delegation
+ */
+export const userDeleteDelegation = (
+    id: string,
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<void>(
+      {url: `/api/v1/delegations/${id}`, method: 'delete'
+    },
+      options);
+    }
+  
+
+/**
+ * Returns the post-incident reviews of break-glass requests which the user is an approver for.
+ * @summary List Break-Glass Reviews
+ */
+export const userListBreakGlassReviews = (
+    params?: UserListBreakGlassReviewsParams,
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<ListBreakGlassReviewsResponseResponse>(
+      {url: `/api/v1/break-glass-reviews`, method: 'get',
+        params
+    },
+      options);
+    }
+  
+
+export const getUserListBreakGlassReviewsKey = (params?: UserListBreakGlassReviewsParams,) => [`/api/v1/break-glass-reviews`, ...(params ? [params]: [])];
+
+    
+export type UserListBreakGlassReviewsQueryResult = NonNullable<Awaited<ReturnType<typeof userListBreakGlassReviews>>>
+export type UserListBreakGlassReviewsQueryError = ErrorType<ErrorResponseResponse>
+
+export const useUserListBreakGlassReviews = <TError = ErrorType<ErrorResponseResponse>>(
+ params?: UserListBreakGlassReviewsParams, options?: { swr?:SWRConfiguration<Awaited<ReturnType<typeof userListBreakGlassReviews>>, TError> & { swrKey?: Key, enabled?: boolean }, request?: SecondParameter<typeof customInstance> }
+
+  ) => {
+
+  const {swr: swrOptions, request: requestOptions} = options ?? {}
+
+  const isEnabled = swrOptions?.enabled !== false
+    const swrKey = swrOptions?.swrKey ?? (() => isEnabled ? getUserListBreakGlassReviewsKey(params) : null);
+  const swrFn = () => userListBreakGlassReviews(params, requestOptions);
+
+  const query = useSwr<Awaited<ReturnType<typeof swrFn>>, TError>(swrKey, swrFn, swrOptions)
+
+  return {
+    swrKey,
+    ...query
+  }
+}
+
+/**
+ * Acknowledge or flag a request which was made using break-glass access. The review can only be completed by an approver of the access rule.
+ * @summary Review Break-Glass Request
+ */
+export const userReviewBreakGlassRequest = (
+    requestId: string,
+    reviewBreakGlassRequestBody: ReviewBreakGlassRequestBody,
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<BreakGlassReview>(
+      {url: `/api/v1/break-glass-reviews/${requestId}/review`, method: 'post',
+      headers: {'Content-Type': 'application/json', },
+      data: reviewBreakGlassRequestBody
+    },
+      options);
+    }
+  
+
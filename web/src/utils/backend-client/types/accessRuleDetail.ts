@@ -7,6 +7,8 @@
  */
 import type { AccessRuleStatus } from './accessRuleStatus';
 import type { ApproverConfig } from './approverConfig';
+import type { BreakGlassConfig } from './breakGlassConfig';
+import type { TicketReferenceConfig } from './ticketReferenceConfig';
 import type { AccessRuleMetadata } from './accessRuleMetadata';
 import type { AccessRuleTargetDetail } from './accessRuleTargetDetail';
 import type { TimeConstraints } from './timeConstraints';
@@ -24,6 +26,8 @@ When a rule is updated, it's ID remains consistent.
   /** The group IDs that the access rule applies to. */
   groups: string[];
   approval: ApproverConfig;
+  breakGlass?: BreakGlassConfig;
+  ticketReference?: TicketReferenceConfig;
   name: string;
   description: string;
   metadata: AccessRuleMetadata;
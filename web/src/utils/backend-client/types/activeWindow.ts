@@ -0,0 +1,22 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+import type { Weekday } from './weekday';
+
+/**
+ * The days of the week and hours of the day that access can be active, in a given timezone.
+ */
+export interface ActiveWindow {
+  /** An IANA timezone name, such as Australia/Sydney. */
+  timezone: string;
+  /** The days of the week that access can be active. If empty, access can be active on any day. */
+  weekdays?: Weekday[];
+  /** The time of day that access can become active from, in HH:MM format. */
+  startTime: string;
+  /** The time of day that access must end by, in HH:MM format. Use 24:00 for the end of the day. */
+  endTime: string;
+}
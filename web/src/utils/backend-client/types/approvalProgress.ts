@@ -0,0 +1,20 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * Progress made towards an approval step of a request.
+ */
+export interface ApprovalProgress {
+  /** The index of the approval step. */
+  step: number;
+  stepName: string;
+  /** The number of approvals the step has received. */
+  approvals: number;
+  /** The number of approvals required to complete the step. */
+  requiredApprovals: number;
+}
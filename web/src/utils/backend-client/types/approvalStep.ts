@@ -0,0 +1,20 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * A single stage of a multi-stage approval workflow.
+ */
+export interface ApprovalStep {
+  name: string;
+  /** The user IDs of the approvers for this step. */
+  users: string[];
+  /** The group IDs whose members may approve this step. */
+  groups: string[];
+  /** The number of distinct approvals required to complete this step. */
+  requiredApprovals: number;
+}
@@ -5,6 +5,8 @@
  * Common Fate API
  * OpenAPI spec version: 1.0
  */
+import type { ApprovalStep } from './approvalStep';
+import type { Escalation } from './escalation';
 
 /**
  * Approver config for access rules
@@ -13,4 +15,7 @@ export interface ApproverConfig {
   /** The user IDs of the approvers for the request. */
   users?: string[];
   groups?: string[];
+  /** Ordered approval steps. When steps are provided, every step must be satisfied in order before the request is approved, and the top level users and groups are ignored. */
+  steps?: ApprovalStep[];
+  escalation?: Escalation;
 }
@@ -0,0 +1,16 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * A period when access can't be active.
+ */
+export interface Blackout {
+  start: string;
+  end: string;
+  reason?: string;
+}
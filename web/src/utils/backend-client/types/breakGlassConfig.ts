@@ -0,0 +1,17 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * Break-glass settings for an access rule. Permitted users can approve their own requests immediately during an incident, and the request is reviewed by the rule's approvers afterwards.
+ */
+export interface BreakGlassConfig {
+  /** The user IDs of the users permitted to use break-glass access. */
+  users: string[];
+  /** The group IDs whose members are permitted to use break-glass access. */
+  groups: string[];
+}
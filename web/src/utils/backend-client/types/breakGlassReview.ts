@@ -0,0 +1,23 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+import type { BreakGlassReviewStatus } from './breakGlassReviewStatus';
+
+/**
+ * A post-incident review of a request made using break-glass access.
+ */
+export interface BreakGlassReview {
+  requestId: string;
+  accessRuleId: string;
+  requestedBy: string;
+  reason: string;
+  status: BreakGlassReviewStatus;
+  reviewedBy?: string;
+  comment?: string;
+  createdAt: string;
+  updatedAt: string;
+}
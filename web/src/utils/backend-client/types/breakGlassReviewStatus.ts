@@ -0,0 +1,20 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * The status of the post-incident review of a break-glass request.
+ */
+export type BreakGlassReviewStatus = typeof BreakGlassReviewStatus[keyof typeof BreakGlassReviewStatus];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const BreakGlassReviewStatus = {
+  OPEN: 'OPEN',
+  ACKNOWLEDGED: 'ACKNOWLEDGED',
+  FLAGGED: 'FLAGGED',
+} as const;
@@ -6,13 +6,17 @@
  * OpenAPI spec version: 1.0
  */
 import type { ApproverConfig } from './approverConfig';
+import type { BreakGlassConfig } from './breakGlassConfig';
 import type { CreateAccessRuleTarget } from './createAccessRuleTarget';
 import type { TimeConstraints } from './timeConstraints';
+import type { TicketReferenceConfig } from './ticketReferenceConfig';
 
 export type CreateAccessRuleRequestBody = {
   /** The group IDs that the access rule applies to. */
   groups: string[];
   approval: ApproverConfig;
+  breakGlass?: BreakGlassConfig;
+  ticketReference?: TicketReferenceConfig;
   name: string;
   description: string;
   target: CreateAccessRuleTarget;
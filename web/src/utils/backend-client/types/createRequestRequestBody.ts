@@ -13,4 +13,11 @@ export type CreateRequestRequestBody = {
   reason?: string;
   timing: RequestTiming;
   with?: CreateRequestWithSubRequest;
+  /** Use break-glass access to approve the request immediately. A reason is required, and the request is reviewed by the rule's approvers afterwards. */
+  breakGlass?: boolean;
+  /**
+   * A ticket reference, such as a Jira issue key or an incident ID. Required if the access rule has a ticket reference configured.
+   * @maxLength 256
+   */
+  ticketReference?: string;
 };
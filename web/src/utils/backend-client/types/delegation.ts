@@ -0,0 +1,18 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * A delegation of a user's approvals to another user for a time window.
+ */
+export interface Delegation {
+  id: string;
+  /** The ID of the user who can review requests on behalf of the approver. */
+  delegateId: string;
+  startTime: string;
+  endTime: string;
+}
@@ -0,0 +1,21 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * Escalation settings applied to requests which have not been reviewed in time.
+ */
+export interface Escalation {
+  /** The number of minutes a request may be pending before it is escalated. */
+  afterMinutes: number;
+  /** The user IDs of the reviewers added when the request is escalated. */
+  users: string[];
+  /** The group IDs whose members are added as reviewers when the request is escalated. */
+  groups: string[];
+  /** If set, the request is automatically declined once it has been pending for this many minutes. */
+  autoDeclineAfterMinutes?: number;
+}
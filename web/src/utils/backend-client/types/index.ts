@@ -9,6 +9,7 @@ export * from './accessRuleTargetDetailArgumentsFormElement';
 export * from './accessRuleTargetDetailArgumentsGroupings';
 export * from './accessRuleTargetDetailWith';
 export * from './accessTokenResponseResponse';
+export * from './activeWindow';
 export * from './adminDeleteHandler204';
 export * from './adminListAccessRulesParams';
 export * from './adminListAccessRulesStatus';
@@ -21,14 +22,21 @@ export * from './adminListUsersParams';
 export * from './adminRemoveTargetGroupLinkParams';
 export * from './adminUpdateUserBody';
 export * from './approvalMethod';
+export * from './approvalProgress';
+export * from './approvalStep';
 export * from './approverConfig';
 export * from './authUserResponseResponse';
+export * from './blackout';
+export * from './breakGlassConfig';
+export * from './breakGlassReview';
+export * from './breakGlassReviewStatus';
 export * from './completeProviderSetupResponseResponse';
 export * from './createAccessRuleRequestBody';
 export * from './createAccessRuleTarget';
 export * from './createAccessRuleTargetDetailArguments';
 export * from './createAccessRuleTargetDetailArgumentsGroupings';
 export * from './createAccessRuleTargetWith';
+export * from './createDelegationRequestBody';
 export * from './createFavoriteRequestBody';
 export * from './createGroupRequestBody';
 export * from './createProviderSetupRequestBody';
@@ -39,9 +47,12 @@ export * from './createRequestWithSubRequest';
 export * from './createTargetGroupLinkBody';
 export * from './createTargetGroupRequestBody';
 export * from './createUserRequestBody';
+export * from './delegation';
 export * from './deploymentVersionResponseResponse';
 export * from './diagnostic';
 export * from './errorResponseResponse';
+export * from './escalation';
+export * from './extendRequestRequestBody';
 export * from './favorite';
 export * from './favoriteDetail';
 export * from './grant';
@@ -53,10 +64,13 @@ export * from './keyValue';
 export * from './listAccessRuleApproversResponseResponse';
 export * from './listAccessRulesDetailResponseResponse';
 export * from './listAccessRulesResponseResponse';
+export * from './listBreakGlassReviewsResponseResponse';
+export * from './listDelegationsResponseResponse';
 export * from './listFavoritesResponseResponse';
 export * from './listGroupsResponseResponse';
 export * from './listHandlersResponseResponse';
 export * from './listProviderSetupsResponseResponse';
+export * from './listRequestActivityResponseResponse';
 export * from './listRequestEventsResponseResponse';
 export * from './listRequestsResponseResponse';
 export * from './listTargetGroupResponseResponse';
@@ -78,23 +92,34 @@ export * from './providerSetupStepDetails';
 export * from './providerSetupStepOverview';
 export * from './providerSetupValidation';
 export * from './providerSetupValidationStatus';
+export * from './recurrenceFrequency';
 export * from './registerHandlerRequestBody';
 export * from './request';
 export * from './requestAccessRule';
 export * from './requestAccessRuleTarget';
 export * from './requestAccessRuleTargetArguments';
+export * from './requestActivity';
+export * from './requestActivityAttribution';
 export * from './requestArgument';
 export * from './requestArgumentFormElement';
+export * from './requestBreakGlass';
 export * from './requestDetail';
 export * from './requestDetailArguments';
+export * from './requestEscalation';
+export * from './requestEscalationAction';
 export * from './requestEvent';
 export * from './requestEventFromGrantStatus';
 export * from './requestEventRecordedEvent';
 export * from './requestEventToGrantStatus';
+export * from './requestExtension';
+export * from './requestExtensionStatus';
+export * from './requestRecurrence';
 export * from './requestStatus';
 export * from './requestTiming';
+export * from './reviewBreakGlassRequestBody';
 export * from './reviewDecision';
 export * from './reviewRequestBody';
+export * from './reviewRequestExtensionRequestBody';
 export * from './reviewResponseResponse';
 export * from './tGHandler';
 export * from './targetArgument';
@@ -106,14 +131,18 @@ export * from './targetGroup';
 export * from './targetGroupFrom';
 export * from './targetRoute';
 export * from './targetSchema';
+export * from './ticketReferenceConfig';
 export * from './timeConstraints';
 export * from './user';
 export * from './userCancelRequest200';
+export * from './userListBreakGlassReviewsParams';
+export * from './userListRequestActivityParams';
 export * from './userListRequestsParams';
 export * from './userListRequestsPastParams';
 export * from './userListRequestsStatus';
 export * from './userListRequestsUpcomingParams';
 export * from './userLookupAccessRuleParams';
 export * from './userLookupAccessRuleType';
+export * from './weekday';
 export * from './with';
 export * from './withOption';
\ No newline at end of file
@@ -0,0 +1,13 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+import type { RequestActivity } from './requestActivity';
+
+export type ListRequestActivityResponseResponse = {
+  activity: RequestActivity[];
+  next: string | null;
+};
@@ -9,6 +9,7 @@ import type { RequestStatus } from './requestStatus';
 import type { RequestTiming } from './requestTiming';
 import type { Grant } from './grant';
 import type { ApprovalMethod } from './approvalMethod';
+import type { RequestExtension } from './requestExtension';
 
 /**
  * A request to access something made by an end user in Common Fate.
@@ -25,4 +26,9 @@ export interface Request {
   updatedAt: string;
   grant?: Grant;
   approvalMethod?: ApprovalMethod;
+  extension?: RequestExtension;
+  /** The ID of the recurring request which this request is an occurrence of. */
+  recurrenceOf?: string;
+  /** The ticket reference given when the request was made. */
+  ticketReference?: string;
 }
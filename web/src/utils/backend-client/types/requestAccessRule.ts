@@ -7,6 +7,7 @@
  */
 import type { RequestAccessRuleTarget } from './requestAccessRuleTarget';
 import type { TimeConstraints } from './timeConstraints';
+import type { TicketReferenceConfig } from './ticketReferenceConfig';
 
 /**
  * Access Rule contains information for an end user to make a request for access.
@@ -23,4 +24,7 @@ When a rule is updated, it's ID remains consistent.
   timeConstraints: TimeConstraints;
   isCurrent: boolean;
   canRequest: boolean;
+  /** Whether the user is permitted to use break-glass access for this rule. */
+  canBreakGlass: boolean;
+  ticketReference?: TicketReferenceConfig;
 }
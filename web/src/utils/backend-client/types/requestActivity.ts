@@ -0,0 +1,27 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+import type { RequestActivityAttribution } from './requestActivityAttribution';
+
+/**
+ * An action recorded in a provider audit log which was performed using the grant of an access request.
+ */
+export interface RequestActivity {
+  /** The ID of the record in the source audit log. */
+  id: string;
+  requestId: string;
+  /** The audit log the action was read from, e.g. aws-cloudtrail. */
+  source: string;
+  subject: string;
+  sessionId?: string;
+  account?: string;
+  action: string;
+  resource?: string;
+  sourceIp?: string;
+  timestamp: string;
+  attributedBy: RequestActivityAttribution;
+}
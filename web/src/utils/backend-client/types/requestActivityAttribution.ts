@@ -0,0 +1,19 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * How an audit log record was attributed to the grant of a request.
+ */
+export type RequestActivityAttribution = typeof RequestActivityAttribution[keyof typeof RequestActivityAttribution];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const RequestActivityAttribution = {
+  SUBJECT: 'SUBJECT',
+  SESSION: 'SESSION',
+} as const;
@@ -0,0 +1,19 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+import type { BreakGlassReviewStatus } from './breakGlassReviewStatus';
+
+/**
+ * Break-glass usage of a request, or the outcome of its post-incident review.
+ */
+export interface RequestBreakGlass {
+  /** The reason given by the user when using break-glass access. */
+  reason?: string;
+  reviewStatus: BreakGlassReviewStatus;
+  /** The comment left by the approver who reviewed the break-glass request. */
+  comment?: string;
+}
@@ -10,6 +10,7 @@ import type { RequestTiming } from './requestTiming';
 import type { AccessRule } from './accessRule';
 import type { Grant } from './grant';
 import type { ApprovalMethod } from './approvalMethod';
+import type { RequestExtension } from './requestExtension';
 import type { RequestDetailArguments } from './requestDetailArguments';
 
 /**
@@ -28,5 +29,10 @@ export interface RequestDetail {
   /** true if the requesting user is a reviewer of this request. */
   canReview: boolean;
   approvalMethod?: ApprovalMethod;
+  extension?: RequestExtension;
+  /** The ID of the recurring request which this request is an occurrence of. */
+  recurrenceOf?: string;
+  /** The ticket reference given when the request was made. */
+  ticketReference?: string;
   arguments: RequestDetailArguments;
 }
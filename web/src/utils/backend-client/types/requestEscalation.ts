@@ -0,0 +1,17 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+import type { RequestEscalationAction } from './requestEscalationAction';
+
+/**
+ * An escalation applied to a request which was not reviewed in time.
+ */
+export interface RequestEscalation {
+  action: RequestEscalationAction;
+  /** The user IDs of the reviewers added to the request by the escalation. */
+  addedReviewers: string[];
+}
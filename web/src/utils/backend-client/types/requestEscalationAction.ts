@@ -0,0 +1,16 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+
+export type RequestEscalationAction = typeof RequestEscalationAction[keyof typeof RequestEscalationAction];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const RequestEscalationAction = {
+  ESCALATED: 'ESCALATED',
+  AUTO_DECLINED: 'AUTO_DECLINED',
+} as const;
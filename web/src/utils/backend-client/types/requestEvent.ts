@@ -10,6 +10,9 @@ import type { RequestTiming } from './requestTiming';
 import type { RequestEventFromGrantStatus } from './requestEventFromGrantStatus';
 import type { RequestEventToGrantStatus } from './requestEventToGrantStatus';
 import type { RequestEventRecordedEvent } from './requestEventRecordedEvent';
+import type { ApprovalProgress } from './approvalProgress';
+import type { RequestEscalation } from './requestEscalation';
+import type { RequestBreakGlass } from './requestBreakGlass';
 
 export interface RequestEvent {
   id: string;
@@ -29,4 +32,9 @@ export interface RequestEvent {
   grantFailureReason?: string;
   /** An event which was recorded relating to the grant. */
   recordedEvent?: RequestEventRecordedEvent;
+  approvalProgress?: ApprovalProgress;
+  /** The IDs of the approvers who delegated their review to the actor. */
+  onBehalfOf?: string[];
+  escalation?: RequestEscalation;
+  breakGlass?: RequestBreakGlass;
 }
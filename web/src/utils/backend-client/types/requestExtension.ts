@@ -0,0 +1,20 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+import type { RequestExtensionStatus } from './requestExtensionStatus';
+
+/**
+ * The most recent request to extend the grant of an access request.
+ */
+export interface RequestExtension {
+  status: RequestExtensionStatus;
+  /** The number of seconds the grant is extended by. */
+  extendBySeconds: number;
+  reason?: string;
+  requestedAt: string;
+  updatedAt: string;
+}
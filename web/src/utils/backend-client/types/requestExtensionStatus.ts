@@ -0,0 +1,20 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * The status of a request to extend an active grant.
+ */
+export type RequestExtensionStatus = typeof RequestExtensionStatus[keyof typeof RequestExtensionStatus];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const RequestExtensionStatus = {
+  PENDING: 'PENDING',
+  APPROVED: 'APPROVED',
+  DECLINED: 'DECLINED',
+} as const;
@@ -0,0 +1,30 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+import type { RecurrenceFrequency } from './recurrenceFrequency';
+import type { Weekday } from './weekday';
+
+/**
+ * Repeats a scheduled request, following a subset of the iCalendar RRULE format.
+Each occurrence starts at the same time of day as the start time of the request and lasts for the duration of the request.
+The request is approved once, and each occurrence is granted separately.
+
+ */
+export interface RequestRecurrence {
+  frequency: RecurrenceFrequency;
+  /**
+   * The number of days or weeks between occurrences. Defaults to 1.
+   * @minimum 1
+   */
+  interval?: number;
+  /** The days of the week a weekly recurrence occurs on. Defaults to the weekday of the start time. */
+  byWeekday?: Weekday[];
+  /** No occurrences start at or after this time. */
+  until: string;
+  /** The recurrence in iCalendar RRULE format. */
+  readonly rrule?: string;
+}
@@ -5,9 +5,11 @@
  * Common Fate API
  * OpenAPI spec version: 1.0
  */
+import type { RequestRecurrence } from './requestRecurrence';
 
 export interface RequestTiming {
   durationSeconds: number;
   /** iso8601 timestamp in UTC timezone */
   startTime?: string;
+  recurrence?: RequestRecurrence;
 }
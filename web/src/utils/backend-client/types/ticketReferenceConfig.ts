@@ -0,0 +1,19 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * Requires requests for an access rule to include a ticket reference, such as a Jira issue key or an incident ID.
+ */
+export interface TicketReferenceConfig {
+  /** A regular expression which the ticket reference must match. */
+  pattern?: string;
+  /** Describes the ticket reference to users making a request. */
+  description?: string;
+  /** Check the ticket reference with the ticket validator configured for the deployment. */
+  validate?: boolean;
+}
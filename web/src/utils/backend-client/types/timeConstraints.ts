@@ -5,6 +5,8 @@
  * Common Fate API
  * OpenAPI spec version: 1.0
  */
+import type { ActiveWindow } from './activeWindow';
+import type { Blackout } from './blackout';
 
 /**
  * Time configuration for an Access Rule.
@@ -12,4 +14,12 @@
 export interface TimeConstraints {
   /** The maximum duration in seconds the access is allowed for. */
   maxDurationSeconds: number;
+  activeWindow?: ActiveWindow;
+  /** Periods when access can't be active, such as change freezes. */
+  blackouts?: Blackout[];
+  /**
+   * The minimum number of seconds between a scheduled request being made and its access starting.
+   * @minimum 0
+   */
+  minimumNoticeSeconds?: number;
 }
@@ -0,0 +1,24 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * A day of the week, using iCalendar RRULE day codes.
+ */
+export type Weekday = typeof Weekday[keyof typeof Weekday];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const Weekday = {
+  MO: 'MO',
+  TU: 'TU',
+  WE: 'WE',
+  TH: 'TH',
+  FR: 'FR',
+  SA: 'SA',
+  SU: 'SU',
+} as const;
@@ -26,6 +26,16 @@ export const registeredProviders: RegisteredProvider[] = [
     shortType: "azure-ad",
     name: "Azure AD Groups",
   },
+  {
+    type: "commonfate/github-teams",
+    shortType: "github-teams",
+    name: "GitHub Teams",
+  },
+  {
+    type: "commonfate/github-repos",
+    shortType: "github-repos",
+    name: "GitHub Repository Roles",
+  },
   {
     type: "commonfate/aws-eks-roles-sso",
     shortType: "aws-eks-roles-sso",
@@ -38,6 +48,12 @@ export const registeredProviders: RegisteredProvider[] = [
     name: "ECS Exec (with AWS SSO)",
     alpha: true,
   },
+  {
+    type: "commonfate/database",
+    shortType: "database",
+    name: "PostgreSQL and MySQL Roles",
+    alpha: true,
+  },
   {
     type: "commonfate/testvault",
     shortType: "testvault",